@@ -307,8 +307,11 @@ type SSO_TokenPair struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Access  string `protobuf:"bytes,1,opt,name=access,proto3" json:"access,omitempty"`
-	Refresh string `protobuf:"bytes,2,opt,name=refresh,proto3" json:"refresh,omitempty"`
+	Access                 string             `protobuf:"bytes,1,opt,name=access,proto3" json:"access,omitempty"`
+	Refresh                string             `protobuf:"bytes,2,opt,name=refresh,proto3" json:"refresh,omitempty"`
+	Challenge              *SSO_MFAChallenge  `protobuf:"bytes,3,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	Approval               *SSO_LoginApproval `protobuf:"bytes,4,opt,name=approval,proto3" json:"approval,omitempty"`
+	PasswordChangeRequired bool               `protobuf:"varint,5,opt,name=password_change_required,json=passwordChangeRequired,proto3" json:"password_change_required,omitempty"`
 }
 
 func (x *SSO_TokenPair) Reset() {
@@ -357,35 +360,967 @@ func (x *SSO_TokenPair) GetRefresh() string {
 	return ""
 }
 
+func (x *SSO_TokenPair) GetChallenge() *SSO_MFAChallenge {
+	if x != nil {
+		return x.Challenge
+	}
+	return nil
+}
+
+func (x *SSO_TokenPair) GetApproval() *SSO_LoginApproval {
+	if x != nil {
+		return x.Approval
+	}
+	return nil
+}
+
+func (x *SSO_TokenPair) GetPasswordChangeRequired() bool {
+	if x != nil {
+		return x.PasswordChangeRequired
+	}
+	return false
+}
+
+type SSO_LoginApproval struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Number int32  `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+}
+
+func (x *SSO_LoginApproval) Reset() {
+	*x = SSO_LoginApproval{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SSO_LoginApproval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSO_LoginApproval) ProtoMessage() {}
+
+func (x *SSO_LoginApproval) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSO_LoginApproval.ProtoReflect.Descriptor instead.
+func (*SSO_LoginApproval) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SSO_LoginApproval) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SSO_LoginApproval) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+type SSO_PendingLogin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DeviceType string                 `protobuf:"bytes,2,opt,name=device_type,json=deviceType,proto3" json:"device_type,omitempty"`
+	Os         string                 `protobuf:"bytes,3,opt,name=os,proto3" json:"os,omitempty"`
+	Browser    string                 `protobuf:"bytes,4,opt,name=browser,proto3" json:"browser,omitempty"`
+	Ip         string                 `protobuf:"bytes,5,opt,name=ip,proto3" json:"ip,omitempty"`
+	Choices    []int32                `protobuf:"varint,6,rep,packed,name=choices,proto3" json:"choices,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *SSO_PendingLogin) Reset() {
+	*x = SSO_PendingLogin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SSO_PendingLogin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSO_PendingLogin) ProtoMessage() {}
+
+func (x *SSO_PendingLogin) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSO_PendingLogin.ProtoReflect.Descriptor instead.
+func (*SSO_PendingLogin) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SSO_PendingLogin) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SSO_PendingLogin) GetDeviceType() string {
+	if x != nil {
+		return x.DeviceType
+	}
+	return ""
+}
+
+func (x *SSO_PendingLogin) GetOs() string {
+	if x != nil {
+		return x.Os
+	}
+	return ""
+}
+
+func (x *SSO_PendingLogin) GetBrowser() string {
+	if x != nil {
+		return x.Browser
+	}
+	return ""
+}
+
+func (x *SSO_PendingLogin) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *SSO_PendingLogin) GetChoices() []int32 {
+	if x != nil {
+		return x.Choices
+	}
+	return nil
+}
+
+func (x *SSO_PendingLogin) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type SSO_ListPendingLoginsRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*SSO_PendingLogin `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *SSO_ListPendingLoginsRes) Reset() {
+	*x = SSO_ListPendingLoginsRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SSO_ListPendingLoginsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSO_ListPendingLoginsRes) ProtoMessage() {}
+
+func (x *SSO_ListPendingLoginsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSO_ListPendingLoginsRes.ProtoReflect.Descriptor instead.
+func (*SSO_ListPendingLoginsRes) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SSO_ListPendingLoginsRes) GetData() []*SSO_PendingLogin {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type SSO_QRLoginRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url       string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Qr        []byte                 `protobuf:"bytes,3,opt,name=qr,proto3" json:"qr,omitempty"`
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *SSO_QRLoginRes) Reset() {
+	*x = SSO_QRLoginRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SSO_QRLoginRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSO_QRLoginRes) ProtoMessage() {}
+
+func (x *SSO_QRLoginRes) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSO_QRLoginRes.ProtoReflect.Descriptor instead.
+func (*SSO_QRLoginRes) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SSO_QRLoginRes) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SSO_QRLoginRes) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *SSO_QRLoginRes) GetQr() []byte {
+	if x != nil {
+		return x.Qr
+	}
+	return nil
+}
+
+func (x *SSO_QRLoginRes) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type SSO_QRLoginSession struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code       string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	DeviceType string                 `protobuf:"bytes,2,opt,name=device_type,json=deviceType,proto3" json:"device_type,omitempty"`
+	Os         string                 `protobuf:"bytes,3,opt,name=os,proto3" json:"os,omitempty"`
+	Browser    string                 `protobuf:"bytes,4,opt,name=browser,proto3" json:"browser,omitempty"`
+	Ip         string                 `protobuf:"bytes,5,opt,name=ip,proto3" json:"ip,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *SSO_QRLoginSession) Reset() {
+	*x = SSO_QRLoginSession{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SSO_QRLoginSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSO_QRLoginSession) ProtoMessage() {}
+
+func (x *SSO_QRLoginSession) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSO_QRLoginSession.ProtoReflect.Descriptor instead.
+func (*SSO_QRLoginSession) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SSO_QRLoginSession) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *SSO_QRLoginSession) GetDeviceType() string {
+	if x != nil {
+		return x.DeviceType
+	}
+	return ""
+}
+
+func (x *SSO_QRLoginSession) GetOs() string {
+	if x != nil {
+		return x.Os
+	}
+	return ""
+}
+
+func (x *SSO_QRLoginSession) GetBrowser() string {
+	if x != nil {
+		return x.Browser
+	}
+	return ""
+}
+
+func (x *SSO_QRLoginSession) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *SSO_QRLoginSession) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type SSO_ApproveLoginReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Approve bool   `protobuf:"varint,2,opt,name=approve,proto3" json:"approve,omitempty"`
+	Number  int32  `protobuf:"varint,3,opt,name=number,proto3" json:"number,omitempty"`
+}
+
+func (x *SSO_ApproveLoginReq) Reset() {
+	*x = SSO_ApproveLoginReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SSO_ApproveLoginReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSO_ApproveLoginReq) ProtoMessage() {}
+
+func (x *SSO_ApproveLoginReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSO_ApproveLoginReq.ProtoReflect.Descriptor instead.
+func (*SSO_ApproveLoginReq) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SSO_ApproveLoginReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SSO_ApproveLoginReq) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+func (x *SSO_ApproveLoginReq) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+type SSO_MFAChallenge struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token   string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Methods []string `protobuf:"bytes,2,rep,name=methods,proto3" json:"methods,omitempty"`
+}
+
+func (x *SSO_MFAChallenge) Reset() {
+	*x = SSO_MFAChallenge{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SSO_MFAChallenge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSO_MFAChallenge) ProtoMessage() {}
+
+func (x *SSO_MFAChallenge) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSO_MFAChallenge.ProtoReflect.Descriptor instead.
+func (*SSO_MFAChallenge) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SSO_MFAChallenge) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SSO_MFAChallenge) GetMethods() []string {
+	if x != nil {
+		return x.Methods
+	}
+	return nil
+}
+
 type SSO_ParseClaimsRes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uid   string      `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
-	Roles []*SSO_Role `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"`
-	Exp   int64       `protobuf:"varint,3,opt,name=exp,proto3" json:"exp,omitempty"`
-	Iat   int64       `protobuf:"varint,4,opt,name=iat,proto3" json:"iat,omitempty"`
-	Sub   string      `protobuf:"bytes,5,opt,name=sub,proto3" json:"sub,omitempty"`
+	Uid      string      `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Roles    []*SSO_Role `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"`
+	Exp      int64       `protobuf:"varint,3,opt,name=exp,proto3" json:"exp,omitempty"`
+	Iat      int64       `protobuf:"varint,4,opt,name=iat,proto3" json:"iat,omitempty"`
+	Sub      string      `protobuf:"bytes,5,opt,name=sub,proto3" json:"sub,omitempty"`
+	Amr      []string    `protobuf:"bytes,6,rep,name=amr,proto3" json:"amr,omitempty"`
+	Acr      string      `protobuf:"bytes,7,opt,name=acr,proto3" json:"acr,omitempty"`
+	AuthTime int64       `protobuf:"varint,8,opt,name=auth_time,json=authTime,proto3" json:"auth_time,omitempty"`
+}
+
+func (x *SSO_ParseClaimsRes) Reset() {
+	*x = SSO_ParseClaimsRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SSO_ParseClaimsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSO_ParseClaimsRes) ProtoMessage() {}
+
+func (x *SSO_ParseClaimsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSO_ParseClaimsRes.ProtoReflect.Descriptor instead.
+func (*SSO_ParseClaimsRes) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SSO_ParseClaimsRes) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *SSO_ParseClaimsRes) GetRoles() []*SSO_Role {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *SSO_ParseClaimsRes) GetExp() int64 {
+	if x != nil {
+		return x.Exp
+	}
+	return 0
+}
+
+func (x *SSO_ParseClaimsRes) GetIat() int64 {
+	if x != nil {
+		return x.Iat
+	}
+	return 0
+}
+
+func (x *SSO_ParseClaimsRes) GetSub() string {
+	if x != nil {
+		return x.Sub
+	}
+	return ""
+}
+
+func (x *SSO_ParseClaimsRes) GetAmr() []string {
+	if x != nil {
+		return x.Amr
+	}
+	return nil
+}
+
+func (x *SSO_ParseClaimsRes) GetAcr() string {
+	if x != nil {
+		return x.Acr
+	}
+	return ""
+}
+
+func (x *SSO_ParseClaimsRes) GetAuthTime() int64 {
+	if x != nil {
+		return x.AuthTime
+	}
+	return 0
+}
+
+type SSO_ReauthReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Password string `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	Code     string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *SSO_ReauthReq) Reset() {
+	*x = SSO_ReauthReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SSO_ReauthReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSO_ReauthReq) ProtoMessage() {}
+
+func (x *SSO_ReauthReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSO_ReauthReq.ProtoReflect.Descriptor instead.
+func (*SSO_ReauthReq) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SSO_ReauthReq) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *SSO_ReauthReq) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type SSO_SendLoginCodeReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email    string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Channel  string `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
+}
+
+func (x *SSO_SendLoginCodeReq) Reset() {
+	*x = SSO_SendLoginCodeReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SSO_SendLoginCodeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSO_SendLoginCodeReq) ProtoMessage() {}
+
+func (x *SSO_SendLoginCodeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSO_SendLoginCodeReq.ProtoReflect.Descriptor instead.
+func (*SSO_SendLoginCodeReq) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SSO_SendLoginCodeReq) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *SSO_SendLoginCodeReq) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *SSO_SendLoginCodeReq) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+type SSO_CheckLoginCodeReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Code  string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *SSO_CheckLoginCodeReq) Reset() {
+	*x = SSO_CheckLoginCodeReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SSO_CheckLoginCodeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSO_CheckLoginCodeReq) ProtoMessage() {}
+
+func (x *SSO_CheckLoginCodeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSO_CheckLoginCodeReq.ProtoReflect.Descriptor instead.
+func (*SSO_CheckLoginCodeReq) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SSO_CheckLoginCodeReq) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *SSO_CheckLoginCodeReq) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type SSO_VerifyMagicLinkReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Nonce string `protobuf:"bytes,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (x *SSO_VerifyMagicLinkReq) Reset() {
+	*x = SSO_VerifyMagicLinkReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SSO_VerifyMagicLinkReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSO_VerifyMagicLinkReq) ProtoMessage() {}
+
+func (x *SSO_VerifyMagicLinkReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSO_VerifyMagicLinkReq.ProtoReflect.Descriptor instead.
+func (*SSO_VerifyMagicLinkReq) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SSO_VerifyMagicLinkReq) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SSO_VerifyMagicLinkReq) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+type SSO_EmailMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email   string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Channel string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+}
+
+func (x *SSO_EmailMsg) Reset() {
+	*x = SSO_EmailMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SSO_EmailMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSO_EmailMsg) ProtoMessage() {}
+
+func (x *SSO_EmailMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSO_EmailMsg.ProtoReflect.Descriptor instead.
+func (*SSO_EmailMsg) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SSO_EmailMsg) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *SSO_EmailMsg) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+type SSO_CheckForgotPasswordEmailReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Password string `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	Token    string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *SSO_CheckForgotPasswordEmailReq) Reset() {
+	*x = SSO_CheckForgotPasswordEmailReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SSO_CheckForgotPasswordEmailReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSO_CheckForgotPasswordEmailReq) ProtoMessage() {}
+
+func (x *SSO_CheckForgotPasswordEmailReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSO_CheckForgotPasswordEmailReq.ProtoReflect.Descriptor instead.
+func (*SSO_CheckForgotPasswordEmailReq) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SSO_CheckForgotPasswordEmailReq) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *SSO_CheckForgotPasswordEmailReq) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type SSO_ChangePasswordReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CurrentPassword string `protobuf:"bytes,1,opt,name=current_password,json=currentPassword,proto3" json:"current_password,omitempty"`
+	Password        string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 }
 
-func (x *SSO_ParseClaimsRes) Reset() {
-	*x = SSO_ParseClaimsRes{}
+func (x *SSO_ChangePasswordReq) Reset() {
+	*x = SSO_ChangePasswordReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[7]
+		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SSO_ParseClaimsRes) String() string {
+func (x *SSO_ChangePasswordReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SSO_ParseClaimsRes) ProtoMessage() {}
+func (*SSO_ChangePasswordReq) ProtoMessage() {}
 
-func (x *SSO_ParseClaimsRes) ProtoReflect() protoreflect.Message {
-	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[7]
+func (x *SSO_ChangePasswordReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -396,72 +1331,161 @@ func (x *SSO_ParseClaimsRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SSO_ParseClaimsRes.ProtoReflect.Descriptor instead.
-func (*SSO_ParseClaimsRes) Descriptor() ([]byte, []int) {
-	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use SSO_ChangePasswordReq.ProtoReflect.Descriptor instead.
+func (*SSO_ChangePasswordReq) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *SSO_ParseClaimsRes) GetUid() string {
+func (x *SSO_ChangePasswordReq) GetCurrentPassword() string {
 	if x != nil {
-		return x.Uid
+		return x.CurrentPassword
 	}
 	return ""
 }
 
-func (x *SSO_ParseClaimsRes) GetRoles() []*SSO_Role {
+func (x *SSO_ChangePasswordReq) GetPassword() string {
 	if x != nil {
-		return x.Roles
+		return x.Password
 	}
-	return nil
+	return ""
 }
 
-func (x *SSO_ParseClaimsRes) GetExp() int64 {
+type SSO_TOTPEnrollRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Secret string `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	Uri    string `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty"`
+	Qr     []byte `protobuf:"bytes,3,opt,name=qr,proto3" json:"qr,omitempty"`
+}
+
+func (x *SSO_TOTPEnrollRes) Reset() {
+	*x = SSO_TOTPEnrollRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SSO_TOTPEnrollRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSO_TOTPEnrollRes) ProtoMessage() {}
+
+func (x *SSO_TOTPEnrollRes) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSO_TOTPEnrollRes.ProtoReflect.Descriptor instead.
+func (*SSO_TOTPEnrollRes) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SSO_TOTPEnrollRes) GetSecret() string {
 	if x != nil {
-		return x.Exp
+		return x.Secret
 	}
-	return 0
+	return ""
 }
 
-func (x *SSO_ParseClaimsRes) GetIat() int64 {
+func (x *SSO_TOTPEnrollRes) GetUri() string {
 	if x != nil {
-		return x.Iat
+		return x.Uri
 	}
-	return 0
+	return ""
 }
 
-func (x *SSO_ParseClaimsRes) GetSub() string {
+func (x *SSO_TOTPEnrollRes) GetQr() []byte {
 	if x != nil {
-		return x.Sub
+		return x.Qr
+	}
+	return nil
+}
+
+type SSO_TOTPCodeReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *SSO_TOTPCodeReq) Reset() {
+	*x = SSO_TOTPCodeReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SSO_TOTPCodeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSO_TOTPCodeReq) ProtoMessage() {}
+
+func (x *SSO_TOTPCodeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSO_TOTPCodeReq.ProtoReflect.Descriptor instead.
+func (*SSO_TOTPCodeReq) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SSO_TOTPCodeReq) GetCode() string {
+	if x != nil {
+		return x.Code
 	}
 	return ""
 }
 
-type SSO_SendLoginCodeReq struct {
+type SSO_VerifyTOTPReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Email    string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Challenge string `protobuf:"bytes,1,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	Code      string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
 }
 
-func (x *SSO_SendLoginCodeReq) Reset() {
-	*x = SSO_SendLoginCodeReq{}
+func (x *SSO_VerifyTOTPReq) Reset() {
+	*x = SSO_VerifyTOTPReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[8]
+		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SSO_SendLoginCodeReq) String() string {
+func (x *SSO_VerifyTOTPReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SSO_SendLoginCodeReq) ProtoMessage() {}
+func (*SSO_VerifyTOTPReq) ProtoMessage() {}
 
-func (x *SSO_SendLoginCodeReq) ProtoReflect() protoreflect.Message {
-	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[8]
+func (x *SSO_VerifyTOTPReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -472,51 +1496,51 @@ func (x *SSO_SendLoginCodeReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SSO_SendLoginCodeReq.ProtoReflect.Descriptor instead.
-func (*SSO_SendLoginCodeReq) Descriptor() ([]byte, []int) {
-	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use SSO_VerifyTOTPReq.ProtoReflect.Descriptor instead.
+func (*SSO_VerifyTOTPReq) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *SSO_SendLoginCodeReq) GetEmail() string {
+func (x *SSO_VerifyTOTPReq) GetChallenge() string {
 	if x != nil {
-		return x.Email
+		return x.Challenge
 	}
 	return ""
 }
 
-func (x *SSO_SendLoginCodeReq) GetPassword() string {
+func (x *SSO_VerifyTOTPReq) GetCode() string {
 	if x != nil {
-		return x.Password
+		return x.Code
 	}
 	return ""
 }
 
-type SSO_CheckLoginCodeReq struct {
+type SSO_VerifyEmailCodeReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	Code  int32  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Challenge string `protobuf:"bytes,1,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	Code      string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
 }
 
-func (x *SSO_CheckLoginCodeReq) Reset() {
-	*x = SSO_CheckLoginCodeReq{}
+func (x *SSO_VerifyEmailCodeReq) Reset() {
+	*x = SSO_VerifyEmailCodeReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[9]
+		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SSO_CheckLoginCodeReq) String() string {
+func (x *SSO_VerifyEmailCodeReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SSO_CheckLoginCodeReq) ProtoMessage() {}
+func (*SSO_VerifyEmailCodeReq) ProtoMessage() {}
 
-func (x *SSO_CheckLoginCodeReq) ProtoReflect() protoreflect.Message {
-	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[9]
+func (x *SSO_VerifyEmailCodeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -527,50 +1551,50 @@ func (x *SSO_CheckLoginCodeReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SSO_CheckLoginCodeReq.ProtoReflect.Descriptor instead.
-func (*SSO_CheckLoginCodeReq) Descriptor() ([]byte, []int) {
-	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use SSO_VerifyEmailCodeReq.ProtoReflect.Descriptor instead.
+func (*SSO_VerifyEmailCodeReq) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *SSO_CheckLoginCodeReq) GetEmail() string {
+func (x *SSO_VerifyEmailCodeReq) GetChallenge() string {
 	if x != nil {
-		return x.Email
+		return x.Challenge
 	}
 	return ""
 }
 
-func (x *SSO_CheckLoginCodeReq) GetCode() int32 {
+func (x *SSO_VerifyEmailCodeReq) GetCode() string {
 	if x != nil {
 		return x.Code
 	}
-	return 0
+	return ""
 }
 
-type SSO_EmailMsg struct {
+type SSO_RecoveryCodes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Codes []string `protobuf:"bytes,1,rep,name=codes,proto3" json:"codes,omitempty"`
 }
 
-func (x *SSO_EmailMsg) Reset() {
-	*x = SSO_EmailMsg{}
+func (x *SSO_RecoveryCodes) Reset() {
+	*x = SSO_RecoveryCodes{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[10]
+		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SSO_EmailMsg) String() string {
+func (x *SSO_RecoveryCodes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SSO_EmailMsg) ProtoMessage() {}
+func (*SSO_RecoveryCodes) ProtoMessage() {}
 
-func (x *SSO_EmailMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[10]
+func (x *SSO_RecoveryCodes) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -581,45 +1605,44 @@ func (x *SSO_EmailMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SSO_EmailMsg.ProtoReflect.Descriptor instead.
-func (*SSO_EmailMsg) Descriptor() ([]byte, []int) {
-	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use SSO_RecoveryCodes.ProtoReflect.Descriptor instead.
+func (*SSO_RecoveryCodes) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *SSO_EmailMsg) GetEmail() string {
+func (x *SSO_RecoveryCodes) GetCodes() []string {
 	if x != nil {
-		return x.Email
+		return x.Codes
 	}
-	return ""
+	return nil
 }
 
-type SSO_CheckForgotPasswordEmailReq struct {
+type SSO_VerifyRecoveryCodeReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Password string `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
-	Id       string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	Code     string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	Challenge string `protobuf:"bytes,1,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	Code      string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
 }
 
-func (x *SSO_CheckForgotPasswordEmailReq) Reset() {
-	*x = SSO_CheckForgotPasswordEmailReq{}
+func (x *SSO_VerifyRecoveryCodeReq) Reset() {
+	*x = SSO_VerifyRecoveryCodeReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[11]
+		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SSO_CheckForgotPasswordEmailReq) String() string {
+func (x *SSO_VerifyRecoveryCodeReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SSO_CheckForgotPasswordEmailReq) ProtoMessage() {}
+func (*SSO_VerifyRecoveryCodeReq) ProtoMessage() {}
 
-func (x *SSO_CheckForgotPasswordEmailReq) ProtoReflect() protoreflect.Message {
-	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[11]
+func (x *SSO_VerifyRecoveryCodeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -630,26 +1653,19 @@ func (x *SSO_CheckForgotPasswordEmailReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SSO_CheckForgotPasswordEmailReq.ProtoReflect.Descriptor instead.
-func (*SSO_CheckForgotPasswordEmailReq) Descriptor() ([]byte, []int) {
-	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{11}
-}
-
-func (x *SSO_CheckForgotPasswordEmailReq) GetPassword() string {
-	if x != nil {
-		return x.Password
-	}
-	return ""
+// Deprecated: Use SSO_VerifyRecoveryCodeReq.ProtoReflect.Descriptor instead.
+func (*SSO_VerifyRecoveryCodeReq) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *SSO_CheckForgotPasswordEmailReq) GetId() string {
+func (x *SSO_VerifyRecoveryCodeReq) GetChallenge() string {
 	if x != nil {
-		return x.Id
+		return x.Challenge
 	}
 	return ""
 }
 
-func (x *SSO_CheckForgotPasswordEmailReq) GetCode() string {
+func (x *SSO_VerifyRecoveryCodeReq) GetCode() string {
 	if x != nil {
 		return x.Code
 	}
@@ -672,12 +1688,13 @@ type SSO_User struct {
 	Roles           []*SSO_Role            `protobuf:"bytes,9,rep,name=roles,proto3" json:"roles,omitempty"`
 	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Phone           string                 `protobuf:"bytes,12,opt,name=phone,proto3" json:"phone,omitempty"`
 }
 
 func (x *SSO_User) Reset() {
 	*x = SSO_User{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[12]
+		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -690,7 +1707,7 @@ func (x *SSO_User) String() string {
 func (*SSO_User) ProtoMessage() {}
 
 func (x *SSO_User) ProtoReflect() protoreflect.Message {
-	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[12]
+	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -703,7 +1720,7 @@ func (x *SSO_User) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SSO_User.ProtoReflect.Descriptor instead.
 func (*SSO_User) Descriptor() ([]byte, []int) {
-	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{12}
+	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *SSO_User) GetId() string {
@@ -783,6 +1800,13 @@ func (x *SSO_User) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *SSO_User) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
 type SSO_UserListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -801,7 +1825,7 @@ type SSO_UserListRequest struct {
 func (x *SSO_UserListRequest) Reset() {
 	*x = SSO_UserListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[13]
+		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -814,7 +1838,7 @@ func (x *SSO_UserListRequest) String() string {
 func (*SSO_UserListRequest) ProtoMessage() {}
 
 func (x *SSO_UserListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[13]
+	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -827,7 +1851,7 @@ func (x *SSO_UserListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SSO_UserListRequest.ProtoReflect.Descriptor instead.
 func (*SSO_UserListRequest) Descriptor() ([]byte, []int) {
-	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{13}
+	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *SSO_UserListRequest) GetPage() uint64 {
@@ -901,7 +1925,7 @@ type SSO_UserListResponse struct {
 func (x *SSO_UserListResponse) Reset() {
 	*x = SSO_UserListResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[14]
+		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -914,7 +1938,7 @@ func (x *SSO_UserListResponse) String() string {
 func (*SSO_UserListResponse) ProtoMessage() {}
 
 func (x *SSO_UserListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[14]
+	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -927,7 +1951,7 @@ func (x *SSO_UserListResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SSO_UserListResponse.ProtoReflect.Descriptor instead.
 func (*SSO_UserListResponse) Descriptor() ([]byte, []int) {
-	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{14}
+	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *SSO_UserListResponse) GetData() []*SSO_User {
@@ -976,7 +2000,7 @@ type SSO_ExistUserRequest struct {
 func (x *SSO_ExistUserRequest) Reset() {
 	*x = SSO_ExistUserRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[15]
+		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -989,7 +2013,7 @@ func (x *SSO_ExistUserRequest) String() string {
 func (*SSO_ExistUserRequest) ProtoMessage() {}
 
 func (x *SSO_ExistUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[15]
+	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1002,7 +2026,7 @@ func (x *SSO_ExistUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SSO_ExistUserRequest.ProtoReflect.Descriptor instead.
 func (*SSO_ExistUserRequest) Descriptor() ([]byte, []int) {
-	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{15}
+	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *SSO_ExistUserRequest) GetEmail() string {
@@ -1023,7 +2047,7 @@ type SSO_ExistUserResponse struct {
 func (x *SSO_ExistUserResponse) Reset() {
 	*x = SSO_ExistUserResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[16]
+		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1036,7 +2060,7 @@ func (x *SSO_ExistUserResponse) String() string {
 func (*SSO_ExistUserResponse) ProtoMessage() {}
 
 func (x *SSO_ExistUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[16]
+	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1049,7 +2073,7 @@ func (x *SSO_ExistUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SSO_ExistUserResponse.ProtoReflect.Descriptor instead.
 func (*SSO_ExistUserResponse) Descriptor() ([]byte, []int) {
-	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{16}
+	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *SSO_ExistUserResponse) GetIsExist() bool {
@@ -1064,19 +2088,21 @@ type SSO_CreateUserReq struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name            string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Email           string   `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	Password        string   `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
-	Avatar          string   `protobuf:"bytes,4,opt,name=avatar,proto3" json:"avatar,omitempty"`
-	IsActive        bool     `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	IsEmailVerified bool     `protobuf:"varint,6,opt,name=is_email_verified,json=isEmailVerified,proto3" json:"is_email_verified,omitempty"`
-	Roles           []uint64 `protobuf:"varint,7,rep,packed,name=roles,proto3" json:"roles,omitempty"`
+	Name               string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Email              string   `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Password           string   `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	Avatar             string   `protobuf:"bytes,4,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	IsActive           bool     `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	IsEmailVerified    bool     `protobuf:"varint,6,opt,name=is_email_verified,json=isEmailVerified,proto3" json:"is_email_verified,omitempty"`
+	Roles              []uint64 `protobuf:"varint,7,rep,packed,name=roles,proto3" json:"roles,omitempty"`
+	MustChangePassword bool     `protobuf:"varint,8,opt,name=must_change_password,json=mustChangePassword,proto3" json:"must_change_password,omitempty"`
+	SendCredentials    bool     `protobuf:"varint,9,opt,name=send_credentials,json=sendCredentials,proto3" json:"send_credentials,omitempty"`
 }
 
 func (x *SSO_CreateUserReq) Reset() {
 	*x = SSO_CreateUserReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[17]
+		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1089,7 +2115,7 @@ func (x *SSO_CreateUserReq) String() string {
 func (*SSO_CreateUserReq) ProtoMessage() {}
 
 func (x *SSO_CreateUserReq) ProtoReflect() protoreflect.Message {
-	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[17]
+	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1102,7 +2128,7 @@ func (x *SSO_CreateUserReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SSO_CreateUserReq.ProtoReflect.Descriptor instead.
 func (*SSO_CreateUserReq) Descriptor() ([]byte, []int) {
-	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{17}
+	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *SSO_CreateUserReq) GetName() string {
@@ -1154,25 +2180,40 @@ func (x *SSO_CreateUserReq) GetRoles() []uint64 {
 	return nil
 }
 
+func (x *SSO_CreateUserReq) GetMustChangePassword() bool {
+	if x != nil {
+		return x.MustChangePassword
+	}
+	return false
+}
+
+func (x *SSO_CreateUserReq) GetSendCredentials() bool {
+	if x != nil {
+		return x.SendCredentials
+	}
+	return false
+}
+
 type SSO_UpdateUserReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uid             string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
-	Name            string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Email           string   `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
-	Password        string   `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
-	Avatar          string   `protobuf:"bytes,5,opt,name=avatar,proto3" json:"avatar,omitempty"`
-	IsActive        bool     `protobuf:"varint,6,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	IsEmailVerified bool     `protobuf:"varint,7,opt,name=is_email_verified,json=isEmailVerified,proto3" json:"is_email_verified,omitempty"`
-	Roles           []uint64 `protobuf:"varint,8,rep,packed,name=roles,proto3" json:"roles,omitempty"`
+	Uid                string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Name               string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email              string   `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Password           string   `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	Avatar             string   `protobuf:"bytes,5,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	IsActive           bool     `protobuf:"varint,6,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	IsEmailVerified    bool     `protobuf:"varint,7,opt,name=is_email_verified,json=isEmailVerified,proto3" json:"is_email_verified,omitempty"`
+	Roles              []uint64 `protobuf:"varint,8,rep,packed,name=roles,proto3" json:"roles,omitempty"`
+	MustChangePassword bool     `protobuf:"varint,9,opt,name=must_change_password,json=mustChangePassword,proto3" json:"must_change_password,omitempty"`
 }
 
 func (x *SSO_UpdateUserReq) Reset() {
 	*x = SSO_UpdateUserReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[18]
+		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1185,7 +2226,7 @@ func (x *SSO_UpdateUserReq) String() string {
 func (*SSO_UpdateUserReq) ProtoMessage() {}
 
 func (x *SSO_UpdateUserReq) ProtoReflect() protoreflect.Message {
-	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[18]
+	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1198,7 +2239,7 @@ func (x *SSO_UpdateUserReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SSO_UpdateUserReq.ProtoReflect.Descriptor instead.
 func (*SSO_UpdateUserReq) Descriptor() ([]byte, []int) {
-	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{18}
+	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *SSO_UpdateUserReq) GetUid() string {
@@ -1254,7 +2295,14 @@ func (x *SSO_UpdateUserReq) GetRoles() []uint64 {
 	if x != nil {
 		return x.Roles
 	}
-	return nil
+	return nil
+}
+
+func (x *SSO_UpdateUserReq) GetMustChangePassword() bool {
+	if x != nil {
+		return x.MustChangePassword
+	}
+	return false
 }
 
 type SSO_CreateUserRes struct {
@@ -1268,7 +2316,7 @@ type SSO_CreateUserRes struct {
 func (x *SSO_CreateUserRes) Reset() {
 	*x = SSO_CreateUserRes{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[19]
+		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1281,7 +2329,7 @@ func (x *SSO_CreateUserRes) String() string {
 func (*SSO_CreateUserRes) ProtoMessage() {}
 
 func (x *SSO_CreateUserRes) ProtoReflect() protoreflect.Message {
-	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[19]
+	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1294,7 +2342,7 @@ func (x *SSO_CreateUserRes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SSO_CreateUserRes.ProtoReflect.Descriptor instead.
 func (*SSO_CreateUserRes) Descriptor() ([]byte, []int) {
-	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{19}
+	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *SSO_CreateUserRes) GetUid() string {
@@ -1317,7 +2365,7 @@ type SSO_Permission struct {
 func (x *SSO_Permission) Reset() {
 	*x = SSO_Permission{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[20]
+		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1330,7 +2378,7 @@ func (x *SSO_Permission) String() string {
 func (*SSO_Permission) ProtoMessage() {}
 
 func (x *SSO_Permission) ProtoReflect() protoreflect.Message {
-	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[20]
+	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1343,7 +2391,7 @@ func (x *SSO_Permission) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SSO_Permission.ProtoReflect.Descriptor instead.
 func (*SSO_Permission) Descriptor() ([]byte, []int) {
-	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{20}
+	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *SSO_Permission) GetId() uint64 {
@@ -1380,7 +2428,7 @@ type SSO_PermissionListRequest struct {
 func (x *SSO_PermissionListRequest) Reset() {
 	*x = SSO_PermissionListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[21]
+		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1393,7 +2441,7 @@ func (x *SSO_PermissionListRequest) String() string {
 func (*SSO_PermissionListRequest) ProtoMessage() {}
 
 func (x *SSO_PermissionListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[21]
+	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1406,7 +2454,7 @@ func (x *SSO_PermissionListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SSO_PermissionListRequest.ProtoReflect.Descriptor instead.
 func (*SSO_PermissionListRequest) Descriptor() ([]byte, []int) {
-	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{21}
+	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *SSO_PermissionListRequest) GetPage() uint64 {
@@ -1445,7 +2493,7 @@ type SSO_PermissionListResponse struct {
 func (x *SSO_PermissionListResponse) Reset() {
 	*x = SSO_PermissionListResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[22]
+		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1458,7 +2506,7 @@ func (x *SSO_PermissionListResponse) String() string {
 func (*SSO_PermissionListResponse) ProtoMessage() {}
 
 func (x *SSO_PermissionListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[22]
+	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1471,7 +2519,7 @@ func (x *SSO_PermissionListResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SSO_PermissionListResponse.ProtoReflect.Descriptor instead.
 func (*SSO_PermissionListResponse) Descriptor() ([]byte, []int) {
-	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{22}
+	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *SSO_PermissionListResponse) GetData() []*SSO_Permission {
@@ -1522,7 +2570,7 @@ type SSO_Role struct {
 func (x *SSO_Role) Reset() {
 	*x = SSO_Role{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[23]
+		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1535,7 +2583,7 @@ func (x *SSO_Role) String() string {
 func (*SSO_Role) ProtoMessage() {}
 
 func (x *SSO_Role) ProtoReflect() protoreflect.Message {
-	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[23]
+	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1548,7 +2596,7 @@ func (x *SSO_Role) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SSO_Role.ProtoReflect.Descriptor instead.
 func (*SSO_Role) Descriptor() ([]byte, []int) {
-	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{23}
+	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *SSO_Role) GetId() uint64 {
@@ -1585,7 +2633,7 @@ type SSO_RoleListRequest struct {
 func (x *SSO_RoleListRequest) Reset() {
 	*x = SSO_RoleListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[24]
+		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1598,7 +2646,7 @@ func (x *SSO_RoleListRequest) String() string {
 func (*SSO_RoleListRequest) ProtoMessage() {}
 
 func (x *SSO_RoleListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[24]
+	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1611,7 +2659,7 @@ func (x *SSO_RoleListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SSO_RoleListRequest.ProtoReflect.Descriptor instead.
 func (*SSO_RoleListRequest) Descriptor() ([]byte, []int) {
-	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{24}
+	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *SSO_RoleListRequest) GetPage() uint64 {
@@ -1650,7 +2698,7 @@ type SSO_RoleListResponse struct {
 func (x *SSO_RoleListResponse) Reset() {
 	*x = SSO_RoleListResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[25]
+		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1663,7 +2711,7 @@ func (x *SSO_RoleListResponse) String() string {
 func (*SSO_RoleListResponse) ProtoMessage() {}
 
 func (x *SSO_RoleListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[25]
+	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1676,7 +2724,7 @@ func (x *SSO_RoleListResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SSO_RoleListResponse.ProtoReflect.Descriptor instead.
 func (*SSO_RoleListResponse) Descriptor() ([]byte, []int) {
-	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{25}
+	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *SSO_RoleListResponse) GetData() []*SSO_Role {
@@ -1734,7 +2782,7 @@ type SSO_Device struct {
 func (x *SSO_Device) Reset() {
 	*x = SSO_Device{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[26]
+		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1747,7 +2795,7 @@ func (x *SSO_Device) String() string {
 func (*SSO_Device) ProtoMessage() {}
 
 func (x *SSO_Device) ProtoReflect() protoreflect.Message {
-	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[26]
+	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1760,7 +2808,7 @@ func (x *SSO_Device) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SSO_Device.ProtoReflect.Descriptor instead.
 func (*SSO_Device) Descriptor() ([]byte, []int) {
-	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{26}
+	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *SSO_Device) GetId() string {
@@ -1845,7 +2893,7 @@ type SSO_ListDevicesRequest struct {
 func (x *SSO_ListDevicesRequest) Reset() {
 	*x = SSO_ListDevicesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[27]
+		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1858,7 +2906,7 @@ func (x *SSO_ListDevicesRequest) String() string {
 func (*SSO_ListDevicesRequest) ProtoMessage() {}
 
 func (x *SSO_ListDevicesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[27]
+	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1871,7 +2919,7 @@ func (x *SSO_ListDevicesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SSO_ListDevicesRequest.ProtoReflect.Descriptor instead.
 func (*SSO_ListDevicesRequest) Descriptor() ([]byte, []int) {
-	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{27}
+	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *SSO_ListDevicesRequest) GetPage() uint64 {
@@ -1899,7 +2947,7 @@ type SSO_ListDevicesResponse struct {
 func (x *SSO_ListDevicesResponse) Reset() {
 	*x = SSO_ListDevicesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[28]
+		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1912,7 +2960,7 @@ func (x *SSO_ListDevicesResponse) String() string {
 func (*SSO_ListDevicesResponse) ProtoMessage() {}
 
 func (x *SSO_ListDevicesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[28]
+	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1925,7 +2973,7 @@ func (x *SSO_ListDevicesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SSO_ListDevicesResponse.ProtoReflect.Descriptor instead.
 func (*SSO_ListDevicesResponse) Descriptor() ([]byte, []int) {
-	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{28}
+	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *SSO_ListDevicesResponse) GetData() []*SSO_Device {
@@ -1947,7 +2995,7 @@ type SSO_UpdateDeviceRequest struct {
 func (x *SSO_UpdateDeviceRequest) Reset() {
 	*x = SSO_UpdateDeviceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[29]
+		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1960,7 +3008,7 @@ func (x *SSO_UpdateDeviceRequest) String() string {
 func (*SSO_UpdateDeviceRequest) ProtoMessage() {}
 
 func (x *SSO_UpdateDeviceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[29]
+	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1973,7 +3021,7 @@ func (x *SSO_UpdateDeviceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SSO_UpdateDeviceRequest.ProtoReflect.Descriptor instead.
 func (*SSO_UpdateDeviceRequest) Descriptor() ([]byte, []int) {
-	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{29}
+	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *SSO_UpdateDeviceRequest) GetId() string {
@@ -1990,6 +3038,305 @@ func (x *SSO_UpdateDeviceRequest) GetName() string {
 	return ""
 }
 
+type SSO_Passkey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Transports     []string               `protobuf:"bytes,3,rep,name=transports,proto3" json:"transports,omitempty"`
+	BackupEligible bool                   `protobuf:"varint,4,opt,name=backup_eligible,json=backupEligible,proto3" json:"backup_eligible,omitempty"`
+	BackupState    bool                   `protobuf:"varint,5,opt,name=backup_state,json=backupState,proto3" json:"backup_state,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastUsedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+}
+
+func (x *SSO_Passkey) Reset() {
+	*x = SSO_Passkey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SSO_Passkey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSO_Passkey) ProtoMessage() {}
+
+func (x *SSO_Passkey) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSO_Passkey.ProtoReflect.Descriptor instead.
+func (*SSO_Passkey) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SSO_Passkey) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SSO_Passkey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SSO_Passkey) GetTransports() []string {
+	if x != nil {
+		return x.Transports
+	}
+	return nil
+}
+
+func (x *SSO_Passkey) GetBackupEligible() bool {
+	if x != nil {
+		return x.BackupEligible
+	}
+	return false
+}
+
+func (x *SSO_Passkey) GetBackupState() bool {
+	if x != nil {
+		return x.BackupState
+	}
+	return false
+}
+
+func (x *SSO_Passkey) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *SSO_Passkey) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+type SSO_ListPasskeysResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*SSO_Passkey `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *SSO_ListPasskeysResponse) Reset() {
+	*x = SSO_ListPasskeysResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SSO_ListPasskeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSO_ListPasskeysResponse) ProtoMessage() {}
+
+func (x *SSO_ListPasskeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSO_ListPasskeysResponse.ProtoReflect.Descriptor instead.
+func (*SSO_ListPasskeysResponse) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SSO_ListPasskeysResponse) GetData() []*SSO_Passkey {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type SSO_RenamePasskeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *SSO_RenamePasskeyRequest) Reset() {
+	*x = SSO_RenamePasskeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SSO_RenamePasskeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSO_RenamePasskeyRequest) ProtoMessage() {}
+
+func (x *SSO_RenamePasskeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSO_RenamePasskeyRequest.ProtoReflect.Descriptor instead.
+func (*SSO_RenamePasskeyRequest) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SSO_RenamePasskeyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SSO_RenamePasskeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type SSO_PhoneRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Phone   string `protobuf:"bytes,1,opt,name=phone,proto3" json:"phone,omitempty"`
+	Channel string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+}
+
+func (x *SSO_PhoneRequest) Reset() {
+	*x = SSO_PhoneRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SSO_PhoneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSO_PhoneRequest) ProtoMessage() {}
+
+func (x *SSO_PhoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSO_PhoneRequest.ProtoReflect.Descriptor instead.
+func (*SSO_PhoneRequest) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *SSO_PhoneRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *SSO_PhoneRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+type SSO_ConfirmPhoneRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *SSO_ConfirmPhoneRequest) Reset() {
+	*x = SSO_ConfirmPhoneRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SSO_ConfirmPhoneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSO_ConfirmPhoneRequest) ProtoMessage() {}
+
+func (x *SSO_ConfirmPhoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_v1_gen_sso_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSO_ConfirmPhoneRequest.ProtoReflect.Descriptor instead.
+func (*SSO_ConfirmPhoneRequest) Descriptor() ([]byte, []int) {
+	return file_api_grpc_v1_gen_sso_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SSO_ConfirmPhoneRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
 var File_api_grpc_v1_gen_sso_proto protoreflect.FileDescriptor
 
 var file_api_grpc_v1_gen_sso_proto_rawDesc = []byte{
@@ -2013,119 +3360,236 @@ var file_api_grpc_v1_gen_sso_proto_rawDesc = []byte{
 	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73,
 	0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x22, 0x41, 0x0a, 0x0d, 0x53, 0x53, 0x4f, 0x5f, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x50, 0x61, 0x69, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a,
-	0x07, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x22, 0x81, 0x01, 0x0a, 0x12, 0x53, 0x53, 0x4f, 0x5f,
-	0x50, 0x61, 0x72, 0x73, 0x65, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x12, 0x10,
-	0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64,
-	0x12, 0x23, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x0d, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x05,
-	0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x78, 0x70, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x03, 0x65, 0x78, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x61, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x69, 0x61, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x75, 0x62,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x75, 0x62, 0x22, 0x48, 0x0a, 0x14, 0x53,
-	0x53, 0x4f, 0x5f, 0x53, 0x65, 0x6e, 0x64, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x64, 0x65,
-	0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x41, 0x0a, 0x15, 0x53, 0x53, 0x4f, 0x5f, 0x43, 0x68, 0x65,
-	0x63, 0x6b, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x14,
-	0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
-	0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x24, 0x0a, 0x0c, 0x53, 0x53, 0x4f, 0x5f,
-	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x4d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69,
-	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x61,
-	0x0a, 0x1f, 0x53, 0x53, 0x4f, 0x5f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x46, 0x6f, 0x72, 0x67, 0x6f,
-	0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65,
-	0x71, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
-	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64,
-	0x65, 0x22, 0xf1, 0x02, 0x0a, 0x08, 0x53, 0x53, 0x4f, 0x5f, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x14,
-	0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
-	0x6d, 0x61, 0x69, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x13, 0x0a, 0x05,
-	0x69, 0x73, 0x5f, 0x77, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x73, 0x57,
-	0x61, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x2a,
-	0x0a, 0x11, 0x69, 0x73, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66,
-	0x69, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x69, 0x73, 0x45, 0x6d, 0x61,
-	0x69, 0x6c, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x05, 0x72, 0x6f,
-	0x6c, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x65, 0x6e, 0x2e,
+	0x77, 0x6f, 0x72, 0x64, 0x22, 0xe4, 0x01, 0x0a, 0x0d, 0x53, 0x53, 0x4f, 0x5f, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x12, 0x33, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6c,
+	0x6c, 0x65, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x65,
+	0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x4d, 0x46, 0x41, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
+	0x67, 0x65, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x32, 0x0a,
+	0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x41,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61,
+	0x6c, 0x12, 0x38, 0x0a, 0x18, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x16, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3b, 0x0a, 0x11, 0x53,
+	0x53, 0x4f, 0x5f, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0xd2, 0x01, 0x0a, 0x10, 0x53, 0x53, 0x4f,
+	0x5f, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x6f, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x6f, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x62, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x62, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x6f, 0x69,
+	0x63, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x05, 0x52, 0x07, 0x63, 0x68, 0x6f, 0x69, 0x63,
+	0x65, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x45, 0x0a,
+	0x18, 0x53, 0x53, 0x4f, 0x5f, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53,
+	0x4f, 0x5f, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x22, 0x7d, 0x0a, 0x0e, 0x53, 0x53, 0x4f, 0x5f, 0x51, 0x52, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x71, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x71, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x41, 0x74, 0x22, 0xbe, 0x01, 0x0a, 0x12, 0x53, 0x53, 0x4f, 0x5f, 0x51, 0x52, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x6f, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x6f, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x62, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x62, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x22, 0x57, 0x0a, 0x13, 0x53, 0x53, 0x4f, 0x5f, 0x41, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x42, 0x0a,
+	0x10, 0x53, 0x53, 0x4f, 0x5f, 0x4d, 0x46, 0x41, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x73, 0x22, 0xc2, 0x01, 0x0a, 0x12, 0x53, 0x53, 0x4f, 0x5f, 0x50, 0x61, 0x72, 0x73, 0x65, 0x43,
+	0x6c, 0x61, 0x69, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x05, 0x72, 0x6f,
+	0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x65, 0x6e, 0x2e,
 	0x53, 0x53, 0x4f, 0x5f, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12,
-	0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x10, 0x0a, 0x03, 0x65, 0x78, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65, 0x78,
+	0x70, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03,
+	0x69, 0x61, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x75, 0x62, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x73, 0x75, 0x62, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6d, 0x72, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x03, 0x61, 0x6d, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x63, 0x72, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x63, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x75, 0x74,
+	0x68, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61, 0x75,
+	0x74, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x3f, 0x0a, 0x0d, 0x53, 0x53, 0x4f, 0x5f, 0x52, 0x65,
+	0x61, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x62, 0x0a, 0x14, 0x53, 0x53, 0x4f, 0x5f, 0x53,
+	0x65, 0x6e, 0x64, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x41, 0x0a, 0x15, 0x53,
+	0x53, 0x4f, 0x5f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x64,
+	0x65, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x44,
+	0x0a, 0x16, 0x53, 0x53, 0x4f, 0x5f, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4d, 0x61, 0x67, 0x69,
+	0x63, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e,
+	0x6f, 0x6e, 0x63, 0x65, 0x22, 0x3e, 0x0a, 0x0c, 0x53, 0x53, 0x4f, 0x5f, 0x45, 0x6d, 0x61, 0x69,
+	0x6c, 0x4d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x53, 0x0a, 0x1f, 0x53, 0x53, 0x4f, 0x5f, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x46, 0x6f, 0x72, 0x67, 0x6f, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x45,
+	0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x5e, 0x0a, 0x15, 0x53, 0x53, 0x4f,
+	0x5f, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52,
+	0x65, 0x71, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x4d, 0x0a, 0x11, 0x53, 0x53, 0x4f,
+	0x5f, 0x54, 0x4f, 0x54, 0x50, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x0e, 0x0a, 0x02, 0x71, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x71, 0x72, 0x22, 0x25, 0x0a, 0x0f, 0x53, 0x53, 0x4f, 0x5f,
+	0x54, 0x4f, 0x54, 0x50, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22,
+	0x45, 0x0a, 0x11, 0x53, 0x53, 0x4f, 0x5f, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x4f, 0x54,
+	0x50, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
+	0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x4a, 0x0a, 0x16, 0x53, 0x53, 0x4f, 0x5f, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71,
+	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x22, 0x29, 0x0a, 0x11, 0x53, 0x53, 0x4f, 0x5f, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x4d, 0x0a,
+	0x19, 0x53, 0x53, 0x4f, 0x5f, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68,
+	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x87, 0x03, 0x0a,
+	0x08, 0x53, 0x53, 0x4f, 0x5f, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x13, 0x0a, 0x05, 0x69, 0x73, 0x5f, 0x77, 0x61,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x73, 0x57, 0x61, 0x12, 0x1b, 0x0a, 0x09,
+	0x69, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x69, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x69, 0x73, 0x5f,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x69, 0x73, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x09,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x52,
+	0x6f, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xdd, 0x01, 0x0a, 0x13, 0x53, 0x53, 0x4f, 0x5f, 0x55, 0x73,
-	0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x70, 0x61, 0x67,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x69, 0x73, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x5f, 0x76,
-	0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69,
-	0x73, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x13,
-	0x0a, 0x05, 0x69, 0x73, 0x5f, 0x77, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x69,
-	0x73, 0x57, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x72,
-	0x6f, 0x6c, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65,
-	0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x73, 0x6f, 0x72, 0x74, 0x22, 0xb7, 0x01, 0x0a, 0x14, 0x53, 0x53, 0x4f, 0x5f, 0x55, 0x73,
-	0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21,
-	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67,
-	0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
-	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x68,
-	0x61, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x22,
-	0x2c, 0x0a, 0x14, 0x53, 0x53, 0x4f, 0x5f, 0x45, 0x78, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x32, 0x0a,
-	0x15, 0x53, 0x53, 0x4f, 0x5f, 0x45, 0x78, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x65, 0x78, 0x69,
-	0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x45, 0x78, 0x69, 0x73,
-	0x74, 0x22, 0xd0, 0x01, 0x0a, 0x11, 0x53, 0x53, 0x4f, 0x5f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65,
-	0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69,
-	0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x16, 0x0a,
-	0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
-	0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x69, 0x73, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x5f, 0x76,
-	0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69,
-	0x73, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x14,
-	0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x04, 0x52, 0x05, 0x72,
-	0x6f, 0x6c, 0x65, 0x73, 0x22, 0xe2, 0x01, 0x0a, 0x11, 0x53, 0x53, 0x4f, 0x5f, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
-	0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
-	0x72, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73,
-	0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69,
-	0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x69, 0x73, 0x5f, 0x65, 0x6d,
-	0x61, 0x69, 0x6c, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0f, 0x69, 0x73, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x56, 0x65, 0x72, 0x69, 0x66,
-	0x69, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03,
-	0x28, 0x04, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x22, 0x25, 0x0a, 0x11, 0x53, 0x53, 0x4f,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x22, 0xdd, 0x01, 0x0a, 0x13, 0x53, 0x53, 0x4f, 0x5f, 0x55,
+	0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x69, 0x73, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x5f,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f,
+	0x69, 0x73, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12,
+	0x13, 0x0a, 0x05, 0x69, 0x73, 0x5f, 0x77, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04,
+	0x69, 0x73, 0x57, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x14, 0x0a, 0x05,
+	0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f, 0x6c,
+	0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x22, 0xb7, 0x01, 0x0a, 0x14, 0x53, 0x53, 0x4f, 0x5f, 0x55,
+	0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x21, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0d,
+	0x68, 0x61, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x22, 0x2c, 0x0a, 0x14, 0x53, 0x53, 0x4f, 0x5f, 0x45, 0x78, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x32,
+	0x0a, 0x15, 0x53, 0x53, 0x4f, 0x5f, 0x45, 0x78, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x65, 0x78,
+	0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x45, 0x78, 0x69,
+	0x73, 0x74, 0x22, 0xad, 0x02, 0x0a, 0x11, 0x53, 0x53, 0x4f, 0x5f, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x69, 0x73, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x5f,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f,
+	0x69, 0x73, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x04, 0x52, 0x05,
+	0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x6d, 0x75, 0x73, 0x74, 0x5f, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x12, 0x6d, 0x75, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x65, 0x6e, 0x64, 0x5f,
+	0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0f, 0x73, 0x65, 0x6e, 0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x73, 0x22, 0x94, 0x02, 0x0a, 0x11, 0x53, 0x53, 0x4f, 0x5f, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x69, 0x73, 0x5f, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0f, 0x69, 0x73, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x04,
+	0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x6d, 0x75, 0x73, 0x74, 0x5f,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x6d, 0x75, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x25, 0x0a, 0x11, 0x53, 0x53, 0x4f,
 	0x5f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x12, 0x10,
 	0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64,
 	0x22, 0x56, 0x0a, 0x0e, 0x53, 0x53, 0x4f, 0x5f, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
@@ -2204,123 +3668,248 @@ var file_api_grpc_v1_gen_sso_proto_rawDesc = []byte{
 	0x3d, 0x0a, 0x17, 0x53, 0x53, 0x4f, 0x5f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76,
 	0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x32, 0xfc,
-	0x03, 0x0a, 0x04, 0x41, 0x75, 0x74, 0x68, 0x12, 0x44, 0x0a, 0x0c, 0x41, 0x75, 0x74, 0x68, 0x65,
-	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x20, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53,
-	0x4f, 0x5f, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x41, 0x6e, 0x64, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
-	0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x67, 0x65, 0x6e, 0x2e,
-	0x53, 0x53, 0x4f, 0x5f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x12, 0x3a, 0x0a,
-	0x0b, 0x50, 0x61, 0x72, 0x73, 0x65, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x12, 0x12, 0x2e, 0x67,
-	0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x73, 0x67,
-	0x1a, 0x17, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x50, 0x61, 0x72, 0x73, 0x65,
-	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x07, 0x52, 0x65, 0x66,
-	0x72, 0x65, 0x73, 0x68, 0x12, 0x17, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x52,
-	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x96,
+	0x02, 0x0a, 0x0b, 0x53, 0x53, 0x4f, 0x5f, 0x50, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x65, 0x6c, 0x69,
+	0x67, 0x69, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x62, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62,
+	0x61, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x39,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3c, 0x0a, 0x0c, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6c, 0x61, 0x73,
+	0x74, 0x55, 0x73, 0x65, 0x64, 0x41, 0x74, 0x22, 0x40, 0x0a, 0x18, 0x53, 0x53, 0x4f, 0x5f, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x50, 0x61, 0x73, 0x73,
+	0x6b, 0x65, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x3e, 0x0a, 0x18, 0x53, 0x53, 0x4f,
+	0x5f, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x42, 0x0a, 0x10, 0x53, 0x53, 0x4f,
+	0x5f, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68,
+	0x6f, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x2d, 0x0a,
+	0x17, 0x53, 0x53, 0x4f, 0x5f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x50, 0x68, 0x6f, 0x6e,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x32, 0xc2, 0x0c, 0x0a,
+	0x04, 0x41, 0x75, 0x74, 0x68, 0x12, 0x44, 0x0a, 0x0c, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x20, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f,
+	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x41, 0x6e, 0x64, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53,
+	0x4f, 0x5f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x12, 0x3a, 0x0a, 0x0b, 0x50,
+	0x61, 0x72, 0x73, 0x65, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x12, 0x12, 0x2e, 0x67, 0x65, 0x6e,
+	0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x73, 0x67, 0x1a, 0x17,
+	0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x50, 0x61, 0x72, 0x73, 0x65, 0x43, 0x6c,
+	0x61, 0x69, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x07, 0x52, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x12, 0x17, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x52, 0x65, 0x66,
+	0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x67, 0x65,
+	0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x12,
+	0x3e, 0x0a, 0x0d, 0x53, 0x65, 0x6e, 0x64, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x19, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x53, 0x65, 0x6e, 0x64, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x67, 0x65,
+	0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x12,
+	0x40, 0x0a, 0x0e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x1a, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e,
 	0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x61, 0x69,
-	0x72, 0x12, 0x3e, 0x0a, 0x0d, 0x53, 0x65, 0x6e, 0x64, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f,
-	0x64, 0x65, 0x12, 0x19, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x53, 0x65, 0x6e,
-	0x64, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e,
+	0x72, 0x12, 0x36, 0x0a, 0x0d, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x61, 0x67, 0x69, 0x63, 0x4c, 0x69,
+	0x6e, 0x6b, 0x12, 0x11, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x45, 0x6d, 0x61,
+	0x69, 0x6c, 0x4d, 0x73, 0x67, 0x1a, 0x12, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x73, 0x67, 0x12, 0x42, 0x0a, 0x0f, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x79, 0x4d, 0x61, 0x67, 0x69, 0x63, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x1b, 0x2e, 0x67,
+	0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4d, 0x61, 0x67,
+	0x69, 0x63, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x67, 0x65, 0x6e, 0x2e,
+	0x53, 0x53, 0x4f, 0x5f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x12, 0x43, 0x0a,
+	0x12, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x61, 0x6c, 0x73, 0x12, 0x0e, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x1d, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x12, 0x38, 0x0a, 0x0c, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x12, 0x18, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x41, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x67,
+	0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3c, 0x0a, 0x12,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x61, 0x6c, 0x12, 0x12, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x4d, 0x73, 0x67, 0x1a, 0x12, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f,
+	0x5f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x12, 0x33, 0x0a, 0x0c, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x51, 0x52, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x0e, 0x2e, 0x67, 0x65, 0x6e,
+	0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x13, 0x2e, 0x67, 0x65, 0x6e,
+	0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x51, 0x52, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x12,
+	0x39, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x51, 0x52, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x12, 0x2e,
+	0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x73,
+	0x67, 0x1a, 0x17, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x51, 0x52, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x0e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x51, 0x52, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x12, 0x2e, 0x67,
+	0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x73, 0x67,
+	0x1a, 0x0e, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x12, 0x36, 0x0a, 0x0c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x51, 0x52, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x12, 0x12, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x4d, 0x73, 0x67, 0x1a, 0x12, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x12, 0x3c, 0x0a, 0x17, 0x53, 0x65, 0x6e, 0x64,
+	0x46, 0x6f, 0x72, 0x67, 0x6f, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x45, 0x6d,
+	0x61, 0x69, 0x6c, 0x12, 0x11, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x45, 0x6d,
+	0x61, 0x69, 0x6c, 0x4d, 0x73, 0x67, 0x1a, 0x0e, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f,
+	0x5f, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x50, 0x0a, 0x18, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x46,
+	0x6f, 0x72, 0x67, 0x6f, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x45, 0x6d, 0x61,
+	0x69, 0x6c, 0x12, 0x24, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x46, 0x6f, 0x72, 0x67, 0x6f, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53,
+	0x53, 0x4f, 0x5f, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x40, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1a, 0x2e, 0x67, 0x65, 0x6e,
+	0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f,
+	0x5f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x12, 0x38, 0x0a, 0x0e, 0x52, 0x65,
+	0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x12, 0x2e, 0x67,
+	0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x52, 0x65, 0x61, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71,
+	0x1a, 0x12, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x50, 0x61, 0x69, 0x72, 0x12, 0x28, 0x0a, 0x06, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x12, 0x0e,
+	0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0e,
+	0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x34,
+	0x0a, 0x0a, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x54, 0x4f, 0x54, 0x50, 0x12, 0x0e, 0x2e, 0x67,
+	0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67,
+	0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x54, 0x4f, 0x54, 0x50, 0x45, 0x6e, 0x72, 0x6f, 0x6c,
+	0x6c, 0x52, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54,
+	0x4f, 0x54, 0x50, 0x12, 0x14, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x54, 0x4f,
+	0x54, 0x50, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x65, 0x6e, 0x2e,
+	0x53, 0x53, 0x4f, 0x5f, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65,
+	0x73, 0x12, 0x33, 0x0a, 0x0b, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x4f, 0x54, 0x50,
+	0x12, 0x14, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x54, 0x4f, 0x54, 0x50, 0x43,
+	0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f,
+	0x5f, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x38, 0x0a, 0x0a, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
+	0x54, 0x4f, 0x54, 0x50, 0x12, 0x16, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x4f, 0x54, 0x50, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x67,
+	0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x61, 0x69, 0x72,
+	0x12, 0x41, 0x0a, 0x17, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x0e, 0x2e, 0x67, 0x65,
+	0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x65,
+	0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f,
+	0x64, 0x65, 0x73, 0x12, 0x48, 0x0a, 0x12, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x2e, 0x67, 0x65, 0x6e, 0x2e,
+	0x53, 0x53, 0x4f, 0x5f, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x67, 0x65, 0x6e, 0x2e,
+	0x53, 0x53, 0x4f, 0x5f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x12, 0x42, 0x0a,
+	0x0f, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x1b, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e,
 	0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x61, 0x69,
-	0x72, 0x12, 0x40, 0x0a, 0x0e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43,
-	0x6f, 0x64, 0x65, 0x12, 0x1a, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x43, 0x68,
-	0x65, 0x63, 0x6b, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a,
-	0x12, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50,
-	0x61, 0x69, 0x72, 0x12, 0x3c, 0x0a, 0x17, 0x53, 0x65, 0x6e, 0x64, 0x46, 0x6f, 0x72, 0x67, 0x6f,
-	0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x11,
-	0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x4d, 0x73,
-	0x67, 0x1a, 0x0e, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x12, 0x50, 0x0a, 0x18, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x46, 0x6f, 0x72, 0x67, 0x6f, 0x74,
-	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x24, 0x2e,
-	0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x46, 0x6f, 0x72,
-	0x67, 0x6f, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c,
-	0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x12, 0x28, 0x0a, 0x06, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x12, 0x0e, 0x2e,
-	0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0e, 0x2e,
-	0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x32, 0xba, 0x03,
-	0x0a, 0x05, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x42, 0x0a, 0x09, 0x45, 0x78, 0x69, 0x73, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x45,
-	0x78, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1a, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x45, 0x78, 0x69, 0x73, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x47,
-	0x65, 0x74, 0x4d, 0x65, 0x12, 0x0e, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0d, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x55,
-	0x73, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x08, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x12,
-	0x16, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53,
-	0x4f, 0x5f, 0x55, 0x73, 0x65, 0x72, 0x12, 0x40, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x73, 0x12, 0x18, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x55, 0x73,
-	0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
-	0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x16, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f,
-	0x5f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x16,
-	0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
-	0x72, 0x12, 0x10, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x55, 0x75, 0x69, 0x64,
-	0x4d, 0x73, 0x67, 0x1a, 0x0d, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x55, 0x73,
-	0x65, 0x72, 0x12, 0x36, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
-	0x12, 0x16, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53,
-	0x53, 0x4f, 0x5f, 0x55, 0x75, 0x69, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x2e, 0x0a, 0x0a, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x10, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53,
-	0x53, 0x4f, 0x5f, 0x55, 0x75, 0x69, 0x64, 0x4d, 0x73, 0x67, 0x1a, 0x0e, 0x2e, 0x67, 0x65, 0x6e,
-	0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x32, 0xc8, 0x02, 0x0a, 0x0a, 0x50,
-	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x52, 0x0a, 0x0f, 0x4c, 0x69, 0x73,
-	0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x2e, 0x67,
-	0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67,
+	0x72, 0x32, 0xba, 0x03, 0x0a, 0x05, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x42, 0x0a, 0x09, 0x45,
+	0x78, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53,
+	0x53, 0x4f, 0x5f, 0x45, 0x78, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x45, 0x78,
+	0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x26, 0x0a, 0x05, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x12, 0x0e, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53,
+	0x53, 0x4f, 0x5f, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0d, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53,
+	0x53, 0x4f, 0x5f, 0x55, 0x73, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x08, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4d, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x67, 0x65,
+	0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x55, 0x73, 0x65, 0x72, 0x12, 0x40, 0x0a, 0x09, 0x4c, 0x69,
+	0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x18, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53,
+	0x4f, 0x5f, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x55, 0x73, 0x65, 0x72,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0a,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x16, 0x2e, 0x67, 0x65, 0x6e,
+	0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x07, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x10, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f,
+	0x55, 0x75, 0x69, 0x64, 0x4d, 0x73, 0x67, 0x1a, 0x0d, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53,
+	0x4f, 0x5f, 0x55, 0x73, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x12, 0x16, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x67,
+	0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x55, 0x75, 0x69, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x2e,
+	0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x10, 0x2e, 0x67,
+	0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x55, 0x75, 0x69, 0x64, 0x4d, 0x73, 0x67, 0x1a, 0x0e,
+	0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x32, 0xc8,
+	0x02, 0x0a, 0x0a, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x52, 0x0a,
+	0x0f, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x1e, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x50, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x50, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3b, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x13, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f,
+	0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x12, 0x2e, 0x67, 0x65, 0x6e,
+	0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4d, 0x73, 0x67, 0x12, 0x38,
+	0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x12, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34,
+	0x4d, 0x73, 0x67, 0x1a, 0x13, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x50, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x13, 0x2e, 0x67,
 	0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a,
-	0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x12, 0x13, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x50, 0x65, 0x72, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x12, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f,
-	0x5f, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4d, 0x73, 0x67, 0x12, 0x38, 0x0a, 0x0d, 0x47, 0x65,
-	0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x2e, 0x67, 0x65,
-	0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4d, 0x73, 0x67, 0x1a,
-	0x13, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65,
-	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x13, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53,
-	0x53, 0x4f, 0x5f, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x0e, 0x2e,
-	0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x36, 0x0a,
-	0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x12, 0x12, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x55, 0x69, 0x6e, 0x74,
-	0x36, 0x34, 0x4d, 0x73, 0x67, 0x1a, 0x0e, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x32, 0x86, 0x02, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x40,
-	0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x18, 0x2e, 0x67, 0x65,
-	0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x52, 0x6f, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f,
-	0x52, 0x6f, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x2f, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x0d,
-	0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x52, 0x6f, 0x6c, 0x65, 0x1a, 0x12, 0x2e,
-	0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4d, 0x73,
-	0x67, 0x12, 0x2c, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x12, 0x2e, 0x67,
-	0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4d, 0x73, 0x67,
-	0x1a, 0x0d, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x52, 0x6f, 0x6c, 0x65, 0x12,
-	0x2b, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x0d, 0x2e,
-	0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x52, 0x6f, 0x6c, 0x65, 0x1a, 0x0e, 0x2e, 0x67,
-	0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x30, 0x0a, 0x0a,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x12, 0x2e, 0x67, 0x65, 0x6e,
-	0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4d, 0x73, 0x67, 0x1a, 0x0e,
-	0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x32, 0xf7,
-	0x01, 0x0a, 0x07, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x48, 0x0a, 0x0b, 0x4c, 0x69,
-	0x73, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x1b, 0x2e, 0x67, 0x65, 0x6e, 0x2e,
-	0x53, 0x53, 0x4f, 0x5f, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f,
-	0x5f, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x12, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x4d, 0x73, 0x67, 0x1a, 0x0f, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f,
-	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3c, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1c, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f,
-	0x5f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x12, 0x32, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x65,
-	0x76, 0x69, 0x63, 0x65, 0x12, 0x12, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x73, 0x67, 0x1a, 0x0e, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53,
-	0x53, 0x4f, 0x5f, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x26, 0x5a, 0x24, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4a, 0x4d, 0x55, 0x52, 0x76, 0x2f, 0x73, 0x73, 0x6f,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x6e,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6e, 0x1a, 0x0e, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x12, 0x36, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f,
+	0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4d, 0x73, 0x67, 0x1a, 0x0e, 0x2e, 0x67, 0x65, 0x6e, 0x2e,
+	0x53, 0x53, 0x4f, 0x5f, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x32, 0x86, 0x02, 0x0a, 0x04, 0x52, 0x6f,
+	0x6c, 0x65, 0x12, 0x40, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12,
+	0x18, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x52, 0x6f, 0x6c, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x67, 0x65, 0x6e, 0x2e,
+	0x53, 0x53, 0x4f, 0x5f, 0x52, 0x6f, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f,
+	0x6c, 0x65, 0x12, 0x0d, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x52, 0x6f, 0x6c,
+	0x65, 0x1a, 0x12, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x55, 0x69, 0x6e, 0x74,
+	0x36, 0x34, 0x4d, 0x73, 0x67, 0x12, 0x2c, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65,
+	0x12, 0x12, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x55, 0x69, 0x6e, 0x74, 0x36,
+	0x34, 0x4d, 0x73, 0x67, 0x1a, 0x0d, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x52,
+	0x6f, 0x6c, 0x65, 0x12, 0x2b, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c,
+	0x65, 0x12, 0x0d, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x52, 0x6f, 0x6c, 0x65,
+	0x1a, 0x0e, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x12, 0x30, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x12,
+	0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4d,
+	0x73, 0x67, 0x1a, 0x0e, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x32, 0xf7, 0x01, 0x0a, 0x07, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x48,
+	0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x1b, 0x2e,
+	0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x65, 0x6e,
+	0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x12, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x73, 0x67, 0x1a, 0x0f, 0x2e, 0x67, 0x65, 0x6e, 0x2e,
+	0x53, 0x53, 0x4f, 0x5f, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3c, 0x0a, 0x0c, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1c, 0x2e, 0x67, 0x65, 0x6e,
+	0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53,
+	0x53, 0x4f, 0x5f, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x32, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x12, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53,
+	0x53, 0x4f, 0x5f, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x73, 0x67, 0x1a, 0x0e, 0x2e, 0x67,
+	0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x32, 0xbe, 0x01, 0x0a,
+	0x08, 0x50, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x3d, 0x0a, 0x0c, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x0e, 0x2e, 0x67, 0x65, 0x6e, 0x2e,
+	0x53, 0x53, 0x4f, 0x5f, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1d, 0x2e, 0x67, 0x65, 0x6e, 0x2e,
+	0x53, 0x53, 0x4f, 0x5f, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0d, 0x52, 0x65, 0x6e, 0x61,
+	0x6d, 0x65, 0x50, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x12, 0x1d, 0x2e, 0x67, 0x65, 0x6e, 0x2e,
+	0x53, 0x53, 0x4f, 0x5f, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x61, 0x73, 0x73, 0x6b, 0x65,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53,
+	0x53, 0x4f, 0x5f, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x33, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x50, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x2e, 0x67, 0x65, 0x6e, 0x2e,
+	0x53, 0x53, 0x4f, 0x5f, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x73, 0x67, 0x1a, 0x0e, 0x2e,
+	0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x32, 0xb6, 0x01,
+	0x0a, 0x06, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x16, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x15, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f, 0x5f, 0x50, 0x68, 0x6f,
+	0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x67, 0x65, 0x6e, 0x2e,
+	0x53, 0x53, 0x4f, 0x5f, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3c, 0x0a, 0x0c, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x72, 0x6d, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x1c, 0x2e, 0x67, 0x65, 0x6e, 0x2e,
+	0x53, 0x53, 0x4f, 0x5f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x50, 0x68, 0x6f, 0x6e, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53,
+	0x4f, 0x5f, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2d, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x0e, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f,
+	0x5f, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0e, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x53, 0x4f,
+	0x5f, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x26, 0x5a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4a, 0x4d, 0x55, 0x52, 0x76, 0x2f, 0x73, 0x73, 0x6f, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x6e, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2335,7 +3924,7 @@ func file_api_grpc_v1_gen_sso_proto_rawDescGZIP() []byte {
 	return file_api_grpc_v1_gen_sso_proto_rawDescData
 }
 
-var file_api_grpc_v1_gen_sso_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
+var file_api_grpc_v1_gen_sso_proto_msgTypes = make([]protoimpl.MessageInfo, 51)
 var file_api_grpc_v1_gen_sso_proto_goTypes = []any{
 	(*SSO_Empty)(nil),                       // 0: gen.SSO_Empty
 	(*SSO_StringMsg)(nil),                   // 1: gen.SSO_StringMsg
@@ -2344,107 +3933,185 @@ var file_api_grpc_v1_gen_sso_proto_goTypes = []any{
 	(*SSO_RefreshRequest)(nil),              // 4: gen.SSO_RefreshRequest
 	(*SSO_EmailAndPasswordRequest)(nil),     // 5: gen.SSO_EmailAndPasswordRequest
 	(*SSO_TokenPair)(nil),                   // 6: gen.SSO_TokenPair
-	(*SSO_ParseClaimsRes)(nil),              // 7: gen.SSO_ParseClaimsRes
-	(*SSO_SendLoginCodeReq)(nil),            // 8: gen.SSO_SendLoginCodeReq
-	(*SSO_CheckLoginCodeReq)(nil),           // 9: gen.SSO_CheckLoginCodeReq
-	(*SSO_EmailMsg)(nil),                    // 10: gen.SSO_EmailMsg
-	(*SSO_CheckForgotPasswordEmailReq)(nil), // 11: gen.SSO_CheckForgotPasswordEmailReq
-	(*SSO_User)(nil),                        // 12: gen.SSO_User
-	(*SSO_UserListRequest)(nil),             // 13: gen.SSO_UserListRequest
-	(*SSO_UserListResponse)(nil),            // 14: gen.SSO_UserListResponse
-	(*SSO_ExistUserRequest)(nil),            // 15: gen.SSO_ExistUserRequest
-	(*SSO_ExistUserResponse)(nil),           // 16: gen.SSO_ExistUserResponse
-	(*SSO_CreateUserReq)(nil),               // 17: gen.SSO_CreateUserReq
-	(*SSO_UpdateUserReq)(nil),               // 18: gen.SSO_UpdateUserReq
-	(*SSO_CreateUserRes)(nil),               // 19: gen.SSO_CreateUserRes
-	(*SSO_Permission)(nil),                  // 20: gen.SSO_Permission
-	(*SSO_PermissionListRequest)(nil),       // 21: gen.SSO_PermissionListRequest
-	(*SSO_PermissionListResponse)(nil),      // 22: gen.SSO_PermissionListResponse
-	(*SSO_Role)(nil),                        // 23: gen.SSO_Role
-	(*SSO_RoleListRequest)(nil),             // 24: gen.SSO_RoleListRequest
-	(*SSO_RoleListResponse)(nil),            // 25: gen.SSO_RoleListResponse
-	(*SSO_Device)(nil),                      // 26: gen.SSO_Device
-	(*SSO_ListDevicesRequest)(nil),          // 27: gen.SSO_ListDevicesRequest
-	(*SSO_ListDevicesResponse)(nil),         // 28: gen.SSO_ListDevicesResponse
-	(*SSO_UpdateDeviceRequest)(nil),         // 29: gen.SSO_UpdateDeviceRequest
-	(*timestamppb.Timestamp)(nil),           // 30: google.protobuf.Timestamp
+	(*SSO_LoginApproval)(nil),               // 7: gen.SSO_LoginApproval
+	(*SSO_PendingLogin)(nil),                // 8: gen.SSO_PendingLogin
+	(*SSO_ListPendingLoginsRes)(nil),        // 9: gen.SSO_ListPendingLoginsRes
+	(*SSO_QRLoginRes)(nil),                  // 10: gen.SSO_QRLoginRes
+	(*SSO_QRLoginSession)(nil),              // 11: gen.SSO_QRLoginSession
+	(*SSO_ApproveLoginReq)(nil),             // 12: gen.SSO_ApproveLoginReq
+	(*SSO_MFAChallenge)(nil),                // 13: gen.SSO_MFAChallenge
+	(*SSO_ParseClaimsRes)(nil),              // 14: gen.SSO_ParseClaimsRes
+	(*SSO_ReauthReq)(nil),                   // 15: gen.SSO_ReauthReq
+	(*SSO_SendLoginCodeReq)(nil),            // 16: gen.SSO_SendLoginCodeReq
+	(*SSO_CheckLoginCodeReq)(nil),           // 17: gen.SSO_CheckLoginCodeReq
+	(*SSO_VerifyMagicLinkReq)(nil),          // 18: gen.SSO_VerifyMagicLinkReq
+	(*SSO_EmailMsg)(nil),                    // 19: gen.SSO_EmailMsg
+	(*SSO_CheckForgotPasswordEmailReq)(nil), // 20: gen.SSO_CheckForgotPasswordEmailReq
+	(*SSO_ChangePasswordReq)(nil),           // 21: gen.SSO_ChangePasswordReq
+	(*SSO_TOTPEnrollRes)(nil),               // 22: gen.SSO_TOTPEnrollRes
+	(*SSO_TOTPCodeReq)(nil),                 // 23: gen.SSO_TOTPCodeReq
+	(*SSO_VerifyTOTPReq)(nil),               // 24: gen.SSO_VerifyTOTPReq
+	(*SSO_VerifyEmailCodeReq)(nil),          // 25: gen.SSO_VerifyEmailCodeReq
+	(*SSO_RecoveryCodes)(nil),               // 26: gen.SSO_RecoveryCodes
+	(*SSO_VerifyRecoveryCodeReq)(nil),       // 27: gen.SSO_VerifyRecoveryCodeReq
+	(*SSO_User)(nil),                        // 28: gen.SSO_User
+	(*SSO_UserListRequest)(nil),             // 29: gen.SSO_UserListRequest
+	(*SSO_UserListResponse)(nil),            // 30: gen.SSO_UserListResponse
+	(*SSO_ExistUserRequest)(nil),            // 31: gen.SSO_ExistUserRequest
+	(*SSO_ExistUserResponse)(nil),           // 32: gen.SSO_ExistUserResponse
+	(*SSO_CreateUserReq)(nil),               // 33: gen.SSO_CreateUserReq
+	(*SSO_UpdateUserReq)(nil),               // 34: gen.SSO_UpdateUserReq
+	(*SSO_CreateUserRes)(nil),               // 35: gen.SSO_CreateUserRes
+	(*SSO_Permission)(nil),                  // 36: gen.SSO_Permission
+	(*SSO_PermissionListRequest)(nil),       // 37: gen.SSO_PermissionListRequest
+	(*SSO_PermissionListResponse)(nil),      // 38: gen.SSO_PermissionListResponse
+	(*SSO_Role)(nil),                        // 39: gen.SSO_Role
+	(*SSO_RoleListRequest)(nil),             // 40: gen.SSO_RoleListRequest
+	(*SSO_RoleListResponse)(nil),            // 41: gen.SSO_RoleListResponse
+	(*SSO_Device)(nil),                      // 42: gen.SSO_Device
+	(*SSO_ListDevicesRequest)(nil),          // 43: gen.SSO_ListDevicesRequest
+	(*SSO_ListDevicesResponse)(nil),         // 44: gen.SSO_ListDevicesResponse
+	(*SSO_UpdateDeviceRequest)(nil),         // 45: gen.SSO_UpdateDeviceRequest
+	(*SSO_Passkey)(nil),                     // 46: gen.SSO_Passkey
+	(*SSO_ListPasskeysResponse)(nil),        // 47: gen.SSO_ListPasskeysResponse
+	(*SSO_RenamePasskeyRequest)(nil),        // 48: gen.SSO_RenamePasskeyRequest
+	(*SSO_PhoneRequest)(nil),                // 49: gen.SSO_PhoneRequest
+	(*SSO_ConfirmPhoneRequest)(nil),         // 50: gen.SSO_ConfirmPhoneRequest
+	(*timestamppb.Timestamp)(nil),           // 51: google.protobuf.Timestamp
 }
 var file_api_grpc_v1_gen_sso_proto_depIdxs = []int32{
-	23, // 0: gen.SSO_ParseClaimsRes.roles:type_name -> gen.SSO_Role
-	23, // 1: gen.SSO_User.roles:type_name -> gen.SSO_Role
-	30, // 2: gen.SSO_User.created_at:type_name -> google.protobuf.Timestamp
-	30, // 3: gen.SSO_User.updated_at:type_name -> google.protobuf.Timestamp
-	12, // 4: gen.SSO_UserListResponse.data:type_name -> gen.SSO_User
-	20, // 5: gen.SSO_PermissionListResponse.data:type_name -> gen.SSO_Permission
-	23, // 6: gen.SSO_RoleListResponse.data:type_name -> gen.SSO_Role
-	30, // 7: gen.SSO_Device.last_active:type_name -> google.protobuf.Timestamp
-	30, // 8: gen.SSO_Device.created_at:type_name -> google.protobuf.Timestamp
-	26, // 9: gen.SSO_ListDevicesResponse.data:type_name -> gen.SSO_Device
-	5,  // 10: gen.Auth.Authenticate:input_type -> gen.SSO_EmailAndPasswordRequest
-	1,  // 11: gen.Auth.ParseClaims:input_type -> gen.SSO_StringMsg
-	4,  // 12: gen.Auth.Refresh:input_type -> gen.SSO_RefreshRequest
-	8,  // 13: gen.Auth.SendLoginCode:input_type -> gen.SSO_SendLoginCodeReq
-	9,  // 14: gen.Auth.CheckLoginCode:input_type -> gen.SSO_CheckLoginCodeReq
-	10, // 15: gen.Auth.SendForgotPasswordEmail:input_type -> gen.SSO_EmailMsg
-	11, // 16: gen.Auth.CheckForgotPasswordEmail:input_type -> gen.SSO_CheckForgotPasswordEmailReq
-	0,  // 17: gen.Auth.Logout:input_type -> gen.SSO_Empty
-	15, // 18: gen.Users.ExistUser:input_type -> gen.SSO_ExistUserRequest
-	0,  // 19: gen.Users.GetMe:input_type -> gen.SSO_Empty
-	18, // 20: gen.Users.UpdateMe:input_type -> gen.SSO_UpdateUserReq
-	13, // 21: gen.Users.ListUsers:input_type -> gen.SSO_UserListRequest
-	17, // 22: gen.Users.CreateUser:input_type -> gen.SSO_CreateUserReq
-	2,  // 23: gen.Users.GetUser:input_type -> gen.SSO_UuidMsg
-	18, // 24: gen.Users.UpdateUser:input_type -> gen.SSO_UpdateUserReq
-	2,  // 25: gen.Users.DeleteUser:input_type -> gen.SSO_UuidMsg
-	21, // 26: gen.Permission.ListPermissions:input_type -> gen.SSO_PermissionListRequest
-	20, // 27: gen.Permission.CreatePermission:input_type -> gen.SSO_Permission
-	3,  // 28: gen.Permission.GetPermission:input_type -> gen.SSO_Uint64Msg
-	20, // 29: gen.Permission.UpdatePermission:input_type -> gen.SSO_Permission
-	3,  // 30: gen.Permission.DeletePermission:input_type -> gen.SSO_Uint64Msg
-	24, // 31: gen.Role.ListRoles:input_type -> gen.SSO_RoleListRequest
-	23, // 32: gen.Role.CreateRole:input_type -> gen.SSO_Role
-	3,  // 33: gen.Role.GetRole:input_type -> gen.SSO_Uint64Msg
-	23, // 34: gen.Role.UpdateRole:input_type -> gen.SSO_Role
-	3,  // 35: gen.Role.DeleteRole:input_type -> gen.SSO_Uint64Msg
-	27, // 36: gen.Devices.ListDevices:input_type -> gen.SSO_ListDevicesRequest
-	1,  // 37: gen.Devices.GetDevice:input_type -> gen.SSO_StringMsg
-	29, // 38: gen.Devices.UpdateDevice:input_type -> gen.SSO_UpdateDeviceRequest
-	1,  // 39: gen.Devices.DeleteDevice:input_type -> gen.SSO_StringMsg
-	6,  // 40: gen.Auth.Authenticate:output_type -> gen.SSO_TokenPair
-	7,  // 41: gen.Auth.ParseClaims:output_type -> gen.SSO_ParseClaimsRes
-	6,  // 42: gen.Auth.Refresh:output_type -> gen.SSO_TokenPair
-	6,  // 43: gen.Auth.SendLoginCode:output_type -> gen.SSO_TokenPair
-	6,  // 44: gen.Auth.CheckLoginCode:output_type -> gen.SSO_TokenPair
-	0,  // 45: gen.Auth.SendForgotPasswordEmail:output_type -> gen.SSO_Empty
-	0,  // 46: gen.Auth.CheckForgotPasswordEmail:output_type -> gen.SSO_Empty
-	0,  // 47: gen.Auth.Logout:output_type -> gen.SSO_Empty
-	16, // 48: gen.Users.ExistUser:output_type -> gen.SSO_ExistUserResponse
-	12, // 49: gen.Users.GetMe:output_type -> gen.SSO_User
-	12, // 50: gen.Users.UpdateMe:output_type -> gen.SSO_User
-	14, // 51: gen.Users.ListUsers:output_type -> gen.SSO_UserListResponse
-	19, // 52: gen.Users.CreateUser:output_type -> gen.SSO_CreateUserRes
-	12, // 53: gen.Users.GetUser:output_type -> gen.SSO_User
-	2,  // 54: gen.Users.UpdateUser:output_type -> gen.SSO_UuidMsg
-	0,  // 55: gen.Users.DeleteUser:output_type -> gen.SSO_Empty
-	22, // 56: gen.Permission.ListPermissions:output_type -> gen.SSO_PermissionListResponse
-	3,  // 57: gen.Permission.CreatePermission:output_type -> gen.SSO_Uint64Msg
-	20, // 58: gen.Permission.GetPermission:output_type -> gen.SSO_Permission
-	0,  // 59: gen.Permission.UpdatePermission:output_type -> gen.SSO_Empty
-	0,  // 60: gen.Permission.DeletePermission:output_type -> gen.SSO_Empty
-	25, // 61: gen.Role.ListRoles:output_type -> gen.SSO_RoleListResponse
-	3,  // 62: gen.Role.CreateRole:output_type -> gen.SSO_Uint64Msg
-	23, // 63: gen.Role.GetRole:output_type -> gen.SSO_Role
-	0,  // 64: gen.Role.UpdateRole:output_type -> gen.SSO_Empty
-	0,  // 65: gen.Role.DeleteRole:output_type -> gen.SSO_Empty
-	28, // 66: gen.Devices.ListDevices:output_type -> gen.SSO_ListDevicesResponse
-	26, // 67: gen.Devices.GetDevice:output_type -> gen.SSO_Device
-	0,  // 68: gen.Devices.UpdateDevice:output_type -> gen.SSO_Empty
-	0,  // 69: gen.Devices.DeleteDevice:output_type -> gen.SSO_Empty
-	40, // [40:70] is the sub-list for method output_type
-	10, // [10:40] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	13, // 0: gen.SSO_TokenPair.challenge:type_name -> gen.SSO_MFAChallenge
+	7,  // 1: gen.SSO_TokenPair.approval:type_name -> gen.SSO_LoginApproval
+	51, // 2: gen.SSO_PendingLogin.created_at:type_name -> google.protobuf.Timestamp
+	8,  // 3: gen.SSO_ListPendingLoginsRes.data:type_name -> gen.SSO_PendingLogin
+	51, // 4: gen.SSO_QRLoginRes.expires_at:type_name -> google.protobuf.Timestamp
+	51, // 5: gen.SSO_QRLoginSession.created_at:type_name -> google.protobuf.Timestamp
+	39, // 6: gen.SSO_ParseClaimsRes.roles:type_name -> gen.SSO_Role
+	39, // 7: gen.SSO_User.roles:type_name -> gen.SSO_Role
+	51, // 8: gen.SSO_User.created_at:type_name -> google.protobuf.Timestamp
+	51, // 9: gen.SSO_User.updated_at:type_name -> google.protobuf.Timestamp
+	28, // 10: gen.SSO_UserListResponse.data:type_name -> gen.SSO_User
+	36, // 11: gen.SSO_PermissionListResponse.data:type_name -> gen.SSO_Permission
+	39, // 12: gen.SSO_RoleListResponse.data:type_name -> gen.SSO_Role
+	51, // 13: gen.SSO_Device.last_active:type_name -> google.protobuf.Timestamp
+	51, // 14: gen.SSO_Device.created_at:type_name -> google.protobuf.Timestamp
+	42, // 15: gen.SSO_ListDevicesResponse.data:type_name -> gen.SSO_Device
+	51, // 16: gen.SSO_Passkey.created_at:type_name -> google.protobuf.Timestamp
+	51, // 17: gen.SSO_Passkey.last_used_at:type_name -> google.protobuf.Timestamp
+	46, // 18: gen.SSO_ListPasskeysResponse.data:type_name -> gen.SSO_Passkey
+	5,  // 19: gen.Auth.Authenticate:input_type -> gen.SSO_EmailAndPasswordRequest
+	1,  // 20: gen.Auth.ParseClaims:input_type -> gen.SSO_StringMsg
+	4,  // 21: gen.Auth.Refresh:input_type -> gen.SSO_RefreshRequest
+	16, // 22: gen.Auth.SendLoginCode:input_type -> gen.SSO_SendLoginCodeReq
+	17, // 23: gen.Auth.CheckLoginCode:input_type -> gen.SSO_CheckLoginCodeReq
+	19, // 24: gen.Auth.SendMagicLink:input_type -> gen.SSO_EmailMsg
+	18, // 25: gen.Auth.VerifyMagicLink:input_type -> gen.SSO_VerifyMagicLinkReq
+	0,  // 26: gen.Auth.ListLoginApprovals:input_type -> gen.SSO_Empty
+	12, // 27: gen.Auth.ApproveLogin:input_type -> gen.SSO_ApproveLoginReq
+	1,  // 28: gen.Auth.CheckLoginApproval:input_type -> gen.SSO_StringMsg
+	0,  // 29: gen.Auth.StartQRLogin:input_type -> gen.SSO_Empty
+	1,  // 30: gen.Auth.GetQRLogin:input_type -> gen.SSO_StringMsg
+	1,  // 31: gen.Auth.ConfirmQRLogin:input_type -> gen.SSO_StringMsg
+	1,  // 32: gen.Auth.CheckQRLogin:input_type -> gen.SSO_StringMsg
+	19, // 33: gen.Auth.SendForgotPasswordEmail:input_type -> gen.SSO_EmailMsg
+	20, // 34: gen.Auth.CheckForgotPasswordEmail:input_type -> gen.SSO_CheckForgotPasswordEmailReq
+	21, // 35: gen.Auth.ChangePassword:input_type -> gen.SSO_ChangePasswordReq
+	15, // 36: gen.Auth.Reauthenticate:input_type -> gen.SSO_ReauthReq
+	0,  // 37: gen.Auth.Logout:input_type -> gen.SSO_Empty
+	0,  // 38: gen.Auth.EnrollTOTP:input_type -> gen.SSO_Empty
+	23, // 39: gen.Auth.ConfirmTOTP:input_type -> gen.SSO_TOTPCodeReq
+	23, // 40: gen.Auth.DisableTOTP:input_type -> gen.SSO_TOTPCodeReq
+	24, // 41: gen.Auth.VerifyTOTP:input_type -> gen.SSO_VerifyTOTPReq
+	0,  // 42: gen.Auth.RegenerateRecoveryCodes:input_type -> gen.SSO_Empty
+	27, // 43: gen.Auth.VerifyRecoveryCode:input_type -> gen.SSO_VerifyRecoveryCodeReq
+	25, // 44: gen.Auth.VerifyEmailCode:input_type -> gen.SSO_VerifyEmailCodeReq
+	31, // 45: gen.Users.ExistUser:input_type -> gen.SSO_ExistUserRequest
+	0,  // 46: gen.Users.GetMe:input_type -> gen.SSO_Empty
+	34, // 47: gen.Users.UpdateMe:input_type -> gen.SSO_UpdateUserReq
+	29, // 48: gen.Users.ListUsers:input_type -> gen.SSO_UserListRequest
+	33, // 49: gen.Users.CreateUser:input_type -> gen.SSO_CreateUserReq
+	2,  // 50: gen.Users.GetUser:input_type -> gen.SSO_UuidMsg
+	34, // 51: gen.Users.UpdateUser:input_type -> gen.SSO_UpdateUserReq
+	2,  // 52: gen.Users.DeleteUser:input_type -> gen.SSO_UuidMsg
+	37, // 53: gen.Permission.ListPermissions:input_type -> gen.SSO_PermissionListRequest
+	36, // 54: gen.Permission.CreatePermission:input_type -> gen.SSO_Permission
+	3,  // 55: gen.Permission.GetPermission:input_type -> gen.SSO_Uint64Msg
+	36, // 56: gen.Permission.UpdatePermission:input_type -> gen.SSO_Permission
+	3,  // 57: gen.Permission.DeletePermission:input_type -> gen.SSO_Uint64Msg
+	40, // 58: gen.Role.ListRoles:input_type -> gen.SSO_RoleListRequest
+	39, // 59: gen.Role.CreateRole:input_type -> gen.SSO_Role
+	3,  // 60: gen.Role.GetRole:input_type -> gen.SSO_Uint64Msg
+	39, // 61: gen.Role.UpdateRole:input_type -> gen.SSO_Role
+	3,  // 62: gen.Role.DeleteRole:input_type -> gen.SSO_Uint64Msg
+	43, // 63: gen.Devices.ListDevices:input_type -> gen.SSO_ListDevicesRequest
+	1,  // 64: gen.Devices.GetDevice:input_type -> gen.SSO_StringMsg
+	45, // 65: gen.Devices.UpdateDevice:input_type -> gen.SSO_UpdateDeviceRequest
+	1,  // 66: gen.Devices.DeleteDevice:input_type -> gen.SSO_StringMsg
+	0,  // 67: gen.Passkeys.ListPasskeys:input_type -> gen.SSO_Empty
+	48, // 68: gen.Passkeys.RenamePasskey:input_type -> gen.SSO_RenamePasskeyRequest
+	1,  // 69: gen.Passkeys.DeletePasskey:input_type -> gen.SSO_StringMsg
+	49, // 70: gen.Phones.StartPhoneVerification:input_type -> gen.SSO_PhoneRequest
+	50, // 71: gen.Phones.ConfirmPhone:input_type -> gen.SSO_ConfirmPhoneRequest
+	0,  // 72: gen.Phones.DeletePhone:input_type -> gen.SSO_Empty
+	6,  // 73: gen.Auth.Authenticate:output_type -> gen.SSO_TokenPair
+	14, // 74: gen.Auth.ParseClaims:output_type -> gen.SSO_ParseClaimsRes
+	6,  // 75: gen.Auth.Refresh:output_type -> gen.SSO_TokenPair
+	6,  // 76: gen.Auth.SendLoginCode:output_type -> gen.SSO_TokenPair
+	6,  // 77: gen.Auth.CheckLoginCode:output_type -> gen.SSO_TokenPair
+	1,  // 78: gen.Auth.SendMagicLink:output_type -> gen.SSO_StringMsg
+	6,  // 79: gen.Auth.VerifyMagicLink:output_type -> gen.SSO_TokenPair
+	9,  // 80: gen.Auth.ListLoginApprovals:output_type -> gen.SSO_ListPendingLoginsRes
+	0,  // 81: gen.Auth.ApproveLogin:output_type -> gen.SSO_Empty
+	6,  // 82: gen.Auth.CheckLoginApproval:output_type -> gen.SSO_TokenPair
+	10, // 83: gen.Auth.StartQRLogin:output_type -> gen.SSO_QRLoginRes
+	11, // 84: gen.Auth.GetQRLogin:output_type -> gen.SSO_QRLoginSession
+	0,  // 85: gen.Auth.ConfirmQRLogin:output_type -> gen.SSO_Empty
+	6,  // 86: gen.Auth.CheckQRLogin:output_type -> gen.SSO_TokenPair
+	0,  // 87: gen.Auth.SendForgotPasswordEmail:output_type -> gen.SSO_Empty
+	0,  // 88: gen.Auth.CheckForgotPasswordEmail:output_type -> gen.SSO_Empty
+	6,  // 89: gen.Auth.ChangePassword:output_type -> gen.SSO_TokenPair
+	6,  // 90: gen.Auth.Reauthenticate:output_type -> gen.SSO_TokenPair
+	0,  // 91: gen.Auth.Logout:output_type -> gen.SSO_Empty
+	22, // 92: gen.Auth.EnrollTOTP:output_type -> gen.SSO_TOTPEnrollRes
+	26, // 93: gen.Auth.ConfirmTOTP:output_type -> gen.SSO_RecoveryCodes
+	0,  // 94: gen.Auth.DisableTOTP:output_type -> gen.SSO_Empty
+	6,  // 95: gen.Auth.VerifyTOTP:output_type -> gen.SSO_TokenPair
+	26, // 96: gen.Auth.RegenerateRecoveryCodes:output_type -> gen.SSO_RecoveryCodes
+	6,  // 97: gen.Auth.VerifyRecoveryCode:output_type -> gen.SSO_TokenPair
+	6,  // 98: gen.Auth.VerifyEmailCode:output_type -> gen.SSO_TokenPair
+	32, // 99: gen.Users.ExistUser:output_type -> gen.SSO_ExistUserResponse
+	28, // 100: gen.Users.GetMe:output_type -> gen.SSO_User
+	28, // 101: gen.Users.UpdateMe:output_type -> gen.SSO_User
+	30, // 102: gen.Users.ListUsers:output_type -> gen.SSO_UserListResponse
+	35, // 103: gen.Users.CreateUser:output_type -> gen.SSO_CreateUserRes
+	28, // 104: gen.Users.GetUser:output_type -> gen.SSO_User
+	2,  // 105: gen.Users.UpdateUser:output_type -> gen.SSO_UuidMsg
+	0,  // 106: gen.Users.DeleteUser:output_type -> gen.SSO_Empty
+	38, // 107: gen.Permission.ListPermissions:output_type -> gen.SSO_PermissionListResponse
+	3,  // 108: gen.Permission.CreatePermission:output_type -> gen.SSO_Uint64Msg
+	36, // 109: gen.Permission.GetPermission:output_type -> gen.SSO_Permission
+	0,  // 110: gen.Permission.UpdatePermission:output_type -> gen.SSO_Empty
+	0,  // 111: gen.Permission.DeletePermission:output_type -> gen.SSO_Empty
+	41, // 112: gen.Role.ListRoles:output_type -> gen.SSO_RoleListResponse
+	3,  // 113: gen.Role.CreateRole:output_type -> gen.SSO_Uint64Msg
+	39, // 114: gen.Role.GetRole:output_type -> gen.SSO_Role
+	0,  // 115: gen.Role.UpdateRole:output_type -> gen.SSO_Empty
+	0,  // 116: gen.Role.DeleteRole:output_type -> gen.SSO_Empty
+	44, // 117: gen.Devices.ListDevices:output_type -> gen.SSO_ListDevicesResponse
+	42, // 118: gen.Devices.GetDevice:output_type -> gen.SSO_Device
+	0,  // 119: gen.Devices.UpdateDevice:output_type -> gen.SSO_Empty
+	0,  // 120: gen.Devices.DeleteDevice:output_type -> gen.SSO_Empty
+	47, // 121: gen.Passkeys.ListPasskeys:output_type -> gen.SSO_ListPasskeysResponse
+	0,  // 122: gen.Passkeys.RenamePasskey:output_type -> gen.SSO_Empty
+	0,  // 123: gen.Passkeys.DeletePasskey:output_type -> gen.SSO_Empty
+	0,  // 124: gen.Phones.StartPhoneVerification:output_type -> gen.SSO_Empty
+	0,  // 125: gen.Phones.ConfirmPhone:output_type -> gen.SSO_Empty
+	0,  // 126: gen.Phones.DeletePhone:output_type -> gen.SSO_Empty
+	73, // [73:127] is the sub-list for method output_type
+	19, // [19:73] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_api_grpc_v1_gen_sso_proto_init() }
@@ -2538,7 +4205,7 @@ func file_api_grpc_v1_gen_sso_proto_init() {
 			}
 		}
 		file_api_grpc_v1_gen_sso_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*SSO_ParseClaimsRes); i {
+			switch v := v.(*SSO_LoginApproval); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2550,7 +4217,7 @@ func file_api_grpc_v1_gen_sso_proto_init() {
 			}
 		}
 		file_api_grpc_v1_gen_sso_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*SSO_SendLoginCodeReq); i {
+			switch v := v.(*SSO_PendingLogin); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2562,7 +4229,7 @@ func file_api_grpc_v1_gen_sso_proto_init() {
 			}
 		}
 		file_api_grpc_v1_gen_sso_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*SSO_CheckLoginCodeReq); i {
+			switch v := v.(*SSO_ListPendingLoginsRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2574,7 +4241,7 @@ func file_api_grpc_v1_gen_sso_proto_init() {
 			}
 		}
 		file_api_grpc_v1_gen_sso_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*SSO_EmailMsg); i {
+			switch v := v.(*SSO_QRLoginRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2586,7 +4253,7 @@ func file_api_grpc_v1_gen_sso_proto_init() {
 			}
 		}
 		file_api_grpc_v1_gen_sso_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*SSO_CheckForgotPasswordEmailReq); i {
+			switch v := v.(*SSO_QRLoginSession); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2598,7 +4265,7 @@ func file_api_grpc_v1_gen_sso_proto_init() {
 			}
 		}
 		file_api_grpc_v1_gen_sso_proto_msgTypes[12].Exporter = func(v any, i int) any {
-			switch v := v.(*SSO_User); i {
+			switch v := v.(*SSO_ApproveLoginReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2610,7 +4277,7 @@ func file_api_grpc_v1_gen_sso_proto_init() {
 			}
 		}
 		file_api_grpc_v1_gen_sso_proto_msgTypes[13].Exporter = func(v any, i int) any {
-			switch v := v.(*SSO_UserListRequest); i {
+			switch v := v.(*SSO_MFAChallenge); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2622,7 +4289,7 @@ func file_api_grpc_v1_gen_sso_proto_init() {
 			}
 		}
 		file_api_grpc_v1_gen_sso_proto_msgTypes[14].Exporter = func(v any, i int) any {
-			switch v := v.(*SSO_UserListResponse); i {
+			switch v := v.(*SSO_ParseClaimsRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2634,7 +4301,7 @@ func file_api_grpc_v1_gen_sso_proto_init() {
 			}
 		}
 		file_api_grpc_v1_gen_sso_proto_msgTypes[15].Exporter = func(v any, i int) any {
-			switch v := v.(*SSO_ExistUserRequest); i {
+			switch v := v.(*SSO_ReauthReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2646,7 +4313,7 @@ func file_api_grpc_v1_gen_sso_proto_init() {
 			}
 		}
 		file_api_grpc_v1_gen_sso_proto_msgTypes[16].Exporter = func(v any, i int) any {
-			switch v := v.(*SSO_ExistUserResponse); i {
+			switch v := v.(*SSO_SendLoginCodeReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2658,7 +4325,7 @@ func file_api_grpc_v1_gen_sso_proto_init() {
 			}
 		}
 		file_api_grpc_v1_gen_sso_proto_msgTypes[17].Exporter = func(v any, i int) any {
-			switch v := v.(*SSO_CreateUserReq); i {
+			switch v := v.(*SSO_CheckLoginCodeReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2670,7 +4337,7 @@ func file_api_grpc_v1_gen_sso_proto_init() {
 			}
 		}
 		file_api_grpc_v1_gen_sso_proto_msgTypes[18].Exporter = func(v any, i int) any {
-			switch v := v.(*SSO_UpdateUserReq); i {
+			switch v := v.(*SSO_VerifyMagicLinkReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2682,7 +4349,7 @@ func file_api_grpc_v1_gen_sso_proto_init() {
 			}
 		}
 		file_api_grpc_v1_gen_sso_proto_msgTypes[19].Exporter = func(v any, i int) any {
-			switch v := v.(*SSO_CreateUserRes); i {
+			switch v := v.(*SSO_EmailMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2694,7 +4361,7 @@ func file_api_grpc_v1_gen_sso_proto_init() {
 			}
 		}
 		file_api_grpc_v1_gen_sso_proto_msgTypes[20].Exporter = func(v any, i int) any {
-			switch v := v.(*SSO_Permission); i {
+			switch v := v.(*SSO_CheckForgotPasswordEmailReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2706,7 +4373,7 @@ func file_api_grpc_v1_gen_sso_proto_init() {
 			}
 		}
 		file_api_grpc_v1_gen_sso_proto_msgTypes[21].Exporter = func(v any, i int) any {
-			switch v := v.(*SSO_PermissionListRequest); i {
+			switch v := v.(*SSO_ChangePasswordReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2718,7 +4385,7 @@ func file_api_grpc_v1_gen_sso_proto_init() {
 			}
 		}
 		file_api_grpc_v1_gen_sso_proto_msgTypes[22].Exporter = func(v any, i int) any {
-			switch v := v.(*SSO_PermissionListResponse); i {
+			switch v := v.(*SSO_TOTPEnrollRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2730,7 +4397,7 @@ func file_api_grpc_v1_gen_sso_proto_init() {
 			}
 		}
 		file_api_grpc_v1_gen_sso_proto_msgTypes[23].Exporter = func(v any, i int) any {
-			switch v := v.(*SSO_Role); i {
+			switch v := v.(*SSO_TOTPCodeReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2742,7 +4409,7 @@ func file_api_grpc_v1_gen_sso_proto_init() {
 			}
 		}
 		file_api_grpc_v1_gen_sso_proto_msgTypes[24].Exporter = func(v any, i int) any {
-			switch v := v.(*SSO_RoleListRequest); i {
+			switch v := v.(*SSO_VerifyTOTPReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2754,7 +4421,7 @@ func file_api_grpc_v1_gen_sso_proto_init() {
 			}
 		}
 		file_api_grpc_v1_gen_sso_proto_msgTypes[25].Exporter = func(v any, i int) any {
-			switch v := v.(*SSO_RoleListResponse); i {
+			switch v := v.(*SSO_VerifyEmailCodeReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2766,7 +4433,7 @@ func file_api_grpc_v1_gen_sso_proto_init() {
 			}
 		}
 		file_api_grpc_v1_gen_sso_proto_msgTypes[26].Exporter = func(v any, i int) any {
-			switch v := v.(*SSO_Device); i {
+			switch v := v.(*SSO_RecoveryCodes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2778,7 +4445,7 @@ func file_api_grpc_v1_gen_sso_proto_init() {
 			}
 		}
 		file_api_grpc_v1_gen_sso_proto_msgTypes[27].Exporter = func(v any, i int) any {
-			switch v := v.(*SSO_ListDevicesRequest); i {
+			switch v := v.(*SSO_VerifyRecoveryCodeReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2790,7 +4457,7 @@ func file_api_grpc_v1_gen_sso_proto_init() {
 			}
 		}
 		file_api_grpc_v1_gen_sso_proto_msgTypes[28].Exporter = func(v any, i int) any {
-			switch v := v.(*SSO_ListDevicesResponse); i {
+			switch v := v.(*SSO_User); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2802,6 +4469,198 @@ func file_api_grpc_v1_gen_sso_proto_init() {
 			}
 		}
 		file_api_grpc_v1_gen_sso_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*SSO_UserListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_sso_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*SSO_UserListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_sso_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*SSO_ExistUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_sso_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*SSO_ExistUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_sso_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*SSO_CreateUserReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_sso_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*SSO_UpdateUserReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_sso_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*SSO_CreateUserRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_sso_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*SSO_Permission); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_sso_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*SSO_PermissionListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_sso_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*SSO_PermissionListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_sso_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*SSO_Role); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_sso_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*SSO_RoleListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_sso_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*SSO_RoleListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_sso_proto_msgTypes[42].Exporter = func(v any, i int) any {
+			switch v := v.(*SSO_Device); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_sso_proto_msgTypes[43].Exporter = func(v any, i int) any {
+			switch v := v.(*SSO_ListDevicesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_sso_proto_msgTypes[44].Exporter = func(v any, i int) any {
+			switch v := v.(*SSO_ListDevicesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_sso_proto_msgTypes[45].Exporter = func(v any, i int) any {
 			switch v := v.(*SSO_UpdateDeviceRequest); i {
 			case 0:
 				return &v.state
@@ -2813,6 +4672,66 @@ func file_api_grpc_v1_gen_sso_proto_init() {
 				return nil
 			}
 		}
+		file_api_grpc_v1_gen_sso_proto_msgTypes[46].Exporter = func(v any, i int) any {
+			switch v := v.(*SSO_Passkey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_sso_proto_msgTypes[47].Exporter = func(v any, i int) any {
+			switch v := v.(*SSO_ListPasskeysResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_sso_proto_msgTypes[48].Exporter = func(v any, i int) any {
+			switch v := v.(*SSO_RenamePasskeyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_sso_proto_msgTypes[49].Exporter = func(v any, i int) any {
+			switch v := v.(*SSO_PhoneRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_v1_gen_sso_proto_msgTypes[50].Exporter = func(v any, i int) any {
+			switch v := v.(*SSO_ConfirmPhoneRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2820,9 +4739,9 @@ func file_api_grpc_v1_gen_sso_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_grpc_v1_gen_sso_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   30,
+			NumMessages:   51,
 			NumExtensions: 0,
-			NumServices:   5,
+			NumServices:   7,
 		},
 		GoTypes:           file_api_grpc_v1_gen_sso_proto_goTypes,
 		DependencyIndexes: file_api_grpc_v1_gen_sso_proto_depIdxs,
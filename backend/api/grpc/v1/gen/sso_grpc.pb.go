@@ -24,9 +24,27 @@ const (
 	Auth_Refresh_FullMethodName                  = "/gen.Auth/Refresh"
 	Auth_SendLoginCode_FullMethodName            = "/gen.Auth/SendLoginCode"
 	Auth_CheckLoginCode_FullMethodName           = "/gen.Auth/CheckLoginCode"
+	Auth_SendMagicLink_FullMethodName            = "/gen.Auth/SendMagicLink"
+	Auth_VerifyMagicLink_FullMethodName          = "/gen.Auth/VerifyMagicLink"
+	Auth_ListLoginApprovals_FullMethodName       = "/gen.Auth/ListLoginApprovals"
+	Auth_ApproveLogin_FullMethodName             = "/gen.Auth/ApproveLogin"
+	Auth_CheckLoginApproval_FullMethodName       = "/gen.Auth/CheckLoginApproval"
+	Auth_StartQRLogin_FullMethodName             = "/gen.Auth/StartQRLogin"
+	Auth_GetQRLogin_FullMethodName               = "/gen.Auth/GetQRLogin"
+	Auth_ConfirmQRLogin_FullMethodName           = "/gen.Auth/ConfirmQRLogin"
+	Auth_CheckQRLogin_FullMethodName             = "/gen.Auth/CheckQRLogin"
 	Auth_SendForgotPasswordEmail_FullMethodName  = "/gen.Auth/SendForgotPasswordEmail"
 	Auth_CheckForgotPasswordEmail_FullMethodName = "/gen.Auth/CheckForgotPasswordEmail"
+	Auth_ChangePassword_FullMethodName           = "/gen.Auth/ChangePassword"
+	Auth_Reauthenticate_FullMethodName           = "/gen.Auth/Reauthenticate"
 	Auth_Logout_FullMethodName                   = "/gen.Auth/Logout"
+	Auth_EnrollTOTP_FullMethodName               = "/gen.Auth/EnrollTOTP"
+	Auth_ConfirmTOTP_FullMethodName              = "/gen.Auth/ConfirmTOTP"
+	Auth_DisableTOTP_FullMethodName              = "/gen.Auth/DisableTOTP"
+	Auth_VerifyTOTP_FullMethodName               = "/gen.Auth/VerifyTOTP"
+	Auth_RegenerateRecoveryCodes_FullMethodName  = "/gen.Auth/RegenerateRecoveryCodes"
+	Auth_VerifyRecoveryCode_FullMethodName       = "/gen.Auth/VerifyRecoveryCode"
+	Auth_VerifyEmailCode_FullMethodName          = "/gen.Auth/VerifyEmailCode"
 )
 
 // AuthClient is the client API for Auth service.
@@ -38,9 +56,27 @@ type AuthClient interface {
 	Refresh(ctx context.Context, in *SSO_RefreshRequest, opts ...grpc.CallOption) (*SSO_TokenPair, error)
 	SendLoginCode(ctx context.Context, in *SSO_SendLoginCodeReq, opts ...grpc.CallOption) (*SSO_TokenPair, error)
 	CheckLoginCode(ctx context.Context, in *SSO_CheckLoginCodeReq, opts ...grpc.CallOption) (*SSO_TokenPair, error)
+	SendMagicLink(ctx context.Context, in *SSO_EmailMsg, opts ...grpc.CallOption) (*SSO_StringMsg, error)
+	VerifyMagicLink(ctx context.Context, in *SSO_VerifyMagicLinkReq, opts ...grpc.CallOption) (*SSO_TokenPair, error)
+	ListLoginApprovals(ctx context.Context, in *SSO_Empty, opts ...grpc.CallOption) (*SSO_ListPendingLoginsRes, error)
+	ApproveLogin(ctx context.Context, in *SSO_ApproveLoginReq, opts ...grpc.CallOption) (*SSO_Empty, error)
+	CheckLoginApproval(ctx context.Context, in *SSO_StringMsg, opts ...grpc.CallOption) (*SSO_TokenPair, error)
+	StartQRLogin(ctx context.Context, in *SSO_Empty, opts ...grpc.CallOption) (*SSO_QRLoginRes, error)
+	GetQRLogin(ctx context.Context, in *SSO_StringMsg, opts ...grpc.CallOption) (*SSO_QRLoginSession, error)
+	ConfirmQRLogin(ctx context.Context, in *SSO_StringMsg, opts ...grpc.CallOption) (*SSO_Empty, error)
+	CheckQRLogin(ctx context.Context, in *SSO_StringMsg, opts ...grpc.CallOption) (*SSO_TokenPair, error)
 	SendForgotPasswordEmail(ctx context.Context, in *SSO_EmailMsg, opts ...grpc.CallOption) (*SSO_Empty, error)
 	CheckForgotPasswordEmail(ctx context.Context, in *SSO_CheckForgotPasswordEmailReq, opts ...grpc.CallOption) (*SSO_Empty, error)
+	ChangePassword(ctx context.Context, in *SSO_ChangePasswordReq, opts ...grpc.CallOption) (*SSO_TokenPair, error)
+	Reauthenticate(ctx context.Context, in *SSO_ReauthReq, opts ...grpc.CallOption) (*SSO_TokenPair, error)
 	Logout(ctx context.Context, in *SSO_Empty, opts ...grpc.CallOption) (*SSO_Empty, error)
+	EnrollTOTP(ctx context.Context, in *SSO_Empty, opts ...grpc.CallOption) (*SSO_TOTPEnrollRes, error)
+	ConfirmTOTP(ctx context.Context, in *SSO_TOTPCodeReq, opts ...grpc.CallOption) (*SSO_RecoveryCodes, error)
+	DisableTOTP(ctx context.Context, in *SSO_TOTPCodeReq, opts ...grpc.CallOption) (*SSO_Empty, error)
+	VerifyTOTP(ctx context.Context, in *SSO_VerifyTOTPReq, opts ...grpc.CallOption) (*SSO_TokenPair, error)
+	RegenerateRecoveryCodes(ctx context.Context, in *SSO_Empty, opts ...grpc.CallOption) (*SSO_RecoveryCodes, error)
+	VerifyRecoveryCode(ctx context.Context, in *SSO_VerifyRecoveryCodeReq, opts ...grpc.CallOption) (*SSO_TokenPair, error)
+	VerifyEmailCode(ctx context.Context, in *SSO_VerifyEmailCodeReq, opts ...grpc.CallOption) (*SSO_TokenPair, error)
 }
 
 type authClient struct {
@@ -101,6 +137,96 @@ func (c *authClient) CheckLoginCode(ctx context.Context, in *SSO_CheckLoginCodeR
 	return out, nil
 }
 
+func (c *authClient) SendMagicLink(ctx context.Context, in *SSO_EmailMsg, opts ...grpc.CallOption) (*SSO_StringMsg, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SSO_StringMsg)
+	err := c.cc.Invoke(ctx, Auth_SendMagicLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) VerifyMagicLink(ctx context.Context, in *SSO_VerifyMagicLinkReq, opts ...grpc.CallOption) (*SSO_TokenPair, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SSO_TokenPair)
+	err := c.cc.Invoke(ctx, Auth_VerifyMagicLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) ListLoginApprovals(ctx context.Context, in *SSO_Empty, opts ...grpc.CallOption) (*SSO_ListPendingLoginsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SSO_ListPendingLoginsRes)
+	err := c.cc.Invoke(ctx, Auth_ListLoginApprovals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) ApproveLogin(ctx context.Context, in *SSO_ApproveLoginReq, opts ...grpc.CallOption) (*SSO_Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SSO_Empty)
+	err := c.cc.Invoke(ctx, Auth_ApproveLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) CheckLoginApproval(ctx context.Context, in *SSO_StringMsg, opts ...grpc.CallOption) (*SSO_TokenPair, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SSO_TokenPair)
+	err := c.cc.Invoke(ctx, Auth_CheckLoginApproval_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) StartQRLogin(ctx context.Context, in *SSO_Empty, opts ...grpc.CallOption) (*SSO_QRLoginRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SSO_QRLoginRes)
+	err := c.cc.Invoke(ctx, Auth_StartQRLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) GetQRLogin(ctx context.Context, in *SSO_StringMsg, opts ...grpc.CallOption) (*SSO_QRLoginSession, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SSO_QRLoginSession)
+	err := c.cc.Invoke(ctx, Auth_GetQRLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) ConfirmQRLogin(ctx context.Context, in *SSO_StringMsg, opts ...grpc.CallOption) (*SSO_Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SSO_Empty)
+	err := c.cc.Invoke(ctx, Auth_ConfirmQRLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) CheckQRLogin(ctx context.Context, in *SSO_StringMsg, opts ...grpc.CallOption) (*SSO_TokenPair, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SSO_TokenPair)
+	err := c.cc.Invoke(ctx, Auth_CheckQRLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *authClient) SendForgotPasswordEmail(ctx context.Context, in *SSO_EmailMsg, opts ...grpc.CallOption) (*SSO_Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SSO_Empty)
@@ -121,6 +247,26 @@ func (c *authClient) CheckForgotPasswordEmail(ctx context.Context, in *SSO_Check
 	return out, nil
 }
 
+func (c *authClient) ChangePassword(ctx context.Context, in *SSO_ChangePasswordReq, opts ...grpc.CallOption) (*SSO_TokenPair, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SSO_TokenPair)
+	err := c.cc.Invoke(ctx, Auth_ChangePassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) Reauthenticate(ctx context.Context, in *SSO_ReauthReq, opts ...grpc.CallOption) (*SSO_TokenPair, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SSO_TokenPair)
+	err := c.cc.Invoke(ctx, Auth_Reauthenticate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *authClient) Logout(ctx context.Context, in *SSO_Empty, opts ...grpc.CallOption) (*SSO_Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SSO_Empty)
@@ -131,6 +277,76 @@ func (c *authClient) Logout(ctx context.Context, in *SSO_Empty, opts ...grpc.Cal
 	return out, nil
 }
 
+func (c *authClient) EnrollTOTP(ctx context.Context, in *SSO_Empty, opts ...grpc.CallOption) (*SSO_TOTPEnrollRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SSO_TOTPEnrollRes)
+	err := c.cc.Invoke(ctx, Auth_EnrollTOTP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) ConfirmTOTP(ctx context.Context, in *SSO_TOTPCodeReq, opts ...grpc.CallOption) (*SSO_RecoveryCodes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SSO_RecoveryCodes)
+	err := c.cc.Invoke(ctx, Auth_ConfirmTOTP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) DisableTOTP(ctx context.Context, in *SSO_TOTPCodeReq, opts ...grpc.CallOption) (*SSO_Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SSO_Empty)
+	err := c.cc.Invoke(ctx, Auth_DisableTOTP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) VerifyTOTP(ctx context.Context, in *SSO_VerifyTOTPReq, opts ...grpc.CallOption) (*SSO_TokenPair, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SSO_TokenPair)
+	err := c.cc.Invoke(ctx, Auth_VerifyTOTP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) RegenerateRecoveryCodes(ctx context.Context, in *SSO_Empty, opts ...grpc.CallOption) (*SSO_RecoveryCodes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SSO_RecoveryCodes)
+	err := c.cc.Invoke(ctx, Auth_RegenerateRecoveryCodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) VerifyRecoveryCode(ctx context.Context, in *SSO_VerifyRecoveryCodeReq, opts ...grpc.CallOption) (*SSO_TokenPair, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SSO_TokenPair)
+	err := c.cc.Invoke(ctx, Auth_VerifyRecoveryCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) VerifyEmailCode(ctx context.Context, in *SSO_VerifyEmailCodeReq, opts ...grpc.CallOption) (*SSO_TokenPair, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SSO_TokenPair)
+	err := c.cc.Invoke(ctx, Auth_VerifyEmailCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthServer is the server API for Auth service.
 // All implementations must embed UnimplementedAuthServer
 // for forward compatibility.
@@ -140,9 +356,27 @@ type AuthServer interface {
 	Refresh(context.Context, *SSO_RefreshRequest) (*SSO_TokenPair, error)
 	SendLoginCode(context.Context, *SSO_SendLoginCodeReq) (*SSO_TokenPair, error)
 	CheckLoginCode(context.Context, *SSO_CheckLoginCodeReq) (*SSO_TokenPair, error)
+	SendMagicLink(context.Context, *SSO_EmailMsg) (*SSO_StringMsg, error)
+	VerifyMagicLink(context.Context, *SSO_VerifyMagicLinkReq) (*SSO_TokenPair, error)
+	ListLoginApprovals(context.Context, *SSO_Empty) (*SSO_ListPendingLoginsRes, error)
+	ApproveLogin(context.Context, *SSO_ApproveLoginReq) (*SSO_Empty, error)
+	CheckLoginApproval(context.Context, *SSO_StringMsg) (*SSO_TokenPair, error)
+	StartQRLogin(context.Context, *SSO_Empty) (*SSO_QRLoginRes, error)
+	GetQRLogin(context.Context, *SSO_StringMsg) (*SSO_QRLoginSession, error)
+	ConfirmQRLogin(context.Context, *SSO_StringMsg) (*SSO_Empty, error)
+	CheckQRLogin(context.Context, *SSO_StringMsg) (*SSO_TokenPair, error)
 	SendForgotPasswordEmail(context.Context, *SSO_EmailMsg) (*SSO_Empty, error)
 	CheckForgotPasswordEmail(context.Context, *SSO_CheckForgotPasswordEmailReq) (*SSO_Empty, error)
+	ChangePassword(context.Context, *SSO_ChangePasswordReq) (*SSO_TokenPair, error)
+	Reauthenticate(context.Context, *SSO_ReauthReq) (*SSO_TokenPair, error)
 	Logout(context.Context, *SSO_Empty) (*SSO_Empty, error)
+	EnrollTOTP(context.Context, *SSO_Empty) (*SSO_TOTPEnrollRes, error)
+	ConfirmTOTP(context.Context, *SSO_TOTPCodeReq) (*SSO_RecoveryCodes, error)
+	DisableTOTP(context.Context, *SSO_TOTPCodeReq) (*SSO_Empty, error)
+	VerifyTOTP(context.Context, *SSO_VerifyTOTPReq) (*SSO_TokenPair, error)
+	RegenerateRecoveryCodes(context.Context, *SSO_Empty) (*SSO_RecoveryCodes, error)
+	VerifyRecoveryCode(context.Context, *SSO_VerifyRecoveryCodeReq) (*SSO_TokenPair, error)
+	VerifyEmailCode(context.Context, *SSO_VerifyEmailCodeReq) (*SSO_TokenPair, error)
 	mustEmbedUnimplementedAuthServer()
 }
 
@@ -168,15 +402,69 @@ func (UnimplementedAuthServer) SendLoginCode(context.Context, *SSO_SendLoginCode
 func (UnimplementedAuthServer) CheckLoginCode(context.Context, *SSO_CheckLoginCodeReq) (*SSO_TokenPair, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckLoginCode not implemented")
 }
+func (UnimplementedAuthServer) SendMagicLink(context.Context, *SSO_EmailMsg) (*SSO_StringMsg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendMagicLink not implemented")
+}
+func (UnimplementedAuthServer) VerifyMagicLink(context.Context, *SSO_VerifyMagicLinkReq) (*SSO_TokenPair, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyMagicLink not implemented")
+}
+func (UnimplementedAuthServer) ListLoginApprovals(context.Context, *SSO_Empty) (*SSO_ListPendingLoginsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLoginApprovals not implemented")
+}
+func (UnimplementedAuthServer) ApproveLogin(context.Context, *SSO_ApproveLoginReq) (*SSO_Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveLogin not implemented")
+}
+func (UnimplementedAuthServer) CheckLoginApproval(context.Context, *SSO_StringMsg) (*SSO_TokenPair, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckLoginApproval not implemented")
+}
+func (UnimplementedAuthServer) StartQRLogin(context.Context, *SSO_Empty) (*SSO_QRLoginRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartQRLogin not implemented")
+}
+func (UnimplementedAuthServer) GetQRLogin(context.Context, *SSO_StringMsg) (*SSO_QRLoginSession, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQRLogin not implemented")
+}
+func (UnimplementedAuthServer) ConfirmQRLogin(context.Context, *SSO_StringMsg) (*SSO_Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmQRLogin not implemented")
+}
+func (UnimplementedAuthServer) CheckQRLogin(context.Context, *SSO_StringMsg) (*SSO_TokenPair, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckQRLogin not implemented")
+}
 func (UnimplementedAuthServer) SendForgotPasswordEmail(context.Context, *SSO_EmailMsg) (*SSO_Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendForgotPasswordEmail not implemented")
 }
 func (UnimplementedAuthServer) CheckForgotPasswordEmail(context.Context, *SSO_CheckForgotPasswordEmailReq) (*SSO_Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckForgotPasswordEmail not implemented")
 }
+func (UnimplementedAuthServer) ChangePassword(context.Context, *SSO_ChangePasswordReq) (*SSO_TokenPair, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+}
+func (UnimplementedAuthServer) Reauthenticate(context.Context, *SSO_ReauthReq) (*SSO_TokenPair, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reauthenticate not implemented")
+}
 func (UnimplementedAuthServer) Logout(context.Context, *SSO_Empty) (*SSO_Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
 }
+func (UnimplementedAuthServer) EnrollTOTP(context.Context, *SSO_Empty) (*SSO_TOTPEnrollRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollTOTP not implemented")
+}
+func (UnimplementedAuthServer) ConfirmTOTP(context.Context, *SSO_TOTPCodeReq) (*SSO_RecoveryCodes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmTOTP not implemented")
+}
+func (UnimplementedAuthServer) DisableTOTP(context.Context, *SSO_TOTPCodeReq) (*SSO_Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableTOTP not implemented")
+}
+func (UnimplementedAuthServer) VerifyTOTP(context.Context, *SSO_VerifyTOTPReq) (*SSO_TokenPair, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyTOTP not implemented")
+}
+func (UnimplementedAuthServer) RegenerateRecoveryCodes(context.Context, *SSO_Empty) (*SSO_RecoveryCodes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegenerateRecoveryCodes not implemented")
+}
+func (UnimplementedAuthServer) VerifyRecoveryCode(context.Context, *SSO_VerifyRecoveryCodeReq) (*SSO_TokenPair, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyRecoveryCode not implemented")
+}
+func (UnimplementedAuthServer) VerifyEmailCode(context.Context, *SSO_VerifyEmailCodeReq) (*SSO_TokenPair, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyEmailCode not implemented")
+}
 func (UnimplementedAuthServer) mustEmbedUnimplementedAuthServer() {}
 func (UnimplementedAuthServer) testEmbeddedByValue()              {}
 
@@ -288,6 +576,168 @@ func _Auth_CheckLoginCode_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Auth_SendMagicLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SSO_EmailMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).SendMagicLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_SendMagicLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).SendMagicLink(ctx, req.(*SSO_EmailMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_VerifyMagicLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SSO_VerifyMagicLinkReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).VerifyMagicLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_VerifyMagicLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).VerifyMagicLink(ctx, req.(*SSO_VerifyMagicLinkReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_ListLoginApprovals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SSO_Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).ListLoginApprovals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_ListLoginApprovals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).ListLoginApprovals(ctx, req.(*SSO_Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_ApproveLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SSO_ApproveLoginReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).ApproveLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_ApproveLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).ApproveLogin(ctx, req.(*SSO_ApproveLoginReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_CheckLoginApproval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SSO_StringMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).CheckLoginApproval(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_CheckLoginApproval_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).CheckLoginApproval(ctx, req.(*SSO_StringMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_StartQRLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SSO_Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).StartQRLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_StartQRLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).StartQRLogin(ctx, req.(*SSO_Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_GetQRLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SSO_StringMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).GetQRLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_GetQRLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).GetQRLogin(ctx, req.(*SSO_StringMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_ConfirmQRLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SSO_StringMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).ConfirmQRLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_ConfirmQRLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).ConfirmQRLogin(ctx, req.(*SSO_StringMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_CheckQRLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SSO_StringMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).CheckQRLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_CheckQRLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).CheckQRLogin(ctx, req.(*SSO_StringMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Auth_SendForgotPasswordEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SSO_EmailMsg)
 	if err := dec(in); err != nil {
@@ -324,6 +774,42 @@ func _Auth_CheckForgotPasswordEmail_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Auth_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SSO_ChangePasswordReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_ChangePassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).ChangePassword(ctx, req.(*SSO_ChangePasswordReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_Reauthenticate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SSO_ReauthReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).Reauthenticate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_Reauthenticate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).Reauthenticate(ctx, req.(*SSO_ReauthReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Auth_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SSO_Empty)
 	if err := dec(in); err != nil {
@@ -342,6 +828,132 @@ func _Auth_Logout_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Auth_EnrollTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SSO_Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).EnrollTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_EnrollTOTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).EnrollTOTP(ctx, req.(*SSO_Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_ConfirmTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SSO_TOTPCodeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).ConfirmTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_ConfirmTOTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).ConfirmTOTP(ctx, req.(*SSO_TOTPCodeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_DisableTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SSO_TOTPCodeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).DisableTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_DisableTOTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).DisableTOTP(ctx, req.(*SSO_TOTPCodeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_VerifyTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SSO_VerifyTOTPReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).VerifyTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_VerifyTOTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).VerifyTOTP(ctx, req.(*SSO_VerifyTOTPReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_RegenerateRecoveryCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SSO_Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).RegenerateRecoveryCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_RegenerateRecoveryCodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).RegenerateRecoveryCodes(ctx, req.(*SSO_Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_VerifyRecoveryCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SSO_VerifyRecoveryCodeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).VerifyRecoveryCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_VerifyRecoveryCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).VerifyRecoveryCode(ctx, req.(*SSO_VerifyRecoveryCodeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_VerifyEmailCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SSO_VerifyEmailCodeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).VerifyEmailCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_VerifyEmailCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).VerifyEmailCode(ctx, req.(*SSO_VerifyEmailCodeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Auth_ServiceDesc is the grpc.ServiceDesc for Auth service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -350,24 +962,60 @@ var Auth_ServiceDesc = grpc.ServiceDesc{
 	HandlerType: (*AuthServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "Authenticate",
-			Handler:    _Auth_Authenticate_Handler,
+			MethodName: "Authenticate",
+			Handler:    _Auth_Authenticate_Handler,
+		},
+		{
+			MethodName: "ParseClaims",
+			Handler:    _Auth_ParseClaims_Handler,
+		},
+		{
+			MethodName: "Refresh",
+			Handler:    _Auth_Refresh_Handler,
+		},
+		{
+			MethodName: "SendLoginCode",
+			Handler:    _Auth_SendLoginCode_Handler,
+		},
+		{
+			MethodName: "CheckLoginCode",
+			Handler:    _Auth_CheckLoginCode_Handler,
+		},
+		{
+			MethodName: "SendMagicLink",
+			Handler:    _Auth_SendMagicLink_Handler,
+		},
+		{
+			MethodName: "VerifyMagicLink",
+			Handler:    _Auth_VerifyMagicLink_Handler,
+		},
+		{
+			MethodName: "ListLoginApprovals",
+			Handler:    _Auth_ListLoginApprovals_Handler,
+		},
+		{
+			MethodName: "ApproveLogin",
+			Handler:    _Auth_ApproveLogin_Handler,
 		},
 		{
-			MethodName: "ParseClaims",
-			Handler:    _Auth_ParseClaims_Handler,
+			MethodName: "CheckLoginApproval",
+			Handler:    _Auth_CheckLoginApproval_Handler,
 		},
 		{
-			MethodName: "Refresh",
-			Handler:    _Auth_Refresh_Handler,
+			MethodName: "StartQRLogin",
+			Handler:    _Auth_StartQRLogin_Handler,
 		},
 		{
-			MethodName: "SendLoginCode",
-			Handler:    _Auth_SendLoginCode_Handler,
+			MethodName: "GetQRLogin",
+			Handler:    _Auth_GetQRLogin_Handler,
 		},
 		{
-			MethodName: "CheckLoginCode",
-			Handler:    _Auth_CheckLoginCode_Handler,
+			MethodName: "ConfirmQRLogin",
+			Handler:    _Auth_ConfirmQRLogin_Handler,
+		},
+		{
+			MethodName: "CheckQRLogin",
+			Handler:    _Auth_CheckQRLogin_Handler,
 		},
 		{
 			MethodName: "SendForgotPasswordEmail",
@@ -377,10 +1025,46 @@ var Auth_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckForgotPasswordEmail",
 			Handler:    _Auth_CheckForgotPasswordEmail_Handler,
 		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _Auth_ChangePassword_Handler,
+		},
+		{
+			MethodName: "Reauthenticate",
+			Handler:    _Auth_Reauthenticate_Handler,
+		},
 		{
 			MethodName: "Logout",
 			Handler:    _Auth_Logout_Handler,
 		},
+		{
+			MethodName: "EnrollTOTP",
+			Handler:    _Auth_EnrollTOTP_Handler,
+		},
+		{
+			MethodName: "ConfirmTOTP",
+			Handler:    _Auth_ConfirmTOTP_Handler,
+		},
+		{
+			MethodName: "DisableTOTP",
+			Handler:    _Auth_DisableTOTP_Handler,
+		},
+		{
+			MethodName: "VerifyTOTP",
+			Handler:    _Auth_VerifyTOTP_Handler,
+		},
+		{
+			MethodName: "RegenerateRecoveryCodes",
+			Handler:    _Auth_RegenerateRecoveryCodes_Handler,
+		},
+		{
+			MethodName: "VerifyRecoveryCode",
+			Handler:    _Auth_VerifyRecoveryCode_Handler,
+		},
+		{
+			MethodName: "VerifyEmailCode",
+			Handler:    _Auth_VerifyEmailCode_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/grpc/v1/gen/sso.proto",
@@ -1477,3 +2161,359 @@ var Devices_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/grpc/v1/gen/sso.proto",
 }
+
+const (
+	Passkeys_ListPasskeys_FullMethodName  = "/gen.Passkeys/ListPasskeys"
+	Passkeys_RenamePasskey_FullMethodName = "/gen.Passkeys/RenamePasskey"
+	Passkeys_DeletePasskey_FullMethodName = "/gen.Passkeys/DeletePasskey"
+)
+
+// PasskeysClient is the client API for Passkeys service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PasskeysClient interface {
+	ListPasskeys(ctx context.Context, in *SSO_Empty, opts ...grpc.CallOption) (*SSO_ListPasskeysResponse, error)
+	RenamePasskey(ctx context.Context, in *SSO_RenamePasskeyRequest, opts ...grpc.CallOption) (*SSO_Empty, error)
+	DeletePasskey(ctx context.Context, in *SSO_StringMsg, opts ...grpc.CallOption) (*SSO_Empty, error)
+}
+
+type passkeysClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPasskeysClient(cc grpc.ClientConnInterface) PasskeysClient {
+	return &passkeysClient{cc}
+}
+
+func (c *passkeysClient) ListPasskeys(ctx context.Context, in *SSO_Empty, opts ...grpc.CallOption) (*SSO_ListPasskeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SSO_ListPasskeysResponse)
+	err := c.cc.Invoke(ctx, Passkeys_ListPasskeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passkeysClient) RenamePasskey(ctx context.Context, in *SSO_RenamePasskeyRequest, opts ...grpc.CallOption) (*SSO_Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SSO_Empty)
+	err := c.cc.Invoke(ctx, Passkeys_RenamePasskey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passkeysClient) DeletePasskey(ctx context.Context, in *SSO_StringMsg, opts ...grpc.CallOption) (*SSO_Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SSO_Empty)
+	err := c.cc.Invoke(ctx, Passkeys_DeletePasskey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PasskeysServer is the server API for Passkeys service.
+// All implementations must embed UnimplementedPasskeysServer
+// for forward compatibility.
+type PasskeysServer interface {
+	ListPasskeys(context.Context, *SSO_Empty) (*SSO_ListPasskeysResponse, error)
+	RenamePasskey(context.Context, *SSO_RenamePasskeyRequest) (*SSO_Empty, error)
+	DeletePasskey(context.Context, *SSO_StringMsg) (*SSO_Empty, error)
+	mustEmbedUnimplementedPasskeysServer()
+}
+
+// UnimplementedPasskeysServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPasskeysServer struct{}
+
+func (UnimplementedPasskeysServer) ListPasskeys(context.Context, *SSO_Empty) (*SSO_ListPasskeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPasskeys not implemented")
+}
+func (UnimplementedPasskeysServer) RenamePasskey(context.Context, *SSO_RenamePasskeyRequest) (*SSO_Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenamePasskey not implemented")
+}
+func (UnimplementedPasskeysServer) DeletePasskey(context.Context, *SSO_StringMsg) (*SSO_Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePasskey not implemented")
+}
+func (UnimplementedPasskeysServer) mustEmbedUnimplementedPasskeysServer() {}
+func (UnimplementedPasskeysServer) testEmbeddedByValue()                  {}
+
+// UnsafePasskeysServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PasskeysServer will
+// result in compilation errors.
+type UnsafePasskeysServer interface {
+	mustEmbedUnimplementedPasskeysServer()
+}
+
+func RegisterPasskeysServer(s grpc.ServiceRegistrar, srv PasskeysServer) {
+	// If the following call pancis, it indicates UnimplementedPasskeysServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Passkeys_ServiceDesc, srv)
+}
+
+func _Passkeys_ListPasskeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SSO_Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PasskeysServer).ListPasskeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Passkeys_ListPasskeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PasskeysServer).ListPasskeys(ctx, req.(*SSO_Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Passkeys_RenamePasskey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SSO_RenamePasskeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PasskeysServer).RenamePasskey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Passkeys_RenamePasskey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PasskeysServer).RenamePasskey(ctx, req.(*SSO_RenamePasskeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Passkeys_DeletePasskey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SSO_StringMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PasskeysServer).DeletePasskey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Passkeys_DeletePasskey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PasskeysServer).DeletePasskey(ctx, req.(*SSO_StringMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Passkeys_ServiceDesc is the grpc.ServiceDesc for Passkeys service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Passkeys_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gen.Passkeys",
+	HandlerType: (*PasskeysServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListPasskeys",
+			Handler:    _Passkeys_ListPasskeys_Handler,
+		},
+		{
+			MethodName: "RenamePasskey",
+			Handler:    _Passkeys_RenamePasskey_Handler,
+		},
+		{
+			MethodName: "DeletePasskey",
+			Handler:    _Passkeys_DeletePasskey_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/grpc/v1/gen/sso.proto",
+}
+
+const (
+	Phones_StartPhoneVerification_FullMethodName = "/gen.Phones/StartPhoneVerification"
+	Phones_ConfirmPhone_FullMethodName           = "/gen.Phones/ConfirmPhone"
+	Phones_DeletePhone_FullMethodName            = "/gen.Phones/DeletePhone"
+)
+
+// PhonesClient is the client API for Phones service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PhonesClient interface {
+	StartPhoneVerification(ctx context.Context, in *SSO_PhoneRequest, opts ...grpc.CallOption) (*SSO_Empty, error)
+	ConfirmPhone(ctx context.Context, in *SSO_ConfirmPhoneRequest, opts ...grpc.CallOption) (*SSO_Empty, error)
+	DeletePhone(ctx context.Context, in *SSO_Empty, opts ...grpc.CallOption) (*SSO_Empty, error)
+}
+
+type phonesClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPhonesClient(cc grpc.ClientConnInterface) PhonesClient {
+	return &phonesClient{cc}
+}
+
+func (c *phonesClient) StartPhoneVerification(ctx context.Context, in *SSO_PhoneRequest, opts ...grpc.CallOption) (*SSO_Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SSO_Empty)
+	err := c.cc.Invoke(ctx, Phones_StartPhoneVerification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *phonesClient) ConfirmPhone(ctx context.Context, in *SSO_ConfirmPhoneRequest, opts ...grpc.CallOption) (*SSO_Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SSO_Empty)
+	err := c.cc.Invoke(ctx, Phones_ConfirmPhone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *phonesClient) DeletePhone(ctx context.Context, in *SSO_Empty, opts ...grpc.CallOption) (*SSO_Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SSO_Empty)
+	err := c.cc.Invoke(ctx, Phones_DeletePhone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PhonesServer is the server API for Phones service.
+// All implementations must embed UnimplementedPhonesServer
+// for forward compatibility.
+type PhonesServer interface {
+	StartPhoneVerification(context.Context, *SSO_PhoneRequest) (*SSO_Empty, error)
+	ConfirmPhone(context.Context, *SSO_ConfirmPhoneRequest) (*SSO_Empty, error)
+	DeletePhone(context.Context, *SSO_Empty) (*SSO_Empty, error)
+	mustEmbedUnimplementedPhonesServer()
+}
+
+// UnimplementedPhonesServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPhonesServer struct{}
+
+func (UnimplementedPhonesServer) StartPhoneVerification(context.Context, *SSO_PhoneRequest) (*SSO_Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartPhoneVerification not implemented")
+}
+func (UnimplementedPhonesServer) ConfirmPhone(context.Context, *SSO_ConfirmPhoneRequest) (*SSO_Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmPhone not implemented")
+}
+func (UnimplementedPhonesServer) DeletePhone(context.Context, *SSO_Empty) (*SSO_Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePhone not implemented")
+}
+func (UnimplementedPhonesServer) mustEmbedUnimplementedPhonesServer() {}
+func (UnimplementedPhonesServer) testEmbeddedByValue()                {}
+
+// UnsafePhonesServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PhonesServer will
+// result in compilation errors.
+type UnsafePhonesServer interface {
+	mustEmbedUnimplementedPhonesServer()
+}
+
+func RegisterPhonesServer(s grpc.ServiceRegistrar, srv PhonesServer) {
+	// If the following call pancis, it indicates UnimplementedPhonesServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Phones_ServiceDesc, srv)
+}
+
+func _Phones_StartPhoneVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SSO_PhoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PhonesServer).StartPhoneVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Phones_StartPhoneVerification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PhonesServer).StartPhoneVerification(ctx, req.(*SSO_PhoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Phones_ConfirmPhone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SSO_ConfirmPhoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PhonesServer).ConfirmPhone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Phones_ConfirmPhone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PhonesServer).ConfirmPhone(ctx, req.(*SSO_ConfirmPhoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Phones_DeletePhone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SSO_Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PhonesServer).DeletePhone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Phones_DeletePhone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PhonesServer).DeletePhone(ctx, req.(*SSO_Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Phones_ServiceDesc is the grpc.ServiceDesc for Phones service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Phones_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gen.Phones",
+	HandlerType: (*PhonesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "StartPhoneVerification",
+			Handler:    _Phones_StartPhoneVerification_Handler,
+		},
+		{
+			MethodName: "ConfirmPhone",
+			Handler:    _Phones_ConfirmPhone_Handler,
+		},
+		{
+			MethodName: "DeletePhone",
+			Handler:    _Phones_DeletePhone_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/grpc/v1/gen/sso.proto",
+}
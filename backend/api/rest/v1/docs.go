@@ -15,61 +15,36 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/auth/email/check": {
-            "post": {
-                "description": "Exchange a valid email code for JWT tokens",
-                "consumes": [
-                    "application/json"
-                ],
+        "/auth/approvals": {
+            "get": {
+                "description": "Logins from unknown devices waiting for approval from one of signed-in devices",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "EmailAuth"
+                    "LoginApproval"
                 ],
-                "summary": "Check email login code",
+                "summary": "List pending logins",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Client real IP address",
-                        "name": "X-Real-IP",
-                        "in": "header",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Client User-Agent",
-                        "name": "User-Agent",
+                        "description": "Authorization token",
+                        "name": "Authorization",
                         "in": "header",
                         "required": true
-                    },
-                    {
-                        "description": "code, reCAPTCHA token",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.CheckLoginCodeRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.TokenPair"
-                        }
-                    },
-                    "400": {
-                        "description": "missing device info or bad payload",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "code not found",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                            "type": "array",
+                            "items": {
+                                "type": "array",
+                                "items": {
+                                    "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.PendingLogin"
+                                }
+                            }
                         }
                     },
                     "500": {
@@ -81,9 +56,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/email/send": {
+        "/auth/approvals/check": {
             "post": {
-                "description": "Verify reCAPTCHA, then send a one-time code to the user’s email. May return tokens if password also valid.",
+                "description": "Polled by the new device. Returns tokens once login is approved on trusted device",
                 "consumes": [
                     "application/json"
                 ],
@@ -91,9 +66,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "EmailAuth"
+                    "LoginApproval"
                 ],
-                "summary": "Send login code via email",
+                "summary": "Check login approval",
                 "parameters": [
                     {
                         "type": "string",
@@ -110,12 +85,12 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "email, password, reCAPTCHA token",
+                        "description": "approval ID",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.LoginCodeRequest"
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.CheckLoginApprovalRequest"
                         }
                     }
                 ],
@@ -126,6 +101,9 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.TokenPair"
                         }
                     },
+                    "202": {
+                        "description": "still pending"
+                    },
                     "400": {
                         "description": "missing device info or bad payload",
                         "schema": {
@@ -133,7 +111,19 @@ const docTemplate = `{
                         }
                     },
                     "401": {
-                        "description": "invalid credentials or reCAPTCHA",
+                        "description": "tokens have already been issued",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "login denied or too risky",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "approval not found or expired",
                         "schema": {
                             "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
                         }
@@ -147,9 +137,50 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/jwt": {
+        "/auth/approvals/stream": {
+            "get": {
+                "description": "Server-sent events with pending logins, \"approval\" event is sent every time the list changes",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "LoginApproval"
+                ],
+                "summary": "Stream pending logins",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "array",
+                                "items": {
+                                    "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.PendingLogin"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/approvals/{id}": {
             "post": {
-                "description": "Verify reCAPTCHA, then authenticate and set JWT cookies",
+                "description": "Resolve login from unknown device. Number shown on that device has to be picked, wrong number denies the login",
                 "consumes": [
                     "application/json"
                 ],
@@ -157,55 +188,46 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Authentication"
+                    "LoginApproval"
                 ],
-                "summary": "Authenticate using email \u0026 password",
+                "summary": "Approve or deny pending login",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Client real IP address",
-                        "name": "X-Real-IP",
-                        "in": "header",
+                        "description": "Pending login ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Client User-Agent",
-                        "name": "User-Agent",
+                        "description": "Authorization token",
+                        "name": "Authorization",
                         "in": "header",
                         "required": true
                     },
                     {
-                        "description": "email, password, reCAPTCHA token",
+                        "description": "decision and picked number",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.EmailAndPasswordRequest"
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.ApproveLoginRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.TokenPair"
-                        }
+                        "description": "OK"
                     },
                     "400": {
-                        "description": "missing device info or bad payload",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
-                        }
-                    },
-                    "401": {
-                        "description": "invalid credentials or reCAPTCHA",
+                        "description": "bad payload or wrong number",
                         "schema": {
                             "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
                         }
                     },
                     "404": {
-                        "description": "user not found",
+                        "description": "pending login not found",
                         "schema": {
                             "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
                         }
@@ -219,9 +241,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/jwt/parse": {
+        "/auth/discover": {
             "post": {
-                "description": "Decode a token without requiring device header",
+                "description": "Returns how the user with given email should sign in. Mapped email domain wins, per-user method (passkey, email code or federated connection) is returned only when anti-enumeration is off",
                 "consumes": [
                     "application/json"
                 ],
@@ -229,17 +251,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Authentication"
+                    "Realm"
                 ],
-                "summary": "Parse JWT claims",
+                "summary": "Discover login method",
                 "parameters": [
                     {
-                        "description": "jwt token",
+                        "description": "Email payload",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.TokenRequest"
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.CheckEmailRequest"
                         }
                     }
                 ],
@@ -247,11 +269,17 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_JMURv_sso_internal_auth_jwt.Claims"
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.DiscoverRealmResponse"
                         }
                     },
-                    "404": {
-                        "description": "token not found",
+                    "400": {
+                        "description": "invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "rate limit exceeded",
                         "schema": {
                             "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
                         }
@@ -265,9 +293,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/jwt/refresh": {
+        "/auth/email/check": {
             "post": {
-                "description": "Validate device header and refresh tokens, reset cookies",
+                "description": "Exchange a valid email code for JWT tokens",
                 "consumes": [
                     "application/json"
                 ],
@@ -275,9 +303,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Authentication"
+                    "EmailAuth"
                 ],
-                "summary": "Refresh JWT tokens",
+                "summary": "Check email login code",
                 "parameters": [
                     {
                         "type": "string",
@@ -294,12 +322,12 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "refresh_token",
+                        "description": "code, reCAPTCHA token",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.RefreshRequest"
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.CheckLoginCodeRequest"
                         }
                     }
                 ],
@@ -310,6 +338,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.TokenPair"
                         }
                     },
+                    "202": {
+                        "description": "second factor required",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.MFAChallenge"
+                        }
+                    },
                     "400": {
                         "description": "missing device info or bad payload",
                         "schema": {
@@ -317,13 +351,31 @@ const docTemplate = `{
                         }
                     },
                     "401": {
-                        "description": "token revoked or invalid",
+                        "description": "invalid code",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "login is too risky",
                         "schema": {
                             "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
                         }
                     },
                     "404": {
-                        "description": "session not found",
+                        "description": "code not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "423": {
+                        "description": "account or IP locked after failed attempts, code is invalidated",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "next attempt is delayed or rate limit exceeded",
                         "schema": {
                             "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
                         }
@@ -337,31 +389,83 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/logout": {
+        "/auth/email/send": {
             "post": {
-                "description": "Revoke refresh token, clear JWT cookies",
+                "description": "Verify reCAPTCHA, then send a one-time code to the user’s email or verified phone, or ask signed-in device to approve the login. May return tokens if password also valid.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Authentication"
+                    "EmailAuth"
                 ],
-                "summary": "Logout user",
+                "summary": "Send login code via email, SMS, voice call or ask trusted device",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Authorization token",
-                        "name": "Authorization",
+                        "description": "Client real IP address",
+                        "name": "X-Real-IP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Client User-Agent",
+                        "name": "User-Agent",
                         "in": "header",
                         "required": true
+                    },
+                    {
+                        "description": "email, password, reCAPTCHA token, delivery channel",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.LoginCodeRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.TokenPair"
+                        }
                     },
-                    "404": {
-                        "description": "session not found",
+                    "202": {
+                        "description": "waiting for approval on trusted device",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.LoginApproval"
+                        }
+                    },
+                    "400": {
+                        "description": "missing device info, bad payload, no verified phone or trusted device",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "invalid credentials or reCAPTCHA",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "password login disabled, phone country not allowed or login is too risky",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "423": {
+                        "description": "account or IP locked after failed attempts",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "too many codes sent, next attempt is delayed or rate limit exceeded",
                         "schema": {
                             "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
                         }
@@ -375,9 +479,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/oauth2/{provider}/callback": {
-            "get": {
-                "description": "Processes provider callback, exchanges code, sets authentication cookies, and redirects to success URL",
+        "/auth/jwt": {
+            "post": {
+                "description": "Verify reCAPTCHA, then authenticate and set JWT cookies",
                 "consumes": [
                     "application/json"
                 ],
@@ -385,58 +489,79 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "OAuth2"
+                    "Authentication"
                 ],
-                "summary": "Handle OAuth2 provider callback",
+                "summary": "Authenticate using email \u0026 password",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "OAuth2 provider identifier",
-                        "name": "provider",
-                        "in": "path",
+                        "description": "Client real IP address",
+                        "name": "X-Real-IP",
+                        "in": "header",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Authorization code returned by provider",
-                        "name": "code",
-                        "in": "query",
+                        "description": "Client User-Agent",
+                        "name": "User-Agent",
+                        "in": "header",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "State parameter for CSRF mitigation",
-                        "name": "state",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Client real IP address",
-                        "name": "X-Real-IP",
-                        "in": "header",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Client User-Agent",
-                        "name": "User-Agent",
-                        "in": "header",
-                        "required": true
+                        "description": "email, password, reCAPTCHA token",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.EmailAndPasswordRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "307": {
-                        "description": "Redirect to success URL"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.TokenPair"
+                        }
+                    },
+                    "202": {
+                        "description": "second factor required",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.MFAChallenge"
+                        }
                     },
                     "400": {
-                        "description": "invalid request or missing device info",
+                        "description": "missing device info or bad payload",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "invalid credentials or reCAPTCHA",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "password login disabled for domain or login is too risky",
                         "schema": {
                             "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
                         }
                     },
                     "404": {
-                        "description": "provider not supported or resource not found",
+                        "description": "user not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "423": {
+                        "description": "account or IP locked after failed attempts",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "next attempt is delayed or rate limit exceeded",
                         "schema": {
                             "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
                         }
@@ -450,9 +575,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/oauth2/{provider}/start": {
-            "get": {
-                "description": "Redirects user to the OAuth2 provider's authorization URL",
+        "/auth/jwt/parse": {
+            "post": {
+                "description": "Decode a token without requiring device header",
                 "consumes": [
                     "application/json"
                 ],
@@ -460,24 +585,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "OAuth2"
+                    "Authentication"
                 ],
-                "summary": "Start OAuth2 authentication flow",
+                "summary": "Parse JWT claims",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "OAuth2 provider identifier",
-                        "name": "provider",
-                        "in": "path",
-                        "required": true
+                        "description": "jwt token",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.TokenRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "307": {
-                        "description": "Redirect to provider auth URL"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_auth_jwt.Claims"
+                        }
                     },
-                    "400": {
-                        "description": "invalid provider",
+                    "404": {
+                        "description": "token not found",
                         "schema": {
                             "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
                         }
@@ -491,9 +621,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/oidc/{provider}/callback": {
-            "get": {
-                "description": "Processes provider callback, exchanges code, sets authentication cookies, and redirects to success URL",
+        "/auth/jwt/refresh": {
+            "post": {
+                "description": "Validate device header and refresh tokens, reset cookies",
                 "consumes": [
                     "application/json"
                 ],
@@ -501,30 +631,10 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "OIDC"
+                    "Authentication"
                 ],
-                "summary": "Handle OIDC provider callback",
+                "summary": "Refresh JWT tokens",
                 "parameters": [
-                    {
-                        "type": "string",
-                        "description": "OIDC provider identifier",
-                        "name": "provider",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Authorization code returned by provider",
-                        "name": "code",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "State parameter for CSRF mitigation",
-                        "name": "state",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
                         "description": "Client real IP address",
@@ -538,20 +648,38 @@ const docTemplate = `{
                         "name": "User-Agent",
                         "in": "header",
                         "required": true
+                    },
+                    {
+                        "description": "refresh_token",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.RefreshRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "307": {
-                        "description": "Redirect to success URL"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.TokenPair"
+                        }
                     },
                     "400": {
-                        "description": "invalid request or missing device info",
+                        "description": "missing device info or bad payload",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "token revoked or invalid",
                         "schema": {
                             "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
                         }
                     },
                     "404": {
-                        "description": "provider not supported or resource not found",
+                        "description": "session not found",
                         "schema": {
                             "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
                         }
@@ -565,34 +693,31 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/oidc/{provider}/start": {
-            "get": {
-                "description": "Redirects user to the OIDC provider's authorization URL",
-                "consumes": [
-                    "application/json"
-                ],
+        "/auth/logout": {
+            "post": {
+                "description": "Revoke refresh token, clear JWT cookies",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "OIDC"
+                    "Authentication"
                 ],
-                "summary": "Start OIDC authentication flow",
+                "summary": "Logout user",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "OIDC provider identifier",
-                        "name": "provider",
-                        "in": "path",
+                        "description": "Authorization token",
+                        "name": "Authorization",
+                        "in": "header",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "307": {
-                        "description": "Redirect to provider auth URL"
+                    "200": {
+                        "description": "OK"
                     },
-                    "400": {
-                        "description": "invalid provider",
+                    "404": {
+                        "description": "session not found",
                         "schema": {
                             "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
                         }
@@ -606,9 +731,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/recovery/check": {
+        "/auth/magic-link/send": {
             "post": {
-                "description": "Validate a recovery code",
+                "description": "Verify reCAPTCHA, then email single-use login link. Link works only in the browser that got the nonce cookie",
                 "consumes": [
                     "application/json"
                 ],
@@ -616,32 +741,44 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "PasswordRecovery"
+                    "EmailAuth"
                 ],
-                "summary": "Check forgot‐password code",
+                "summary": "Send magic login link via email",
                 "parameters": [
                     {
-                        "description": "code",
+                        "description": "email, reCAPTCHA token",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.CheckForgotPasswordEmailRequest"
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.MagicLinkRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK"
+                    "202": {
+                        "description": "Accepted"
                     },
                     "401": {
-                        "description": "invalid code",
+                        "description": "invalid reCAPTCHA",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "domain is forced to sign in through SSO",
                         "schema": {
                             "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
                         }
                     },
                     "404": {
-                        "description": "code not found",
+                        "description": "user not found and self-registration disabled",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "rate limit exceeded",
                         "schema": {
                             "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
                         }
@@ -655,9 +792,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/recovery/send": {
+        "/auth/magic-link/verify": {
             "post": {
-                "description": "Verify reCAPTCHA and send recovery email",
+                "description": "Exchange token from the emailed link for JWT tokens. Requires nonce cookie set when the link was requested",
                 "consumes": [
                     "application/json"
                 ],
@@ -665,26 +802,67 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "PasswordRecovery"
+                    "EmailAuth"
                 ],
-                "summary": "Send forgot‐password email",
+                "summary": "Verify magic login link",
                 "parameters": [
                     {
-                        "description": "email, reCAPTCHA token",
+                        "type": "string",
+                        "description": "Client real IP address",
+                        "name": "X-Real-IP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Client User-Agent",
+                        "name": "User-Agent",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "token from the link",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.SendForgotPasswordEmail"
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.VerifyMagicLinkRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.TokenPair"
+                        }
+                    },
+                    "202": {
+                        "description": "second factor required",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.MFAChallenge"
+                        }
+                    },
+                    "400": {
+                        "description": "missing device info or bad payload",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "link has already been used",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "link was requested from another browser or login is too risky",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
                     },
                     "404": {
-                        "description": "email not found",
+                        "description": "link not found or expired",
                         "schema": {
                             "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
                         }
@@ -698,9 +876,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/webauthn/login/finish": {
+        "/auth/mfa/email/verify": {
             "post": {
-                "description": "Verifies client assertion, sets auth cookies, and returns tokens",
+                "description": "Completes risky login of user without second factor enrolled, sets JWT cookies",
                 "consumes": [
                     "application/json"
                 ],
@@ -708,17 +886,10 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "WebAuthn"
+                    "MFA"
                 ],
-                "summary": "Complete WebAuthn login",
+                "summary": "Pass MFA challenge with code sent by email",
                 "parameters": [
-                    {
-                        "type": "string",
-                        "description": "User email header",
-                        "name": "X-User-Email",
-                        "in": "header",
-                        "required": true
-                    },
                     {
                         "type": "string",
                         "description": "Client real IP address",
@@ -732,17 +903,35 @@ const docTemplate = `{
                         "name": "User-Agent",
                         "in": "header",
                         "required": true
+                    },
+                    {
+                        "description": "challenge token and code",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.VerifyEmailCodeRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.TokenPair"
-                        }
+                        "description": "OK"
                     },
                     "400": {
-                        "description": "missing email or device info",
+                        "description": "missing device info or bad payload",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "invalid or reused code, unknown challenge",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "rate limit exceeded",
                         "schema": {
                             "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
                         }
@@ -756,45 +945,40 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/webauthn/login/start": {
+        "/auth/mfa/recovery-codes": {
             "post": {
-                "description": "Generates an authentication challenge for the client",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Issues new set of one-time recovery codes. Previous set, used codes included, stops working. Codes replace TOTP, so it must be enabled",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "WebAuthn"
+                    "MFA"
                 ],
-                "summary": "Start WebAuthn login",
+                "summary": "Regenerate recovery codes",
                 "parameters": [
                     {
-                        "description": "Email + reCAPTCHA token",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.LoginStartRequest"
-                        }
+                        "type": "string",
+                        "description": "Authorization token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/protocol.CredentialAssertion"
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.RecoveryCodesResponse"
                         }
                     },
-                    "401": {
-                        "description": "invalid captcha",
+                    "400": {
+                        "description": "totp is not enabled",
                         "schema": {
                             "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
                         }
                     },
-                    "404": {
-                        "description": "user not found",
+                    "403": {
+                        "description": "recent authentication required",
                         "schema": {
                             "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
                         }
@@ -808,9 +992,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/webauthn/register/finish": {
+        "/auth/mfa/recovery/verify": {
             "post": {
-                "description": "Verifies the client response to finalize registration",
+                "description": "Completes login which returned MFA challenge, sets JWT cookies. Code is burned and owner is notified by email",
                 "consumes": [
                     "application/json"
                 ],
@@ -818,25 +1002,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "WebAuthn"
+                    "MFA"
                 ],
-                "summary": "Complete WebAuthn registration",
+                "summary": "Pass MFA challenge with recovery code",
                 "parameters": [
                     {
-                        "description": "Registration result from client",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/http.Request"
-                        }
+                        "type": "string",
+                        "description": "Client real IP address",
+                        "name": "X-Real-IP",
+                        "in": "header",
+                        "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Authorization token",
-                        "name": "Authorization",
+                        "description": "Client User-Agent",
+                        "name": "User-Agent",
                         "in": "header",
                         "required": true
+                    },
+                    {
+                        "description": "challenge token and recovery code",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.VerifyRecoveryCodeRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -844,7 +1035,19 @@ const docTemplate = `{
                         "description": "OK"
                     },
                     "400": {
-                        "description": "invalid request",
+                        "description": "missing device info or bad payload",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "invalid or used code, unknown challenge",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "rate limit exceeded",
                         "schema": {
                             "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
                         }
@@ -858,16 +1061,19 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/webauthn/register/start": {
+        "/auth/mfa/totp/confirm": {
             "post": {
-                "description": "Generates a registration challenge for the client",
+                "description": "Enables TOTP after the first code from authenticator app is accepted. Returns one-time recovery codes, they are shown only once",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "WebAuthn"
+                    "MFA"
                 ],
-                "summary": "Start WebAuthn registration",
+                "summary": "Confirm TOTP enrollment",
                 "parameters": [
                     {
                         "type": "string",
@@ -875,13 +1081,46 @@ const docTemplate = `{
                         "name": "Authorization",
                         "in": "header",
                         "required": true
+                    },
+                    {
+                        "description": "code from authenticator app",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.TOTPCodeRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/protocol.CredentialCreation"
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.RecoveryCodesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "invalid code",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "recent authentication required",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "no pending enrollment",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "totp is already enabled",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
                         }
                     },
                     "500": {
@@ -893,16 +1132,19 @@ const docTemplate = `{
                 }
             }
         },
-        "/device": {
-            "get": {
-                "description": "Retrieve a list of registered devices for the current user",
+        "/auth/mfa/totp/disable": {
+            "post": {
+                "description": "Removes authenticator app after checking current code",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Device"
+                    "MFA"
                 ],
-                "summary": "List all devices for the authenticated user",
+                "summary": "Disable TOTP",
                 "parameters": [
                     {
                         "type": "string",
@@ -910,23 +1152,47 @@ const docTemplate = `{
                         "name": "Authorization",
                         "in": "header",
                         "required": true
+                    },
+                    {
+                        "description": "code from authenticator app",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.TOTPCodeRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "invalid or reused code",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "array",
-                                "items": {
-                                    "$ref": "#/definitions/github_com_JMURv_sso_internal_models.Device"
-                                }
-                            }
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "recent authentication required",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
                         }
                     },
                     "404": {
-                        "description": "no devices found",
+                        "description": "totp is not enabled",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "423": {
+                        "description": "too many wrong codes",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "next attempt is delayed",
                         "schema": {
                             "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
                         }
@@ -940,24 +1206,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/device/{id}": {
-            "get": {
-                "description": "Retrieve details of a device owned by the current user",
+        "/auth/mfa/totp/enroll": {
+            "post": {
+                "description": "Generates new authenticator secret, returns otpauth URI and QR code PNG. Enrollment is pending until confirmed with a first code",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Device"
+                    "MFA"
                 ],
-                "summary": "Get a specific device by ID",
+                "summary": "Start TOTP enrollment",
                 "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Device UUID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
                     {
                         "type": "string",
                         "description": "Authorization token",
@@ -970,17 +1229,23 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_JMURv_sso_internal_models.Device"
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.TOTPEnrollResponse"
                         }
                     },
-                    "400": {
-                        "description": "invalid device ID path parameter",
+                    "403": {
+                        "description": "recent authentication required",
                         "schema": {
                             "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
                         }
                     },
                     "404": {
-                        "description": "device not found",
+                        "description": "user not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "totp is already enabled",
                         "schema": {
                             "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
                         }
@@ -992,9 +1257,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "Modify properties of a device owned by the current user",
+            }
+        },
+        "/auth/mfa/totp/verify": {
+            "post": {
+                "description": "Completes login which returned MFA challenge, sets JWT cookies",
                 "consumes": [
                     "application/json"
                 ],
@@ -1002,32 +1269,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Device"
+                    "MFA"
                 ],
-                "summary": "Update a device",
+                "summary": "Pass TOTP challenge",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Device UUID",
-                        "name": "id",
-                        "in": "path",
+                        "description": "Client real IP address",
+                        "name": "X-Real-IP",
+                        "in": "header",
                         "required": true
                     },
                     {
-                        "description": "Update payload",
+                        "type": "string",
+                        "description": "Client User-Agent",
+                        "name": "User-Agent",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "challenge token and code",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.UpdateDeviceRequest"
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.VerifyTOTPRequest"
                         }
-                    },
-                    {
-                        "type": "string",
-                        "description": "Authorization token",
-                        "name": "Authorization",
-                        "in": "header",
-                        "required": true
                     }
                 ],
                 "responses": {
@@ -1035,13 +1302,25 @@ const docTemplate = `{
                         "description": "OK"
                     },
                     "400": {
-                        "description": "invalid device ID or payload",
+                        "description": "missing device info or bad payload",
                         "schema": {
                             "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
                         }
                     },
-                    "404": {
-                        "description": "device not found",
+                    "401": {
+                        "description": "invalid or reused code, unknown challenge",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "423": {
+                        "description": "too many wrong codes",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "next attempt is delayed or rate limit exceeded",
                         "schema": {
                             "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
                         }
@@ -1053,41 +1332,1951 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Remove a device owned by the current user",
+            }
+        },
+        "/auth/oauth2/{provider}/callback": {
+            "get": {
+                "description": "Processes provider callback, exchanges code, sets authentication cookies, and redirects to success URL",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Device"
+                    "OAuth2"
                 ],
-                "summary": "Delete a device",
+                "summary": "Handle OAuth2 provider callback",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Device UUID",
-                        "name": "id",
+                        "description": "OAuth2 provider identifier",
+                        "name": "provider",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Authorization token",
-                        "name": "Authorization",
-                        "in": "header",
+                        "description": "Authorization code returned by provider",
+                        "name": "code",
+                        "in": "query",
                         "required": true
-                    }
-                ],
-                "responses": {
-                    "204": {
-                        "description": "No Content"
                     },
-                    "400": {
-                        "description": "invalid device ID",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
-                        }
+                    {
+                        "type": "string",
+                        "description": "State parameter for CSRF mitigation",
+                        "name": "state",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Client real IP address",
+                        "name": "X-Real-IP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Client User-Agent",
+                        "name": "User-Agent",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "307": {
+                        "description": "Redirect to success URL"
+                    },
+                    "400": {
+                        "description": "invalid request or missing device info",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "login is too risky",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "provider not supported or resource not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/oauth2/{provider}/start": {
+            "get": {
+                "description": "Redirects user to the OAuth2 provider's authorization URL",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "OAuth2"
+                ],
+                "summary": "Start OAuth2 authentication flow",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "OAuth2 provider identifier",
+                        "name": "provider",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "307": {
+                        "description": "Redirect to provider auth URL"
+                    },
+                    "400": {
+                        "description": "invalid provider",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/oidc/{provider}/callback": {
+            "get": {
+                "description": "Processes provider callback, exchanges code, sets authentication cookies, and redirects to success URL",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "OIDC"
+                ],
+                "summary": "Handle OIDC provider callback",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "OIDC provider identifier",
+                        "name": "provider",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Authorization code returned by provider",
+                        "name": "code",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "State parameter for CSRF mitigation",
+                        "name": "state",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Client real IP address",
+                        "name": "X-Real-IP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Client User-Agent",
+                        "name": "User-Agent",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "307": {
+                        "description": "Redirect to success URL"
+                    },
+                    "400": {
+                        "description": "invalid request or missing device info",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "login is too risky",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "provider not supported or resource not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "account with this email is not linked to provider",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Same as GET callback, but for providers which post response as form (e.g. Apple). Optional ` + "`" + `user` + "`" + ` field carries profile sent on first login",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "OIDC"
+                ],
+                "summary": "Handle OIDC provider form_post callback",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "OIDC provider identifier",
+                        "name": "provider",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Authorization code returned by provider",
+                        "name": "code",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "State parameter for CSRF mitigation",
+                        "name": "state",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "User profile JSON posted by provider",
+                        "name": "user",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Client real IP address",
+                        "name": "X-Real-IP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Client User-Agent",
+                        "name": "User-Agent",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "303": {
+                        "description": "Redirect to success URL"
+                    },
+                    "400": {
+                        "description": "invalid request or missing device info",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "login is too risky",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "account with this email is not linked to provider",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/oidc/{provider}/start": {
+            "get": {
+                "description": "Redirects user to the OIDC provider's authorization URL",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "OIDC"
+                ],
+                "summary": "Start OIDC authentication flow",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "OIDC provider identifier",
+                        "name": "provider",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "307": {
+                        "description": "Redirect to provider auth URL"
+                    },
+                    "400": {
+                        "description": "invalid provider",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/password/change": {
+            "post": {
+                "description": "Replace password of signed-in user. Also accepts session restricted to password change,\nwhich is given when password was set by admin or has expired. Other sessions are revoked and new cookies are set",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "Change password",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Client real IP address",
+                        "name": "X-Real-IP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Client User-Agent",
+                        "name": "User-Agent",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "current and new password",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.ChangePasswordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "missing device info or password policy violation",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "current password is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "user not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "423": {
+                        "description": "account or IP locked after failed attempts",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "next attempt is delayed",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/qr": {
+            "post": {
+                "description": "Create short-lived login session for this device. QR code is scanned by the phone where user is signed in",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "QRLogin"
+                ],
+                "summary": "Start QR code login",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Client real IP address",
+                        "name": "X-Real-IP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Client User-Agent",
+                        "name": "User-Agent",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.QRLoginResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "missing device info",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "rate limit exceeded",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/qr/check": {
+            "post": {
+                "description": "Polled by the device that shows QR code. Returns tokens once login is confirmed from the phone",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "QRLogin"
+                ],
+                "summary": "Check QR code login",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Client real IP address",
+                        "name": "X-Real-IP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Client User-Agent",
+                        "name": "User-Agent",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "login session ID",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.CheckQRLoginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.TokenPair"
+                        }
+                    },
+                    "202": {
+                        "description": "not confirmed yet"
+                    },
+                    "400": {
+                        "description": "missing device info or bad payload",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "tokens have already been issued",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "login is too risky",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "session not found or expired",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/qr/{code}": {
+            "get": {
+                "description": "Show on the phone which device is going to be signed in",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "QRLogin"
+                ],
+                "summary": "Get QR code login session",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Code from QR",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Authorization token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.QRLoginSession"
+                        }
+                    },
+                    "404": {
+                        "description": "session not found or expired",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/qr/{code}/confirm": {
+            "post": {
+                "description": "Sign in the device that shows QR code as the current user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "QRLogin"
+                ],
+                "summary": "Confirm QR code login",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Code from QR",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Authorization token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Client real IP address",
+                        "name": "X-Real-IP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Client User-Agent",
+                        "name": "User-Agent",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "missing device info",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "session not found or expired",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/realms": {
+            "get": {
+                "description": "Retrieve all email domain to login method mappings",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Realm"
+                ],
+                "summary": "List realm domains",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_JMURv_sso_internal_models.RealmDomain"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Map an email domain to a login method, optionally forcing SSO for it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Realm"
+                ],
+                "summary": "Create realm domain",
+                "parameters": [
+                    {
+                        "description": "Mapping details",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.CreateRealmDomainRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Authorization token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Mapping ID",
+                        "schema": {
+                            "type": "int"
+                        }
+                    },
+                    "400": {
+                        "description": "invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "domain already mapped",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/realms/{domain}": {
+            "delete": {
+                "description": "Remove an email domain mapping",
+                "tags": [
+                    "Realm"
+                ],
+                "summary": "Delete realm domain",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Email domain",
+                        "name": "domain",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Authorization token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "OK"
+                    },
+                    "403": {
+                        "description": "not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "domain not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/reauth": {
+            "post": {
+                "description": "Confirms identity with password or TOTP code and resets JWT cookies with fresh auth_time. Required before sensitive actions when login is older than a few minutes",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "Re-authenticate current user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Client real IP address",
+                        "name": "X-Real-IP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Client User-Agent",
+                        "name": "User-Agent",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "password or TOTP code",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.ReauthRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.TokenPair"
+                        }
+                    },
+                    "400": {
+                        "description": "missing device info or bad payload",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "invalid credentials or code",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "user not found or totp is not enabled",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "423": {
+                        "description": "account or IP locked after failed attempts",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "next attempt is delayed",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/recovery/check": {
+            "post": {
+                "description": "Set new password with token from reset link. All sessions are revoked and confirmation email is sent",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PasswordRecovery"
+                ],
+                "summary": "Reset password",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Client real IP address",
+                        "name": "X-Real-IP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Client User-Agent",
+                        "name": "User-Agent",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "reset token and new password",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.CheckForgotPasswordEmailRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "missing device info or password policy violation",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "password changed since token was issued or token already used",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "token not found, expired or superseded by newer one",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "423": {
+                        "description": "account or IP locked after failed attempts",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "next attempt is delayed or rate limit exceeded",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/recovery/send": {
+            "post": {
+                "description": "Verify reCAPTCHA and send single-use password reset link by email or SMS, previous links stop working",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PasswordRecovery"
+                ],
+                "summary": "Send forgot‐password email",
+                "parameters": [
+                    {
+                        "description": "email, reCAPTCHA token, delivery channel",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.SendForgotPasswordEmail"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "no verified phone",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "phone country not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "email not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "too many messages sent to phone or rate limit exceeded",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/saml/providers": {
+            "get": {
+                "description": "Retrieve all configured upstream SAML identity providers",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SAML"
+                ],
+                "summary": "List SAML providers",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_JMURv_sso_internal_models.SAMLProvider"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Register an upstream SAML identity provider from its metadata XML",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SAML"
+                ],
+                "summary": "Create SAML provider",
+                "parameters": [
+                    {
+                        "description": "Provider details",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.CreateSAMLProviderRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Authorization token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Provider ID",
+                        "schema": {
+                            "type": "int"
+                        }
+                    },
+                    "400": {
+                        "description": "invalid request or metadata",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "provider already exists",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/saml/providers/{provider}": {
+            "delete": {
+                "description": "Remove an upstream SAML identity provider and its user links",
+                "tags": [
+                    "SAML"
+                ],
+                "summary": "Delete SAML provider",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "SAML provider name",
+                        "name": "provider",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Authorization token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "OK"
+                    },
+                    "403": {
+                        "description": "not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "provider not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/saml/{provider}/acs": {
+            "post": {
+                "description": "Validates the IdP response, links or provisions the user, sets authentication cookies and redirects to success URL",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "tags": [
+                    "SAML"
+                ],
+                "summary": "SAML assertion consumer service",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "SAML provider name",
+                        "name": "provider",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Base64 encoded SAML response",
+                        "name": "SAMLResponse",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Relay state returned by IdP",
+                        "name": "RelayState",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Client real IP address",
+                        "name": "X-Real-IP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Client User-Agent",
+                        "name": "User-Agent",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "303": {
+                        "description": "Redirect to success URL"
+                    },
+                    "400": {
+                        "description": "invalid relay state or missing device info",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "invalid or replayed assertion",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "login is too risky",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "provider or request not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "email belongs to account which is not linked to provider",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/saml/{provider}/metadata": {
+            "get": {
+                "description": "Returns SAML service provider metadata to be imported into the upstream IdP",
+                "produces": [
+                    "text/xml"
+                ],
+                "tags": [
+                    "SAML"
+                ],
+                "summary": "Service provider metadata",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "SAML provider name",
+                        "name": "provider",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "SP metadata XML",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "provider not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/saml/{provider}/start": {
+            "get": {
+                "description": "Redirects user to the upstream IdP with a signed AuthnRequest",
+                "tags": [
+                    "SAML"
+                ],
+                "summary": "Start SAML authentication flow",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "SAML provider name",
+                        "name": "provider",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "307": {
+                        "description": "Redirect to IdP"
+                    },
+                    "404": {
+                        "description": "provider not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/webauthn/login/discoverable/finish": {
+            "post": {
+                "description": "Resolves user from the user handle of the assertion, verifies it and sets auth cookies",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WebAuthn"
+                ],
+                "summary": "Complete usernameless WebAuthn login",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Client real IP address",
+                        "name": "X-Real-IP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Client User-Agent",
+                        "name": "User-Agent",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.TokenPair"
+                        }
+                    },
+                    "400": {
+                        "description": "missing device info",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "unknown passkey or expired challenge",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "login is too risky",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/webauthn/login/discoverable/start": {
+            "post": {
+                "description": "Generates an authentication challenge without allowed credentials, so the authenticator offers its resident passkeys. Pass mediation=conditional for browser autofill",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WebAuthn"
+                ],
+                "summary": "Start usernameless WebAuthn login",
+                "parameters": [
+                    {
+                        "enum": [
+                            "conditional"
+                        ],
+                        "type": "string",
+                        "description": "credential mediation",
+                        "name": "mediation",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/protocol.CredentialAssertion"
+                        }
+                    },
+                    "400": {
+                        "description": "unsupported mediation",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/webauthn/login/finish": {
+            "post": {
+                "description": "Verifies client assertion, sets auth cookies, and returns tokens",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WebAuthn"
+                ],
+                "summary": "Complete WebAuthn login",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User email header",
+                        "name": "X-User-Email",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Client real IP address",
+                        "name": "X-Real-IP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Client User-Agent",
+                        "name": "User-Agent",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.TokenPair"
+                        }
+                    },
+                    "400": {
+                        "description": "missing email or device info",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "login is too risky",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/webauthn/login/start": {
+            "post": {
+                "description": "Generates an authentication challenge for the client",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WebAuthn"
+                ],
+                "summary": "Start WebAuthn login",
+                "parameters": [
+                    {
+                        "description": "Email + reCAPTCHA token",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.LoginStartRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/protocol.CredentialAssertion"
+                        }
+                    },
+                    "401": {
+                        "description": "invalid captcha",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "user not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/webauthn/metadata": {
+            "put": {
+                "description": "Replaces locally stored FIDO Metadata Service blob, signature is verified against FIDO root certificate",
+                "consumes": [
+                    "text/plain"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WebAuthn"
+                ],
+                "summary": "Update authenticator metadata",
+                "parameters": [
+                    {
+                        "description": "MDS blob (JWT)",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Authorization token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.WAMetadataResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "invalid metadata",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "file too large",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/webauthn/register/finish": {
+            "post": {
+                "description": "Verifies the client response to finalize registration",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WebAuthn"
+                ],
+                "summary": "Complete WebAuthn registration",
+                "parameters": [
+                    {
+                        "description": "Registration result from client",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.Request"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Authorization token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "recent authentication required or authenticator is not allowed by policy",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/webauthn/register/start": {
+            "post": {
+                "description": "Generates a registration challenge for the client",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WebAuthn"
+                ],
+                "summary": "Start WebAuthn registration",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/protocol.CredentialCreation"
+                        }
+                    },
+                    "403": {
+                        "description": "recent authentication required",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/device": {
+            "get": {
+                "description": "Retrieve a list of registered devices for the current user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Device"
+                ],
+                "summary": "List all devices for the authenticated user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "array",
+                                "items": {
+                                    "$ref": "#/definitions/github_com_JMURv_sso_internal_models.Device"
+                                }
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "no devices found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/device/{id}": {
+            "get": {
+                "description": "Retrieve details of a device owned by the current user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Device"
+                ],
+                "summary": "Get a specific device by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Device UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Authorization token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_models.Device"
+                        }
+                    },
+                    "400": {
+                        "description": "invalid device ID path parameter",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "device not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Modify properties of a device owned by the current user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Device"
+                ],
+                "summary": "Update a device",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Device UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update payload",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.UpdateDeviceRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Authorization token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "invalid device ID or payload",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "device not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove a device owned by the current user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Device"
+                ],
+                "summary": "Delete a device",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Device UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Authorization token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "invalid device ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
                     },
                     "404": {
                         "description": "device not found",
@@ -1104,6 +3293,54 @@ const docTemplate = `{
                 }
             }
         },
+        "/lockouts/ip/{ip}": {
+            "delete": {
+                "description": "Clear failed attempts and lock of the IP address",
+                "tags": [
+                    "Lockout"
+                ],
+                "summary": "Unlock IP address",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "IP address",
+                        "name": "ip",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Authorization token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "invalid IP address",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    }
+                }
+            }
+        },
         "/perm": {
             "get": {
                 "description": "Retrieve a paginated list of permissions with optional filters",
@@ -1170,7 +3407,260 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "201": {
-                        "description": "Permission ID",
+                        "description": "Permission ID",
+                        "schema": {
+                            "type": "int"
+                        }
+                    },
+                    "400": {
+                        "description": "invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "permission already exists",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/perm/{id}": {
+            "get": {
+                "description": "Retrieve a specific permission",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Permission"
+                ],
+                "summary": "Get permission by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Permission ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_models.Permission"
+                        }
+                    },
+                    "400": {
+                        "description": "invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "permission not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Modify an existing permission",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Permission"
+                ],
+                "summary": "Update a permission",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Permission ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated permission data",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.UpdatePermissionRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Authorization token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "permission not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove a permission by ID",
+                "tags": [
+                    "Permission"
+                ],
+                "summary": "Delete a permission",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Permission ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Authorization token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "permission not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/roles": {
+            "get": {
+                "description": "Retrieve a paginated list of roles with optional filters",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Role"
+                ],
+                "summary": "List roles",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Page size",
+                        "name": "size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.PaginatedRoleResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Add a new role with associated permissions",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Role"
+                ],
+                "summary": "Create a new role",
+                "parameters": [
+                    {
+                        "description": "Role details",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.CreateRoleRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Authorization token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Role ID",
                         "schema": {
                             "type": "int"
                         }
@@ -1182,7 +3672,7 @@ const docTemplate = `{
                         }
                     },
                     "409": {
-                        "description": "permission already exists",
+                        "description": "role already exists",
                         "schema": {
                             "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
                         }
@@ -1196,20 +3686,20 @@ const docTemplate = `{
                 }
             }
         },
-        "/perm/{id}": {
+        "/roles/{id}": {
             "get": {
-                "description": "Retrieve a specific permission",
+                "description": "Retrieve details of a specific role",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Permission"
+                    "Role"
                 ],
-                "summary": "Get permission by ID",
+                "summary": "Get role by ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Permission ID",
+                        "description": "Role ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1219,7 +3709,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_JMURv_sso_internal_models.Permission"
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_models.Role"
                         }
                     },
                     "400": {
@@ -1229,7 +3719,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "permission not found",
+                        "description": "role not found",
                         "schema": {
                             "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
                         }
@@ -1243,7 +3733,7 @@ const docTemplate = `{
                 }
             },
             "put": {
-                "description": "Modify an existing permission",
+                "description": "Modify an existing role's name or permissions",
                 "consumes": [
                     "application/json"
                 ],
@@ -1251,24 +3741,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Permission"
+                    "Role"
                 ],
-                "summary": "Update a permission",
+                "summary": "Update a role",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Permission ID",
+                        "description": "Role ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Updated permission data",
+                        "description": "Updated role data",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.UpdatePermissionRequest"
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.UpdateRoleRequest"
                         }
                     },
                     {
@@ -1290,7 +3780,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "permission not found",
+                        "description": "role not found",
                         "schema": {
                             "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
                         }
@@ -1304,15 +3794,15 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "Remove a permission by ID",
+                "description": "Remove a role by ID",
                 "tags": [
-                    "Permission"
+                    "Role"
                 ],
-                "summary": "Delete a permission",
+                "summary": "Delete a role",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Permission ID",
+                        "description": "Role ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1336,7 +3826,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "permission not found",
+                        "description": "role not found",
                         "schema": {
                             "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
                         }
@@ -1350,16 +3840,19 @@ const docTemplate = `{
                 }
             }
         },
-        "/roles": {
+        "/users": {
             "get": {
-                "description": "Retrieve a paginated list of roles with optional filters",
+                "description": "Retrieve a paginated list of users with optional filters",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Role"
+                    "User"
                 ],
-                "summary": "List roles",
+                "summary": "List all users",
                 "parameters": [
                     {
                         "type": "integer",
@@ -1380,7 +3873,10 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.PaginatedRoleResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.PaginatedUserResponse"
+                            }
                         }
                     },
                     "500": {
@@ -1392,7 +3888,63 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "Add a new role with associated permissions",
+                "description": "Creates a user with optional avatar upload",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Create a new user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JSON payload in 'data' field",
+                        "name": "data",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Avatar image file",
+                        "name": "avatar",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.CreateUserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request, file too large or password policy violation",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "user already exists",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/exists": {
+            "post": {
+                "description": "Returns 200 if user exists, 404 otherwise. Available to admins only",
                 "consumes": [
                     "application/json"
                 ],
@@ -1400,42 +3952,178 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Role"
+                    "User"
                 ],
-                "summary": "Create a new role",
+                "summary": "Check if a user exists by email",
                 "parameters": [
                     {
-                        "description": "Role details",
+                        "type": "string",
+                        "description": "Authorization token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Email payload",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.CreateRoleRequest"
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.CheckEmailRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.ExistsUserResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "user not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "rate limit exceeded",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
                         }
                     },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me": {
+            "get": {
+                "description": "Returns the authenticated user's profile",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Retrieve current user profile",
+                "parameters": [
                     {
                         "type": "string",
-                        "description": "Authorization token",
+                        "description": "Bearer token, e.g. 'Bearer {jwt}'",
                         "name": "Authorization",
                         "in": "header",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Role ID",
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_models.User"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "user not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Updates user profile and avatar",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Update current user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "JSON payload in 'data' field",
+                        "name": "data",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Avatar image file",
+                        "name": "avatar",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer token, e.g. 'Bearer {jwt}'",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "bad request or password policy violation",
                         "schema": {
-                            "type": "int"
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
                         }
                     },
-                    "400": {
-                        "description": "invalid request",
+                    "401": {
+                        "description": "unauthorized",
                         "schema": {
                             "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
                         }
                     },
-                    "409": {
-                        "description": "role already exists",
+                    "403": {
+                        "description": "recent authentication required",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "user not found",
                         "schema": {
                             "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
                         }
@@ -1449,22 +4137,22 @@ const docTemplate = `{
                 }
             }
         },
-        "/roles/{id}": {
+        "/users/me/passkeys": {
             "get": {
-                "description": "Retrieve details of a specific role",
+                "description": "Retrieve registered WebAuthn credentials with their names, transports and backup state",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Role"
+                    "WebAuthn"
                 ],
-                "summary": "Get role by ID",
+                "summary": "List passkeys of the authenticated user",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Role ID",
-                        "name": "id",
-                        "in": "path",
+                        "type": "string",
+                        "description": "Authorization token",
+                        "name": "Authorization",
+                        "in": "header",
                         "required": true
                     }
                 ],
@@ -1472,19 +4160,13 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_JMURv_sso_internal_models.Role"
-                        }
-                    },
-                    "400": {
-                        "description": "invalid ID",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "role not found",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                            "type": "array",
+                            "items": {
+                                "type": "array",
+                                "items": {
+                                    "$ref": "#/definitions/github_com_JMURv_sso_internal_models.Passkey"
+                                }
+                            }
                         }
                     },
                     "500": {
@@ -1494,9 +4176,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/users/me/passkeys/{id}": {
             "put": {
-                "description": "Modify an existing role's name or permissions",
+                "description": "Change display name of a WebAuthn credential owned by the current user",
                 "consumes": [
                     "application/json"
                 ],
@@ -1504,32 +4188,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Role"
+                    "WebAuthn"
                 ],
-                "summary": "Update a role",
+                "summary": "Rename a passkey",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Role ID",
+                        "type": "string",
+                        "description": "Base64url credential ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
-                    {
-                        "description": "Updated role data",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.UpdateRoleRequest"
-                        }
-                    },
                     {
                         "type": "string",
                         "description": "Authorization token",
                         "name": "Authorization",
                         "in": "header",
                         "required": true
+                    },
+                    {
+                        "description": "new name",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.RenamePasskeyRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -1537,13 +4221,13 @@ const docTemplate = `{
                         "description": "OK"
                     },
                     "400": {
-                        "description": "invalid request",
+                        "description": "invalid passkey ID or payload",
                         "schema": {
                             "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
                         }
                     },
                     "404": {
-                        "description": "role not found",
+                        "description": "passkey not found",
                         "schema": {
                             "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
                         }
@@ -1557,15 +4241,18 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "Remove a role by ID",
+                "description": "Remove WebAuthn credential owned by the current user. Requires recent authentication",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Role"
+                    "WebAuthn"
                 ],
-                "summary": "Delete a role",
+                "summary": "Delete a passkey",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Role ID",
+                        "type": "string",
+                        "description": "Base64url credential ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1583,13 +4270,19 @@ const docTemplate = `{
                         "description": "No Content"
                     },
                     "400": {
-                        "description": "invalid ID",
+                        "description": "invalid passkey ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "recent authentication required",
                         "schema": {
                             "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
                         }
                     },
                     "404": {
-                        "description": "role not found",
+                        "description": "passkey not found",
                         "schema": {
                             "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
                         }
@@ -1603,9 +4296,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/users": {
-            "get": {
-                "description": "Retrieve a paginated list of users with optional filters",
+        "/users/me/phone": {
+            "post": {
+                "description": "Send verification code to the number by SMS or voice call. Requires recent authentication",
                 "consumes": [
                     "application/json"
                 ],
@@ -1613,33 +4306,47 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Phone"
                 ],
-                "summary": "List all users",
+                "summary": "Start phone number verification",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "default": 1,
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
+                        "type": "string",
+                        "description": "Authorization token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "default": 20,
-                        "description": "Page size",
-                        "name": "size",
-                        "in": "query"
+                        "description": "phone in E.164 format and delivery channel",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.PhoneRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "202": {
+                        "description": "Accepted"
+                    },
+                    "400": {
+                        "description": "bad payload",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.PaginatedUserResponse"
-                            }
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "recent authentication required or country not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "too many codes sent to phone",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
                         }
                     },
                     "500": {
@@ -1650,48 +4357,36 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
-                "description": "Creates a user with optional avatar upload",
-                "consumes": [
-                    "multipart/form-data"
-                ],
+            "delete": {
+                "description": "Remove verified phone number of the current user. Requires recent authentication",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Phone"
                 ],
-                "summary": "Create a new user",
+                "summary": "Delete phone number",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "JSON payload in 'data' field",
-                        "name": "data",
-                        "in": "formData",
+                        "description": "Authorization token",
+                        "name": "Authorization",
+                        "in": "header",
                         "required": true
-                    },
-                    {
-                        "type": "file",
-                        "description": "Avatar image file",
-                        "name": "avatar",
-                        "in": "formData"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.CreateUserResponse"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
-                    "400": {
-                        "description": "bad request or file too large",
+                    "403": {
+                        "description": "recent authentication required",
                         "schema": {
                             "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
                         }
                     },
-                    "409": {
-                        "description": "user already exists",
+                    "404": {
+                        "description": "user has no phone",
                         "schema": {
                             "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
                         }
@@ -1705,9 +4400,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/exists": {
+        "/users/me/phone/verify": {
             "post": {
-                "description": "Returns 200 if user exists, 404 otherwise",
+                "description": "Check verification code and store the number as verified attribute of the user",
                 "consumes": [
                     "application/json"
                 ],
@@ -1715,29 +4410,45 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Phone"
                 ],
-                "summary": "Check if a user exists by email",
+                "summary": "Confirm phone number",
                 "parameters": [
                     {
-                        "description": "Email payload",
+                        "type": "string",
+                        "description": "Authorization token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "verification code",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.CheckEmailRequest"
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.ConfirmPhoneRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "bad payload or invalid code",
                         "schema": {
-                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.ExistsUserResponse"
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
                         }
                     },
                     "404": {
-                        "description": "user not found",
+                        "description": "verification not started or expired",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "phone belongs to another user",
                         "schema": {
                             "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
                         }
@@ -1751,22 +4462,25 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/me": {
+        "/users/{id}": {
             "get": {
-                "description": "Returns the authenticated user's profile",
+                "description": "Retrieve a user by their UUID",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "User"
                 ],
-                "summary": "Retrieve current user profile",
+                "summary": "Get user by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Bearer token, e.g. 'Bearer {jwt}'",
-                        "name": "Authorization",
-                        "in": "header",
+                        "description": "User UUID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     }
                 ],
@@ -1777,8 +4491,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_JMURv_sso_internal_models.User"
                         }
                     },
-                    "401": {
-                        "description": "unauthorized",
+                    "400": {
+                        "description": "invalid UUID",
                         "schema": {
                             "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
                         }
@@ -1808,7 +4522,7 @@ const docTemplate = `{
                 "tags": [
                     "User"
                 ],
-                "summary": "Update current user",
+                "summary": "Update an existing user",
                 "parameters": [
                     {
                         "type": "string",
@@ -1843,10 +4557,62 @@ const docTemplate = `{
                         "description": "OK"
                     },
                     "400": {
-                        "description": "bad request",
+                        "description": "bad request or password policy violation",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "user not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
                         "schema": {
                             "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
                         }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Removes a user by UUID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Delete a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer token, e.g. 'Bearer {jwt}'",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
                     },
                     "401": {
                         "description": "unauthorized",
@@ -1869,19 +4635,16 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/{id}": {
+        "/users/{id}/lockout": {
             "get": {
-                "description": "Retrieve a user by their UUID",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Failed attempts and active locks of the user for every login method",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Lockout"
                 ],
-                "summary": "Get user by ID",
+                "summary": "Get user lockout",
                 "parameters": [
                     {
                         "type": "string",
@@ -1889,17 +4652,24 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Authorization token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_JMURv_sso_internal_models.User"
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.LockoutStatus"
                         }
                     },
-                    "400": {
-                        "description": "invalid UUID",
+                    "403": {
+                        "description": "not authorized",
                         "schema": {
                             "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
                         }
@@ -1918,18 +4688,12 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
-                "description": "Updates user profile and avatar",
-                "consumes": [
-                    "multipart/form-data"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+            "delete": {
+                "description": "Clear failed attempts and locks of the user for every login method",
                 "tags": [
-                    "User"
+                    "Lockout"
                 ],
-                "summary": "Update an existing user",
+                "summary": "Unlock user",
                 "parameters": [
                     {
                         "type": "string",
@@ -1940,37 +4704,18 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "JSON payload in 'data' field",
-                        "name": "data",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "file",
-                        "description": "Avatar image file",
-                        "name": "avatar",
-                        "in": "formData"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Bearer token, e.g. 'Bearer {jwt}'",
+                        "description": "Authorization token",
                         "name": "Authorization",
                         "in": "header",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
+                    "204": {
                         "description": "OK"
                     },
-                    "400": {
-                        "description": "bad request",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
-                        }
-                    },
-                    "401": {
-                        "description": "unauthorized",
+                    "403": {
+                        "description": "not authorized",
                         "schema": {
                             "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
                         }
@@ -1988,19 +4733,18 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Removes a user by UUID",
-                "consumes": [
-                    "application/json"
-                ],
+            }
+        },
+        "/users/{id}/risk-events": {
+            "get": {
+                "description": "Score, decision and reasons of every login of the user, the latest first",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Risk"
                 ],
-                "summary": "Delete a user",
+                "summary": "List login risk assessments",
                 "parameters": [
                     {
                         "type": "string",
@@ -2009,20 +4753,35 @@ const docTemplate = `{
                         "in": "path",
                         "required": true
                     },
+                    {
+                        "type": "integer",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "size",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "description": "Bearer token, e.g. 'Bearer {jwt}'",
+                        "description": "Authorization token",
                         "name": "Authorization",
                         "in": "header",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.PaginatedRiskEventResponse"
+                        }
                     },
-                    "401": {
-                        "description": "unauthorized",
+                    "403": {
+                        "description": "not authorized",
                         "schema": {
                             "$ref": "#/definitions/github_com_JMURv_sso_internal_hdl_http_utils.ErrorsResponse"
                         }
@@ -2050,6 +4809,15 @@ const docTemplate = `{
         "github_com_JMURv_sso_internal_auth_jwt.Claims": {
             "type": "object",
             "properties": {
+                "acr": {
+                    "type": "string"
+                },
+                "amr": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "aud": {
                     "description": "the ` + "`" + `aud` + "`" + ` (Audience) claim. See https://datatracker.ietf.org/doc/html/rfc7519#section-4.1.3",
                     "type": "array",
@@ -2057,11 +4825,14 @@ const docTemplate = `{
                         "type": "string"
                     }
                 },
+                "auth_time": {
+                    "$ref": "#/definitions/github_com_golang-jwt_jwt_v5.NumericDate"
+                },
                 "exp": {
                     "description": "the ` + "`" + `exp` + "`" + ` (Expiration Time) claim. See https://datatracker.ietf.org/doc/html/rfc7519#section-4.1.4",
                     "allOf": [
                         {
-                            "$ref": "#/definitions/jwt.NumericDate"
+                            "$ref": "#/definitions/github_com_golang-jwt_jwt_v5.NumericDate"
                         }
                     ]
                 },
@@ -2069,7 +4840,7 @@ const docTemplate = `{
                     "description": "the ` + "`" + `iat` + "`" + ` (Issued At) claim. See https://datatracker.ietf.org/doc/html/rfc7519#section-4.1.6",
                     "allOf": [
                         {
-                            "$ref": "#/definitions/jwt.NumericDate"
+                            "$ref": "#/definitions/github_com_golang-jwt_jwt_v5.NumericDate"
                         }
                     ]
                 },
@@ -2085,10 +4856,14 @@ const docTemplate = `{
                     "description": "the ` + "`" + `nbf` + "`" + ` (Not Before) claim. See https://datatracker.ietf.org/doc/html/rfc7519#section-4.1.5",
                     "allOf": [
                         {
-                            "$ref": "#/definitions/jwt.NumericDate"
+                            "$ref": "#/definitions/github_com_golang-jwt_jwt_v5.NumericDate"
                         }
                     ]
                 },
+                "pwd_change": {
+                    "description": "PasswordChange restricts token to changing expired or temporary password.",
+                    "type": "boolean"
+                },
                 "roles": {
                     "type": "array",
                     "items": {
@@ -2104,6 +4879,32 @@ const docTemplate = `{
                 }
             }
         },
+        "github_com_JMURv_sso_internal_dto.ApproveLoginRequest": {
+            "type": "object",
+            "properties": {
+                "approve": {
+                    "type": "boolean"
+                },
+                "number": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_JMURv_sso_internal_dto.ChangePasswordRequest": {
+            "type": "object",
+            "required": [
+                "current_password",
+                "password"
+            ],
+            "properties": {
+                "current_password": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                }
+            }
+        },
         "github_com_JMURv_sso_internal_dto.CheckEmailRequest": {
             "type": "object",
             "required": [
@@ -2119,17 +4920,24 @@ const docTemplate = `{
             "type": "object",
             "required": [
                 "password",
-                "token",
-                "uidb64"
+                "token"
             ],
             "properties": {
                 "password": {
                     "type": "string"
                 },
                 "token": {
-                    "type": "integer"
-                },
-                "uidb64": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_JMURv_sso_internal_dto.CheckLoginApprovalRequest": {
+            "type": "object",
+            "required": [
+                "id"
+            ],
+            "properties": {
+                "id": {
                     "type": "string"
                 }
             }
@@ -2142,13 +4950,35 @@ const docTemplate = `{
             ],
             "properties": {
                 "code": {
-                    "type": "integer"
+                    "type": "string"
                 },
                 "email": {
                     "type": "string"
                 }
             }
         },
+        "github_com_JMURv_sso_internal_dto.CheckQRLoginRequest": {
+            "type": "object",
+            "required": [
+                "id"
+            ],
+            "properties": {
+                "id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_JMURv_sso_internal_dto.ConfirmPhoneRequest": {
+            "type": "object",
+            "required": [
+                "code"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string"
+                }
+            }
+        },
         "github_com_JMURv_sso_internal_dto.CreatePermissionRequest": {
             "type": "object",
             "required": [
@@ -2163,6 +4993,36 @@ const docTemplate = `{
                 }
             }
         },
+        "github_com_JMURv_sso_internal_dto.CreateRealmDomainRequest": {
+            "type": "object",
+            "required": [
+                "domain",
+                "method"
+            ],
+            "properties": {
+                "connection": {
+                    "type": "string"
+                },
+                "domain": {
+                    "type": "string"
+                },
+                "force_sso": {
+                    "type": "boolean"
+                },
+                "method": {
+                    "type": "string",
+                    "enum": [
+                        "password",
+                        "passkey",
+                        "oauth2",
+                        "oidc",
+                        "saml",
+                        "ldap",
+                        "email_code"
+                    ]
+                }
+            }
+        },
         "github_com_JMURv_sso_internal_dto.CreateRoleRequest": {
             "type": "object",
             "required": [
@@ -2174,24 +5034,134 @@ const docTemplate = `{
                 },
                 "name": {
                     "type": "string"
-                },
-                "permissions": {
+                },
+                "permissions": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "github_com_JMURv_sso_internal_dto.CreateSAMLProviderRequest": {
+            "type": "object",
+            "required": [
+                "metadata",
+                "name"
+            ],
+            "properties": {
+                "allow_idp_initiated": {
+                    "type": "boolean"
+                },
+                "attribute_map": {
+                    "$ref": "#/definitions/github_com_JMURv_sso_internal_models.SAMLAttributeMap"
+                },
+                "link_domains": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "metadata": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "role_map": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "github_com_JMURv_sso_internal_dto.CreateUserResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_JMURv_sso_internal_dto.DiscoverRealmResponse": {
+            "type": "object",
+            "properties": {
+                "connection": {
+                    "type": "string"
+                },
+                "method": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_JMURv_sso_internal_dto.EmailAndPasswordRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "password",
+                "token"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_JMURv_sso_internal_dto.ExistsUserResponse": {
+            "type": "object",
+            "properties": {
+                "exists": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "github_com_JMURv_sso_internal_dto.LockoutEntry": {
+            "type": "object",
+            "properties": {
+                "failures": {
+                    "type": "integer"
+                },
+                "locked_until": {
+                    "type": "string"
+                },
+                "method": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_JMURv_sso_internal_dto.LockoutStatus": {
+            "type": "object",
+            "properties": {
+                "methods": {
                     "type": "array",
                     "items": {
-                        "type": "integer"
+                        "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.LockoutEntry"
                     }
                 }
             }
         },
-        "github_com_JMURv_sso_internal_dto.CreateUserResponse": {
+        "github_com_JMURv_sso_internal_dto.LoginApproval": {
             "type": "object",
             "properties": {
                 "id": {
                     "type": "string"
+                },
+                "number": {
+                    "type": "integer"
                 }
             }
         },
-        "github_com_JMURv_sso_internal_dto.EmailAndPasswordRequest": {
+        "github_com_JMURv_sso_internal_dto.LoginCodeRequest": {
             "type": "object",
             "required": [
                 "email",
@@ -2199,6 +5169,15 @@ const docTemplate = `{
                 "token"
             ],
             "properties": {
+                "channel": {
+                    "type": "string",
+                    "enum": [
+                        "email",
+                        "sms",
+                        "voice",
+                        "device"
+                    ]
+                },
                 "email": {
                     "type": "string"
                 },
@@ -2210,34 +5189,36 @@ const docTemplate = `{
                 }
             }
         },
-        "github_com_JMURv_sso_internal_dto.ExistsUserResponse": {
-            "type": "object",
-            "properties": {
-                "exists": {
-                    "type": "boolean"
-                }
-            }
-        },
-        "github_com_JMURv_sso_internal_dto.LoginCodeRequest": {
+        "github_com_JMURv_sso_internal_dto.LoginStartRequest": {
             "type": "object",
             "required": [
                 "email",
-                "password",
                 "token"
             ],
             "properties": {
                 "email": {
                     "type": "string"
                 },
-                "password": {
+                "token": {
                     "type": "string"
+                }
+            }
+        },
+        "github_com_JMURv_sso_internal_dto.MFAChallenge": {
+            "type": "object",
+            "properties": {
+                "methods": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
                 "token": {
                     "type": "string"
                 }
             }
         },
-        "github_com_JMURv_sso_internal_dto.LoginStartRequest": {
+        "github_com_JMURv_sso_internal_dto.MagicLinkRequest": {
             "type": "object",
             "required": [
                 "email",
@@ -2275,6 +5256,29 @@ const docTemplate = `{
                 }
             }
         },
+        "github_com_JMURv_sso_internal_dto.PaginatedRiskEventResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "current_page": {
+                    "type": "integer"
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_JMURv_sso_internal_models.RiskEvent"
+                    }
+                },
+                "has_next_page": {
+                    "type": "boolean"
+                },
+                "total_pages": {
+                    "type": "integer"
+                }
+            }
+        },
         "github_com_JMURv_sso_internal_dto.PaginatedRoleResponse": {
             "type": "object",
             "properties": {
@@ -2321,44 +5325,292 @@ const docTemplate = `{
                 }
             }
         },
+        "github_com_JMURv_sso_internal_dto.PendingLogin": {
+            "type": "object",
+            "properties": {
+                "browser": {
+                    "type": "string"
+                },
+                "choices": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "device_type": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "ip": {
+                    "type": "string"
+                },
+                "os": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_JMURv_sso_internal_dto.PhoneRequest": {
+            "type": "object",
+            "required": [
+                "phone"
+            ],
+            "properties": {
+                "channel": {
+                    "type": "string",
+                    "enum": [
+                        "sms",
+                        "voice"
+                    ]
+                },
+                "phone": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_JMURv_sso_internal_dto.QRLoginResponse": {
+            "type": "object",
+            "properties": {
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "qr": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_JMURv_sso_internal_dto.QRLoginSession": {
+            "type": "object",
+            "properties": {
+                "browser": {
+                    "type": "string"
+                },
+                "code": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "device_type": {
+                    "type": "string"
+                },
+                "ip": {
+                    "type": "string"
+                },
+                "os": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_JMURv_sso_internal_dto.ReauthRequest": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_JMURv_sso_internal_dto.RecoveryCodesResponse": {
+            "type": "object",
+            "properties": {
+                "codes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
         "github_com_JMURv_sso_internal_dto.RefreshRequest": {
             "type": "object",
             "required": [
                 "refresh"
             ],
             "properties": {
-                "refresh": {
+                "refresh": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_JMURv_sso_internal_dto.RenamePasskeyRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 64
+                }
+            }
+        },
+        "github_com_JMURv_sso_internal_dto.SendForgotPasswordEmail": {
+            "type": "object",
+            "required": [
+                "email",
+                "token"
+            ],
+            "properties": {
+                "channel": {
+                    "type": "string",
+                    "enum": [
+                        "email",
+                        "sms"
+                    ]
+                },
+                "email": {
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_JMURv_sso_internal_dto.TOTPCodeRequest": {
+            "type": "object",
+            "required": [
+                "code"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_JMURv_sso_internal_dto.TOTPEnrollResponse": {
+            "type": "object",
+            "properties": {
+                "qr": {
+                    "type": "string",
+                    "format": "base64"
+                },
+                "secret": {
+                    "type": "string"
+                },
+                "uri": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_JMURv_sso_internal_dto.TokenPair": {
+            "type": "object",
+            "properties": {
+                "access": {
+                    "type": "string"
+                },
+                "approval": {
+                    "description": "Approval is returned instead of tokens when login waits for trusted device.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.LoginApproval"
+                        }
+                    ]
+                },
+                "challenge": {
+                    "description": "Challenge is returned instead of tokens when user has to pass second factor.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_JMURv_sso_internal_dto.MFAChallenge"
+                        }
+                    ]
+                },
+                "password_change_required": {
+                    "description": "PasswordChange is set when tokens only allow to change expired or temporary password.",
+                    "type": "boolean"
+                },
+                "refresh": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_JMURv_sso_internal_dto.TokenRequest": {
+            "type": "object",
+            "required": [
+                "token"
+            ],
+            "properties": {
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_JMURv_sso_internal_dto.UpdateDeviceRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_JMURv_sso_internal_dto.UpdatePermissionRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "name": {
                     "type": "string"
                 }
             }
         },
-        "github_com_JMURv_sso_internal_dto.SendForgotPasswordEmail": {
+        "github_com_JMURv_sso_internal_dto.UpdateRoleRequest": {
             "type": "object",
             "required": [
-                "email",
-                "token"
+                "name"
             ],
             "properties": {
-                "email": {
+                "description": {
                     "type": "string"
                 },
-                "token": {
+                "name": {
                     "type": "string"
+                },
+                "permissions": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
                 }
             }
         },
-        "github_com_JMURv_sso_internal_dto.TokenPair": {
+        "github_com_JMURv_sso_internal_dto.VerifyEmailCodeRequest": {
             "type": "object",
+            "required": [
+                "challenge",
+                "code"
+            ],
             "properties": {
-                "access": {
+                "challenge": {
                     "type": "string"
                 },
-                "refresh": {
+                "code": {
                     "type": "string"
                 }
             }
         },
-        "github_com_JMURv_sso_internal_dto.TokenRequest": {
+        "github_com_JMURv_sso_internal_dto.VerifyMagicLinkRequest": {
             "type": "object",
             "required": [
                 "token"
@@ -2369,48 +5621,47 @@ const docTemplate = `{
                 }
             }
         },
-        "github_com_JMURv_sso_internal_dto.UpdateDeviceRequest": {
+        "github_com_JMURv_sso_internal_dto.VerifyRecoveryCodeRequest": {
             "type": "object",
             "required": [
-                "name"
+                "challenge",
+                "code"
             ],
             "properties": {
-                "name": {
+                "challenge": {
+                    "type": "string"
+                },
+                "code": {
                     "type": "string"
                 }
             }
         },
-        "github_com_JMURv_sso_internal_dto.UpdatePermissionRequest": {
+        "github_com_JMURv_sso_internal_dto.VerifyTOTPRequest": {
             "type": "object",
             "required": [
-                "name"
+                "challenge",
+                "code"
             ],
             "properties": {
-                "description": {
+                "challenge": {
                     "type": "string"
                 },
-                "name": {
+                "code": {
                     "type": "string"
                 }
             }
         },
-        "github_com_JMURv_sso_internal_dto.UpdateRoleRequest": {
+        "github_com_JMURv_sso_internal_dto.WAMetadataResponse": {
             "type": "object",
-            "required": [
-                "name"
-            ],
             "properties": {
-                "description": {
-                    "type": "string"
+                "entries": {
+                    "type": "integer"
                 },
-                "name": {
+                "next_update": {
                     "type": "string"
                 },
-                "permissions": {
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
-                    }
+                "number": {
+                    "type": "integer"
                 }
             }
         },
@@ -2492,6 +5743,35 @@ const docTemplate = `{
                 }
             }
         },
+        "github_com_JMURv_sso_internal_models.Passkey": {
+            "type": "object",
+            "properties": {
+                "backup_eligible": {
+                    "type": "boolean"
+                },
+                "backup_state": {
+                    "type": "boolean"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "last_used_at": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "transports": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
         "github_com_JMURv_sso_internal_models.Permission": {
             "type": "object",
             "properties": {
@@ -2506,6 +5786,64 @@ const docTemplate = `{
                 }
             }
         },
+        "github_com_JMURv_sso_internal_models.RealmDomain": {
+            "type": "object",
+            "properties": {
+                "connection": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "domain": {
+                    "type": "string"
+                },
+                "force_sso": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "method": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_JMURv_sso_internal_models.RiskEvent": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "country": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "device_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "ip": {
+                    "type": "string"
+                },
+                "reasons": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "score": {
+                    "type": "integer"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
         "github_com_JMURv_sso_internal_models.Role": {
             "type": "object",
             "properties": {
@@ -2526,6 +5864,61 @@ const docTemplate = `{
                 }
             }
         },
+        "github_com_JMURv_sso_internal_models.SAMLAttributeMap": {
+            "type": "object",
+            "properties": {
+                "avatar": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "groups": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_JMURv_sso_internal_models.SAMLProvider": {
+            "type": "object",
+            "properties": {
+                "allow_idp_initiated": {
+                    "type": "boolean"
+                },
+                "attribute_map": {
+                    "$ref": "#/definitions/github_com_JMURv_sso_internal_models.SAMLAttributeMap"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "entity_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "link_domains": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "metadata": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "role_map": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
         "github_com_JMURv_sso_internal_models.User": {
             "type": "object",
             "properties": {
@@ -2556,6 +5949,9 @@ const docTemplate = `{
                 "is_wa": {
                     "type": "boolean"
                 },
+                "must_change_password": {
+                    "type": "boolean"
+                },
                 "name": {
                     "type": "string"
                 },
@@ -2568,6 +5964,13 @@ const docTemplate = `{
                 "password": {
                     "type": "string"
                 },
+                "password_changed_at": {
+                    "description": "PasswordChangedAt together with per-role max age decides whether password has expired.",
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                },
                 "roles": {
                     "type": "array",
                     "items": {
@@ -2579,6 +5982,14 @@ const docTemplate = `{
                 }
             }
         },
+        "github_com_golang-jwt_jwt_v5.NumericDate": {
+            "type": "object",
+            "properties": {
+                "time.Time": {
+                    "type": "string"
+                }
+            }
+        },
         "http.Header": {
             "type": "object",
             "additionalProperties": {
@@ -2687,7 +6098,7 @@ const docTemplate = `{
                     ]
                 },
                 "trailer": {
-                    "description": "Trailer specifies additional headers that are sent after the request\nbody.\n\nFor server requests, the Trailer map initially contains only the\ntrailer keys, with nil values. (The client declares which trailers it\nwill later send.)  While the handler is reading from Body, it must\nnot reference Trailer. After reading from Body returns EOF, Trailer\ncan be read again and will contain non-nil values, if they were sent\nby the client.\n\nFor client requests, Trailer must be initialized to a map containing\nthe trailer keys to later send. The values may be nil or their final\nvalues. The ContentLength must be 0 or -1, to send a chunked request.\nAfter the HTTP request is sent the map values can be updated while\nthe request body is read. Once the body returns EOF, the caller must\nnot mutate Trailer.\n\nFew HTTP clients, servers, or proxies support HTTP trailers.",
+                    "description": "Trailer specifies additional headers that are sent after the request\nbody.\n\nFor server requests, the Trailer map initially contains only the\ntrailer keys, with nil values. (The client declares which trailers it\nwill later send.)  While the handler is reading from Body, it must\nnot reference Trailer. After reading from Body returns EOF, Trailer\ncan be read again and will contain non-nil values, if they were sent\nby the client.\n\nFor client requests, Trailer must be initialized to a map containing\nthe trailer keys to later send. The values may be nil or their final\nvalues. The ContentLength must be 0 or -1, to send a chunked request.\nAfter the HTTP request is sent the map values can be updated while\nthe request body is read. Once the body returns EOF, the caller must\nnot mutate Trailer.\n\nWriting a request whose Trailer contains a key with invalid bytes\n(such as CR or LF), or such a value present when Write begins,\nreturns an error.\n\nFew HTTP clients, servers, or proxies support HTTP trailers.",
                     "allOf": [
                         {
                             "$ref": "#/definitions/http.Header"
@@ -2715,7 +6126,7 @@ const docTemplate = `{
             "type": "object",
             "properties": {
                 "body": {
-                    "description": "Body represents the response body.\n\nThe response body is streamed on demand as the Body field\nis read. If the network connection fails or the server\nterminates the response, Body.Read calls return an error.\n\nThe http Client and Transport guarantee that Body is always\nnon-nil, even on responses without a body or responses with\na zero-length body. It is the caller's responsibility to\nclose Body. The default HTTP client's Transport may not\nreuse HTTP/1.x \"keep-alive\" TCP connections if the Body is\nnot read to completion and closed.\n\nThe Body is automatically dechunked if the server replied\nwith a \"chunked\" Transfer-Encoding.\n\nAs of Go 1.12, the Body will also implement io.Writer\non a successful \"101 Switching Protocols\" response,\nas used by WebSockets and HTTP/2's \"h2c\" mode."
+                    "description": "Body represents the response body.\n\nThe response body is streamed on demand as the Body field\nis read. If the network connection fails or the server\nterminates the response, Body.Read calls return an error.\n\nThe http Client and Transport guarantee that Body is always\nnon-nil, even on responses without a body or responses with\na zero-length body. It is the caller's responsibility to\nclose Body. The default HTTP client's Transport may not\nreuse HTTP/1.x \"keep-alive\" TCP connections if the Body is\nnot read to completion and closed; however, manually reading\nthe body to completion should not be needed in most cases,\nas closing the body will also cause the body to be read to\ncompletion asynchronously, up to a conservative limit.\n\nThe Body is automatically dechunked if the server replied\nwith a \"chunked\" Transfer-Encoding.\n\nAs of Go 1.12, the Body will also implement io.Writer\non a successful \"101 Switching Protocols\" response,\nas used by WebSockets and HTTP/2's \"h2c\" mode."
                 },
                 "close": {
                     "description": "Close records whether the header directed that the connection be\nclosed after reading Body. The value is advice for clients: neither\nReadResponse nor Response.Write ever closes a connection.",
@@ -2790,14 +6201,6 @@ const docTemplate = `{
                 }
             }
         },
-        "jwt.NumericDate": {
-            "type": "object",
-            "properties": {
-                "time.Time": {
-                    "type": "string"
-                }
-            }
-        },
         "multipart.FileHeader": {
             "type": "object",
             "properties": {
@@ -3315,6 +6718,14 @@ const docTemplate = `{
                     "description": "CipherSuite is the cipher suite negotiated for the connection (e.g.\nTLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256, TLS_AES_128_GCM_SHA256).",
                     "type": "integer"
                 },
+                "curveID": {
+                    "description": "CurveID is the key exchange mechanism used for the connection. The name\nrefers to elliptic curves for legacy reasons, see [CurveID]. If a legacy\nRSA key exchange is used, this value is zero.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/tls.CurveID"
+                        }
+                    ]
+                },
                 "didResume": {
                     "description": "DidResume is true if this connection was successfully resumed from a\nprevious session with a session ticket or similar mechanism.",
                     "type": "boolean"
@@ -3327,6 +6738,20 @@ const docTemplate = `{
                     "description": "HandshakeComplete is true if the handshake has concluded.",
                     "type": "boolean"
                 },
+                "helloRetryRequest": {
+                    "description": "HelloRetryRequest indicates whether we sent a HelloRetryRequest if we\nare a server, or if we received a HelloRetryRequest if we are a client.",
+                    "type": "boolean"
+                },
+                "localCertificate": {
+                    "description": "LocalCertificate is the certificate chain presented to the peer, if any,\nduring the handshake. This field is only populated for connections which\nare not resumed (DidResume is false).",
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        }
+                    }
+                },
                 "negotiatedProtocol": {
                     "description": "NegotiatedProtocol is the application protocol negotiated with ALPN.",
                     "type": "string"
@@ -3386,23 +6811,46 @@ const docTemplate = `{
                 }
             }
         },
+        "tls.CurveID": {
+            "type": "integer",
+            "enum": [
+                23,
+                24,
+                25,
+                29,
+                4588,
+                4587,
+                4589,
+                514
+            ],
+            "x-enum-varnames": [
+                "CurveP256",
+                "CurveP384",
+                "CurveP521",
+                "X25519",
+                "X25519MLKEM768",
+                "SecP256r1MLKEM768",
+                "SecP384r1MLKEM1024",
+                "MLKEM1024"
+            ]
+        },
         "url.URL": {
             "type": "object",
             "properties": {
                 "forceQuery": {
-                    "description": "append a query ('?') even if RawQuery is empty",
+                    "description": "ForceQuery indicates whether the original URL contained a query ('?') character.\nWhen set, the String method will include a trailing '?', even when RawQuery is empty.",
                     "type": "boolean"
                 },
                 "fragment": {
-                    "description": "fragment for references, without '#'",
+                    "description": "fragment for references (without '#')",
                     "type": "string"
                 },
                 "host": {
-                    "description": "host or host:port (see Hostname and Port methods)",
+                    "description": "\"host\" or \"host:port\" (see Hostname and Port methods)",
                     "type": "string"
                 },
                 "omitHost": {
-                    "description": "do not emit empty host (authority)",
+                    "description": "OmitHost indicates the URL has an empty host (authority).\nWhen set, the String method will not include the host when it is empty.",
                     "type": "boolean"
                 },
                 "opaque": {
@@ -3414,15 +6862,15 @@ const docTemplate = `{
                     "type": "string"
                 },
                 "rawFragment": {
-                    "description": "encoded fragment hint (see EscapedFragment method)",
+                    "description": "RawFragment is an optional field containing an encoded fragment hint.\nSee the EscapedFragment method for more details.\n\nIn general, code should call EscapedFragment instead of reading RawFragment.",
                     "type": "string"
                 },
                 "rawPath": {
-                    "description": "encoded path hint (see EscapedPath method)",
+                    "description": "RawPath is an optional field containing an encoded path hint.\nSee the EscapedPath method for more details.\n\nIn general, code should call EscapedPath instead of reading RawPath.",
                     "type": "string"
                 },
                 "rawQuery": {
-                    "description": "encoded query values, without '?'",
+                    "description": "RawQuery contains the encoded query values, without the initial '?'.\nUse URL.Query to decode the query.",
                     "type": "string"
                 },
                 "scheme": {
@@ -3559,6 +7007,22 @@ const docTemplate = `{
                         "$ref": "#/definitions/pkix.Extension"
                     }
                 },
+                "inhibitAnyPolicy": {
+                    "description": "InhibitAnyPolicy and InhibitAnyPolicyZero indicate the presence and value\nof the inhibitAnyPolicy extension.\n\nThe value of InhibitAnyPolicy indicates the number of additional\ncertificates in the path after this certificate that may use the\nanyPolicy policy OID to indicate a match with any other policy.\n\nWhen parsing a certificate, a positive non-zero InhibitAnyPolicy means\nthat the field was specified, -1 means it was unset, and\nInhibitAnyPolicyZero being true mean that the field was explicitly set to\nzero. The case of InhibitAnyPolicy==0 with InhibitAnyPolicyZero==false\nshould be treated equivalent to -1 (unset).",
+                    "type": "integer"
+                },
+                "inhibitAnyPolicyZero": {
+                    "description": "InhibitAnyPolicyZero indicates that InhibitAnyPolicy==0 should be\ninterpreted as an actual maximum path length of zero. Otherwise, that\ncombination is interpreted as InhibitAnyPolicy not being set.",
+                    "type": "boolean"
+                },
+                "inhibitPolicyMapping": {
+                    "description": "InhibitPolicyMapping and InhibitPolicyMappingZero indicate the presence\nand value of the inhibitPolicyMapping field of the policyConstraints\nextension.\n\nThe value of InhibitPolicyMapping indicates the number of additional\ncertificates in the path after this certificate that may use policy\nmapping.\n\nWhen parsing a certificate, a positive non-zero InhibitPolicyMapping\nmeans that the field was specified, -1 means it was unset, and\nInhibitPolicyMappingZero being true mean that the field was explicitly\nset to zero. The case of InhibitPolicyMapping==0 with\nInhibitPolicyMappingZero==false should be treated equivalent to -1\n(unset).",
+                    "type": "integer"
+                },
+                "inhibitPolicyMappingZero": {
+                    "description": "InhibitPolicyMappingZero indicates that InhibitPolicyMapping==0 should be\ninterpreted as an actual maximum path length of zero. Otherwise, that\ncombination is interpreted as InhibitAnyPolicy not being set.",
+                    "type": "boolean"
+                },
                 "ipaddresses": {
                     "type": "array",
                     "items": {
@@ -3635,14 +7099,14 @@ const docTemplate = `{
                     }
                 },
                 "policies": {
-                    "description": "Policies contains all policy identifiers included in the certificate.\nIn Go 1.22, encoding/gob cannot handle and ignores this field.",
+                    "description": "Policies contains all policy identifiers included in the certificate.\nSee CreateCertificate for context about how this field and the PolicyIdentifiers field\ninteract.\nIn Go 1.22, encoding/gob cannot handle and ignores this field.",
                     "type": "array",
                     "items": {
                         "$ref": "#/definitions/x509.OID"
                     }
                 },
                 "policyIdentifiers": {
-                    "description": "PolicyIdentifiers contains asn1.ObjectIdentifiers, the components\nof which are limited to int32. If a certificate contains a policy which\ncannot be represented by asn1.ObjectIdentifier, it will not be included in\nPolicyIdentifiers, but will be present in Policies, which contains all parsed\npolicy OIDs.",
+                    "description": "PolicyIdentifiers contains asn1.ObjectIdentifiers, the components\nof which are limited to int32. If a certificate contains a policy which\ncannot be represented by asn1.ObjectIdentifier, it will not be included in\nPolicyIdentifiers, but will be present in Policies, which contains all parsed\npolicy OIDs.\nSee CreateCertificate for context about how this field and the Policies field\ninteract.",
                     "type": "array",
                     "items": {
                         "type": "array",
@@ -3651,6 +7115,13 @@ const docTemplate = `{
                         }
                     }
                 },
+                "policyMappings": {
+                    "description": "PolicyMappings contains a list of policy mappings included in the certificate.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/x509.PolicyMapping"
+                    }
+                },
                 "publicKey": {},
                 "publicKeyAlgorithm": {
                     "$ref": "#/definitions/x509.PublicKeyAlgorithm"
@@ -3669,6 +7140,13 @@ const docTemplate = `{
                         "type": "integer"
                     }
                 },
+                "rawSignatureAlgorithm": {
+                    "description": "DER encoded AlgorithmIdentifier",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
                 "rawSubject": {
                     "description": "DER encoded Subject",
                     "type": "array",
@@ -3690,6 +7168,14 @@ const docTemplate = `{
                         "type": "integer"
                     }
                 },
+                "requireExplicitPolicy": {
+                    "description": "RequireExplicitPolicy and RequireExplicitPolicyZero indicate the presence\nand value of the requireExplicitPolicy field of the policyConstraints\nextension.\n\nThe value of RequireExplicitPolicy indicates the number of additional\ncertificates in the path after this certificate before an explicit policy\nis required for the rest of the path. When an explicit policy is required,\neach subsequent certificate in the path must contain a required policy OID,\nor a policy OID which has been declared as equivalent through the policy\nmapping extension.\n\nWhen parsing a certificate, a positive non-zero RequireExplicitPolicy\nmeans that the field was specified, -1 means it was unset, and\nRequireExplicitPolicyZero being true mean that the field was explicitly\nset to zero. The case of RequireExplicitPolicy==0 with\nRequireExplicitPolicyZero==false should be treated equivalent to -1\n(unset).",
+                    "type": "integer"
+                },
+                "requireExplicitPolicyZero": {
+                    "description": "RequireExplicitPolicyZero indicates that RequireExplicitPolicy==0 should be\ninterpreted as an actual maximum path length of zero. Otherwise, that\ncombination is interpreted as InhibitAnyPolicy not being set.",
+                    "type": "boolean"
+                },
                 "serialNumber": {
                     "$ref": "#/definitions/big.Int"
                 },
@@ -3760,6 +7246,22 @@ const docTemplate = `{
                 12,
                 13
             ],
+            "x-enum-comments": {
+                "ExtKeyUsageAny": "anyExtendedKeyUsage",
+                "ExtKeyUsageClientAuth": "clientAuth",
+                "ExtKeyUsageCodeSigning": "codeSigning",
+                "ExtKeyUsageEmailProtection": "emailProtection",
+                "ExtKeyUsageIPSECEndSystem": "ipsecEndSystem",
+                "ExtKeyUsageIPSECTunnel": "ipsecTunnel",
+                "ExtKeyUsageIPSECUser": "ipsecUser",
+                "ExtKeyUsageMicrosoftCommercialCodeSigning": "msCodeCom",
+                "ExtKeyUsageMicrosoftKernelCodeSigning": "msKernelCode",
+                "ExtKeyUsageMicrosoftServerGatedCrypto": "msSGC",
+                "ExtKeyUsageNetscapeServerGatedCrypto": "nsSGC",
+                "ExtKeyUsageOCSPSigning": "OCSPSigning",
+                "ExtKeyUsageServerAuth": "serverAuth",
+                "ExtKeyUsageTimeStamping": "timeStamping"
+            },
             "x-enum-varnames": [
                 "ExtKeyUsageAny",
                 "ExtKeyUsageServerAuth",
@@ -3790,6 +7292,17 @@ const docTemplate = `{
                 128,
                 256
             ],
+            "x-enum-comments": {
+                "KeyUsageCRLSign": "cRLSign",
+                "KeyUsageCertSign": "keyCertSign",
+                "KeyUsageContentCommitment": "contentCommitment",
+                "KeyUsageDataEncipherment": "dataEncipherment",
+                "KeyUsageDecipherOnly": "decipherOnly",
+                "KeyUsageDigitalSignature": "digitalSignature",
+                "KeyUsageEncipherOnly": "encipherOnly",
+                "KeyUsageKeyAgreement": "keyAgreement",
+                "KeyUsageKeyEncipherment": "keyEncipherment"
+            },
             "x-enum-varnames": [
                 "KeyUsageDigitalSignature",
                 "KeyUsageContentCommitment",
@@ -3805,6 +7318,27 @@ const docTemplate = `{
         "x509.OID": {
             "type": "object"
         },
+        "x509.PolicyMapping": {
+            "type": "object",
+            "properties": {
+                "issuerDomainPolicy": {
+                    "description": "IssuerDomainPolicy contains a policy OID the issuing certificate considers\nequivalent to SubjectDomainPolicy in the subject certificate.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/x509.OID"
+                        }
+                    ]
+                },
+                "subjectDomainPolicy": {
+                    "description": "SubjectDomainPolicy contains a OID the issuing certificate considers\nequivalent to IssuerDomainPolicy in the subject certificate.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/x509.OID"
+                        }
+                    ]
+                }
+            }
+        },
         "x509.PublicKeyAlgorithm": {
             "type": "integer",
             "enum": [
@@ -3812,7 +7346,8 @@ const docTemplate = `{
                 1,
                 2,
                 3,
-                4
+                4,
+                5
             ],
             "x-enum-comments": {
                 "DSA": "Only supported for parsing."
@@ -3822,7 +7357,8 @@ const docTemplate = `{
                 "RSA",
                 "DSA",
                 "ECDSA",
-                "Ed25519"
+                "Ed25519",
+                "MLDSA"
             ]
         },
         "x509.SignatureAlgorithm": {
@@ -3844,7 +7380,10 @@ const docTemplate = `{
                 13,
                 14,
                 15,
-                16
+                16,
+                17,
+                18,
+                19
             ],
             "x-enum-comments": {
                 "DSAWithSHA1": "Unsupported.",
@@ -3871,7 +7410,10 @@ const docTemplate = `{
                 "SHA256WithRSAPSS",
                 "SHA384WithRSAPSS",
                 "SHA512WithRSAPSS",
-                "PureEd25519"
+                "PureEd25519",
+                "MLDSA44",
+                "MLDSA65",
+                "MLDSA87"
             ]
         }
     }
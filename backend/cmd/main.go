@@ -14,8 +14,10 @@ import (
 	"github.com/JMURv/sso/internal/hdl/http"
 	"github.com/JMURv/sso/internal/observability/metrics/prometheus"
 	"github.com/JMURv/sso/internal/observability/tracing/jaeger"
+	"github.com/JMURv/sso/internal/ratelimit"
 	"github.com/JMURv/sso/internal/repo/db"
 	"github.com/JMURv/sso/internal/repo/s3"
+	"github.com/JMURv/sso/internal/sms"
 	"github.com/JMURv/sso/internal/smtp"
 	"go.uber.org/zap"
 )
@@ -50,9 +52,11 @@ func main() {
 	au := auth.New(conf)
 	cache := redis.New(conf)
 	repo := db.New(conf)
-	svc := ctrl.New(repo, au, cache, s3.New(conf), smtp.New(conf))
-	h := http.New(svc, au)
-	hg := grpc.New(conf.ServiceName, svc, au)
+	go repo.StartKeyRotation(ctx)
+	svc := ctrl.New(repo, au, cache, s3.New(conf), smtp.New(conf), sms.New(conf))
+	rl := ratelimit.New(conf, cache)
+	h := http.New(svc, au, rl, conf.Server.TrustedProxies)
+	hg := grpc.New(conf.ServiceName, svc, au, rl)
 
 	go h.Start(conf.Server.Port)
 	go hg.Start(conf.Server.GRPCPort)
@@ -2,28 +2,51 @@ package auth
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"time"
 
 	"github.com/JMURv/sso/internal/auth/captcha"
 	"github.com/JMURv/sso/internal/auth/jwt"
+	"github.com/JMURv/sso/internal/auth/ldap"
+	"github.com/JMURv/sso/internal/auth/otp"
+	"github.com/JMURv/sso/internal/auth/password"
 	"github.com/JMURv/sso/internal/auth/providers"
+	"github.com/JMURv/sso/internal/auth/risk"
+	"github.com/JMURv/sso/internal/auth/saml"
+	"github.com/JMURv/sso/internal/auth/totp"
 	wa "github.com/JMURv/sso/internal/auth/webauthn"
 	"github.com/JMURv/sso/internal/config"
+	"github.com/JMURv/sso/internal/dto"
 	md "github.com/JMURv/sso/internal/models"
 	"github.com/go-webauthn/webauthn/protocol"
 	"github.com/go-webauthn/webauthn/webauthn"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
-	"golang.org/x/crypto/bcrypt"
 )
 
 type Core interface {
 	Hash(val string) (string, error)
 	ComparePasswords(hashed, pswd []byte) error
+	DummyCompare(pswd []byte)
+	NeedsRehash(hashed string) bool
+	ValidatePassword(pswd string, history []string, personal ...string) error
+	PasswordHistory() int
+	PasswordMaxAge(roles []md.Role) time.Duration
+	SelfRegistration() bool
+	ReauthTime() time.Duration
+	Lockout() config.LockoutConfig
+	AntiEnumeration() config.AntiEnumerationConfig
+	OTP() config.OTPConfig
+	QRLoginURL(code string) string
 	jwt.Port
 	captcha.Port
 	providers.Port
+	saml.Port
+	ldap.Port
+	totp.Port
+	otp.Port
+	risk.Port
 	wa.Port
 }
 
@@ -31,7 +54,20 @@ type Auth struct {
 	jwt       jwt.Port
 	captcha   captcha.Port
 	providers providers.Port
+	saml      saml.Port
+	ldap      ldap.Port
+	totp      totp.Port
+	otp       otp.Port
+	risk      risk.Port
 	wa        wa.Port
+	password  password.Port
+
+	selfRegistration bool
+	reauthTime       time.Duration
+	lockout          config.LockoutConfig
+	antiEnumeration  config.AntiEnumerationConfig
+	otpConf          config.OTPConfig
+	server           config.ServerConfig
 }
 
 func New(conf config.Config) *Auth {
@@ -39,33 +75,96 @@ func New(conf config.Config) *Auth {
 		jwt:       jwt.New(conf),
 		captcha:   captcha.New(conf),
 		providers: providers.New(conf),
+		saml:      saml.New(conf),
+		ldap:      ldap.New(conf),
+		totp:      totp.New(conf),
+		otp:       otp.New(conf),
+		risk:      risk.New(conf),
 		wa:        wa.New(conf),
+		password:  password.New(conf),
+
+		selfRegistration: conf.Auth.SelfRegistration,
+		reauthTime:       conf.Auth.ReauthTime,
+		lockout:          conf.Auth.Lockout,
+		antiEnumeration:  conf.Auth.AntiEnumeration,
+		otpConf:          conf.Auth.OTP,
+		server:           conf.Server,
 	}
 }
 
 func (a *Auth) Hash(val string) (string, error) {
-	bytes, err := bcrypt.GenerateFromPassword([]byte(val), bcrypt.MinCost)
+	hash, err := a.password.Hash(val)
 	if err != nil {
 		zap.L().Error(
 			"Failed to generate hash",
-			zap.String("val", val),
 			zap.Error(err),
 		)
 
 		return "", err
 	}
 
-	return string(bytes), nil
+	return hash, nil
 }
 
 func (a *Auth) ComparePasswords(hashed, pswd []byte) error {
-	if err := bcrypt.CompareHashAndPassword(hashed, pswd); err != nil {
+	if err := a.password.Compare(string(hashed), string(pswd)); err != nil {
 		return ErrInvalidCredentials
 	}
 
 	return nil
 }
 
+// DummyCompare takes as long as ComparePasswords but never succeeds, it is used for unknown accounts.
+func (a *Auth) DummyCompare(pswd []byte) {
+	a.password.DummyCompare(string(pswd))
+}
+
+func (a *Auth) NeedsRehash(hashed string) bool {
+	return a.password.NeedsRehash(hashed)
+}
+
+func (a *Auth) ValidatePassword(pswd string, history []string, personal ...string) error {
+	return a.password.Validate(pswd, history, personal...)
+}
+
+func (a *Auth) PasswordHistory() int {
+	return a.password.History()
+}
+
+func (a *Auth) PasswordMaxAge(roles []md.Role) time.Duration {
+	names := make([]string, 0, len(roles))
+	for i := 0; i < len(roles); i++ {
+		names = append(names, roles[i].Name)
+	}
+	return a.password.MaxAge(names...)
+}
+
+func (a *Auth) SelfRegistration() bool {
+	return a.selfRegistration
+}
+
+// ReauthTime is how long after login sensitive actions are allowed without re-authentication.
+func (a *Auth) ReauthTime() time.Duration {
+	return a.reauthTime
+}
+
+func (a *Auth) Lockout() config.LockoutConfig {
+	return a.lockout
+}
+
+func (a *Auth) AntiEnumeration() config.AntiEnumerationConfig {
+	return a.antiEnumeration
+}
+
+func (a *Auth) OTP() config.OTPConfig {
+	return a.otpConf
+}
+
+// QRLoginURL is opened on signed-in phone after scanning QR code shown on the new device.
+func (a *Auth) QRLoginURL(code string) string {
+	return fmt.Sprintf("%v://%v/qr/?code=%v", a.server.Scheme, a.server.Domain, code)
+}
+
 func (a *Auth) GetAccessTime() time.Time {
 	return a.jwt.GetAccessTime()
 }
@@ -74,12 +173,12 @@ func (a *Auth) GetRefreshTime() time.Time {
 	return a.jwt.GetRefreshTime()
 }
 
-func (a *Auth) GenPair(ctx context.Context, uid uuid.UUID, roles []md.Role) (string, string, error) {
-	return a.jwt.GenPair(ctx, uid, roles)
+func (a *Auth) GenPair(ctx context.Context, uid uuid.UUID, roles []md.Role, ai jwt.AuthInfo) (string, string, error) {
+	return a.jwt.GenPair(ctx, uid, roles, ai)
 }
 
-func (a *Auth) NewToken(ctx context.Context, uid uuid.UUID, roles []md.Role, d time.Duration) (string, error) {
-	return a.jwt.NewToken(ctx, uid, roles, d)
+func (a *Auth) NewToken(ctx context.Context, uid uuid.UUID, roles []md.Role, ai jwt.AuthInfo, d time.Duration) (string, error) {
+	return a.jwt.NewToken(ctx, uid, roles, ai, d)
 }
 
 func (a *Auth) ParseClaims(ctx context.Context, tokenStr string) (jwt.Claims, error) {
@@ -98,6 +197,10 @@ func (a *Auth) SuccessURL() string {
 	return a.providers.SuccessURL()
 }
 
+func (a *Auth) MockIDP() http.Handler {
+	return a.providers.MockIDP()
+}
+
 func (a *Auth) GenerateSignedState() string {
 	return a.providers.GenerateSignedState()
 }
@@ -106,6 +209,66 @@ func (a *Auth) ValidateSignedState(signedState string, maxAge time.Duration) err
 	return a.providers.ValidateSignedState(signedState, maxAge)
 }
 
+func (a *Auth) ParseIDPMetadata(data []byte) (string, error) {
+	return a.saml.ParseIDPMetadata(data)
+}
+
+func (a *Auth) SAMLAuthURL(p *md.SAMLProvider, relayState string) (*dto.SAMLAuthRequest, error) {
+	return a.saml.SAMLAuthURL(p, relayState)
+}
+
+func (a *Auth) ParseSAMLResponse(p *md.SAMLProvider, r *http.Request, requestIDs []string) (*dto.SAMLAssertion, error) {
+	return a.saml.ParseSAMLResponse(p, r, requestIDs)
+}
+
+func (a *Auth) SAMLMetadata(p *md.SAMLProvider) ([]byte, error) {
+	return a.saml.SAMLMetadata(p)
+}
+
+func (a *Auth) IsLDAPUser(email string) bool {
+	return a.ldap.IsLDAPUser(email)
+}
+
+func (a *Auth) LDAPAuthenticate(ctx context.Context, login, password string) (*dto.LDAPUser, error) {
+	return a.ldap.LDAPAuthenticate(ctx, login, password)
+}
+
+func (a *Auth) LDAPManagedRoles() []string {
+	return a.ldap.LDAPManagedRoles()
+}
+
+func (a *Auth) LDAPFailureKey(login, password string) string {
+	return a.ldap.LDAPFailureKey(login, password)
+}
+
+func (a *Auth) GenerateTOTP(account string) (*dto.TOTPEnrollResponse, string, error) {
+	return a.totp.GenerateTOTP(account)
+}
+
+func (a *Auth) ValidateTOTP(secret, code string) (int64, error) {
+	return a.totp.ValidateTOTP(secret, code)
+}
+
+func (a *Auth) GenerateOTP() (string, error) {
+	return a.otp.GenerateOTP()
+}
+
+func (a *Auth) HashOTP(purpose, subject, code string) string {
+	return a.otp.HashOTP(purpose, subject, code)
+}
+
+func (a *Auth) CompareOTP(hashed, purpose, subject, code string) bool {
+	return a.otp.CompareOTP(hashed, purpose, subject, code)
+}
+
+func (a *Auth) RiskEnabled() bool {
+	return a.risk.RiskEnabled()
+}
+
+func (a *Auth) AssessRisk(s risk.Signals) risk.Assessment {
+	return a.risk.AssessRisk(s)
+}
+
 func (a *Auth) BeginLogin(
 	user webauthn.User,
 	opts ...webauthn.LoginOption,
@@ -121,6 +284,21 @@ func (a *Auth) FinishLogin(
 	return a.wa.FinishLogin(user, session, response)
 }
 
+func (a *Auth) BeginDiscoverableMediatedLogin(
+	mediation protocol.CredentialMediationRequirement,
+	opts ...webauthn.LoginOption,
+) (*protocol.CredentialAssertion, *webauthn.SessionData, error) {
+	return a.wa.BeginDiscoverableMediatedLogin(mediation, opts...)
+}
+
+func (a *Auth) ValidatePasskeyLogin(
+	handler webauthn.DiscoverableUserHandler,
+	session webauthn.SessionData,
+	parsedResponse *protocol.ParsedCredentialAssertionData,
+) (webauthn.User, *webauthn.Credential, error) {
+	return a.wa.ValidatePasskeyLogin(handler, session, parsedResponse)
+}
+
 func (a *Auth) BeginRegistration(
 	user webauthn.User,
 	opts ...webauthn.RegistrationOption,
@@ -135,3 +313,15 @@ func (a *Auth) FinishRegistration(
 ) (*webauthn.Credential, error) {
 	return a.wa.FinishRegistration(user, session, response)
 }
+
+func (a *Auth) RegistrationOptions(roles []md.Role) []webauthn.RegistrationOption {
+	return a.wa.RegistrationOptions(roles)
+}
+
+func (a *Auth) VerifyPolicy(ctx context.Context, roles []md.Role, cred *webauthn.Credential) error {
+	return a.wa.VerifyPolicy(ctx, roles, cred)
+}
+
+func (a *Auth) UpdateMetadata(blob []byte) (*dto.WAMetadataResponse, error) {
+	return a.wa.UpdateMetadata(blob)
+}
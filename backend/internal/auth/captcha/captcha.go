@@ -21,6 +21,7 @@ const (
 	PassAuth   Actions = "pass_auth"
 	EmailAuth  Actions = "email_auth"
 	ForgotPass Actions = "forgot_pass"
+	MagicLink  Actions = "magic_link"
 	WALogin    Actions = "wa_login"
 )
 
@@ -16,8 +16,8 @@ import (
 type Port interface {
 	GetAccessTime() time.Time
 	GetRefreshTime() time.Time
-	GenPair(ctx context.Context, uid uuid.UUID, roles []md.Role) (string, string, error)
-	NewToken(ctx context.Context, uid uuid.UUID, roles []md.Role, d time.Duration) (string, error)
+	GenPair(ctx context.Context, uid uuid.UUID, roles []md.Role, ai AuthInfo) (string, string, error)
+	NewToken(ctx context.Context, uid uuid.UUID, roles []md.Role, ai AuthInfo, d time.Duration) (string, error)
 	ParseClaims(ctx context.Context, tokenStr string) (Claims, error)
 }
 
@@ -38,11 +38,43 @@ type Core struct {
 }
 
 type Claims struct {
-	UID   uuid.UUID `json:"uid"`
-	Roles []md.Role `json:"roles"`
+	UID      uuid.UUID        `json:"uid"`
+	Roles    []md.Role        `json:"roles"`
+	AMR      []string         `json:"amr,omitempty"`
+	ACR      string           `json:"acr,omitempty"`
+	AuthTime *jwt.NumericDate `json:"auth_time,omitempty"`
+	// PasswordChange restricts token to changing expired or temporary password.
+	PasswordChange bool `json:"pwd_change,omitempty"`
 	jwt.RegisteredClaims
 }
 
+// AuthInfo tells how and when user has proven identity. It is kept
+// as is on refresh, so auth_time is the time of the actual login.
+type AuthInfo struct {
+	AMR            []string
+	Time           time.Time
+	PasswordChange bool
+}
+
+// ACR maps methods to assurance level, passkey or second factor gives md.ACRMultiFactor.
+func (a AuthInfo) ACR() string {
+	for i := range a.AMR {
+		if a.AMR[i] == md.AMRMultiFactor || a.AMR[i] == md.AMRHardwareKey {
+			return md.ACRMultiFactor
+		}
+	}
+	return md.ACRSingleFactor
+}
+
+// Info restores AuthInfo the token was issued with.
+func (c Claims) Info() AuthInfo {
+	ai := AuthInfo{AMR: c.AMR, PasswordChange: c.PasswordChange}
+	if c.AuthTime != nil {
+		ai.Time = c.AuthTime.Time
+	}
+	return ai
+}
+
 func New(conf config.Config) *Core {
 	return &Core{secret: []byte(conf.Auth.JWT.Secret), issuer: conf.Auth.JWT.Issuer}
 }
@@ -55,12 +87,12 @@ func (c *Core) GetRefreshTime() time.Time {
 	return time.Now().Add(config.RefreshTokenDuration)
 }
 
-func (c *Core) GenPair(ctx context.Context, uid uuid.UUID, roles []md.Role) (string, string, error) {
+func (c *Core) GenPair(ctx context.Context, uid uuid.UUID, roles []md.Role, ai AuthInfo) (string, string, error) {
 	const op = "auth.GenPair.jwt"
 	span, ctx := opentracing.StartSpanFromContext(ctx, op)
 	defer span.Finish()
 
-	access, err := c.NewToken(ctx, uid, roles, config.AccessTokenDuration)
+	access, err := c.NewToken(ctx, uid, roles, ai, config.AccessTokenDuration)
 	if err != nil {
 		zap.L().Error(
 			"Failed to generate token pair",
@@ -71,7 +103,7 @@ func (c *Core) GenPair(ctx context.Context, uid uuid.UUID, roles []md.Role) (str
 		return "", "", err
 	}
 
-	refresh, err := c.NewToken(ctx, uid, roles, config.RefreshTokenDuration)
+	refresh, err := c.NewToken(ctx, uid, roles, ai, config.RefreshTokenDuration)
 	if err != nil {
 		zap.L().Error(
 			"Failed to generate token pair",
@@ -85,15 +117,25 @@ func (c *Core) GenPair(ctx context.Context, uid uuid.UUID, roles []md.Role) (str
 	return access, refresh, nil
 }
 
-func (c *Core) NewToken(ctx context.Context, uid uuid.UUID, roles []md.Role, d time.Duration) (string, error) {
+func (c *Core) NewToken(ctx context.Context, uid uuid.UUID, roles []md.Role, ai AuthInfo, d time.Duration) (string, error) {
 	const op = "auth.NewToken.jwt"
 	span, ctx := opentracing.StartSpanFromContext(ctx, op)
 	defer span.Finish()
 
+	var authTime *jwt.NumericDate
+	if !ai.Time.IsZero() {
+		authTime = jwt.NewNumericDate(ai.Time)
+	}
+
 	signed, err := jwt.NewWithClaims(
 		jwt.SigningMethodHS256, &Claims{
-			UID:   uid,
-			Roles: roles,
+			UID:      uid,
+			Roles:    roles,
+			AMR:      ai.AMR,
+			ACR:      ai.ACR(),
+			AuthTime: authTime,
+
+			PasswordChange: ai.PasswordChange,
 			RegisteredClaims: jwt.RegisteredClaims{
 				ExpiresAt: jwt.NewNumericDate(time.Now().Add(d)),
 				IssuedAt:  jwt.NewNumericDate(time.Now()),
@@ -0,0 +1,74 @@
+package jwt
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/JMURv/sso/internal/config"
+	md "github.com/JMURv/sso/internal/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newCore() *Core {
+	conf := config.Config{}
+	conf.Auth.JWT.Secret = "secret"
+	conf.Auth.JWT.Issuer = "sso"
+	return New(conf)
+}
+
+func TestCore_AuthClaims(t *testing.T) {
+	c := newCore()
+	ctx := context.Background()
+	uid := uuid.New()
+	at := time.Now().Add(-time.Hour).Truncate(time.Second)
+
+	t.Run("Single factor", func(t *testing.T) {
+		token, err := c.NewToken(ctx, uid, nil, AuthInfo{AMR: []string{md.AMRPassword}, Time: at}, time.Minute)
+		require.NoError(t, err)
+
+		claims, err := c.ParseClaims(ctx, token)
+		require.NoError(t, err)
+		assert.Equal(t, []string{md.AMRPassword}, claims.AMR)
+		assert.Equal(t, md.ACRSingleFactor, claims.ACR)
+		assert.True(t, at.Equal(claims.Info().Time))
+	})
+
+	t.Run("Second factor", func(t *testing.T) {
+		ai := AuthInfo{AMR: []string{md.AMRPassword, md.AMROTP, md.AMRMultiFactor}, Time: at}
+		token, err := c.NewToken(ctx, uid, nil, ai, time.Minute)
+		require.NoError(t, err)
+
+		claims, err := c.ParseClaims(ctx, token)
+		require.NoError(t, err)
+		assert.Equal(t, md.ACRMultiFactor, claims.ACR)
+	})
+
+	t.Run("Passkey", func(t *testing.T) {
+		assert.Equal(t, md.ACRMultiFactor, AuthInfo{AMR: []string{md.AMRHardwareKey}}.ACR())
+	})
+
+	t.Run("Password change", func(t *testing.T) {
+		ai := AuthInfo{AMR: []string{md.AMRPassword}, Time: at, PasswordChange: true}
+		token, err := c.NewToken(ctx, uid, nil, ai, time.Minute)
+		require.NoError(t, err)
+
+		claims, err := c.ParseClaims(ctx, token)
+		require.NoError(t, err)
+		assert.True(t, claims.PasswordChange)
+		assert.True(t, claims.Info().PasswordChange)
+	})
+
+	t.Run("No auth info", func(t *testing.T) {
+		token, err := c.NewToken(ctx, uid, nil, AuthInfo{}, time.Minute)
+		require.NoError(t, err)
+
+		claims, err := c.ParseClaims(ctx, token)
+		require.NoError(t, err)
+		assert.Nil(t, claims.AuthTime)
+		assert.True(t, claims.Info().Time.IsZero())
+		assert.False(t, claims.PasswordChange)
+	})
+}
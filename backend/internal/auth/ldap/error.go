@@ -0,0 +1,17 @@
+package ldap
+
+import "errors"
+
+var (
+	// ErrNotConfigured is error that indicates missing directory url.
+	ErrNotConfigured = errors.New("ldap is not configured")
+
+	// ErrInvalidCredentials is error that indicates rejected bind.
+	ErrInvalidCredentials = errors.New("ldap invalid credentials")
+
+	// ErrUserNotFound is error that indicates missing or ambiguous directory entry.
+	ErrUserNotFound = errors.New("ldap user not found")
+
+	// ErrUnavailable is error that indicates directory connection failure.
+	ErrUnavailable = errors.New("ldap directory is unavailable")
+)
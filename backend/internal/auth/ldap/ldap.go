@@ -0,0 +1,296 @@
+package ldap
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/JMURv/sso/internal/config"
+	"github.com/JMURv/sso/internal/dto"
+	"github.com/go-ldap/ldap/v3"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+)
+
+type Port interface {
+	IsLDAPUser(email string) bool
+	LDAPAuthenticate(ctx context.Context, login, password string) (*dto.LDAPUser, error)
+	LDAPManagedRoles() []string
+	LDAPFailureKey(login, password string) string
+}
+
+type Core struct {
+	url        string
+	startTLS   bool
+	tls        *tls.Config
+	timeout    time.Duration
+	bindDN     string
+	bindPass   string
+	baseDN     string
+	userFilter string
+	userDN     string
+	domains    []string
+	emailAttr  string
+	nameAttr   string
+	groupAttr  string
+	groupRoles map[string]string
+	failKey    []byte
+	dial       func() (ldap.Client, error)
+}
+
+func New(conf config.Config) *Core {
+	c := &Core{
+		url:        conf.Auth.LDAP.URL,
+		startTLS:   conf.Auth.LDAP.StartTLS,
+		timeout:    conf.Auth.LDAP.Timeout,
+		bindDN:     conf.Auth.LDAP.BindDN,
+		bindPass:   conf.Auth.LDAP.BindPass,
+		baseDN:     conf.Auth.LDAP.BaseDN,
+		userFilter: conf.Auth.LDAP.UserFilter,
+		userDN:     conf.Auth.LDAP.UserDN,
+		emailAttr:  conf.Auth.LDAP.EmailAttr,
+		nameAttr:   conf.Auth.LDAP.NameAttr,
+		groupAttr:  conf.Auth.LDAP.GroupAttr,
+		groupRoles: conf.Auth.LDAP.GroupRoles,
+		tls: &tls.Config{
+			InsecureSkipVerify: conf.Auth.LDAP.InsecureSkipVerify,
+		},
+		failKey: make([]byte, 32),
+	}
+
+	if _, err := rand.Read(c.failKey); err != nil {
+		zap.L().Fatal("failed to generate LDAP cache key", zap.Error(err))
+	}
+
+	for _, d := range conf.Auth.LDAP.Domains {
+		c.domains = append(c.domains, strings.ToLower(strings.TrimPrefix(d, "@")))
+	}
+
+	if c.url == "" {
+		zap.L().Debug("LDAP url is not set, LDAP authentication is disabled")
+		return c
+	}
+
+	if u, err := url.Parse(c.url); err == nil {
+		c.tls.ServerName = u.Hostname()
+	}
+
+	c.dial = func() (ldap.Client, error) {
+		return ldap.DialURL(
+			c.url,
+			ldap.DialWithDialer(&net.Dialer{Timeout: c.timeout}),
+			ldap.DialWithTLSConfig(c.tls),
+		)
+	}
+	return c
+}
+
+func (c *Core) IsLDAPUser(email string) bool {
+	if c.dial == nil {
+		return false
+	}
+
+	idx := strings.LastIndex(email, "@")
+	if idx == -1 {
+		return false
+	}
+	return slices.Contains(c.domains, strings.ToLower(email[idx+1:]))
+}
+
+func (c *Core) LDAPManagedRoles() []string {
+	res := make([]string, 0, len(c.groupRoles))
+	for _, role := range c.groupRoles {
+		if !slices.Contains(res, role) {
+			res = append(res, role)
+		}
+	}
+	return res
+}
+
+// LDAPFailureKey identifies failed login in negative cache. It is HMAC keyed with random
+// key which never leaves the process, so cached entries cannot be tested against guessed passwords.
+func (c *Core) LDAPFailureKey(login, password string) string {
+	mac := hmac.New(sha256.New, c.failKey)
+	mac.Write([]byte(strings.ToLower(login) + "\x00" + password))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (c *Core) LDAPAuthenticate(ctx context.Context, login, password string) (*dto.LDAPUser, error) {
+	const op = "auth.LDAPAuthenticate.ldap"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	if c.dial == nil {
+		return nil, ErrNotConfigured
+	}
+
+	// Empty password turns a simple bind into an unauthenticated one which most servers accept.
+	if password == "" {
+		return nil, ErrInvalidCredentials
+	}
+
+	conn, err := c.connect()
+	if err != nil {
+		zap.L().Error(
+			"failed to connect to ldap",
+			zap.String("op", op),
+			zap.String("url", c.url),
+			zap.Error(err),
+		)
+		return nil, ErrUnavailable
+	}
+	defer func(conn ldap.Client) {
+		if err := conn.Close(); err != nil {
+			zap.L().Debug("failed to close ldap connection", zap.String("op", op), zap.Error(err))
+		}
+	}(conn)
+
+	var entry *ldap.Entry
+	if c.userDN != "" {
+		if err = conn.Bind(fmt.Sprintf(c.userDN, ldap.EscapeDN(login)), password); err != nil {
+			return nil, c.bindErr(op, err)
+		}
+
+		if entry, err = c.search(conn, login); err != nil {
+			return nil, err
+		}
+	} else {
+		if c.bindDN != "" {
+			if err = conn.Bind(c.bindDN, c.bindPass); err != nil {
+				zap.L().Error(
+					"failed to bind service account",
+					zap.String("op", op),
+					zap.String("bindDN", c.bindDN),
+					zap.Error(err),
+				)
+				return nil, ErrUnavailable
+			}
+		}
+
+		if entry, err = c.search(conn, login); err != nil {
+			return nil, err
+		}
+
+		if err = conn.Bind(entry.DN, password); err != nil {
+			return nil, c.bindErr(op, err)
+		}
+	}
+
+	return c.toUser(entry, login), nil
+}
+
+func (c *Core) connect() (ldap.Client, error) {
+	conn, err := c.dial()
+	if err != nil {
+		return nil, err
+	}
+
+	if c.timeout > 0 {
+		conn.SetTimeout(c.timeout)
+	}
+
+	if c.startTLS {
+		if err = conn.StartTLS(c.tls); err != nil {
+			_ = conn.Close()
+			return nil, err
+		}
+	}
+	return conn, nil
+}
+
+func (c *Core) search(conn ldap.Client, login string) (*ldap.Entry, error) {
+	const op = "auth.search.ldap"
+	res, err := conn.Search(
+		ldap.NewSearchRequest(
+			c.baseDN,
+			ldap.ScopeWholeSubtree,
+			ldap.NeverDerefAliases,
+			2,
+			int(c.timeout.Seconds()),
+			false,
+			fmt.Sprintf(c.userFilter, ldap.EscapeFilter(login)),
+			[]string{"dn", c.emailAttr, c.nameAttr, c.groupAttr},
+			nil,
+		),
+	)
+	if err != nil && !ldap.IsErrorWithCode(err, ldap.LDAPResultSizeLimitExceeded) {
+		zap.L().Error(
+			"failed to search ldap",
+			zap.String("op", op),
+			zap.String("login", login),
+			zap.Error(err),
+		)
+		return nil, ErrUnavailable
+	}
+
+	if res == nil || len(res.Entries) != 1 {
+		zap.L().Debug(
+			"ldap entry is missing or ambiguous",
+			zap.String("op", op),
+			zap.String("login", login),
+		)
+		return nil, ErrUserNotFound
+	}
+	return res.Entries[0], nil
+}
+
+func (c *Core) bindErr(op string, err error) error {
+	if ldap.IsErrorWithCode(err, ldap.LDAPResultInvalidCredentials) {
+		return ErrInvalidCredentials
+	}
+
+	var lerr *ldap.Error
+	if errors.As(err, &lerr) && lerr.ResultCode == ldap.ErrorNetwork {
+		zap.L().Error("ldap connection lost", zap.String("op", op), zap.Error(err))
+		return ErrUnavailable
+	}
+
+	zap.L().Debug("ldap bind failed", zap.String("op", op), zap.Error(err))
+	return ErrInvalidCredentials
+}
+
+func (c *Core) toUser(e *ldap.Entry, login string) *dto.LDAPUser {
+	res := &dto.LDAPUser{
+		DN:     e.DN,
+		Email:  e.GetAttributeValue(c.emailAttr),
+		Name:   e.GetAttributeValue(c.nameAttr),
+		Groups: make([]string, 0),
+		Roles:  make([]string, 0),
+	}
+
+	if res.Email == "" {
+		res.Email = login
+	}
+
+	for _, g := range e.GetAttributeValues(c.groupAttr) {
+		name := groupName(g)
+		res.Groups = append(res.Groups, name)
+		if role, ok := c.groupRoles[name]; ok && !slices.Contains(res.Roles, role) {
+			res.Roles = append(res.Roles, role)
+		}
+	}
+	return res
+}
+
+// groupName returns the leading CN of group DN, or value itself for plain group names.
+func groupName(val string) string {
+	dn, err := ldap.ParseDN(val)
+	if err != nil || len(dn.RDNs) == 0 || len(dn.RDNs[0].Attributes) == 0 {
+		return val
+	}
+
+	if attr := dn.RDNs[0].Attributes[0]; strings.EqualFold(attr.Type, "cn") {
+		return attr.Value
+	}
+	return val
+}
@@ -0,0 +1,146 @@
+package ldap
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/JMURv/sso/internal/config"
+	"github.com/go-ldap/ldap/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeEntry struct {
+	dn       string
+	password string
+	attrs    map[string][]string
+}
+
+// fakeDirectory is a stand-in LDAP server implementing bind and search only.
+type fakeDirectory struct {
+	ldap.Client
+	entries map[string]fakeEntry
+	binds   []string
+}
+
+func (f *fakeDirectory) Close() error {
+	return nil
+}
+
+func (f *fakeDirectory) Bind(dn, password string) error {
+	f.binds = append(f.binds, dn)
+	for upn, e := range f.entries {
+		if (e.dn == dn || upn == dn) && e.password == password {
+			return nil
+		}
+	}
+	return ldap.NewError(ldap.LDAPResultInvalidCredentials, errors.New("invalid credentials"))
+}
+
+func (f *fakeDirectory) Search(req *ldap.SearchRequest) (*ldap.SearchResult, error) {
+	res := &ldap.SearchResult{}
+	for login, e := range f.entries {
+		if req.Filter == "(mail="+ldap.EscapeFilter(login)+")" {
+			res.Entries = append(res.Entries, ldap.NewEntry(e.dn, e.attrs))
+		}
+	}
+	return res, nil
+}
+
+func newTestCore(dir *fakeDirectory) *Core {
+	return &Core{
+		url:        "ldap://fake",
+		bindDN:     "cn=svc,dc=corp,dc=local",
+		bindPass:   "svc",
+		baseDN:     "dc=corp,dc=local",
+		userFilter: "(mail=%s)",
+		domains:    []string{"corp.local"},
+		emailAttr:  "mail",
+		nameAttr:   "cn",
+		groupAttr:  "memberOf",
+		groupRoles: map[string]string{"Staff": "user", "Domain Admins": "admin"},
+		dial: func() (ldap.Client, error) {
+			return dir, nil
+		},
+	}
+}
+
+func TestCore_LDAPAuthenticate(t *testing.T) {
+	dir := &fakeDirectory{
+		entries: map[string]fakeEntry{
+			"svc@corp.local": {dn: "cn=svc,dc=corp,dc=local", password: "svc"},
+			"jdoe@corp.local": {
+				dn:       "cn=John Doe,ou=staff,dc=corp,dc=local",
+				password: "secret",
+				attrs: map[string][]string{
+					"mail":     {"jdoe@corp.local"},
+					"cn":       {"John Doe"},
+					"memberOf": {"CN=Staff,OU=Groups,DC=corp,DC=local", "CN=Printers,OU=Groups,DC=corp,DC=local"},
+				},
+			},
+		},
+	}
+	c := newTestCore(dir)
+	ctx := context.Background()
+
+	t.Run("SearchThenBind", func(t *testing.T) {
+		res, err := c.LDAPAuthenticate(ctx, "jdoe@corp.local", "secret")
+		require.NoError(t, err)
+		assert.Equal(t, "cn=John Doe,ou=staff,dc=corp,dc=local", res.DN)
+		assert.Equal(t, "John Doe", res.Name)
+		assert.Equal(t, []string{"Staff", "Printers"}, res.Groups)
+		assert.Equal(t, []string{"user"}, res.Roles)
+	})
+
+	t.Run("InvalidPassword", func(t *testing.T) {
+		_, err := c.LDAPAuthenticate(ctx, "jdoe@corp.local", "wrong")
+		assert.ErrorIs(t, err, ErrInvalidCredentials)
+	})
+
+	t.Run("EmptyPassword", func(t *testing.T) {
+		_, err := c.LDAPAuthenticate(ctx, "jdoe@corp.local", "")
+		assert.ErrorIs(t, err, ErrInvalidCredentials)
+	})
+
+	t.Run("UnknownUser", func(t *testing.T) {
+		_, err := c.LDAPAuthenticate(ctx, "nobody@corp.local", "secret")
+		assert.ErrorIs(t, err, ErrUserNotFound)
+	})
+
+	t.Run("BindAsUser", func(t *testing.T) {
+		bc := newTestCore(dir)
+		bc.userDN = "%s"
+		dir.binds = nil
+
+		res, err := bc.LDAPAuthenticate(ctx, "jdoe@corp.local", "secret")
+		require.NoError(t, err)
+		assert.Equal(t, "cn=John Doe,ou=staff,dc=corp,dc=local", res.DN)
+		assert.Equal(t, []string{"jdoe@corp.local"}, dir.binds)
+	})
+
+	t.Run("NotConfigured", func(t *testing.T) {
+		_, err := (&Core{}).LDAPAuthenticate(ctx, "jdoe@corp.local", "secret")
+		assert.ErrorIs(t, err, ErrNotConfigured)
+	})
+}
+
+func TestCore_IsLDAPUser(t *testing.T) {
+	c := newTestCore(&fakeDirectory{})
+	assert.True(t, c.IsLDAPUser("jdoe@CORP.local"))
+	assert.False(t, c.IsLDAPUser("jdoe@example.com"))
+	assert.False(t, c.IsLDAPUser("jdoe"))
+	assert.False(t, (&Core{domains: []string{"corp.local"}}).IsLDAPUser("jdoe@corp.local"))
+}
+
+func TestCore_LDAPFailureKey(t *testing.T) {
+	c := New(config.Config{})
+	assert.Equal(t, c.LDAPFailureKey("jdoe@corp.local", "secret"), c.LDAPFailureKey("JDoe@corp.local", "secret"))
+	assert.NotEqual(t, c.LDAPFailureKey("jdoe@corp.local", "secret"), c.LDAPFailureKey("jdoe@corp.local", "Secret"))
+
+	sum := sha256.Sum256([]byte("jdoe@corp.local\x00secret"))
+	assert.NotEqual(t, hex.EncodeToString(sum[:]), c.LDAPFailureKey("jdoe@corp.local", "secret"))
+	assert.NotEqual(t, New(config.Config{}).LDAPFailureKey("jdoe@corp.local", "secret"), c.LDAPFailureKey("jdoe@corp.local", "secret"))
+}
@@ -0,0 +1,53 @@
+package otp
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/JMURv/sso/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testSecret = strings.Repeat("s", minSecretLen)
+
+func TestGenerateOTP(t *testing.T) {
+	conf := config.Config{}
+	conf.Auth.OTP.Secret = testSecret
+	conf.Auth.OTP.Length = 8
+	conf.Auth.OTP.Alphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	c := New(conf)
+
+	seen := make(map[string]struct{})
+	for i := 0; i < 100; i++ {
+		code, err := c.GenerateOTP()
+		require.NoError(t, err)
+		assert.Regexp(t, `^[A-HJ-NP-Z2-9]{8}$`, code)
+		seen[code] = struct{}{}
+	}
+	assert.Greater(t, len(seen), 90)
+
+	conf = config.Config{}
+	conf.Auth.OTP.Secret = testSecret
+	code, err := New(conf).GenerateOTP()
+	require.NoError(t, err)
+	assert.Regexp(t, `^[0-9]{6}$`, code)
+}
+
+func TestCompareOTP(t *testing.T) {
+	conf := config.Config{}
+	conf.Auth.OTP.Secret = testSecret
+	conf.Auth.OTP.Alphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	c := New(conf)
+
+	hashed := c.HashOTP("login", "test@example.com", "ABC234")
+	assert.NotContains(t, hashed, "ABC234")
+	assert.True(t, c.CompareOTP(hashed, "login", "test@example.com", "ABC234"))
+	assert.True(t, c.CompareOTP(hashed, "login", "test@example.com", "abc-234"))
+	assert.False(t, c.CompareOTP(hashed, "login", "test@example.com", "ABC235"))
+	assert.False(t, c.CompareOTP(hashed, "recovery", "test@example.com", "ABC234"))
+	assert.False(t, c.CompareOTP(hashed, "login", "other@example.com", "ABC234"))
+
+	conf.Auth.OTP.Secret = strings.Repeat("o", minSecretLen)
+	assert.False(t, New(conf).CompareOTP(hashed, "login", "test@example.com", "ABC234"))
+}
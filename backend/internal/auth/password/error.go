@@ -0,0 +1,34 @@
+package password
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	// ErrMismatch is error that indicates password which does not match stored hash.
+	ErrMismatch = errors.New("password does not match")
+
+	// ErrUnknownFormat is error that indicates stored hash in unsupported format.
+	ErrUnknownFormat = errors.New("unknown password hash format")
+
+	// ErrInvalidParams is error that indicates Argon2id parameters outside of supported bounds.
+	ErrInvalidParams = errors.New("invalid argon2id parameters")
+
+	// ErrPolicy is error that indicates password rejected by password policy, see PolicyError for failed rules.
+	ErrPolicy = errors.New("password does not meet policy")
+)
+
+// PolicyError lists every rule password has failed, so all of them can be shown at once.
+type PolicyError struct {
+	Rules []string
+}
+
+func (e *PolicyError) Error() string {
+	return fmt.Sprintf("password failed on the %s rule", strings.Join(e.Rules, ", "))
+}
+
+func (e *PolicyError) Is(target error) bool {
+	return target == ErrPolicy
+}
@@ -0,0 +1,213 @@
+package password
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/JMURv/sso/internal/config"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	argon2idID = "argon2id"
+	saltLen    = 16
+	keyLen     = 32
+)
+
+// Bounds of Argon2id parameters. Hashes outside of them are rejected instead of being computed,
+// so corrupted or tampered hash cannot crash the service or make single comparison exhaust it.
+const (
+	maxMemory      = 1 << 21 // KiB
+	maxIterations  = 64
+	maxParallelism = 64
+	minSaltLen     = 8
+	maxKeyLen      = 1024
+)
+
+type Port interface {
+	Hash(val string) (string, error)
+	Compare(hashed, pswd string) error
+	DummyCompare(pswd string)
+	NeedsRehash(hashed string) bool
+	Validate(pswd string, history []string, personal ...string) error
+	History() int
+	MaxAge(roles ...string) time.Duration
+}
+
+type params struct {
+	memory      uint32
+	iterations  uint32
+	parallelism uint8
+}
+
+// validate checks bounds, Argon2 needs at least one lane and 8 KiB of memory per lane.
+func (p params) validate() error {
+	if p.parallelism < 1 || p.parallelism > maxParallelism {
+		return fmt.Errorf("%w: parallelism must be from 1 to %d", ErrInvalidParams, maxParallelism)
+	}
+	if p.iterations < 1 || p.iterations > maxIterations {
+		return fmt.Errorf("%w: iterations must be from 1 to %d", ErrInvalidParams, maxIterations)
+	}
+	if p.memory < 8*uint32(p.parallelism) || p.memory > maxMemory {
+		return fmt.Errorf("%w: memory must be from %d to %d KiB", ErrInvalidParams, 8*uint32(p.parallelism), maxMemory)
+	}
+	return nil
+}
+
+// Core hashes passwords with Argon2id and stores them as PHC strings:
+// $argon2id$v=19$m=65536,t=3,p=2$<salt>$<key>. Legacy bcrypt hashes are still verified.
+// Number of simultaneous hash computations is limited, so burst of logins cannot exhaust CPU.
+type Core struct {
+	params params
+	policy policy
+	sem    chan struct{}
+
+	dummyOnce sync.Once
+	dummy     string
+}
+
+func New(conf config.Config) *Core {
+	workers := conf.Auth.Password.Workers
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	p := params{
+		memory:      conf.Auth.Password.Memory,
+		iterations:  conf.Auth.Password.Iterations,
+		parallelism: conf.Auth.Password.Parallelism,
+	}
+	if err := p.validate(); err != nil {
+		zap.L().Fatal("Invalid Argon2id parameters", zap.Error(err))
+	}
+
+	return &Core{
+		params: p,
+		policy: policy{
+			minLength:  conf.Auth.Password.MinLength,
+			minClasses: conf.Auth.Password.MinClasses,
+			minEntropy: conf.Auth.Password.MinEntropy,
+			history:    conf.Auth.Password.History,
+			maxAge:     conf.Auth.Password.MaxAge,
+			breached:   loadBreached(conf.Auth.Password.BreachedFile),
+		},
+		sem: make(chan struct{}, workers),
+	}
+}
+
+func (c *Core) Hash(val string) (string, error) {
+	salt := make([]byte, saltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+
+	key := c.derive(val, salt, c.params, keyLen)
+	return fmt.Sprintf(
+		"$%s$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2idID,
+		argon2.Version,
+		c.params.memory,
+		c.params.iterations,
+		c.params.parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+func (c *Core) Compare(hashed, pswd string) error {
+	if isBcrypt(hashed) {
+		c.sem <- struct{}{}
+		defer func() { <-c.sem }()
+
+		if err := bcrypt.CompareHashAndPassword([]byte(hashed), []byte(pswd)); err != nil {
+			return ErrMismatch
+		}
+		return nil
+	}
+
+	p, salt, key, err := decode(hashed)
+	if err != nil {
+		return err
+	}
+
+	if subtle.ConstantTimeCompare(key, c.derive(pswd, salt, p, uint32(len(key)))) != 1 {
+		return ErrMismatch
+	}
+	return nil
+}
+
+// DummyCompare spends the same time as Compare against real hash, it is used when account is unknown
+// so response timing does not tell whether it exists. Dummy hash is made once with current parameters.
+func (c *Core) DummyCompare(pswd string) {
+	c.dummyOnce.Do(func() {
+		key := make([]byte, keyLen)
+		_, _ = rand.Read(key)
+		c.dummy, _ = c.Hash(base64.RawStdEncoding.EncodeToString(key))
+	})
+	_ = c.Compare(c.dummy, pswd)
+}
+
+// NeedsRehash reports whether hash was made by legacy algorithm or with outdated parameters.
+func (c *Core) NeedsRehash(hashed string) bool {
+	if isBcrypt(hashed) {
+		return true
+	}
+
+	p, _, _, err := decode(hashed)
+	if err != nil {
+		return false
+	}
+	return p != c.params
+}
+
+func (c *Core) derive(val string, salt []byte, p params, size uint32) []byte {
+	c.sem <- struct{}{}
+	defer func() { <-c.sem }()
+
+	return argon2.IDKey([]byte(val), salt, p.iterations, p.memory, p.parallelism, size)
+}
+
+func decode(hashed string) (params, []byte, []byte, error) {
+	p := params{}
+	parts := strings.Split(hashed, "$")
+	if len(parts) != 6 || parts[1] != argon2idID {
+		return p, nil, nil, ErrUnknownFormat
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return p, nil, nil, ErrUnknownFormat
+	}
+
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &p.memory, &p.iterations, &p.parallelism); err != nil {
+		return p, nil, nil, ErrUnknownFormat
+	}
+	if err := p.validate(); err != nil {
+		return p, nil, nil, err
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil || len(salt) < minSaltLen {
+		return p, nil, nil, ErrUnknownFormat
+	}
+
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 || len(key) > maxKeyLen {
+		return p, nil, nil, ErrUnknownFormat
+	}
+	return p, salt, key, nil
+}
+
+func isBcrypt(hashed string) bool {
+	return strings.HasPrefix(hashed, "$2a$") ||
+		strings.HasPrefix(hashed, "$2b$") ||
+		strings.HasPrefix(hashed, "$2y$")
+}
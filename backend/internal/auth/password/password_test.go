@@ -0,0 +1,87 @@
+package password
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/JMURv/sso/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func newCore(memory, iterations uint32) *Core {
+	conf := config.Config{}
+	conf.Auth.Password.Memory = memory
+	conf.Auth.Password.Iterations = iterations
+	conf.Auth.Password.Parallelism = 1
+	return New(conf)
+}
+
+func TestCore_Hash(t *testing.T) {
+	c := newCore(1024, 1)
+
+	h, err := c.Hash("secret")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(h, "$argon2id$v=19$m=1024,t=1,p=1$"))
+
+	other, err := c.Hash("secret")
+	require.NoError(t, err)
+	assert.NotEqual(t, h, other)
+
+	assert.NoError(t, c.Compare(h, "secret"))
+	assert.ErrorIs(t, c.Compare(h, "wrong"), ErrMismatch)
+	assert.False(t, c.NeedsRehash(h))
+}
+
+func TestCore_Bcrypt(t *testing.T) {
+	c := newCore(1024, 1)
+
+	h, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	assert.NoError(t, c.Compare(string(h), "secret"))
+	assert.ErrorIs(t, c.Compare(string(h), "wrong"), ErrMismatch)
+	assert.True(t, c.NeedsRehash(string(h)))
+}
+
+func TestCore_NeedsRehash(t *testing.T) {
+	h, err := newCore(1024, 1).Hash("secret")
+	require.NoError(t, err)
+
+	c := newCore(2048, 2)
+	assert.NoError(t, c.Compare(h, "secret"))
+	assert.True(t, c.NeedsRehash(h))
+}
+
+func TestCore_Compare_UnknownFormat(t *testing.T) {
+	c := newCore(1024, 1)
+
+	assert.ErrorIs(t, c.Compare("plain", "plain"), ErrUnknownFormat)
+	assert.ErrorIs(t, c.Compare("$argon2i$v=19$m=1,t=1,p=1$c2FsdA$a2V5", "secret"), ErrUnknownFormat)
+	assert.False(t, c.NeedsRehash("plain"))
+}
+
+func TestCore_Compare_InvalidParams(t *testing.T) {
+	c := newCore(1024, 1)
+
+	// salt and key are valid, only parameters are out of bounds
+	const key = "$a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2U"
+	const tail = "$c2FsdHNhbHRzYWx0" + key
+	for _, p := range []string{"m=1024,t=1,p=0", "m=0,t=1,p=1", "m=1024,t=0,p=1", "m=4294967295,t=1,p=1", "m=1024,t=1000,p=1", "m=64,t=1,p=16"} {
+		assert.ErrorIs(t, c.Compare("$argon2id$v=19$"+p+tail, "secret"), ErrInvalidParams, p)
+	}
+	assert.ErrorIs(t, c.Compare("$argon2id$v=19$m=1024,t=1,p=1$c2FsdA"+key, "secret"), ErrUnknownFormat)
+}
+
+func TestCore_DummyCompare(t *testing.T) {
+	c := newCore(1024, 1)
+
+	c.DummyCompare("secret")
+	dummy := c.dummy
+	assert.False(t, c.NeedsRehash(dummy))
+	assert.ErrorIs(t, c.Compare(dummy, "secret"), ErrMismatch)
+
+	c.DummyCompare("other")
+	assert.Equal(t, dummy, c.dummy)
+}
@@ -0,0 +1,230 @@
+package password
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"math"
+	"os"
+	"strings"
+	"time"
+	"unicode"
+
+	"go.uber.org/zap"
+)
+
+const (
+	RuleMinLength    = "min_length"
+	RuleCharClasses  = "char_classes"
+	RuleEntropy      = "entropy"
+	RulePersonalInfo = "personal_info"
+	RuleBreached     = "breached"
+	RuleReused       = "reused"
+)
+
+const (
+	prefixLen      = 5
+	minPersonalLen = 3
+)
+
+type policy struct {
+	minLength  int
+	minClasses int
+	minEntropy float64
+	history    int
+	maxAge     map[string]time.Duration
+	// breached is indexed by SHA-1 prefix the same way as k-anonymity range API,
+	// so only the bucket of candidate's prefix is ever looked at.
+	breached map[string]map[string]struct{}
+}
+
+// Validate checks candidate password against policy. History holds hashes of previously used passwords,
+// personal holds values password must not contain, e.g. user name and email.
+func (c *Core) Validate(pswd string, history []string, personal ...string) error {
+	rules := make([]string, 0, 1)
+	if len([]rune(pswd)) < c.policy.minLength {
+		rules = append(rules, RuleMinLength)
+	}
+
+	if charClasses(pswd) < c.policy.minClasses {
+		rules = append(rules, RuleCharClasses)
+	}
+
+	if entropy(pswd) < c.policy.minEntropy {
+		rules = append(rules, RuleEntropy)
+	}
+
+	if containsPersonal(pswd, personal) {
+		rules = append(rules, RulePersonalInfo)
+	}
+
+	if c.isBreached(pswd) {
+		rules = append(rules, RuleBreached)
+	}
+
+	if len(rules) == 0 {
+		for i := 0; i < len(history); i++ {
+			if c.Compare(history[i], pswd) == nil {
+				rules = append(rules, RuleReused)
+				break
+			}
+		}
+	}
+
+	if len(rules) > 0 {
+		return &PolicyError{Rules: rules}
+	}
+	return nil
+}
+
+// History returns number of previous passwords which cannot be reused.
+func (c *Core) History() int {
+	return c.policy.history
+}
+
+// MaxAge returns the shortest password age allowed for any of given roles, zero means password never expires.
+func (c *Core) MaxAge(roles ...string) time.Duration {
+	var res time.Duration
+	for _, r := range roles {
+		if age := c.policy.maxAge[r]; age > 0 && (res == 0 || age < res) {
+			res = age
+		}
+	}
+	return res
+}
+
+func (c *Core) isBreached(pswd string) bool {
+	if len(c.policy.breached) == 0 {
+		return false
+	}
+
+	sum := sha1.Sum([]byte(pswd))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+	_, ok := c.policy.breached[hash[:prefixLen]][hash[prefixLen:]]
+	return ok
+}
+
+// loadBreached reads SHA-1 hashes, one per line, optionally followed by ":count"
+// as in the downloadable Pwned Passwords list.
+func loadBreached(path string) map[string]map[string]struct{} {
+	res := make(map[string]map[string]struct{})
+	if path == "" {
+		return res
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		zap.L().Error("failed to open breached passwords file", zap.String("path", path), zap.Error(err))
+		return res
+	}
+	defer f.Close()
+
+	count := 0
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		hash, _, _ := strings.Cut(strings.TrimSpace(sc.Text()), ":")
+		if len(hash) != sha1.Size*2 {
+			continue
+		}
+
+		hash = strings.ToUpper(hash)
+		bucket, ok := res[hash[:prefixLen]]
+		if !ok {
+			bucket = make(map[string]struct{})
+			res[hash[:prefixLen]] = bucket
+		}
+		bucket[hash[prefixLen:]] = struct{}{}
+		count++
+	}
+
+	if err = sc.Err(); err != nil {
+		zap.L().Error("failed to read breached passwords file", zap.String("path", path), zap.Error(err))
+	}
+
+	zap.L().Info("breached passwords loaded", zap.Int("count", count))
+	return res
+}
+
+func charClasses(pswd string) int {
+	var lower, upper, digit, other bool
+	for _, r := range pswd {
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		default:
+			other = true
+		}
+	}
+
+	res := 0
+	for _, ok := range []bool{lower, upper, digit, other} {
+		if ok {
+			res++
+		}
+	}
+	return res
+}
+
+// entropy estimates strength in bits as length times log2 of alphabet size
+// built from character classes present in password.
+func entropy(pswd string) float64 {
+	var lower, upper, digit, symbol, other bool
+	for _, r := range pswd {
+		switch {
+		case r >= 'a' && r <= 'z':
+			lower = true
+		case r >= 'A' && r <= 'Z':
+			upper = true
+		case r >= '0' && r <= '9':
+			digit = true
+		case r < unicode.MaxASCII:
+			symbol = true
+		default:
+			other = true
+		}
+	}
+
+	pool := 0
+	for _, c := range []struct {
+		ok   bool
+		size int
+	}{{lower, 26}, {upper, 26}, {digit, 10}, {symbol, 33}, {other, 100}} {
+		if c.ok {
+			pool += c.size
+		}
+	}
+
+	if pool == 0 {
+		return 0
+	}
+	return float64(len([]rune(pswd))) * math.Log2(float64(pool))
+}
+
+// containsPersonal reports whether password holds a word of name or email local part.
+// Email domain is skipped, otherwise every "gmail" or "example" would be banned.
+func containsPersonal(pswd string, personal []string) bool {
+	pswd = strings.ToLower(pswd)
+	for i := 0; i < len(personal); i++ {
+		val := personal[i]
+		if at := strings.LastIndex(val, "@"); at >= 0 {
+			val = val[:at]
+		}
+
+		fields := strings.FieldsFunc(
+			strings.ToLower(val), func(r rune) bool {
+				return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+			},
+		)
+
+		for j := 0; j < len(fields); j++ {
+			if len([]rune(fields[j])) >= minPersonalLen && strings.Contains(pswd, fields[j]) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,139 @@
+package password
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/JMURv/sso/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newPolicyCore(t *testing.T, breached ...string) *Core {
+	t.Helper()
+
+	lines := make([]string, 0, len(breached))
+	for _, b := range breached {
+		sum := sha1.Sum([]byte(b))
+		lines = append(lines, strings.ToUpper(hex.EncodeToString(sum[:]))+":42")
+	}
+
+	path := filepath.Join(t.TempDir(), "breached.txt")
+	require.NoError(t, os.WriteFile(path, []byte(strings.Join(lines, "\n")), 0o600))
+
+	conf := config.Config{}
+	conf.Auth.Password.Memory = 1024
+	conf.Auth.Password.Iterations = 1
+	conf.Auth.Password.Parallelism = 1
+	conf.Auth.Password.MinLength = 12
+	conf.Auth.Password.MinClasses = 2
+	conf.Auth.Password.MinEntropy = 50
+	conf.Auth.Password.History = 3
+	conf.Auth.Password.BreachedFile = path
+	conf.Auth.Password.MaxAge = map[string]time.Duration{"admin": 90 * 24 * time.Hour, "devops": 30 * 24 * time.Hour}
+	return New(conf)
+}
+
+func rulesOf(t *testing.T, err error) []string {
+	t.Helper()
+
+	if err == nil {
+		return nil
+	}
+
+	pErr := &PolicyError{}
+	require.ErrorAs(t, err, &pErr)
+	assert.ErrorIs(t, err, ErrPolicy)
+	return pErr.Rules
+}
+
+func TestCore_Validate(t *testing.T) {
+	c := newPolicyCore(t, "correct horse battery")
+
+	tests := []struct {
+		name     string
+		pswd     string
+		personal []string
+		expect   []string
+	}{
+		{
+			name:   "Success",
+			pswd:   "Tr0ub4dor&3-staple",
+			expect: nil,
+		},
+		{
+			name:   "Short",
+			pswd:   "aB3$",
+			expect: []string{RuleMinLength, RuleEntropy},
+		},
+		{
+			name:   "SingleClass",
+			pswd:   "abcdefghijklmnop",
+			expect: []string{RuleCharClasses},
+		},
+		{
+			name:     "Personal",
+			pswd:     "John-Doe-2024-secret",
+			personal: []string{"John Doe", "jdoe@example.com"},
+			expect:   []string{RulePersonalInfo},
+		},
+		{
+			name:     "EmailLocalPart",
+			pswd:     "x-JDOE-x-42-long",
+			personal: []string{"", "jdoe@example.com"},
+			expect:   []string{RulePersonalInfo},
+		},
+		{
+			name:     "EmailDomain",
+			pswd:     "Gmail-Lover-2024!",
+			personal: []string{"Jane Roe", "jane.roe@gmail.com"},
+			expect:   nil,
+		},
+		{
+			name:   "Breached",
+			pswd:   "correct horse battery",
+			expect: []string{RuleBreached},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				assert.Equal(t, tt.expect, rulesOf(t, c.Validate(tt.pswd, nil, tt.personal...)))
+			},
+		)
+	}
+}
+
+func TestCore_Validate_History(t *testing.T) {
+	c := newPolicyCore(t)
+
+	old, err := c.Hash("Tr0ub4dor&3-staple")
+	require.NoError(t, err)
+
+	other, err := c.Hash("another-Passw0rd-here")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{RuleReused}, rulesOf(t, c.Validate("Tr0ub4dor&3-staple", []string{other, old})))
+	assert.NoError(t, c.Validate("brand-new-Passw0rd-1", []string{other, old}))
+	assert.Equal(t, 3, c.History())
+}
+
+func TestCore_MaxAge(t *testing.T) {
+	c := newPolicyCore(t)
+
+	assert.Equal(t, time.Duration(0), c.MaxAge())
+	assert.Equal(t, time.Duration(0), c.MaxAge("user"))
+	assert.Equal(t, 90*24*time.Hour, c.MaxAge("user", "admin"))
+	assert.Equal(t, 30*24*time.Hour, c.MaxAge("admin", "devops"))
+}
+
+func TestPolicyError_Error(t *testing.T) {
+	err := &PolicyError{Rules: []string{RuleMinLength, RuleBreached}}
+	assert.Equal(t, "password failed on the min_length, breached rule", err.Error())
+}
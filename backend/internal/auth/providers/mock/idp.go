@@ -0,0 +1,385 @@
+package mock
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"html/template"
+	"math/big"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/golang-jwt/jwt/v5"
+	"go.uber.org/zap"
+)
+
+const (
+	codeTTL  = time.Minute
+	tokenTTL = time.Hour
+)
+
+// User is a fake account served by IdP. Claims are merged into id_token and userinfo.
+type User struct {
+	Subject string         `json:"sub"`
+	Email   string         `json:"email"`
+	Name    string         `json:"name"`
+	Picture string         `json:"picture"`
+	Claims  map[string]any `json:"claims"`
+}
+
+// DefaultUsers are served when no users file is configured.
+var DefaultUsers = []User{
+	{Subject: "mock-alice", Email: "alice@example.com", Name: "Alice Example"},
+	{Subject: "mock-bob", Email: "bob@example.com", Name: "Bob Example"},
+}
+
+type grant struct {
+	user        *User
+	clientID    string
+	redirectURI string
+	nonce       string
+	expiresAt   time.Time
+}
+
+// IdP is a minimal OpenID Connect provider meant for local development and tests.
+// It serves discovery, authorize, token, userinfo and JWKS endpoints and signs
+// id tokens with an ephemeral RSA key.
+type IdP struct {
+	issuer       string
+	clientID     string
+	clientSecret string
+	redirectURLs []string
+	users        []User
+	key          *rsa.PrivateKey
+	kid          string
+	tmpl         *template.Template
+
+	mu     sync.Mutex
+	codes  map[string]grant
+	tokens map[string]grant
+}
+
+func NewIdP(issuer, clientID, clientSecret string, redirectURLs []string, users []User) (*IdP, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(users) == 0 {
+		users = DefaultUsers
+	}
+
+	sum := sha256.Sum256(key.PublicKey.N.Bytes())
+	return &IdP{
+		issuer:       strings.TrimSuffix(issuer, "/"),
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		redirectURLs: redirectURLs,
+		users:        users,
+		key:          key,
+		kid:          hex.EncodeToString(sum[:8]),
+		tmpl:         template.Must(template.New("login").Parse(loginPage)),
+		codes:        make(map[string]grant),
+		tokens:       make(map[string]grant),
+	}, nil
+}
+
+// LoadUsers reads fake users from JSON file.
+func LoadUsers(path string) ([]User, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []User
+	if err = json.Unmarshal(data, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (i *IdP) Issuer() string {
+	return i.issuer
+}
+
+// ServeHTTP dispatches by path suffix, so IdP works under any mount prefix.
+func (i *IdP) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimSuffix(r.URL.Path, "/")
+	switch {
+	case strings.HasSuffix(path, "/.well-known/openid-configuration"):
+		i.discovery(w, r)
+	case strings.HasSuffix(path, "/authorize"):
+		i.authorize(w, r)
+	case strings.HasSuffix(path, "/token") && r.Method == http.MethodPost:
+		i.token(w, r)
+	case strings.HasSuffix(path, "/userinfo"):
+		i.userinfo(w, r)
+	case strings.HasSuffix(path, "/jwks"):
+		i.jwks(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (i *IdP) discovery(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(
+		w, http.StatusOK, map[string]any{
+			"issuer":                                i.issuer,
+			"authorization_endpoint":                i.issuer + "/authorize",
+			"token_endpoint":                        i.issuer + "/token",
+			"userinfo_endpoint":                     i.issuer + "/userinfo",
+			"jwks_uri":                              i.issuer + "/jwks",
+			"response_types_supported":              []string{"code"},
+			"subject_types_supported":               []string{"public"},
+			"id_token_signing_alg_values_supported": []string{"RS256"},
+			"scopes_supported":                      []string{"openid", "email", "profile"},
+			"token_endpoint_auth_methods_supported": []string{"client_secret_post", "client_secret_basic"},
+			"claims_supported":                      []string{"sub", "email", "email_verified", "name", "picture"},
+		},
+	)
+}
+
+// authorize issues code right away when user is chosen by `user` or `login_hint`
+// query parameter, otherwise renders a page listing fake accounts.
+func (i *IdP) authorize(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	if q.Get("client_id") != i.clientID {
+		http.Error(w, "unknown client_id", http.StatusBadRequest)
+		return
+	}
+
+	redirectURI := q.Get("redirect_uri")
+	if !slices.Contains(i.redirectURLs, redirectURI) {
+		http.Error(w, "redirect_uri is not registered", http.StatusBadRequest)
+		return
+	}
+
+	hint := q.Get("user")
+	if hint == "" {
+		hint = q.Get("login_hint")
+	}
+
+	user := i.findUser(hint)
+	if user == nil {
+		type option struct {
+			User
+			URL string
+		}
+
+		opts := make([]option, 0, len(i.users))
+		for _, u := range i.users {
+			uq := r.URL.Query()
+			uq.Set("user", u.Subject)
+			opts = append(opts, option{User: u, URL: "?" + uq.Encode()})
+		}
+
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		if err := i.tmpl.Execute(w, opts); err != nil {
+			zap.L().Error("failed to render mock idp login page", zap.Error(err))
+		}
+		return
+	}
+
+	code := randomString()
+	i.mu.Lock()
+	i.codes[code] = grant{
+		user:        user,
+		clientID:    i.clientID,
+		redirectURI: redirectURI,
+		nonce:       q.Get("nonce"),
+		expiresAt:   time.Now().Add(codeTTL),
+	}
+	i.mu.Unlock()
+
+	u, err := url.Parse(redirectURI)
+	if err != nil {
+		http.Error(w, "invalid redirect_uri", http.StatusBadRequest)
+		return
+	}
+
+	rq := u.Query()
+	rq.Set("code", code)
+	if state := q.Get("state"); state != "" {
+		rq.Set("state", state)
+	}
+	u.RawQuery = rq.Encode()
+	http.Redirect(w, r, u.String(), http.StatusFound)
+}
+
+func (i *IdP) token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		tokenError(w, "invalid_request")
+		return
+	}
+
+	clientID, clientSecret, ok := r.BasicAuth()
+	if !ok {
+		clientID, clientSecret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+	}
+
+	if clientID != i.clientID || clientSecret != i.clientSecret {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_client"})
+		return
+	}
+
+	if r.PostForm.Get("grant_type") != "authorization_code" {
+		tokenError(w, "unsupported_grant_type")
+		return
+	}
+
+	code := r.PostForm.Get("code")
+	i.mu.Lock()
+	g, ok := i.codes[code]
+	delete(i.codes, code)
+	i.mu.Unlock()
+
+	if !ok || time.Now().After(g.expiresAt) || g.redirectURI != r.PostForm.Get("redirect_uri") {
+		tokenError(w, "invalid_grant")
+		return
+	}
+
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"iss":            i.issuer,
+		"sub":            g.user.Subject,
+		"aud":            g.clientID,
+		"iat":            now.Unix(),
+		"exp":            now.Add(tokenTTL).Unix(),
+		"email":          g.user.Email,
+		"email_verified": true,
+		"name":           g.user.Name,
+		"picture":        g.user.Picture,
+	}
+	if g.nonce != "" {
+		claims["nonce"] = g.nonce
+	}
+	for k, v := range g.user.Claims {
+		claims[k] = v
+	}
+
+	t := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	t.Header["kid"] = i.kid
+	idToken, err := t.SignedString(i.key)
+	if err != nil {
+		zap.L().Error("failed to sign mock id token", zap.Error(err))
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "server_error"})
+		return
+	}
+
+	access := randomString()
+	g.expiresAt = now.Add(tokenTTL)
+	i.mu.Lock()
+	i.tokens[access] = g
+	i.mu.Unlock()
+
+	w.Header().Set("Cache-Control", "no-store")
+	writeJSON(
+		w, http.StatusOK, map[string]any{
+			"access_token": access,
+			"token_type":   "Bearer",
+			"expires_in":   int(tokenTTL.Seconds()),
+			"id_token":     idToken,
+			"scope":        "openid email profile",
+		},
+	)
+}
+
+func (i *IdP) userinfo(w http.ResponseWriter, r *http.Request) {
+	access := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if access == "" {
+		access = r.URL.Query().Get("access_token")
+	}
+
+	i.mu.Lock()
+	g, ok := i.tokens[access]
+	i.mu.Unlock()
+
+	if !ok || time.Now().After(g.expiresAt) {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_token"})
+		return
+	}
+
+	res := map[string]any{
+		"sub":            g.user.Subject,
+		"email":          g.user.Email,
+		"email_verified": true,
+		"name":           g.user.Name,
+		"picture":        g.user.Picture,
+	}
+	for k, v := range g.user.Claims {
+		res[k] = v
+	}
+	writeJSON(w, http.StatusOK, res)
+}
+
+func (i *IdP) jwks(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(
+		w, http.StatusOK, map[string]any{
+			"keys": []map[string]string{
+				{
+					"kty": "RSA",
+					"use": "sig",
+					"alg": "RS256",
+					"kid": i.kid,
+					"n":   base64.RawURLEncoding.EncodeToString(i.key.PublicKey.N.Bytes()),
+					"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(i.key.PublicKey.E)).Bytes()),
+				},
+			},
+		},
+	)
+}
+
+func (i *IdP) findUser(hint string) *User {
+	if hint == "" {
+		return nil
+	}
+
+	for idx := range i.users {
+		if i.users[idx].Subject == hint || strings.EqualFold(i.users[idx].Email, hint) {
+			return &i.users[idx]
+		}
+	}
+	return nil
+}
+
+func randomString() string {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		panic(fmt.Sprintf("mock idp: failed to read random bytes: %v", err))
+	}
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func tokenError(w http.ResponseWriter, code string) {
+	writeJSON(w, http.StatusBadRequest, map[string]string{"error": code})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		zap.L().Error("failed to encode mock idp response", zap.Error(err))
+	}
+}
+
+const loginPage = `<!DOCTYPE html>
+<html>
+<head><title>Mock IdP</title></head>
+<body>
+<h1>Mock IdP</h1>
+<p>Choose an account to continue:</p>
+<ul>
+{{range .}}<li><a href="{{.URL}}">{{.Name}} &lt;{{.Email}}&gt;</a></li>
+{{end}}</ul>
+</body>
+</html>
+`
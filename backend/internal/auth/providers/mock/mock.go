@@ -0,0 +1,178 @@
+package mock
+
+import (
+	"context"
+	"crypto"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/JMURv/sso/internal/config"
+	"github.com/JMURv/sso/internal/dto"
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/goccy/go-json"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+)
+
+// ErrNoIDToken is error that indicates missing id_token in token response.
+var ErrNoIDToken = errors.New("no id_token in token response")
+
+type Provider struct {
+	idp      *IdP
+	isOIDC   bool
+	config   *oauth2.Config
+	verifier *oidc.IDTokenVerifier
+	client   *http.Client
+}
+
+// NewIdPFromConfig builds IdP when it is enabled in config, otherwise returns nil.
+// IdP logs in any listed user without credentials, so it refuses to run in prod mode.
+func NewIdPFromConfig(conf config.Config) *IdP {
+	mc := conf.Auth.Providers.Mock
+	if !mc.Enabled {
+		return nil
+	}
+
+	if conf.Mode == "prod" {
+		zap.L().Fatal("Mock IdP must not be enabled in prod mode", zap.String("issuer", mc.Issuer))
+	}
+
+	var users []User
+	if mc.UsersFile != "" {
+		var err error
+		if users, err = LoadUsers(mc.UsersFile); err != nil {
+			zap.L().Error("failed to load mock idp users", zap.String("path", mc.UsersFile), zap.Error(err))
+		}
+	}
+
+	idp, err := NewIdP(
+		mc.Issuer,
+		mc.ClientID,
+		mc.ClientSecret,
+		[]string{mc.OAuth2RedirectURL, mc.OIDCRedirectURL},
+		users,
+	)
+	if err != nil {
+		zap.L().Error("failed to create mock idp", zap.Error(err))
+		return nil
+	}
+
+	zap.L().Warn("Mock IdP is enabled, do not use it in production", zap.String("issuer", mc.Issuer))
+	return idp
+}
+
+// New returns provider backed by in-process IdP. Server side calls are dispatched
+// straight to IdP handler, so neither discovery nor token exchange needs network.
+func New(idp *IdP, clientID, clientSecret, redirectURL string, isOIDC bool) *Provider {
+	scopes := []string{"email", "profile"}
+	if isOIDC {
+		scopes = append([]string{oidc.ScopeOpenID}, scopes...)
+	}
+
+	return &Provider{
+		idp:    idp,
+		isOIDC: isOIDC,
+		config: &oauth2.Config{
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+			RedirectURL:  redirectURL,
+			Scopes:       scopes,
+			Endpoint: oauth2.Endpoint{
+				AuthURL:   idp.Issuer() + "/authorize",
+				TokenURL:  idp.Issuer() + "/token",
+				AuthStyle: oauth2.AuthStyleInParams,
+			},
+		},
+		verifier: oidc.NewVerifier(
+			idp.Issuer(),
+			&oidc.StaticKeySet{PublicKeys: []crypto.PublicKey{&idp.key.PublicKey}},
+			&oidc.Config{ClientID: clientID},
+		),
+		client: &http.Client{Transport: transport{h: idp}},
+	}
+}
+
+// LinksByEmail reports that mock identity must never take over existing account by email.
+func (p *Provider) LinksByEmail() bool {
+	return false
+}
+
+func (p *Provider) AuthCodeURL(state string) string {
+	return p.config.AuthCodeURL(state)
+}
+
+func (p *Provider) Exchange(ctx context.Context, code string) (*dto.ProviderResponse, error) {
+	const op = "provider.Exchange.mock"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, p.client)
+	token, err := p.config.Exchange(ctx, code)
+	if err != nil {
+		zap.L().Error("failed to exchange token", zap.String("op", op), zap.Error(err))
+		return nil, err
+	}
+
+	var claims struct {
+		Subject string `json:"sub"`
+		Email   string `json:"email"`
+		Name    string `json:"name"`
+		Picture string `json:"picture"`
+	}
+
+	rawIDToken, _ := token.Extra("id_token").(string)
+	if p.isOIDC {
+		if rawIDToken == "" {
+			return nil, ErrNoIDToken
+		}
+
+		idToken, err := p.verifier.Verify(ctx, rawIDToken)
+		if err != nil {
+			zap.L().Error("failed to verify ID token", zap.String("op", op), zap.Error(err))
+			return nil, err
+		}
+
+		if err = idToken.Claims(&claims); err != nil {
+			zap.L().Error("failed to parse claims", zap.String("op", op), zap.Error(err))
+			return nil, err
+		}
+	} else {
+		resp, err := p.config.Client(ctx, token).Get(p.idp.Issuer() + "/userinfo")
+		if err != nil {
+			return nil, err
+		}
+		defer func(Body io.ReadCloser) {
+			if err := Body.Close(); err != nil {
+				zap.L().Error("failed to close body", zap.Error(err))
+			}
+		}(resp.Body)
+
+		if err = json.NewDecoder(resp.Body).Decode(&claims); err != nil {
+			zap.L().Error("failed to decode body", zap.String("op", op), zap.Error(err))
+			return nil, err
+		}
+	}
+
+	return &dto.ProviderResponse{
+		ProviderID:  claims.Subject,
+		Email:       claims.Email,
+		Name:        claims.Name,
+		Picture:     claims.Picture,
+		AccessToken: token.AccessToken,
+		IDToken:     rawIDToken,
+		Expiry:      token.Expiry,
+	}, nil
+}
+
+type transport struct {
+	h http.Handler
+}
+
+func (t transport) RoundTrip(r *http.Request) (*http.Response, error) {
+	rec := httptest.NewRecorder()
+	t.h.ServeHTTP(rec, r)
+	return rec.Result(), nil
+}
@@ -0,0 +1,120 @@
+package mock
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testClientID     = "client"
+	testClientSecret = "secret"
+	testRedirectURL  = "http://localhost/callback"
+)
+
+func newTestIdP(t *testing.T) (*IdP, *httptest.Server) {
+	t.Helper()
+
+	var idp *IdP
+	srv := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				idp.ServeHTTP(w, r)
+			},
+		),
+	)
+	t.Cleanup(srv.Close)
+
+	var err error
+	idp, err = NewIdP(
+		srv.URL+"/mock-idp", testClientID, testClientSecret, []string{testRedirectURL}, []User{
+			{
+				Subject: "u1",
+				Email:   "jane@example.com",
+				Name:    "Jane",
+				Claims:  map[string]any{"groups": []string{"dev"}},
+			},
+		},
+	)
+	require.NoError(t, err)
+	return idp, srv
+}
+
+// authorize follows provider auth URL like a browser and returns issued code.
+func authorize(t *testing.T, p *Provider, user, state string) string {
+	t.Helper()
+
+	cli := &http.Client{
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	resp, err := cli.Get(p.AuthCodeURL(state) + "&user=" + url.QueryEscape(user))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusFound, resp.StatusCode)
+
+	loc, err := url.Parse(resp.Header.Get("Location"))
+	require.NoError(t, err)
+	assert.Equal(t, state, loc.Query().Get("state"))
+	return loc.Query().Get("code")
+}
+
+func TestProvider_Exchange(t *testing.T) {
+	idp, _ := newTestIdP(t)
+
+	for _, isOIDC := range []bool{true, false} {
+		p := New(idp, testClientID, testClientSecret, testRedirectURL, isOIDC)
+		assert.False(t, p.LinksByEmail(), "mock identity must not link existing accounts by email")
+		code := authorize(t, p, "jane@example.com", "st")
+
+		res, err := p.Exchange(context.Background(), code)
+		require.NoError(t, err)
+		assert.Equal(t, "u1", res.ProviderID)
+		assert.Equal(t, "jane@example.com", res.Email)
+		assert.Equal(t, "Jane", res.Name)
+		if isOIDC {
+			assert.NotEmpty(t, res.IDToken)
+		}
+
+		_, err = p.Exchange(context.Background(), code)
+		assert.Error(t, err, "code must be single use")
+	}
+}
+
+func TestIdP_Endpoints(t *testing.T) {
+	_, srv := newTestIdP(t)
+
+	resp, err := http.Get(srv.URL + "/mock-idp/.well-known/openid-configuration")
+	require.NoError(t, err)
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.Contains(t, string(body), `"issuer":"`+srv.URL+`/mock-idp"`)
+
+	resp, err = http.Get(srv.URL + "/mock-idp/jwks")
+	require.NoError(t, err)
+	body, _ = io.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.Contains(t, string(body), `"kty":"RSA"`)
+
+	q := url.Values{"client_id": {testClientID}, "redirect_uri": {testRedirectURL}}
+	resp, err = http.Get(srv.URL + "/mock-idp/authorize?" + q.Encode())
+	require.NoError(t, err)
+	body, _ = io.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.True(t, strings.Contains(string(body), "jane@example.com"), "login page lists users")
+
+	q.Set("redirect_uri", "http://evil.example.com/cb")
+	resp, err = http.Get(srv.URL + "/mock-idp/authorize?" + q.Encode())
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
@@ -0,0 +1,192 @@
+package apple
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/JMURv/sso/internal/config"
+	"github.com/JMURv/sso/internal/dto"
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/goccy/go-json"
+	"github.com/golang-jwt/jwt/v5"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+)
+
+// ErrNoIDToken is error that indicates missing id_token in token response.
+var ErrNoIDToken = errors.New("no id_token in token response")
+
+// secretTTL is lifetime of generated client secret. Apple accepts up to six months,
+// but there is no reason to keep signed secret alive for that long.
+const secretTTL = 24 * time.Hour
+
+type Provider struct {
+	config   *oauth2.Config
+	verifier *oidc.IDTokenVerifier
+	baseURL  string
+	teamID   string
+	keyID    string
+	key      *ecdsa.PrivateKey
+
+	mu        sync.Mutex
+	secret    string
+	secretExp time.Time
+}
+
+// New returns Sign in with Apple provider or nil when it is not configured.
+func New(conf config.Config) *Provider {
+	ac := conf.Auth.Providers.OIDC.Apple
+	if ac.ClientID == "" || ac.PrivateKeyFile == "" {
+		return nil
+	}
+
+	pem, err := os.ReadFile(ac.PrivateKeyFile)
+	if err != nil {
+		zap.L().Error("failed to read apple private key", zap.String("path", ac.PrivateKeyFile), zap.Error(err))
+		return nil
+	}
+
+	key, err := jwt.ParseECPrivateKeyFromPEM(pem)
+	if err != nil {
+		zap.L().Error("failed to parse apple private key", zap.Error(err))
+		return nil
+	}
+
+	return NewWithKey(ac.BaseURL, ac.ClientID, ac.TeamID, ac.KeyID, ac.RedirectURL, ac.Scopes, key)
+}
+
+// NewWithKey builds provider from already parsed signing key. Endpoints are derived
+// from baseURL, so provider can be pointed to a local stub.
+func NewWithKey(baseURL, clientID, teamID, keyID, redirectURL string, scopes []string, key *ecdsa.PrivateKey) *Provider {
+	baseURL = strings.TrimSuffix(baseURL, "/")
+	return &Provider{
+		config: &oauth2.Config{
+			ClientID:    clientID,
+			RedirectURL: redirectURL,
+			Scopes:      scopes,
+			Endpoint: oauth2.Endpoint{
+				AuthURL:   baseURL + "/auth/authorize",
+				TokenURL:  baseURL + "/auth/token",
+				AuthStyle: oauth2.AuthStyleInParams,
+			},
+		},
+		verifier: oidc.NewVerifier(
+			baseURL,
+			oidc.NewRemoteKeySet(context.Background(), baseURL+"/auth/keys"),
+			&oidc.Config{ClientID: clientID},
+		),
+		baseURL: baseURL,
+		teamID:  teamID,
+		keyID:   keyID,
+		key:     key,
+	}
+}
+
+// AuthCodeURL uses form_post response mode, because Apple refuses to return
+// requested name and email scopes to a query redirect.
+func (p *Provider) AuthCodeURL(state string) string {
+	return p.config.AuthCodeURL(state, oauth2.SetAuthURLParam("response_mode", "form_post"))
+}
+
+func (p *Provider) Exchange(ctx context.Context, code string) (*dto.ProviderResponse, error) {
+	secret, err := p.clientSecret()
+	if err != nil {
+		zap.L().Error("failed to generate apple client secret", zap.Error(err))
+		return nil, err
+	}
+
+	conf := *p.config
+	conf.ClientSecret = secret
+
+	token, err := conf.Exchange(ctx, code)
+	if err != nil {
+		zap.L().Error("failed to exchange token", zap.Error(err))
+		return nil, err
+	}
+
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok {
+		zap.L().Error("no id_token in token response")
+		return nil, ErrNoIDToken
+	}
+
+	idToken, err := p.verifier.Verify(ctx, rawIDToken)
+	if err != nil {
+		zap.L().Error("failed to verify ID token", zap.Error(err))
+		return nil, err
+	}
+
+	var claims struct {
+		Email   string `json:"email"`
+		Subject string `json:"sub"`
+	}
+	if err = idToken.Claims(&claims); err != nil {
+		zap.L().Error("failed to parse claims", zap.Error(err))
+		return nil, err
+	}
+
+	return &dto.ProviderResponse{
+		ProviderID:   claims.Subject,
+		Email:        claims.Email,
+		AccessToken:  token.AccessToken,
+		RefreshToken: token.RefreshToken,
+		IDToken:      rawIDToken,
+		Expiry:       token.Expiry,
+	}, nil
+}
+
+// ParseProfile reads `user` form field which Apple posts to callback only
+// on the first authorization. The id_token never carries the name.
+func (p *Provider) ParseProfile(raw string, res *dto.ProviderResponse) {
+	var profile struct {
+		Name struct {
+			FirstName string `json:"firstName"`
+			LastName  string `json:"lastName"`
+		} `json:"name"`
+	}
+	if err := json.Unmarshal([]byte(raw), &profile); err != nil {
+		zap.L().Debug("failed to parse apple user profile", zap.Error(err))
+		return
+	}
+
+	if name := strings.TrimSpace(profile.Name.FirstName + " " + profile.Name.LastName); name != "" {
+		res.Name = name
+	}
+}
+
+// clientSecret returns ES256 signed JWT which Apple expects instead of static secret.
+// Secret is reused until it is close to expiry.
+func (p *Provider) clientSecret() (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	if p.secret != "" && now.Add(time.Minute).Before(p.secretExp) {
+		return p.secret, nil
+	}
+
+	exp := now.Add(secretTTL)
+	t := jwt.NewWithClaims(
+		jwt.SigningMethodES256, jwt.RegisteredClaims{
+			Issuer:    p.teamID,
+			Subject:   p.config.ClientID,
+			Audience:  jwt.ClaimStrings{p.baseURL},
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(exp),
+		},
+	)
+	t.Header["kid"] = p.keyID
+
+	secret, err := t.SignedString(p.key)
+	if err != nil {
+		return "", err
+	}
+
+	p.secret, p.secretExp = secret, exp
+	return secret, nil
+}
@@ -0,0 +1,160 @@
+package apple
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/JMURv/sso/internal/dto"
+	"github.com/goccy/go-json"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testClientID = "com.example.web"
+	testTeamID   = "TEAM123456"
+	testKeyID    = "KEY1234567"
+)
+
+// newStub serves Apple token and keys endpoints. Token endpoint accepts only
+// client secrets signed with clientKey.
+func newStub(t *testing.T, clientKey *ecdsa.PrivateKey) *httptest.Server {
+	t.Helper()
+
+	signKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	var srv *httptest.Server
+	mux := http.NewServeMux()
+	mux.HandleFunc(
+		"/auth/keys", func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewEncoder(w).Encode(
+				map[string]any{
+					"keys": []map[string]string{
+						{
+							"kty": "RSA",
+							"alg": "RS256",
+							"kid": "apple",
+							"n":   base64.RawURLEncoding.EncodeToString(signKey.N.Bytes()),
+							"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(signKey.E)).Bytes()),
+						},
+					},
+				},
+			)
+		},
+	)
+	mux.HandleFunc(
+		"/auth/token", func(w http.ResponseWriter, r *http.Request) {
+			secret, err := jwt.ParseWithClaims(
+				r.FormValue("client_secret"), &jwt.RegisteredClaims{},
+				func(t *jwt.Token) (any, error) {
+					return &clientKey.PublicKey, nil
+				},
+				jwt.WithValidMethods([]string{"ES256"}),
+				jwt.WithIssuer(testTeamID),
+				jwt.WithSubject(testClientID),
+				jwt.WithAudience(srv.URL),
+			)
+			if err != nil || secret.Header["kid"] != testKeyID || r.FormValue("code") != "code" {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"error":"invalid_client"}`))
+				return
+			}
+
+			idToken := jwt.NewWithClaims(
+				jwt.SigningMethodRS256, jwt.MapClaims{
+					"iss":            srv.URL,
+					"aud":            testClientID,
+					"sub":            "000123.abc",
+					"email":          "jane@privaterelay.appleid.com",
+					"email_verified": "true",
+					"iat":            time.Now().Unix(),
+					"exp":            time.Now().Add(time.Hour).Unix(),
+				},
+			)
+			idToken.Header["kid"] = "apple"
+			raw, err := idToken.SignedString(signKey)
+			require.NoError(t, err)
+
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(
+				map[string]any{
+					"access_token": "access",
+					"token_type":   "Bearer",
+					"expires_in":   3600,
+					"id_token":     raw,
+				},
+			)
+		},
+	)
+
+	srv = httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestProvider(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	srv := newStub(t, key)
+	p := NewWithKey(srv.URL, testClientID, testTeamID, testKeyID, "http://localhost/callback", []string{"name", "email"}, key)
+
+	t.Run("AuthCodeURL", func(t *testing.T) {
+		u, err := url.Parse(p.AuthCodeURL("state"))
+		require.NoError(t, err)
+		assert.Equal(t, "/auth/authorize", u.Path)
+		assert.Equal(t, "form_post", u.Query().Get("response_mode"))
+		assert.Equal(t, "name email", u.Query().Get("scope"))
+	})
+
+	t.Run("Exchange", func(t *testing.T) {
+		res, err := p.Exchange(context.Background(), "code")
+		require.NoError(t, err)
+		assert.Equal(t, "000123.abc", res.ProviderID)
+		assert.Equal(t, "jane@privaterelay.appleid.com", res.Email)
+		assert.Empty(t, res.Name)
+
+		first := p.secret
+		_, err = p.Exchange(context.Background(), "code")
+		require.NoError(t, err)
+		assert.Equal(t, first, p.secret)
+	})
+
+	t.Run("Invalid code", func(t *testing.T) {
+		_, err := p.Exchange(context.Background(), "wrong")
+		assert.Error(t, err)
+	})
+
+	t.Run("Foreign key", func(t *testing.T) {
+		other, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+
+		fp := NewWithKey(srv.URL, testClientID, testTeamID, testKeyID, "", nil, other)
+		_, err = fp.Exchange(context.Background(), "code")
+		assert.Error(t, err)
+	})
+}
+
+func TestProvider_ParseProfile(t *testing.T) {
+	p := &Provider{}
+
+	res := &dto.ProviderResponse{}
+	p.ParseProfile(`{"name":{"firstName":"Jane","lastName":"Doe"},"email":"jane@example.com"}`, res)
+	assert.Equal(t, "Jane Doe", res.Name)
+
+	res = &dto.ProviderResponse{Name: "kept"}
+	p.ParseProfile(`not json`, res)
+	assert.Equal(t, "kept", res.Name)
+}
@@ -0,0 +1,176 @@
+package microsoft
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/JMURv/sso/internal/config"
+	"github.com/JMURv/sso/internal/dto"
+	"github.com/coreos/go-oidc/v3/oidc"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+)
+
+var (
+	// ErrNoIDToken is error that indicates missing id_token in token response.
+	ErrNoIDToken = errors.New("no id_token in token response")
+
+	// ErrInvalidIssuer is error that indicates issuer which does not match token tenant.
+	ErrInvalidIssuer = errors.New("invalid token issuer")
+
+	// ErrTenantNotAllowed is error that indicates tenant which is not in allow-list.
+	ErrTenantNotAllowed = errors.New("tenant is not allowed")
+
+	// ErrNoAllowedTenants is error that indicates multi-tenant authority without allow-list.
+	ErrNoAllowedTenants = errors.New("multi-tenant authority requires allowed tenants")
+
+	// ErrNoIdentity is error that indicates token without object id or user principal name.
+	ErrNoIdentity = errors.New("token has no object id or user principal name")
+)
+
+// multiTenant are authorities which accept users from more than one directory.
+var multiTenant = []string{"common", "organizations", "consumers"}
+
+type Provider struct {
+	config   *oauth2.Config
+	verifier *oidc.IDTokenVerifier
+	baseURL  string
+	tenant   string
+	allowed  []string
+}
+
+// New returns Microsoft Entra ID provider or nil when it is not configured.
+func New(conf config.Config) *Provider {
+	mc := conf.Auth.Providers.OIDC.Microsoft
+	if mc.ClientID == "" {
+		return nil
+	}
+
+	p, err := NewWithEndpoint(
+		mc.BaseURL, mc.Tenant, mc.ClientID, mc.ClientSecret, mc.RedirectURL, mc.Scopes, mc.AllowedTenants,
+	)
+	if err != nil {
+		zap.L().Fatal("invalid microsoft provider config", zap.String("tenant", mc.Tenant), zap.Error(err))
+	}
+	return p
+}
+
+// NewWithEndpoint builds provider for given login host, so it can be pointed to a local stub.
+// Anyone can create a tenant, so multi-tenant authority is accepted only with tenant allow-list.
+func NewWithEndpoint(baseURL, tenant, clientID, clientSecret, redirectURL string, scopes, allowed []string) (*Provider, error) {
+	baseURL = strings.TrimSuffix(baseURL, "/")
+	if tenant == "" {
+		tenant = "organizations"
+	}
+
+	if slices.Contains(multiTenant, tenant) && len(allowed) == 0 {
+		return nil, ErrNoAllowedTenants
+	}
+
+	authority := baseURL + "/" + tenant
+	return &Provider{
+		config: &oauth2.Config{
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+			RedirectURL:  redirectURL,
+			Scopes:       scopes,
+			Endpoint: oauth2.Endpoint{
+				AuthURL:  authority + "/oauth2/v2.0/authorize",
+				TokenURL: authority + "/oauth2/v2.0/token",
+			},
+		},
+		// Multi-tenant discovery document advertises issuer with {tenantid} placeholder,
+		// so issuer is checked against token tid after signature verification.
+		verifier: oidc.NewVerifier(
+			"",
+			oidc.NewRemoteKeySet(context.Background(), authority+"/discovery/v2.0/keys"),
+			&oidc.Config{ClientID: clientID, SkipIssuerCheck: true},
+		),
+		baseURL: baseURL,
+		tenant:  tenant,
+		allowed: allowed,
+	}, nil
+}
+
+func (p *Provider) AuthCodeURL(state string) string {
+	return p.config.AuthCodeURL(state)
+}
+
+func (p *Provider) Exchange(ctx context.Context, code string) (*dto.ProviderResponse, error) {
+	token, err := p.config.Exchange(ctx, code)
+	if err != nil {
+		zap.L().Error("failed to exchange token", zap.Error(err))
+		return nil, err
+	}
+
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok {
+		zap.L().Error("no id_token in token response")
+		return nil, ErrNoIDToken
+	}
+
+	idToken, err := p.verifier.Verify(ctx, rawIDToken)
+	if err != nil {
+		zap.L().Error("failed to verify ID token", zap.Error(err))
+		return nil, err
+	}
+
+	var claims struct {
+		ObjectID          string `json:"oid"`
+		TenantID          string `json:"tid"`
+		PreferredUsername string `json:"preferred_username"`
+		Name              string `json:"name"`
+	}
+	if err = idToken.Claims(&claims); err != nil {
+		zap.L().Error("failed to parse claims", zap.Error(err))
+		return nil, err
+	}
+
+	if err = p.checkTenant(idToken.Issuer, claims.TenantID); err != nil {
+		zap.L().Debug(
+			"rejected microsoft id token",
+			zap.String("iss", idToken.Issuer),
+			zap.String("tid", claims.TenantID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	// Entra does not verify optional `email` claim, so it is never read. User is identified
+	// by object id within tenant, user principal name is kept as contact email only.
+	if claims.ObjectID == "" || !strings.Contains(claims.PreferredUsername, "@") {
+		return nil, ErrNoIdentity
+	}
+
+	return &dto.ProviderResponse{
+		ProviderID:   claims.TenantID + ":" + claims.ObjectID,
+		Email:        claims.PreferredUsername,
+		Name:         claims.Name,
+		AccessToken:  token.AccessToken,
+		RefreshToken: token.RefreshToken,
+		IDToken:      rawIDToken,
+		Expiry:       token.Expiry,
+	}, nil
+}
+
+// LinksByEmail reports that email asserted by tenant admin does not prove ownership of existing account.
+func (p *Provider) LinksByEmail() bool {
+	return false
+}
+
+func (p *Provider) checkTenant(issuer, tid string) error {
+	if tid == "" || issuer != p.baseURL+"/"+tid+"/v2.0" {
+		return ErrInvalidIssuer
+	}
+
+	if !slices.Contains(multiTenant, p.tenant) && !strings.EqualFold(p.tenant, tid) {
+		return ErrTenantNotAllowed
+	}
+
+	if len(p.allowed) > 0 && !slices.Contains(p.allowed, tid) {
+		return ErrTenantNotAllowed
+	}
+	return nil
+}
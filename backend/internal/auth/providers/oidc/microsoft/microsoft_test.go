@@ -0,0 +1,177 @@
+package microsoft
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testClientID = "client"
+	tenantA      = "11111111-1111-1111-1111-111111111111"
+	tenantB      = "22222222-2222-2222-2222-222222222222"
+)
+
+// newStub serves Entra token and keys endpoints for any authority. Token endpoint
+// issues id_token with claims from the `code` value, which is looked up in tokens.
+func newStub(t *testing.T, tokens map[string]jwt.MapClaims) *httptest.Server {
+	t.Helper()
+
+	signKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	var srv *httptest.Server
+	srv = httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch {
+				case strings.HasSuffix(r.URL.Path, "/discovery/v2.0/keys"):
+					_ = json.NewEncoder(w).Encode(
+						map[string]any{
+							"keys": []map[string]string{
+								{
+									"kty": "RSA",
+									"kid": "entra",
+									"n":   base64.RawURLEncoding.EncodeToString(signKey.N.Bytes()),
+									"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(signKey.E)).Bytes()),
+								},
+							},
+						},
+					)
+				default:
+					claims, ok := tokens[r.FormValue("code")]
+					if !ok {
+						w.WriteHeader(http.StatusBadRequest)
+						_, _ = w.Write([]byte(`{"error":"invalid_grant"}`))
+						return
+					}
+
+					claims["aud"] = testClientID
+					claims["iat"] = time.Now().Unix()
+					claims["exp"] = time.Now().Add(time.Hour).Unix()
+					if _, ok := claims["iss"]; !ok {
+						claims["iss"] = srv.URL + "/" + claims["tid"].(string) + "/v2.0"
+					}
+
+					idToken := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+					idToken.Header["kid"] = "entra"
+					raw, err := idToken.SignedString(signKey)
+					require.NoError(t, err)
+
+					_ = json.NewEncoder(w).Encode(
+						map[string]any{
+							"access_token": "access",
+							"token_type":   "Bearer",
+							"expires_in":   3600,
+							"id_token":     raw,
+						},
+					)
+				}
+			},
+		),
+	)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestNewWithEndpoint(t *testing.T) {
+	for _, tenant := range []string{"", "common", "organizations", "consumers"} {
+		_, err := NewWithEndpoint("http://localhost", tenant, testClientID, "secret", "http://localhost/callback", nil, nil)
+		assert.ErrorIs(t, err, ErrNoAllowedTenants, tenant)
+	}
+
+	_, err := NewWithEndpoint("http://localhost", tenantA, testClientID, "secret", "http://localhost/callback", nil, nil)
+	assert.NoError(t, err)
+}
+
+func TestProvider_Exchange(t *testing.T) {
+	srv := newStub(
+		t, map[string]jwt.MapClaims{
+			"a": {
+				"sub":                "sub-a",
+				"oid":                "oid-a",
+				"tid":                tenantA,
+				"name":               "Jane Doe",
+				"email":              "spoofed@example.com",
+				"preferred_username": "jane@contoso.com",
+			},
+			"b": {
+				"sub":                "sub-b",
+				"oid":                "oid-b",
+				"tid":                tenantB,
+				"preferred_username": "john@fabrikam.com",
+			},
+			"email only": {
+				"sub":                "sub-d",
+				"oid":                "oid-d",
+				"tid":                tenantA,
+				"email":              "admin@contoso.com",
+				"preferred_username": "guest",
+			},
+			"no oid": {
+				"sub":                "sub-e",
+				"tid":                tenantA,
+				"preferred_username": "jane@contoso.com",
+			},
+			"spoofed": {
+				"sub": "sub-c",
+				"oid": "oid-c",
+				"tid": tenantA,
+				"iss": "https://evil.example.com/" + tenantA + "/v2.0",
+			},
+		},
+	)
+
+	tests := []struct {
+		name    string
+		tenant  string
+		allowed []string
+		code    string
+		id      string
+		email   string
+		err     error
+	}{
+		{
+			name: "Allowed tenant", tenant: "common", allowed: []string{tenantA}, code: "a",
+			id: tenantA + ":oid-a", email: "jane@contoso.com",
+		},
+		{name: "Tenant not in allow-list", tenant: "common", allowed: []string{tenantA}, code: "b", err: ErrTenantNotAllowed},
+		{name: "Single tenant mismatch", tenant: tenantA, code: "b", err: ErrTenantNotAllowed},
+		{name: "Single tenant", tenant: tenantA, code: "a", id: tenantA + ":oid-a", email: "jane@contoso.com"},
+		{name: "Unverified email claim is ignored", tenant: tenantA, code: "email only", err: ErrNoIdentity},
+		{name: "No object id", tenant: tenantA, code: "no oid", err: ErrNoIdentity},
+		{name: "Issuer mismatch", tenant: "common", allowed: []string{tenantA}, code: "spoofed", err: ErrInvalidIssuer},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				p, err := NewWithEndpoint(srv.URL, tt.tenant, testClientID, "secret", "http://localhost/callback", nil, tt.allowed)
+				require.NoError(t, err)
+
+				res, err := p.Exchange(context.Background(), tt.code)
+				if tt.err != nil {
+					assert.ErrorIs(t, err, tt.err)
+					return
+				}
+
+				require.NoError(t, err)
+				assert.Equal(t, tt.id, res.ProviderID)
+				assert.Equal(t, tt.email, res.Email)
+			},
+		)
+	}
+}
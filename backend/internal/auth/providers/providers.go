@@ -6,12 +6,16 @@ import (
 	"crypto/sha256"
 	"encoding/base64"
 	"fmt"
+	"net/http"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/JMURv/sso/internal/auth/providers/mock"
 	"github.com/JMURv/sso/internal/auth/providers/oauth2/google"
+	"github.com/JMURv/sso/internal/auth/providers/oidc/apple"
 	g_oidc "github.com/JMURv/sso/internal/auth/providers/oidc/google"
+	"github.com/JMURv/sso/internal/auth/providers/oidc/microsoft"
 	"github.com/JMURv/sso/internal/config"
 	"github.com/JMURv/sso/internal/dto"
 	"github.com/google/uuid"
@@ -21,6 +25,7 @@ import (
 type Port interface {
 	Get(provider Providers, flow Flow) (OAuthProvider, error)
 	SuccessURL() string
+	MockIDP() http.Handler
 	GenerateSignedState() string
 	ValidateSignedState(signedState string, maxAge time.Duration) error
 }
@@ -36,7 +41,10 @@ const (
 )
 
 const (
-	Google Providers = "google"
+	Google    Providers = "google"
+	Apple     Providers = "apple"
+	Microsoft Providers = "microsoft"
+	Mock      Providers = "mock"
 )
 
 type OAuthProvider interface {
@@ -44,23 +52,40 @@ type OAuthProvider interface {
 	Exchange(ctx context.Context, code string) (*dto.ProviderResponse, error)
 }
 
+// EmailLinker is implemented by providers which decide themselves whether their users may be
+// linked to existing accounts by email. Providers which do not implement it are trusted.
+type EmailLinker interface {
+	LinksByEmail() bool
+}
+
+// ProfileProvider is implemented by providers which post part of user profile
+// to callback instead of returning it from token endpoint.
+type ProfileProvider interface {
+	ParseProfile(raw string, res *dto.ProviderResponse)
+}
+
 type Core struct {
 	secret          []byte
 	successURL      string
 	OAuth2Providers OAuthProviders
 	OIDCProviders   OIDCProviders
+	mockIdP         *mock.IdP
 }
 
 type OAuthProviders struct {
 	Google OAuthProvider
+	Mock   OAuthProvider
 }
 
 type OIDCProviders struct {
-	Google OAuthProvider
+	Google    OAuthProvider
+	Apple     OAuthProvider
+	Microsoft OAuthProvider
+	Mock      OAuthProvider
 }
 
 func New(conf config.Config) *Core {
-	return &Core{
+	c := &Core{
 		secret:     []byte(conf.Auth.Providers.Secret),
 		successURL: conf.Auth.Providers.SuccessURL,
 		OAuth2Providers: OAuthProviders{
@@ -70,6 +95,22 @@ func New(conf config.Config) *Core {
 			Google: g_oidc.New(conf),
 		},
 	}
+
+	if p := apple.New(conf); p != nil {
+		c.OIDCProviders.Apple = p
+	}
+
+	if p := microsoft.New(conf); p != nil {
+		c.OIDCProviders.Microsoft = p
+	}
+
+	if idp := mock.NewIdPFromConfig(conf); idp != nil {
+		mc := conf.Auth.Providers.Mock
+		c.mockIdP = idp
+		c.OAuth2Providers.Mock = mock.New(idp, mc.ClientID, mc.ClientSecret, mc.OAuth2RedirectURL, false)
+		c.OIDCProviders.Mock = mock.New(idp, mc.ClientID, mc.ClientSecret, mc.OIDCRedirectURL, true)
+	}
+	return c
 }
 
 func (c *Core) Get(provider Providers, flow Flow) (OAuthProvider, error) {
@@ -79,6 +120,25 @@ func (c *Core) Get(provider Providers, flow Flow) (OAuthProvider, error) {
 			return c.OIDCProviders.Google, nil
 		}
 		return c.OAuth2Providers.Google, nil
+	case Apple:
+		if flow != OIDC || c.OIDCProviders.Apple == nil {
+			return nil, ErrUnknownProvider
+		}
+		return c.OIDCProviders.Apple, nil
+	case Microsoft:
+		if flow != OIDC || c.OIDCProviders.Microsoft == nil {
+			return nil, ErrUnknownProvider
+		}
+		return c.OIDCProviders.Microsoft, nil
+	case Mock:
+		if c.mockIdP == nil {
+			return nil, ErrUnknownProvider
+		}
+
+		if flow == OIDC {
+			return c.OIDCProviders.Mock, nil
+		}
+		return c.OAuth2Providers.Mock, nil
 	default:
 		zap.L().Error(
 			"Unknown provider",
@@ -93,6 +153,14 @@ func (c *Core) SuccessURL() string {
 	return c.successURL
 }
 
+// MockIDP returns handler of built-in mock IdP or nil when it is disabled.
+func (c *Core) MockIDP() http.Handler {
+	if c.mockIdP == nil {
+		return nil
+	}
+	return c.mockIdP
+}
+
 func (c *Core) GenerateSignedState() string {
 	rawState := uuid.New().String()
 	timestamp := fmt.Sprintf("%d", time.Now().Unix())
@@ -0,0 +1,30 @@
+package auth
+
+import (
+	"bytes"
+	"image/png"
+
+	"github.com/boombuler/barcode"
+	"github.com/boombuler/barcode/qr"
+)
+
+const qrSize = 256
+
+// EncodeQR renders content as PNG QR code.
+func EncodeQR(content string) ([]byte, error) {
+	code, err := qr.Encode(content, qr.M, qr.Auto)
+	if err != nil {
+		return nil, err
+	}
+
+	code, err = barcode.Scale(code, qrSize, qrSize)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := &bytes.Buffer{}
+	if err = png.Encode(buf, code); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
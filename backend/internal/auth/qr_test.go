@@ -0,0 +1,20 @@
+package auth
+
+import (
+	"bytes"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeQR(t *testing.T) {
+	res, err := EncodeQR("https://example.com/qr/?code=abc")
+	require.NoError(t, err)
+
+	img, err := png.Decode(bytes.NewReader(res))
+	require.NoError(t, err)
+	assert.Equal(t, qrSize, img.Bounds().Dx())
+	assert.Equal(t, qrSize, img.Bounds().Dy())
+}
@@ -0,0 +1,51 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// recoveryAlphabet has no look-alike characters, codes are often typed from paper.
+const recoveryAlphabet = "abcdefghjkmnpqrstuvwxyz23456789"
+
+const recoveryCodeLen = 10
+
+// GenerateRecoveryCodes returns n random codes formatted as xxxxx-xxxxx.
+func GenerateRecoveryCodes(n int) ([]string, error) {
+	// bytes above the largest multiple of alphabet size are dropped to avoid modulo bias
+	limit := byte(256 - 256%len(recoveryAlphabet))
+
+	res := make([]string, n)
+	buf := make([]byte, 1)
+	for i := range res {
+		b := strings.Builder{}
+		for b.Len() < recoveryCodeLen+1 {
+			if b.Len() == recoveryCodeLen/2 {
+				b.WriteByte('-')
+				continue
+			}
+
+			if _, err := rand.Read(buf); err != nil {
+				return nil, err
+			}
+
+			if buf[0] < limit {
+				b.WriteByte(recoveryAlphabet[int(buf[0])%len(recoveryAlphabet)])
+			}
+		}
+		res[i] = b.String()
+	}
+	return res, nil
+}
+
+// HashRecoveryCode normalizes user input and returns hash to store and look up.
+// Codes have enough entropy, so slow password hash is not needed.
+func HashRecoveryCode(code string) string {
+	code = strings.ToLower(code)
+	code = strings.NewReplacer("-", "", " ", "").Replace(code)
+
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,28 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateRecoveryCodes(t *testing.T) {
+	codes, err := GenerateRecoveryCodes(10)
+	require.NoError(t, err)
+	require.Len(t, codes, 10)
+
+	seen := make(map[string]struct{}, len(codes))
+	for _, c := range codes {
+		assert.Regexp(t, `^[a-z2-9]{5}-[a-z2-9]{5}$`, c)
+		seen[c] = struct{}{}
+	}
+	assert.Len(t, seen, len(codes))
+}
+
+func TestHashRecoveryCode(t *testing.T) {
+	h := HashRecoveryCode("abcde-fghjk")
+	assert.Equal(t, h, HashRecoveryCode("ABCDE FGHJK"))
+	assert.Equal(t, h, HashRecoveryCode("abcdefghjk"))
+	assert.NotEqual(t, h, HashRecoveryCode("abcde-fghjm"))
+}
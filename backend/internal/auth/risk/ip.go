@@ -0,0 +1,201 @@
+package risk
+
+import (
+	"bufio"
+	"net/netip"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+type Location struct {
+	Country string
+	Lat     float64
+	Lon     float64
+}
+
+type ipRange struct {
+	start netip.Addr
+	end   netip.Addr
+}
+
+// ipSet answers membership with binary search over sorted, merged ranges.
+type ipSet struct {
+	ranges []ipRange
+}
+
+type geoRange struct {
+	ipRange
+	loc Location
+}
+
+// geoDB is offline GeoIP database, ranges must not overlap.
+type geoDB struct {
+	ranges []geoRange
+}
+
+func loadBlocklists(paths []string) *ipSet {
+	ranges := make([]ipRange, 0)
+	for _, path := range paths {
+		path = strings.TrimSpace(path)
+		if path == "" {
+			continue
+		}
+
+		before := len(ranges)
+		err := readLines(
+			path, func(line string) {
+				if r, ok := parseRange(line); ok {
+					ranges = append(ranges, r)
+				}
+			},
+		)
+		if err != nil {
+			zap.L().Error("failed to read IP blocklist", zap.String("path", path), zap.Error(err))
+			continue
+		}
+		zap.L().Info("IP blocklist loaded", zap.String("path", path), zap.Int("count", len(ranges)-before))
+	}
+	return newIPSet(ranges)
+}
+
+func newIPSet(ranges []ipRange) *ipSet {
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i].start.Less(ranges[j].start) })
+
+	merged := make([]ipRange, 0, len(ranges))
+	for _, r := range ranges {
+		last := len(merged) - 1
+		if last >= 0 && merged[last].start.Is4() == r.start.Is4() && !merged[last].end.Less(r.start) {
+			if merged[last].end.Less(r.end) {
+				merged[last].end = r.end
+			}
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return &ipSet{ranges: merged}
+}
+
+func (s *ipSet) contains(ip netip.Addr) bool {
+	if !ip.IsValid() {
+		return false
+	}
+
+	i := sort.Search(len(s.ranges), func(i int) bool { return ip.Less(s.ranges[i].start) }) - 1
+	return i >= 0 && s.ranges[i].contains(ip)
+}
+
+// loadGeoIP reads "network,country,latitude,longitude" lines, e.g. "81.2.69.0/24,GB,51.5142,-0.0931".
+func loadGeoIP(path string) *geoDB {
+	db := &geoDB{ranges: make([]geoRange, 0)}
+	if path == "" {
+		return db
+	}
+
+	err := readLines(
+		path, func(line string) {
+			parts := strings.Split(line, ",")
+			if len(parts) < 4 {
+				return
+			}
+
+			r, ok := parseRange(parts[0])
+			if !ok {
+				return
+			}
+
+			lat, err := strconv.ParseFloat(strings.TrimSpace(parts[2]), 64)
+			if err != nil {
+				return
+			}
+
+			lon, err := strconv.ParseFloat(strings.TrimSpace(parts[3]), 64)
+			if err != nil {
+				return
+			}
+
+			db.ranges = append(
+				db.ranges, geoRange{
+					ipRange: r,
+					loc: Location{
+						Country: strings.ToUpper(strings.TrimSpace(parts[1])),
+						Lat:     lat,
+						Lon:     lon,
+					},
+				},
+			)
+		},
+	)
+	if err != nil {
+		zap.L().Error("failed to read GeoIP database", zap.String("path", path), zap.Error(err))
+	}
+
+	sort.Slice(db.ranges, func(i, j int) bool { return db.ranges[i].start.Less(db.ranges[j].start) })
+	zap.L().Info("GeoIP database loaded", zap.Int("count", len(db.ranges)))
+	return db
+}
+
+func (g *geoDB) lookup(ip netip.Addr) (Location, bool) {
+	if !ip.IsValid() {
+		return Location{}, false
+	}
+
+	i := sort.Search(len(g.ranges), func(i int) bool { return ip.Less(g.ranges[i].start) }) - 1
+	if i < 0 || !g.ranges[i].contains(ip) {
+		return Location{}, false
+	}
+	return g.ranges[i].loc, true
+}
+
+func (r ipRange) contains(ip netip.Addr) bool {
+	return ip.Is4() == r.start.Is4() && !ip.Less(r.start) && !r.end.Less(ip)
+}
+
+// parseRange accepts single address or CIDR network.
+func parseRange(val string) (ipRange, bool) {
+	val = strings.TrimSpace(val)
+	if !strings.Contains(val, "/") {
+		addr, err := netip.ParseAddr(val)
+		if err != nil {
+			return ipRange{}, false
+		}
+		addr = addr.Unmap()
+		return ipRange{start: addr, end: addr}, true
+	}
+
+	p, err := netip.ParsePrefix(val)
+	if err != nil {
+		return ipRange{}, false
+	}
+
+	p = p.Masked()
+	end := p.Addr().AsSlice()
+	for bit := p.Bits(); bit < len(end)*8; bit++ {
+		end[bit/8] |= 1 << (7 - bit%8)
+	}
+
+	last, _ := netip.AddrFromSlice(end)
+	return ipRange{start: p.Addr(), end: last}, true
+}
+
+// readLines calls fn for every line which is not blank or comment.
+func readLines(path string, fn func(line string)) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fn(line)
+	}
+	return sc.Err()
+}
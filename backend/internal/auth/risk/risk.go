@@ -0,0 +1,155 @@
+package risk
+
+import (
+	"math"
+	"net/netip"
+	"time"
+
+	"github.com/JMURv/sso/internal/config"
+	md "github.com/JMURv/sso/internal/models"
+)
+
+const (
+	maxScore = 100
+
+	// Locations closer than this are not checked for impossible travel,
+	// offline GeoIP is not precise enough for shorter distances.
+	minTravelDistance = 500.0
+	earthRadius       = 6371.0
+)
+
+type Port interface {
+	RiskEnabled() bool
+	AssessRisk(s Signals) Assessment
+}
+
+// Signals are facts about login attempt gathered from storage, the rest is derived from IP.
+type Signals struct {
+	IP          string
+	KnownDevice bool
+	Failures    int
+	Last        *Sighting
+	Now         time.Time
+}
+
+// Sighting is the latest activity of user, it is compared with current attempt to spot impossible travel.
+type Sighting struct {
+	IP string
+	At time.Time
+}
+
+type Assessment struct {
+	Score   int
+	Action  string
+	Reasons []string
+	Country string
+}
+
+// Core scores login attempts with weights from config. GeoIP database and IP blocklists
+// are loaded once on start, missing files disable signals which depend on them.
+type Core struct {
+	conf      config.RiskConfig
+	geo       *geoDB
+	blocklist *ipSet
+}
+
+func New(conf config.Config) *Core {
+	return &Core{
+		conf:      conf.Auth.Risk,
+		geo:       loadGeoIP(conf.Auth.Risk.GeoIPFile),
+		blocklist: loadBlocklists(conf.Auth.Risk.BlocklistFiles),
+	}
+}
+
+func (c *Core) RiskEnabled() bool {
+	return c.conf.Enabled
+}
+
+func (c *Core) AssessRisk(s Signals) Assessment {
+	res := Assessment{Action: md.RiskActionAllow, Reasons: make([]string, 0, 5)}
+	add := func(weight int, reason string) {
+		if weight > 0 {
+			res.Score += weight
+			res.Reasons = append(res.Reasons, reason)
+		}
+	}
+
+	if !s.KnownDevice {
+		add(c.conf.NewDeviceWeight, md.RiskReasonNewDevice)
+	}
+
+	ip := parseAddr(s.IP)
+	if c.blocklist.contains(ip) {
+		add(c.conf.BadIPWeight, md.RiskReasonBadIP)
+	}
+
+	loc, ok := c.geo.lookup(ip)
+	if ok {
+		res.Country = loc.Country
+	}
+
+	if ok && s.Last != nil && s.Last.IP != s.IP {
+		if last, found := c.geo.lookup(parseAddr(s.Last.IP)); found {
+			if last.Country != loc.Country {
+				add(c.conf.NewCountryWeight, md.RiskReasonNewCountry)
+			}
+			if impossibleTravel(last, loc, s.Now.Sub(s.Last.At), c.conf.MaxSpeed) {
+				add(c.conf.TravelWeight, md.RiskReasonImpossibleTravel)
+			}
+		}
+	}
+
+	if c.conf.Failures > 0 && s.Failures >= c.conf.Failures {
+		add(c.conf.FailuresWeight, md.RiskReasonFailures)
+	}
+
+	res.Score = min(res.Score, maxScore)
+	res.Action = c.action(res.Score)
+	return res
+}
+
+func (c *Core) action(score int) string {
+	switch {
+	case reached(score, c.conf.BlockThreshold):
+		return md.RiskActionBlock
+	case reached(score, c.conf.MFAThreshold):
+		return md.RiskActionMFA
+	case reached(score, c.conf.CaptchaThreshold):
+		return md.RiskActionCaptcha
+	}
+	return md.RiskActionAllow
+}
+
+func reached(score, threshold int) bool {
+	return threshold > 0 && score >= threshold
+}
+
+func impossibleTravel(from, to Location, elapsed time.Duration, maxSpeed float64) bool {
+	if maxSpeed <= 0 {
+		return false
+	}
+
+	dist := distance(from, to)
+	if dist < minTravelDistance {
+		return false
+	}
+	return elapsed <= 0 || dist/elapsed.Hours() > maxSpeed
+}
+
+// distance is great-circle distance in kilometers.
+func distance(a, b Location) float64 {
+	lat1, lat2 := a.Lat*math.Pi/180, b.Lat*math.Pi/180
+	dLat := lat2 - lat1
+	dLon := (b.Lon - a.Lon) * math.Pi / 180
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadius * math.Asin(math.Sqrt(h))
+}
+
+func parseAddr(ip string) netip.Addr {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return netip.Addr{}
+	}
+	return addr.Unmap()
+}
@@ -0,0 +1,204 @@
+package risk
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/JMURv/sso/internal/config"
+	md "github.com/JMURv/sso/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	london  = "81.2.69.10"
+	paris   = "90.84.10.10"
+	sydney  = "1.128.0.10"
+	badIP   = "203.0.113.7"
+	badNet  = "198.51.100.200"
+	unknown = "192.0.2.1"
+)
+
+func newRiskCore(t *testing.T) *Core {
+	t.Helper()
+
+	dir := t.TempDir()
+	geo := filepath.Join(dir, "geoip.csv")
+	require.NoError(
+		t, os.WriteFile(
+			geo, []byte(
+				"# network,country,latitude,longitude\n"+
+					"81.2.69.0/24,gb,51.5142,-0.0931\n"+
+					"90.84.0.0/16,FR,48.8566,2.3522\n"+
+					"1.128.0.0/11,AU,-33.8688,151.2093\n"+
+					"2001:db8::/32,DE,52.52,13.405\n"+
+					"broken line\n",
+			), 0o600,
+		),
+	)
+
+	list := filepath.Join(dir, "blocklist.txt")
+	require.NoError(t, os.WriteFile(list, []byte("203.0.113.7\n198.51.100.0/24\n198.51.100.128/25\n"), 0o600))
+
+	conf := config.Config{}
+	conf.Auth.Risk = config.RiskConfig{
+		Enabled:          true,
+		GeoIPFile:        geo,
+		BlocklistFiles:   []string{list, filepath.Join(dir, "missing.txt")},
+		CaptchaThreshold: 30,
+		MFAThreshold:     50,
+		BlockThreshold:   80,
+		NewDeviceWeight:  25,
+		BadIPWeight:      60,
+		NewCountryWeight: 20,
+		TravelWeight:     50,
+		FailuresWeight:   20,
+		Failures:         3,
+		MaxSpeed:         900,
+	}
+	return New(conf)
+}
+
+func TestCore_AssessRisk(t *testing.T) {
+	c := newRiskCore(t)
+	now := time.Now()
+
+	tests := []struct {
+		name    string
+		signals Signals
+		score   int
+		action  string
+		reasons []string
+		country string
+	}{
+		{
+			name:    "Known device",
+			signals: Signals{IP: london, KnownDevice: true, Now: now},
+			action:  md.RiskActionAllow,
+			reasons: []string{},
+			country: "GB",
+		},
+		{
+			name:    "New device",
+			signals: Signals{IP: london, Now: now},
+			score:   25,
+			action:  md.RiskActionAllow,
+			reasons: []string{md.RiskReasonNewDevice},
+			country: "GB",
+		},
+		{
+			name: "New country",
+			signals: Signals{
+				IP:   paris,
+				Last: &Sighting{IP: london, At: now.Add(-24 * time.Hour)},
+				Now:  now,
+			},
+			score:   45,
+			action:  md.RiskActionCaptcha,
+			reasons: []string{md.RiskReasonNewDevice, md.RiskReasonNewCountry},
+			country: "FR",
+		},
+		{
+			name: "Impossible travel",
+			signals: Signals{
+				IP:          sydney,
+				KnownDevice: true,
+				Last:        &Sighting{IP: london, At: now.Add(-2 * time.Hour)},
+				Now:         now,
+			},
+			score:   70,
+			action:  md.RiskActionMFA,
+			reasons: []string{md.RiskReasonNewCountry, md.RiskReasonImpossibleTravel},
+			country: "AU",
+		},
+		{
+			name:    "Blocklisted IP",
+			signals: Signals{IP: badIP, Now: now},
+			score:   85,
+			action:  md.RiskActionBlock,
+			reasons: []string{md.RiskReasonNewDevice, md.RiskReasonBadIP},
+		},
+		{
+			name:    "Blocklisted network",
+			signals: Signals{IP: badNet, KnownDevice: true, Failures: 3, Now: now},
+			score:   80,
+			action:  md.RiskActionBlock,
+			reasons: []string{md.RiskReasonBadIP, md.RiskReasonFailures},
+		},
+		{
+			name: "Score is capped",
+			signals: Signals{
+				IP:       badIP,
+				Failures: 10,
+				Now:      now,
+			},
+			score:   100,
+			action:  md.RiskActionBlock,
+			reasons: []string{md.RiskReasonNewDevice, md.RiskReasonBadIP, md.RiskReasonFailures},
+		},
+		{
+			name: "Unknown location",
+			signals: Signals{
+				IP:          unknown,
+				KnownDevice: true,
+				Last:        &Sighting{IP: london, At: now.Add(-time.Minute)},
+				Now:         now,
+			},
+			action:  md.RiskActionAllow,
+			reasons: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				res := c.AssessRisk(tt.signals)
+				assert.Equal(t, tt.score, res.Score)
+				assert.Equal(t, tt.action, res.Action)
+				assert.Equal(t, tt.reasons, res.Reasons)
+				assert.Equal(t, tt.country, res.Country)
+			},
+		)
+	}
+}
+
+func TestCore_AssessRisk_ZeroThresholds(t *testing.T) {
+	c := newRiskCore(t)
+	c.conf.BlockThreshold = 0
+	c.conf.MFAThreshold = 0
+
+	res := c.AssessRisk(Signals{IP: badIP, Now: time.Now()})
+	assert.Equal(t, md.RiskActionCaptcha, res.Action)
+}
+
+func TestGeoDB_Lookup(t *testing.T) {
+	c := newRiskCore(t)
+
+	loc, ok := c.geo.lookup(parseAddr("2001:db8::1"))
+	require.True(t, ok)
+	assert.Equal(t, "DE", loc.Country)
+
+	loc, ok = c.geo.lookup(parseAddr("::ffff:" + london))
+	require.True(t, ok)
+	assert.Equal(t, "GB", loc.Country)
+
+	_, ok = c.geo.lookup(parseAddr("not an ip"))
+	assert.False(t, ok)
+}
+
+func TestIPSet_Contains(t *testing.T) {
+	c := newRiskCore(t)
+
+	assert.Len(t, c.blocklist.ranges, 2)
+	assert.True(t, c.blocklist.contains(parseAddr("198.51.100.0")))
+	assert.True(t, c.blocklist.contains(parseAddr("198.51.100.255")))
+	assert.False(t, c.blocklist.contains(parseAddr("198.51.101.0")))
+	assert.False(t, c.blocklist.contains(parseAddr("2001:db8::1")))
+}
+
+func TestDistance(t *testing.T) {
+	d := distance(Location{Lat: 51.5142, Lon: -0.0931}, Location{Lat: 48.8566, Lon: 2.3522})
+	assert.InDelta(t, 343, d, 5)
+}
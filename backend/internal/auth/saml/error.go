@@ -0,0 +1,14 @@
+package saml
+
+import "errors"
+
+var (
+	// ErrNotConfigured is error that indicates missing service provider key pair.
+	ErrNotConfigured = errors.New("saml service provider is not configured")
+
+	// ErrInvalidMetadata is error that indicates invalid IdP metadata.
+	ErrInvalidMetadata = errors.New("invalid idp metadata")
+
+	// ErrInvalidAssertion is error that indicates assertion validation failure.
+	ErrInvalidAssertion = errors.New("invalid saml assertion")
+)
@@ -0,0 +1,213 @@
+package saml
+
+import (
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/xml"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/JMURv/sso/internal/config"
+	"github.com/JMURv/sso/internal/dto"
+	md "github.com/JMURv/sso/internal/models"
+	gosaml "github.com/crewjam/saml"
+	"github.com/crewjam/saml/samlsp"
+	dsig "github.com/russellhaering/goxmldsig"
+	"go.uber.org/zap"
+)
+
+type Port interface {
+	ParseIDPMetadata(data []byte) (string, error)
+	SAMLAuthURL(p *md.SAMLProvider, relayState string) (*dto.SAMLAuthRequest, error)
+	ParseSAMLResponse(p *md.SAMLProvider, r *http.Request, requestIDs []string) (*dto.SAMLAssertion, error)
+	SAMLMetadata(p *md.SAMLProvider) ([]byte, error)
+}
+
+type Core struct {
+	entityID string
+	baseURL  string
+	key      *rsa.PrivateKey
+	cert     *x509.Certificate
+}
+
+func New(conf config.Config) *Core {
+	if conf.Auth.SAML.ClockSkew > 0 {
+		gosaml.MaxClockSkew = conf.Auth.SAML.ClockSkew
+	}
+
+	c := &Core{
+		entityID: conf.Auth.SAML.EntityID,
+		baseURL:  strings.TrimSuffix(conf.Auth.SAML.BaseURL, "/"),
+	}
+
+	if conf.Auth.SAML.CertFile == "" || conf.Auth.SAML.KeyFile == "" {
+		zap.L().Warn("SAML key pair is not set, SAML federation is disabled")
+		return c
+	}
+
+	pair, err := tls.LoadX509KeyPair(conf.Auth.SAML.CertFile, conf.Auth.SAML.KeyFile)
+	if err != nil {
+		zap.L().Error("failed to load SAML key pair", zap.Error(err))
+		return c
+	}
+
+	key, ok := pair.PrivateKey.(*rsa.PrivateKey)
+	if !ok {
+		zap.L().Error("SAML private key must be RSA")
+		return c
+	}
+
+	cert, err := x509.ParseCertificate(pair.Certificate[0])
+	if err != nil {
+		zap.L().Error("failed to parse SAML certificate", zap.Error(err))
+		return c
+	}
+
+	c.key, c.cert = key, cert
+	return c
+}
+
+func (c *Core) ParseIDPMetadata(data []byte) (string, error) {
+	meta, err := samlsp.ParseMetadata(data)
+	if err != nil {
+		zap.L().Debug("failed to parse idp metadata", zap.Error(err))
+		return "", ErrInvalidMetadata
+	}
+
+	if meta.EntityID == "" || len(meta.IDPSSODescriptors) == 0 {
+		zap.L().Debug("idp metadata has no sso descriptors", zap.String("entityID", meta.EntityID))
+		return "", ErrInvalidMetadata
+	}
+	return meta.EntityID, nil
+}
+
+func (c *Core) SAMLAuthURL(p *md.SAMLProvider, relayState string) (*dto.SAMLAuthRequest, error) {
+	sp, err := c.serviceProvider(p)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := sp.MakeAuthenticationRequest(
+		sp.GetSSOBindingLocation(gosaml.HTTPRedirectBinding),
+		gosaml.HTTPRedirectBinding,
+		gosaml.HTTPPostBinding,
+	)
+	if err != nil {
+		zap.L().Error("failed to make authn request", zap.String("provider", p.Name), zap.Error(err))
+		return nil, err
+	}
+
+	u, err := req.Redirect(url.QueryEscape(relayState), sp)
+	if err != nil {
+		zap.L().Error("failed to build redirect", zap.String("provider", p.Name), zap.Error(err))
+		return nil, err
+	}
+
+	return &dto.SAMLAuthRequest{
+		URL:       u.String(),
+		RequestID: req.ID,
+	}, nil
+}
+
+func (c *Core) ParseSAMLResponse(p *md.SAMLProvider, r *http.Request, requestIDs []string) (*dto.SAMLAssertion, error) {
+	sp, err := c.serviceProvider(p)
+	if err != nil {
+		return nil, err
+	}
+
+	assertion, err := sp.ParseResponse(r, requestIDs)
+	if err != nil {
+		var ire *gosaml.InvalidResponseError
+		if errors.As(err, &ire) {
+			zap.L().Debug(
+				"invalid saml response",
+				zap.String("provider", p.Name),
+				zap.Error(ire.PrivateErr),
+			)
+		}
+		return nil, ErrInvalidAssertion
+	}
+
+	res := &dto.SAMLAssertion{
+		ID:         assertion.ID,
+		Issuer:     assertion.Issuer.Value,
+		Attributes: make(map[string][]string),
+	}
+
+	if assertion.Subject != nil && assertion.Subject.NameID != nil {
+		res.NameID = assertion.Subject.NameID.Value
+	}
+
+	// Expiry bounds replay protection, assertion without it could be stored forever
+	if assertion.Conditions == nil || assertion.Conditions.NotOnOrAfter.IsZero() {
+		zap.L().Debug("saml assertion has no conditions", zap.String("provider", p.Name))
+		return nil, ErrInvalidAssertion
+	}
+	res.ExpiresAt = assertion.Conditions.NotOnOrAfter.Add(gosaml.MaxClockSkew)
+
+	for _, st := range assertion.AuthnStatements {
+		if st.SessionIndex != "" {
+			res.SessionIndex = st.SessionIndex
+			break
+		}
+	}
+
+	for _, st := range assertion.AttributeStatements {
+		for _, attr := range st.Attributes {
+			for _, v := range attr.Values {
+				res.Attributes[attr.Name] = append(res.Attributes[attr.Name], v.Value)
+				if attr.FriendlyName != "" {
+					res.Attributes[attr.FriendlyName] = append(res.Attributes[attr.FriendlyName], v.Value)
+				}
+			}
+		}
+	}
+
+	return res, nil
+}
+
+func (c *Core) SAMLMetadata(p *md.SAMLProvider) ([]byte, error) {
+	sp, err := c.serviceProvider(p)
+	if err != nil {
+		return nil, err
+	}
+
+	return xml.MarshalIndent(sp.Metadata(), "", "  ")
+}
+
+func (c *Core) serviceProvider(p *md.SAMLProvider) (*gosaml.ServiceProvider, error) {
+	if c.key == nil || c.cert == nil {
+		return nil, ErrNotConfigured
+	}
+
+	idp, err := samlsp.ParseMetadata([]byte(p.Metadata))
+	if err != nil {
+		zap.L().Error("failed to parse stored idp metadata", zap.String("provider", p.Name), zap.Error(err))
+		return nil, ErrInvalidMetadata
+	}
+
+	metadataURL, err := url.Parse(c.baseURL + "/" + p.Name + "/metadata")
+	if err != nil {
+		return nil, err
+	}
+
+	acsURL, err := url.Parse(c.baseURL + "/" + p.Name + "/acs")
+	if err != nil {
+		return nil, err
+	}
+
+	return &gosaml.ServiceProvider{
+		EntityID:          c.entityID,
+		Key:               c.key,
+		Certificate:       c.cert,
+		MetadataURL:       *metadataURL,
+		AcsURL:            *acsURL,
+		IDPMetadata:       idp,
+		AuthnNameIDFormat: gosaml.UnspecifiedNameIDFormat,
+		AllowIDPInitiated: p.AllowIDPInitiated,
+		SignatureMethod:   dsig.RSASHA256SignatureMethod,
+	}, nil
+}
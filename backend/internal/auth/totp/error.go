@@ -0,0 +1,6 @@
+package totp
+
+import "errors"
+
+// ErrInvalidCode is error that indicates code which does not match any step in drift window.
+var ErrInvalidCode = errors.New("invalid totp code")
@@ -0,0 +1,101 @@
+package totp
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"image/png"
+	"time"
+
+	"github.com/JMURv/sso/internal/config"
+	"github.com/JMURv/sso/internal/dto"
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
+	"go.uber.org/zap"
+)
+
+const (
+	period = 30
+	digits = otp.DigitsSix
+	qrSize = 256
+)
+
+type Port interface {
+	GenerateTOTP(account string) (*dto.TOTPEnrollResponse, string, error)
+	ValidateTOTP(secret, code string) (int64, error)
+}
+
+type Core struct {
+	issuer string
+	skew   uint
+	now    func() time.Time
+}
+
+func New(conf config.Config) *Core {
+	return &Core{
+		issuer: conf.Auth.TOTP.Issuer,
+		skew:   conf.Auth.TOTP.Skew,
+		now:    time.Now,
+	}
+}
+
+// GenerateTOTP creates new secret for account. It returns enrollment data for the user
+// and the secret to store, repository seals it with the key ring.
+func (c *Core) GenerateTOTP(account string) (*dto.TOTPEnrollResponse, string, error) {
+	key, err := totp.Generate(
+		totp.GenerateOpts{
+			Issuer:      c.issuer,
+			AccountName: account,
+			Period:      period,
+			Digits:      digits,
+			Algorithm:   otp.AlgorithmSHA1,
+		},
+	)
+	if err != nil {
+		zap.L().Error("failed to generate totp key", zap.Error(err))
+		return nil, "", err
+	}
+
+	img, err := key.Image(qrSize, qrSize)
+	if err != nil {
+		zap.L().Error("failed to render totp qr code", zap.Error(err))
+		return nil, "", err
+	}
+
+	buf := &bytes.Buffer{}
+	if err = png.Encode(buf, img); err != nil {
+		zap.L().Error("failed to encode totp qr code", zap.Error(err))
+		return nil, "", err
+	}
+
+	return &dto.TOTPEnrollResponse{
+		Secret: key.Secret(),
+		URI:    key.URL(),
+		QR:     buf.Bytes(),
+	}, key.Secret(), nil
+}
+
+// ValidateTOTP checks code against secret within configured drift window.
+// It returns time step of matched code, so caller can refuse codes at or before
+// the last accepted step.
+func (c *Core) ValidateTOTP(secret, code string) (int64, error) {
+	now := c.now()
+	step := now.Unix() / period
+	for i := -int64(c.skew); i <= int64(c.skew); i++ {
+		expected, err := totp.GenerateCodeCustom(
+			secret, time.Unix((step+i)*period, 0), totp.ValidateOpts{
+				Period:    period,
+				Digits:    digits,
+				Algorithm: otp.AlgorithmSHA1,
+			},
+		)
+		if err != nil {
+			zap.L().Error("failed to generate totp code", zap.Error(err))
+			return 0, err
+		}
+
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return step + i, nil
+		}
+	}
+	return 0, ErrInvalidCode
+}
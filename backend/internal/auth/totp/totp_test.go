@@ -0,0 +1,95 @@
+package totp
+
+import (
+	"bytes"
+	"image/png"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/JMURv/sso/internal/config"
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newCore(t *testing.T, now time.Time) *Core {
+	t.Helper()
+
+	conf := config.Config{}
+	conf.Auth.TOTP.Issuer = "SSO"
+	conf.Auth.TOTP.Skew = 1
+
+	c := New(conf)
+	c.now = func() time.Time { return now }
+	return c
+}
+
+func codeAt(t *testing.T, secret string, at time.Time) string {
+	t.Helper()
+
+	code, err := totp.GenerateCodeCustom(
+		secret, at, totp.ValidateOpts{
+			Period:    period,
+			Digits:    digits,
+			Algorithm: otp.AlgorithmSHA1,
+		},
+	)
+	require.NoError(t, err)
+	return code
+}
+
+func TestCore_GenerateTOTP(t *testing.T) {
+	c := newCore(t, time.Now())
+
+	res, secret, err := c.GenerateTOTP("jane@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, res.Secret, secret)
+
+	u, err := url.Parse(res.URI)
+	require.NoError(t, err)
+	assert.Equal(t, "otpauth", u.Scheme)
+	assert.Equal(t, "totp", u.Host)
+	assert.Equal(t, res.Secret, u.Query().Get("secret"))
+	assert.Equal(t, "SSO", u.Query().Get("issuer"))
+
+	_, err = png.Decode(bytes.NewReader(res.QR))
+	assert.NoError(t, err)
+}
+
+func TestCore_ValidateTOTP(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	c := newCore(t, now)
+
+	res, secret, err := c.GenerateTOTP("jane@example.com")
+	require.NoError(t, err)
+
+	step := now.Unix() / period
+	tests := []struct {
+		name string
+		at   time.Time
+		step int64
+		err  error
+	}{
+		{name: "Current step", at: now, step: step},
+		{name: "Previous step", at: now.Add(-period * time.Second), step: step - 1},
+		{name: "Next step", at: now.Add(period * time.Second), step: step + 1},
+		{name: "Outside window", at: now.Add(-2 * period * time.Second), err: ErrInvalidCode},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got, err := c.ValidateTOTP(secret, codeAt(t, res.Secret, tt.at))
+				if tt.err != nil {
+					assert.ErrorIs(t, err, tt.err)
+					return
+				}
+
+				require.NoError(t, err)
+				assert.Equal(t, tt.step, got)
+			},
+		)
+	}
+}
@@ -0,0 +1,29 @@
+package wa
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrPolicyViolation is error that indicates authenticator rejected by attestation policy.
+	ErrPolicyViolation = errors.New("authenticator is not allowed by policy")
+
+	// ErrAAGUIDNotAllowed is error that indicates authenticator model outside of role allow list.
+	ErrAAGUIDNotAllowed = fmt.Errorf("%w: authenticator model is not allowed", ErrPolicyViolation)
+
+	// ErrUnknownAuthenticator is error that indicates authenticator missing from metadata.
+	ErrUnknownAuthenticator = fmt.Errorf("%w: authenticator is unknown", ErrPolicyViolation)
+
+	// ErrAttestationRequired is error that indicates credential registered without attestation statement.
+	ErrAttestationRequired = fmt.Errorf("%w: attestation is required", ErrPolicyViolation)
+
+	// ErrUserNotVerified is error that indicates authenticator which did not verify the user.
+	ErrUserNotVerified = fmt.Errorf("%w: user verification is required", ErrPolicyViolation)
+
+	// ErrInvalidPolicy is error that indicates policy file with unknown conveyance or verification value.
+	ErrInvalidPolicy = errors.New("invalid webauthn policy")
+
+	// ErrInvalidMetadata is error that indicates metadata blob which cannot be verified or parsed.
+	ErrInvalidMetadata = errors.New("invalid authenticator metadata")
+)
@@ -0,0 +1,200 @@
+package wa
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/JMURv/sso/internal/dto"
+	"github.com/go-webauthn/webauthn/metadata"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// MDS is metadata.Provider backed by locally stored FIDO Metadata Service blob.
+// Blob is never fetched over network, it is read from disk and replaced through Update.
+type MDS struct {
+	mu      sync.RWMutex
+	path    string
+	root    *x509.CertPool
+	entries map[uuid.UUID]*metadata.Entry
+}
+
+type mdsClaims struct {
+	metadata.PayloadJSON
+	jwt.RegisteredClaims
+}
+
+// NewMDS loads blob from path when it exists. root overrides FIDO production root certificate (base64 DER).
+func NewMDS(path, root string) *MDS {
+	if root == "" {
+		root = metadata.ProductionMDSRoot
+	}
+
+	m := &MDS{path: path, root: x509.NewCertPool()}
+	der, err := base64.StdEncoding.DecodeString(root)
+	if err != nil {
+		zap.L().Fatal("Failed to decode MDS root certificate", zap.Error(err))
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		zap.L().Fatal("Failed to parse MDS root certificate", zap.Error(err))
+	}
+	m.root.AddCert(cert)
+
+	if path == "" {
+		return m
+	}
+
+	blob, err := os.ReadFile(path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			zap.L().Error("failed to read authenticator metadata", zap.String("path", path), zap.Error(err))
+		}
+		return m
+	}
+
+	if _, err = m.load(blob); err != nil {
+		zap.L().Error("failed to load authenticator metadata", zap.String("path", path), zap.Error(err))
+	}
+	return m
+}
+
+// Update verifies blob signature, swaps entries and persists blob so it survives restart.
+func (m *MDS) Update(blob []byte) (*dto.WAMetadataResponse, error) {
+	res, err := m.load(blob)
+	if err != nil {
+		return nil, err
+	}
+
+	if m.path == "" {
+		zap.L().Warn("WEBAUTHN_MDS_FILE is not set, metadata will be lost on restart")
+		return res, nil
+	}
+
+	if err = os.MkdirAll(filepath.Dir(m.path), 0o755); err != nil {
+		return nil, err
+	}
+
+	tmp := m.path + ".tmp"
+	if err = os.WriteFile(tmp, blob, 0o644); err != nil {
+		return nil, err
+	}
+
+	if err = os.Rename(tmp, m.path); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// load verifies signing chain against root without revocation lookups, which would need network.
+func (m *MDS) load(blob []byte) (*dto.WAMetadataResponse, error) {
+	claims := &mdsClaims{}
+	_, err := jwt.ParseWithClaims(
+		string(blob), claims, func(token *jwt.Token) (any, error) {
+			x5c, ok := token.Header[metadata.HeaderX509Certificate].([]any)
+			if !ok || len(x5c) == 0 {
+				return nil, ErrInvalidMetadata
+			}
+
+			chain := make([]*x509.Certificate, 0, len(x5c))
+			for i := 0; i < len(x5c); i++ {
+				raw, ok := x5c[i].(string)
+				if !ok {
+					return nil, ErrInvalidMetadata
+				}
+
+				der, err := base64.StdEncoding.DecodeString(raw)
+				if err != nil {
+					return nil, err
+				}
+
+				cert, err := x509.ParseCertificate(der)
+				if err != nil {
+					return nil, err
+				}
+				chain = append(chain, cert)
+			}
+
+			intermediates := x509.NewCertPool()
+			for i := 1; i < len(chain); i++ {
+				intermediates.AddCert(chain[i])
+			}
+
+			if _, err := chain[0].Verify(x509.VerifyOptions{Roots: m.root, Intermediates: intermediates}); err != nil {
+				return nil, err
+			}
+			return chain[0].PublicKey, nil
+		},
+		jwt.WithValidMethods([]string{"RS256", "ES256"}),
+	)
+	if err != nil {
+		zap.L().Debug("failed to verify authenticator metadata", zap.Error(err))
+		return nil, ErrInvalidMetadata
+	}
+
+	decoder, err := metadata.NewDecoder(metadata.WithIgnoreEntryParsingErrors())
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := decoder.Parse(&claims.PayloadJSON)
+	if err != nil {
+		zap.L().Debug("failed to parse authenticator metadata", zap.Error(err))
+		return nil, ErrInvalidMetadata
+	}
+
+	entries := parsed.ToMap()
+	if parsed.Parsed.NextUpdate.Before(time.Now()) {
+		zap.L().Warn("authenticator metadata is outdated", zap.Time("nextUpdate", parsed.Parsed.NextUpdate))
+	}
+
+	m.mu.Lock()
+	m.entries = entries
+	m.mu.Unlock()
+
+	return &dto.WAMetadataResponse{
+		Number:     parsed.Parsed.Number,
+		NextUpdate: parsed.Parsed.NextUpdate,
+		Entries:    len(entries),
+	}, nil
+}
+
+func (m *MDS) GetEntry(_ context.Context, aaguid uuid.UUID) (*metadata.Entry, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.entries[aaguid], nil
+}
+
+// GetValidateEntry is disabled globally, presence of entry is required per role by Policy.
+func (m *MDS) GetValidateEntry(context.Context) bool {
+	return false
+}
+
+func (m *MDS) GetValidateEntryPermitZeroAAGUID(context.Context) bool {
+	return false
+}
+
+func (m *MDS) GetValidateTrustAnchor(context.Context) bool {
+	return true
+}
+
+func (m *MDS) GetValidateStatus(context.Context) bool {
+	return true
+}
+
+func (m *MDS) GetValidateAttestationTypes(context.Context) bool {
+	return true
+}
+
+func (m *MDS) ValidateStatusReports(_ context.Context, reports []metadata.StatusReport) error {
+	return metadata.ValidateStatusReports(reports, nil, metadata.DefaultUndesiredAuthenticatorStatuses())
+}
+
+var _ metadata.Provider = (*MDS)(nil)
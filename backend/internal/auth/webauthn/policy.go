@@ -0,0 +1,160 @@
+package wa
+
+import (
+	"context"
+	"os"
+	"slices"
+
+	md "github.com/JMURv/sso/internal/models"
+	"github.com/go-webauthn/webauthn/metadata"
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/protocol/webauthncbor"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+)
+
+// Policy describes which authenticators members of role may register.
+type Policy struct {
+	AllowedAAGUIDs   []uuid.UUID                          `json:"allowed_aaguids"`
+	Attestation      protocol.ConveyancePreference        `json:"attestation"`
+	UserVerification protocol.UserVerificationRequirement `json:"user_verification"`
+	RequireMetadata  bool                                 `json:"require_metadata"`
+}
+
+// Policies maps role name to its policy.
+type Policies map[string]Policy
+
+var conveyanceRank = map[protocol.ConveyancePreference]int{
+	"":                                   0,
+	protocol.PreferNoAttestation:         0,
+	protocol.PreferIndirectAttestation:   1,
+	protocol.PreferDirectAttestation:     2,
+	protocol.PreferEnterpriseAttestation: 3,
+}
+
+var verificationRank = map[protocol.UserVerificationRequirement]int{
+	"":                               0,
+	protocol.VerificationDiscouraged: 0,
+	protocol.VerificationPreferred:   1,
+	protocol.VerificationRequired:    2,
+}
+
+// LoadPolicies reads policies from JSON file keyed by role name. AAGUID is stated by authenticator itself,
+// so allow list is accepted only together with direct attestation and required metadata.
+func LoadPolicies(path string) (Policies, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var res Policies
+	if err = json.Unmarshal(data, &res); err != nil {
+		return nil, err
+	}
+
+	for _, p := range res {
+		if _, ok := conveyanceRank[p.Attestation]; !ok {
+			return nil, ErrInvalidPolicy
+		}
+		if _, ok := verificationRank[p.UserVerification]; !ok {
+			return nil, ErrInvalidPolicy
+		}
+		if len(p.AllowedAAGUIDs) > 0 &&
+			(conveyanceRank[p.Attestation] < conveyanceRank[protocol.PreferDirectAttestation] || !p.RequireMetadata) {
+			return nil, ErrInvalidPolicy
+		}
+	}
+	return res, nil
+}
+
+func (p Policies) applicable(roles []md.Role) []Policy {
+	res := make([]Policy, 0, len(roles))
+	for i := 0; i < len(roles); i++ {
+		if policy, ok := p[roles[i].Name]; ok {
+			res = append(res, policy)
+		}
+	}
+	return res
+}
+
+// RegistrationOptions returns options for the strictest conveyance and user verification among user roles.
+func (p Policies) RegistrationOptions(roles []md.Role) []webauthn.RegistrationOption {
+	var conveyance protocol.ConveyancePreference
+	var verification protocol.UserVerificationRequirement
+	for _, policy := range p.applicable(roles) {
+		if conveyanceRank[policy.Attestation] > conveyanceRank[conveyance] {
+			conveyance = policy.Attestation
+		}
+		if verificationRank[policy.UserVerification] > verificationRank[verification] {
+			verification = policy.UserVerification
+		}
+	}
+
+	opts := make([]webauthn.RegistrationOption, 0, 2)
+	if conveyance != "" {
+		opts = append(opts, webauthn.WithConveyancePreference(conveyance))
+	}
+	if verification != "" {
+		opts = append(
+			opts, func(o *protocol.PublicKeyCredentialCreationOptions) {
+				o.AuthenticatorSelection.UserVerification = verification
+			},
+		)
+	}
+	return opts
+}
+
+// Verify checks new credential against policy of every user role, all of them must pass.
+func (p Policies) Verify(ctx context.Context, mds metadata.Provider, roles []md.Role, cred *webauthn.Credential) error {
+	aaguid, err := uuid.FromBytes(cred.Authenticator.AAGUID)
+	if err != nil {
+		aaguid = uuid.Nil
+	}
+
+	for _, policy := range p.applicable(roles) {
+		if len(policy.AllowedAAGUIDs) > 0 {
+			if !attested(cred) {
+				return ErrAttestationRequired
+			}
+			if !slices.Contains(policy.AllowedAAGUIDs, aaguid) {
+				return ErrAAGUIDNotAllowed
+			}
+		}
+
+		if policy.RequireMetadata {
+			entry, err := mds.GetEntry(ctx, aaguid)
+			if err != nil || entry == nil {
+				return ErrUnknownAuthenticator
+			}
+		}
+
+		if conveyanceRank[policy.Attestation] >= conveyanceRank[protocol.PreferDirectAttestation] && !attested(cred) {
+			return ErrAttestationRequired
+		}
+
+		if policy.UserVerification == protocol.VerificationRequired && !cred.Flags.UserVerified {
+			return ErrUserNotVerified
+		}
+	}
+	return nil
+}
+
+// attested reports whether credential came with attestation certificate of the manufacturer. None and
+// self attestation are produced by authenticator itself, any software authenticator may claim any AAGUID.
+func attested(cred *webauthn.Credential) bool {
+	switch protocol.AttestationFormat(cred.AttestationType) {
+	case "", protocol.AttestationFormatNone:
+		return false
+	case protocol.AttestationFormatAndroidSafetyNet:
+		return true
+	}
+
+	var obj protocol.AttestationObject
+	if err := webauthncbor.Unmarshal(cred.Attestation.Object, &obj); err != nil {
+		return false
+	}
+
+	x5c, ok := obj.AttStatement["x5c"].([]any)
+	return ok && len(x5c) > 0
+}
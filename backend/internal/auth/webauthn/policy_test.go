@@ -0,0 +1,247 @@
+package wa
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	md "github.com/JMURv/sso/internal/models"
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/protocol/webauthncbor"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var yubikey = uuid.MustParse("cb69481e-8ff7-4039-93ec-0a2729a154a8")
+
+func newCert(t *testing.T, name string, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  parent == nil,
+	}
+	if parent == nil {
+		parent, parentKey = tmpl, key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parent, &key.PublicKey, parentKey)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert, key
+}
+
+// newBlob returns MDS blob with single entry, signed by leaf issued from returned root.
+func newBlob(t *testing.T) ([]byte, string) {
+	t.Helper()
+
+	root, rootKey := newCert(t, "Test MDS Root", nil, nil)
+	leaf, leafKey := newCert(t, "Test MDS Signer", root, rootKey)
+
+	token := jwt.NewWithClaims(
+		jwt.SigningMethodES256, jwt.MapClaims{
+			"no":         7,
+			"nextUpdate": time.Now().AddDate(0, 1, 0).Format(time.DateOnly),
+			"entries": []map[string]any{
+				{
+					"aaguid": yubikey.String(),
+					"metadataStatement": map[string]any{
+						"description":      "Security Key",
+						"attestationTypes": []string{"basic_full"},
+					},
+					"statusReports": []map[string]any{
+						{"status": "FIDO_CERTIFIED_L1", "effectiveDate": "2024-01-01"},
+					},
+					"timeOfLastStatusChange": "2024-01-01",
+				},
+			},
+		},
+	)
+	token.Header["x5c"] = []string{base64.StdEncoding.EncodeToString(leaf.Raw)}
+
+	signed, err := token.SignedString(leafKey)
+	require.NoError(t, err)
+	return []byte(signed), base64.StdEncoding.EncodeToString(root.Raw)
+}
+
+func TestMDS_Update(t *testing.T) {
+	blob, root := newBlob(t)
+	path := filepath.Join(t.TempDir(), "mds", "blob.jwt")
+
+	m := NewMDS(path, root)
+	entry, err := m.GetEntry(context.Background(), yubikey)
+	require.NoError(t, err)
+	assert.Nil(t, entry)
+
+	res, err := m.Update(blob)
+	require.NoError(t, err)
+	assert.Equal(t, 7, res.Number)
+	assert.Equal(t, 1, res.Entries)
+
+	entry, err = m.GetEntry(context.Background(), yubikey)
+	require.NoError(t, err)
+	require.NotNil(t, entry)
+	assert.Equal(t, "Security Key", entry.MetadataStatement.Description)
+
+	reloaded := NewMDS(path, root)
+	entry, err = reloaded.GetEntry(context.Background(), yubikey)
+	require.NoError(t, err)
+	assert.NotNil(t, entry)
+
+	_, foreign := newBlob(t)
+	_, err = NewMDS("", foreign).Update(blob)
+	assert.ErrorIs(t, err, ErrInvalidMetadata)
+
+	_, err = m.Update([]byte("not a jwt"))
+	assert.ErrorIs(t, err, ErrInvalidMetadata)
+}
+
+// attestationObject returns CBOR attestation object, statement without x5c is self attestation.
+func attestationObject(t *testing.T, format string, x5c bool) []byte {
+	t.Helper()
+
+	stmt := map[string]any{"alg": -7, "sig": []byte{1}}
+	if x5c {
+		stmt["x5c"] = []any{[]byte{1}}
+	}
+
+	obj, err := webauthncbor.Marshal(map[string]any{"fmt": format, "attStmt": stmt, "authData": []byte{}})
+	require.NoError(t, err)
+	return obj
+}
+
+func TestLoadPolicies(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		err  error
+	}{
+		{
+			name: "Valid",
+			data: `{"admin":{"allowed_aaguids":["` + yubikey.String() + `"],"attestation":"direct","require_metadata":true}}`,
+		},
+		{
+			name: "Unknown attestation",
+			data: `{"admin":{"attestation":"always"}}`,
+			err:  ErrInvalidPolicy,
+		},
+		{
+			name: "Allow list without attestation",
+			data: `{"admin":{"allowed_aaguids":["` + yubikey.String() + `"],"attestation":"none","require_metadata":true}}`,
+			err:  ErrInvalidPolicy,
+		},
+		{
+			name: "Allow list without metadata",
+			data: `{"admin":{"allowed_aaguids":["` + yubikey.String() + `"],"attestation":"direct"}}`,
+			err:  ErrInvalidPolicy,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				path := filepath.Join(t.TempDir(), "policy.json")
+				require.NoError(t, os.WriteFile(path, []byte(tt.data), 0o600))
+
+				_, err := LoadPolicies(path)
+				assert.ErrorIs(t, err, tt.err)
+			},
+		)
+	}
+}
+
+func TestPolicies_RegistrationOptions(t *testing.T) {
+	p := Policies{
+		"admin": {Attestation: protocol.PreferDirectAttestation, UserVerification: protocol.VerificationPreferred},
+		"user":  {Attestation: protocol.PreferIndirectAttestation, UserVerification: protocol.VerificationRequired},
+	}
+
+	opts := &protocol.PublicKeyCredentialCreationOptions{}
+	for _, opt := range p.RegistrationOptions([]md.Role{{Name: "user"}, {Name: "admin"}}) {
+		opt(opts)
+	}
+	assert.Equal(t, protocol.PreferDirectAttestation, opts.Attestation)
+	assert.Equal(t, protocol.VerificationRequired, opts.AuthenticatorSelection.UserVerification)
+
+	assert.Empty(t, p.RegistrationOptions([]md.Role{{Name: "guest"}}))
+}
+
+func TestPolicies_Verify(t *testing.T) {
+	blob, root := newBlob(t)
+	m := NewMDS("", root)
+	_, err := m.Update(blob)
+	require.NoError(t, err)
+
+	unknown := uuid.New()
+	p := Policies{
+		"admin": {
+			AllowedAAGUIDs:   []uuid.UUID{yubikey},
+			Attestation:      protocol.PreferDirectAttestation,
+			UserVerification: protocol.VerificationRequired,
+			RequireMetadata:  true,
+		},
+		"user": {RequireMetadata: true},
+	}
+	admin := []md.Role{{Name: "admin"}}
+
+	tests := []struct {
+		name   string
+		roles  []md.Role
+		aaguid uuid.UUID
+		format string
+		x5c    bool
+		uv     bool
+		err    error
+	}{
+		{name: "Allowed", roles: admin, aaguid: yubikey, format: "packed", x5c: true, uv: true},
+		{name: "Not in allow list", roles: admin, aaguid: unknown, format: "packed", x5c: true, uv: true, err: ErrAAGUIDNotAllowed},
+		{name: "Spoofed AAGUID with none attestation", roles: admin, aaguid: yubikey, format: "none", uv: true, err: ErrAttestationRequired},
+		{name: "Spoofed AAGUID with self attestation", roles: admin, aaguid: yubikey, format: "packed", uv: true, err: ErrAttestationRequired},
+		{name: "User not verified", roles: admin, aaguid: yubikey, format: "packed", x5c: true, err: ErrUserNotVerified},
+		{name: "Unknown authenticator", roles: []md.Role{{Name: "user"}}, aaguid: unknown, format: "none", err: ErrUnknownAuthenticator},
+		{name: "Role without policy", roles: []md.Role{{Name: "guest"}}, aaguid: unknown, format: "none"},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				cred := &webauthn.Credential{
+					AttestationType: tt.format,
+					Flags:           webauthn.CredentialFlags{UserVerified: tt.uv},
+					Authenticator:   webauthn.Authenticator{AAGUID: tt.aaguid[:]},
+					Attestation:     webauthn.CredentialAttestation{Object: attestationObject(t, tt.format, tt.x5c)},
+				}
+
+				err := p.Verify(context.Background(), m, tt.roles, cred)
+				if tt.err != nil {
+					assert.ErrorIs(t, err, tt.err)
+					assert.ErrorIs(t, err, ErrPolicyViolation)
+					return
+				}
+				assert.NoError(t, err)
+			},
+		)
+	}
+}
@@ -1,10 +1,13 @@
 package wa
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
 	"github.com/JMURv/sso/internal/config"
+	"github.com/JMURv/sso/internal/dto"
+	md "github.com/JMURv/sso/internal/models"
 	"github.com/go-webauthn/webauthn/protocol"
 	"github.com/go-webauthn/webauthn/webauthn"
 	"go.uber.org/zap"
@@ -13,35 +16,66 @@ import (
 type Port interface {
 	BeginLogin(user webauthn.User, opts ...webauthn.LoginOption) (*protocol.CredentialAssertion, *webauthn.SessionData, error)
 	FinishLogin(user webauthn.User, session webauthn.SessionData, response *http.Request) (*webauthn.Credential, error)
+	BeginDiscoverableMediatedLogin(mediation protocol.CredentialMediationRequirement, opts ...webauthn.LoginOption) (*protocol.CredentialAssertion, *webauthn.SessionData, error)
+	ValidatePasskeyLogin(handler webauthn.DiscoverableUserHandler, session webauthn.SessionData, parsedResponse *protocol.ParsedCredentialAssertionData) (webauthn.User, *webauthn.Credential, error)
 	BeginRegistration(user webauthn.User, opts ...webauthn.RegistrationOption) (creation *protocol.CredentialCreation, session *webauthn.SessionData, err error)
 	FinishRegistration(user webauthn.User, session webauthn.SessionData, response *http.Request) (*webauthn.Credential, error)
+	RegistrationOptions(roles []md.Role) []webauthn.RegistrationOption
+	VerifyPolicy(ctx context.Context, roles []md.Role, cred *webauthn.Credential) error
+	UpdateMetadata(blob []byte) (*dto.WAMetadataResponse, error)
 }
 
 type SessionType string
 
 const (
-	Login    SessionType = "login"
-	Register SessionType = "registration"
+	Login             SessionType = "login"
+	DiscoverableLogin SessionType = "discoverable"
+	Register          SessionType = "registration"
 )
 
 type WAuthn struct {
 	*webauthn.WebAuthn
+	mds      *MDS
+	policies Policies
 }
 
 func New(conf config.Config) *WAuthn {
 	origins := make([]string, 0, len(conf.Auth.WebAuthn.Origins)+1)
 	origins = append(origins, fmt.Sprintf("%v://%v", conf.Server.Scheme, conf.Server.Domain))
 	origins = append(origins, conf.Auth.WebAuthn.Origins...)
+
+	policies := Policies{}
+	if conf.Auth.WebAuthn.PolicyFile != "" {
+		var err error
+		if policies, err = LoadPolicies(conf.Auth.WebAuthn.PolicyFile); err != nil {
+			zap.L().Fatal("Failed to load WebAuthn policies", zap.String("path", conf.Auth.WebAuthn.PolicyFile), zap.Error(err))
+		}
+	}
+
+	mds := NewMDS(conf.Auth.WebAuthn.MDSFile, conf.Auth.WebAuthn.MDSRoot)
 	wa, err := webauthn.New(
 		&webauthn.Config{
 			RPDisplayName: conf.ServiceName,
 			RPID:          conf.Server.Domain,
 			RPOrigins:     origins,
+			MDS:           mds,
 		},
 	)
 	if err != nil {
 		zap.L().Fatal("Failed to initialize WebAuthn", zap.Error(err))
 	}
 
-	return &WAuthn{wa}
+	return &WAuthn{WebAuthn: wa, mds: mds, policies: policies}
+}
+
+func (w *WAuthn) RegistrationOptions(roles []md.Role) []webauthn.RegistrationOption {
+	return w.policies.RegistrationOptions(roles)
+}
+
+func (w *WAuthn) VerifyPolicy(ctx context.Context, roles []md.Role, cred *webauthn.Credential) error {
+	return w.policies.Verify(ctx, w.mds, roles, cred)
+}
+
+func (w *WAuthn) UpdateMetadata(blob []byte) (*dto.WAMetadataResponse, error) {
+	return w.mds.Update(blob)
 }
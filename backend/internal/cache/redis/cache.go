@@ -8,6 +8,7 @@ import (
 	"github.com/JMURv/sso/internal/cache"
 	"github.com/JMURv/sso/internal/config"
 	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
 	ot "github.com/opentracing/opentracing-go"
 	"go.uber.org/zap"
 )
@@ -128,6 +129,100 @@ func (c *Cache) Set(ctx context.Context, t time.Duration, key string, val any) {
 	return
 }
 
+func (c *Cache) SetNX(ctx context.Context, t time.Duration, key string, val any) (bool, error) {
+	const op = "cache.SetNX"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	ok, err := c.cli.SetNX(ctx, key, val, t).Result()
+	if err != nil {
+		span.SetTag("error", true)
+		zap.L().Error(
+			"[CACHE] --> ERROR",
+			zap.String("op", op),
+			zap.String("t", t.String()), zap.String("key", key),
+			zap.Error(err),
+		)
+		return false, err
+	}
+
+	zap.L().Info("[CACHE] --> SETNX", zap.String("key", key), zap.Bool("set", ok))
+	return ok, nil
+}
+
+// Incr increments counter, expiration is set only when counter is created, so window is fixed.
+func (c *Cache) Incr(ctx context.Context, t time.Duration, key string) (int64, error) {
+	const op = "cache.Incr"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	pipe := c.cli.TxPipeline()
+	incr := pipe.Incr(ctx, key)
+	pipe.ExpireNX(ctx, key, t)
+	if _, err := pipe.Exec(ctx); err != nil {
+		span.SetTag("error", true)
+		zap.L().Error(
+			"[CACHE] --> ERROR",
+			zap.String("op", op),
+			zap.String("t", t.String()), zap.String("key", key),
+			zap.Error(err),
+		)
+		return 0, err
+	}
+
+	zap.L().Info("[CACHE] --> INCR", zap.String("key", key), zap.Int64("val", incr.Val()))
+	return incr.Val(), nil
+}
+
+// slidingWindow keeps timestamps of accepted hits in sorted set, hits older than window are dropped
+// before counting. Redis clock is used so every instance shares the same window.
+var slidingWindow = redis.NewScript(`
+local t = redis.call('TIME')
+local now = tonumber(t[1]) * 1000 + math.floor(tonumber(t[2]) / 1000)
+local window = tonumber(ARGV[1])
+local limit = tonumber(ARGV[2])
+
+redis.call('ZREMRANGEBYSCORE', KEYS[1], '-inf', now - window)
+local count = redis.call('ZCARD', KEYS[1])
+local allowed = 0
+if count < limit then
+	redis.call('ZADD', KEYS[1], now, now .. '-' .. ARGV[3])
+	count = count + 1
+	allowed = 1
+end
+redis.call('PEXPIRE', KEYS[1], window)
+
+local reset = window
+local oldest = redis.call('ZRANGE', KEYS[1], 0, 0, 'WITHSCORES')
+if oldest[2] then
+	reset = tonumber(oldest[2]) + window - now
+end
+return {allowed, count, reset}
+`)
+
+// SlidingWindow registers hit when fewer than limit hits were accepted within window. It returns whether
+// hit was accepted, number of accepted hits within window and time until the oldest of them expires.
+func (c *Cache) SlidingWindow(ctx context.Context, key string, limit int, window time.Duration) (bool, int, time.Duration, error) {
+	const op = "cache.SlidingWindow"
+	span, ctx := ot.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := slidingWindow.Run(ctx, c.cli, []string{key}, window.Milliseconds(), limit, uuid.NewString()).Int64Slice()
+	if err != nil {
+		span.SetTag("error", true)
+		zap.L().Error(
+			"[CACHE] --> ERROR",
+			zap.String("op", op),
+			zap.String("window", window.String()), zap.String("key", key),
+			zap.Error(err),
+		)
+		return false, 0, 0, err
+	}
+
+	zap.L().Debug("[CACHE] --> SLIDING WINDOW", zap.String("key", key), zap.Int64("count", res[1]))
+	return res[0] == 1, int(res[1]), time.Duration(res[2]) * time.Millisecond, nil
+}
+
 func (c *Cache) Delete(ctx context.Context, key string) {
 	const op = "cache.Delete"
 	span, ctx := ot.StartSpanFromContext(ctx, op)
@@ -3,6 +3,7 @@ package config
 import (
 	"log"
 	"os"
+	"time"
 
 	"github.com/caarlos0/env/v9"
 	"github.com/joho/godotenv"
@@ -14,9 +15,12 @@ type Config struct {
 	Server      ServerConfig
 	Auth        authConfig
 	Email       smtpConfig
+	SMS         smsConfig
 	DB          dbConfig
 	Minio       s3Config
 	Redis       redisConfig
+	RateLimit   RateLimitConfig
+	Crypto      CryptoConfig
 	Prometheus  prometheusConfig
 	Jaeger      jaegerConfig
 }
@@ -26,11 +30,20 @@ type ServerConfig struct {
 	GRPCPort int    `env:"SERVER_GRPC_PORT" envDefault:"50050"`
 	Scheme   string `env:"SERVER_SCHEME" envDefault:"http"`
 	Domain   string `env:"SERVER_DOMAIN" envDefault:"localhost"`
+	// TrustedProxies lists CIDRs of reverse proxies allowed to set X-Forwarded-For and X-Real-IP,
+	// client address of other requests is taken from the connection.
+	TrustedProxies []string `env:"SERVER_TRUSTED_PROXIES" envSeparator:","`
 }
 
 type authConfig struct {
 	Admins []string `env:"ADMIN_USERS" envSeparator:","`
 
+	// SelfRegistration lets passwordless login create account for unknown email.
+	SelfRegistration bool `env:"SELF_REGISTRATION" envDefault:"false"`
+
+	// ReauthTime is how long after login sensitive actions are allowed without re-authentication.
+	ReauthTime time.Duration `env:"REAUTH_TIME" envDefault:"10m"`
+
 	JWT struct {
 		Secret string `env:"JWT_SECRET,required"`
 		Issuer string `env:"JWT_ISSUER,required"`
@@ -42,7 +55,10 @@ type authConfig struct {
 	} `yaml:"captcha"`
 
 	WebAuthn struct {
-		Origins []string `env:"WEBAUTHN_ORIGINS" envSeparator:","`
+		Origins    []string `env:"WEBAUTHN_ORIGINS" envSeparator:","`
+		MDSFile    string   `env:"WEBAUTHN_MDS_FILE"`
+		MDSRoot    string   `env:"WEBAUTHN_MDS_ROOT_CERT"`
+		PolicyFile string   `env:"WEBAUTHN_POLICY_FILE"`
 	} `yaml:"webauthn"`
 
 	Providers struct {
@@ -65,8 +81,151 @@ type authConfig struct {
 				RedirectURL  string   `env:"OIDC_GOOGLE_REDIRECT_URL" envDefault:""`
 				Scopes       []string `env:"OIDC_GOOGLE_SCOPES" envDefault:"" envSeparator:","`
 			} `yaml:"google"`
+
+			Apple struct {
+				ClientID       string   `env:"OIDC_APPLE_CLIENT_ID" envDefault:""`
+				TeamID         string   `env:"OIDC_APPLE_TEAM_ID" envDefault:""`
+				KeyID          string   `env:"OIDC_APPLE_KEY_ID" envDefault:""`
+				PrivateKeyFile string   `env:"OIDC_APPLE_PRIVATE_KEY_FILE" envDefault:""`
+				RedirectURL    string   `env:"OIDC_APPLE_REDIRECT_URL" envDefault:""`
+				Scopes         []string `env:"OIDC_APPLE_SCOPES" envDefault:"name,email" envSeparator:","`
+				BaseURL        string   `env:"OIDC_APPLE_BASE_URL" envDefault:"https://appleid.apple.com"`
+			} `yaml:"apple"`
+
+			Microsoft struct {
+				ClientID       string   `env:"OIDC_MICROSOFT_CLIENT_ID" envDefault:""`
+				ClientSecret   string   `env:"OIDC_MICROSOFT_CLIENT_SECRET" envDefault:""`
+				RedirectURL    string   `env:"OIDC_MICROSOFT_REDIRECT_URL" envDefault:""`
+				Scopes         []string `env:"OIDC_MICROSOFT_SCOPES" envDefault:"openid,email,profile" envSeparator:","`
+				Tenant         string   `env:"OIDC_MICROSOFT_TENANT" envDefault:"organizations"`
+				AllowedTenants []string `env:"OIDC_MICROSOFT_ALLOWED_TENANTS" envSeparator:","`
+				BaseURL        string   `env:"OIDC_MICROSOFT_BASE_URL" envDefault:"https://login.microsoftonline.com"`
+			} `yaml:"microsoft"`
 		} `yaml:"oidc"`
+
+		Mock struct {
+			Enabled           bool   `env:"MOCK_IDP_ENABLED" envDefault:"false"`
+			Issuer            string `env:"MOCK_IDP_ISSUER" envDefault:"http://localhost:8080/api/mock-idp"`
+			ClientID          string `env:"MOCK_IDP_CLIENT_ID" envDefault:"sso-dev"`
+			ClientSecret      string `env:"MOCK_IDP_CLIENT_SECRET" envDefault:"sso-dev-secret"`
+			OAuth2RedirectURL string `env:"MOCK_IDP_OAUTH2_REDIRECT_URL" envDefault:"http://localhost:8080/api/auth/oauth2/mock/callback"`
+			OIDCRedirectURL   string `env:"MOCK_IDP_OIDC_REDIRECT_URL" envDefault:"http://localhost:8080/api/auth/oidc/mock/callback"`
+			UsersFile         string `env:"MOCK_IDP_USERS_FILE"`
+		} `yaml:"mock"`
 	} `yaml:"providers"`
+
+	SAML struct {
+		EntityID  string        `env:"SAML_SP_ENTITY_ID"`
+		BaseURL   string        `env:"SAML_SP_BASE_URL"`
+		CertFile  string        `env:"SAML_SP_CERT_FILE"`
+		KeyFile   string        `env:"SAML_SP_KEY_FILE"`
+		ClockSkew time.Duration `env:"SAML_CLOCK_SKEW" envDefault:"3m"`
+	} `yaml:"saml"`
+
+	LDAP struct {
+		URL                string            `env:"LDAP_URL"`
+		StartTLS           bool              `env:"LDAP_START_TLS" envDefault:"false"`
+		InsecureSkipVerify bool              `env:"LDAP_INSECURE_SKIP_VERIFY" envDefault:"false"`
+		Timeout            time.Duration     `env:"LDAP_TIMEOUT" envDefault:"5s"`
+		BindDN             string            `env:"LDAP_BIND_DN"`
+		BindPass           string            `env:"LDAP_BIND_PASS"`
+		BaseDN             string            `env:"LDAP_BASE_DN"`
+		UserFilter         string            `env:"LDAP_USER_FILTER" envDefault:"(mail=%s)"`
+		UserDN             string            `env:"LDAP_USER_DN"`
+		Domains            []string          `env:"LDAP_DOMAINS" envSeparator:","`
+		EmailAttr          string            `env:"LDAP_EMAIL_ATTR" envDefault:"mail"`
+		NameAttr           string            `env:"LDAP_NAME_ATTR" envDefault:"cn"`
+		GroupAttr          string            `env:"LDAP_GROUP_ATTR" envDefault:"memberOf"`
+		GroupRoles         map[string]string `env:"LDAP_GROUP_ROLES"`
+	} `yaml:"ldap"`
+
+	// Password holds Argon2id parameters, memory is in KiB. Workers limits simultaneous
+	// hash computations, zero means number of CPUs. The rest is password policy,
+	// entropy is in bits and BreachedFile is list of SHA-1 hashes of breached passwords.
+	// MaxAge maps role name to how long its members may keep password, e.g. "admin:2160h".
+	Password struct {
+		Memory      uint32 `env:"PASSWORD_ARGON2_MEMORY" envDefault:"65536"`
+		Iterations  uint32 `env:"PASSWORD_ARGON2_ITERATIONS" envDefault:"3"`
+		Parallelism uint8  `env:"PASSWORD_ARGON2_PARALLELISM" envDefault:"2"`
+		Workers     int    `env:"PASSWORD_HASH_WORKERS" envDefault:"0"`
+
+		MinLength    int     `env:"PASSWORD_MIN_LENGTH" envDefault:"12"`
+		MinClasses   int     `env:"PASSWORD_MIN_CLASSES" envDefault:"0"`
+		MinEntropy   float64 `env:"PASSWORD_MIN_ENTROPY" envDefault:"50"`
+		History      int     `env:"PASSWORD_HISTORY" envDefault:"5"`
+		BreachedFile string  `env:"PASSWORD_BREACHED_FILE"`
+
+		MaxAge map[string]time.Duration `env:"PASSWORD_MAX_AGE"`
+	} `yaml:"password"`
+
+	Lockout LockoutConfig `yaml:"lockout"`
+
+	AntiEnumeration AntiEnumerationConfig `yaml:"anti_enumeration"`
+
+	Risk RiskConfig `yaml:"risk"`
+
+	OTP OTPConfig `yaml:"otp"`
+
+	TOTP struct {
+		Issuer string `env:"TOTP_ISSUER" envDefault:"SSO"`
+		Skew   uint   `env:"TOTP_SKEW" envDefault:"1"`
+	} `yaml:"totp"`
+}
+
+// LockoutConfig limits failed attempts per login method, zero disables the limit. Every failure delays
+// next attempt exponentially from BaseDelay up to MaxDelay, reaching the limit locks account or IP for Duration.
+type LockoutConfig struct {
+	PasswordAttempts int           `env:"LOCKOUT_PASSWORD_ATTEMPTS" envDefault:"10"`
+	CodeAttempts     int           `env:"LOCKOUT_CODE_ATTEMPTS" envDefault:"5"`
+	RecoveryAttempts int           `env:"LOCKOUT_RECOVERY_ATTEMPTS" envDefault:"5"`
+	TOTPAttempts     int           `env:"LOCKOUT_TOTP_ATTEMPTS" envDefault:"5"`
+	MFAAttempts      int           `env:"LOCKOUT_MFA_ATTEMPTS" envDefault:"5"`
+	IPAttempts       int           `env:"LOCKOUT_IP_ATTEMPTS" envDefault:"50"`
+	Duration         time.Duration `env:"LOCKOUT_DURATION" envDefault:"15m"`
+	BaseDelay        time.Duration `env:"LOCKOUT_BASE_DELAY" envDefault:"1s"`
+	MaxDelay         time.Duration `env:"LOCKOUT_MAX_DELAY" envDefault:"30s"`
+}
+
+// AntiEnumerationConfig makes login, code, recovery and passkey endpoints answer the same way for unknown
+// and existing accounts. Unknown accounts still pay for password comparison against dummy hash and
+// every response is delayed to at least MinDuration, so timing does not reveal whether account exists.
+type AntiEnumerationConfig struct {
+	Enabled     bool          `env:"ANTI_ENUMERATION" envDefault:"true"`
+	MinDuration time.Duration `env:"ANTI_ENUMERATION_MIN_DURATION" envDefault:"250ms"`
+}
+
+// RiskConfig scores every login from 0 to 100. Each signal adds its weight: unknown device, IP found in
+// one of blocklist files, country differing from the last login, travel faster than MaxSpeed km/h since
+// the last login and at least Failures recent failed attempts. GeoIPFile is offline CSV database with
+// "network,country,latitude,longitude" lines. Score reaching a threshold requires captcha, second factor
+// or blocks the login, zero threshold is never reached.
+type RiskConfig struct {
+	Enabled          bool     `env:"RISK_ENABLED" envDefault:"true"`
+	GeoIPFile        string   `env:"RISK_GEOIP_FILE"`
+	BlocklistFiles   []string `env:"RISK_IP_BLOCKLIST_FILES" envSeparator:","`
+	CaptchaThreshold int      `env:"RISK_CAPTCHA_THRESHOLD" envDefault:"30"`
+	MFAThreshold     int      `env:"RISK_MFA_THRESHOLD" envDefault:"50"`
+	BlockThreshold   int      `env:"RISK_BLOCK_THRESHOLD" envDefault:"80"`
+
+	NewDeviceWeight  int     `env:"RISK_NEW_DEVICE_WEIGHT" envDefault:"25"`
+	BadIPWeight      int     `env:"RISK_BAD_IP_WEIGHT" envDefault:"60"`
+	NewCountryWeight int     `env:"RISK_NEW_COUNTRY_WEIGHT" envDefault:"20"`
+	TravelWeight     int     `env:"RISK_IMPOSSIBLE_TRAVEL_WEIGHT" envDefault:"50"`
+	FailuresWeight   int     `env:"RISK_FAILURES_WEIGHT" envDefault:"20"`
+	Failures         int     `env:"RISK_FAILURES" envDefault:"3"`
+	MaxSpeed         float64 `env:"RISK_MAX_TRAVEL_SPEED" envDefault:"900"`
+}
+
+// OTPConfig describes one-time codes sent by email, SMS or voice. Codes are stored as HMAC keyed with Secret,
+// which is required and holds at least 32 bytes. Code is dropped after MaxAttempts wrong guesses,
+// new code for the same purpose and recipient is not sent until ResendCooldown passes.
+type OTPConfig struct {
+	Length         int           `env:"OTP_LENGTH" envDefault:"6"`
+	Alphabet       string        `env:"OTP_ALPHABET" envDefault:"0123456789"`
+	TTL            time.Duration `env:"OTP_TTL" envDefault:"5m"`
+	MaxAttempts    int           `env:"OTP_MAX_ATTEMPTS" envDefault:"5"`
+	ResendCooldown time.Duration `env:"OTP_RESEND_COOLDOWN" envDefault:"1m"`
+	Secret         string        `env:"OTP_SECRET,required"`
 }
 
 type smtpConfig struct {
@@ -77,6 +236,30 @@ type smtpConfig struct {
 	Admin  string `env:"EMAIL_ADMIN" envDefault:""`
 }
 
+// smsConfig turns on SMS and voice login codes, phone verification and reset links by SMS. Enabled requires
+// Gateway, log gateway is meant for tests and local development and prints message text only with LogBody.
+type smsConfig struct {
+	Enabled bool   `env:"SMS_ENABLED" envDefault:"false"`
+	Gateway string `env:"SMS_GATEWAY"`
+	LogBody bool   `env:"SMS_LOG_BODY" envDefault:"false"`
+	From    string `env:"SMS_FROM" envDefault:""`
+	File    string `env:"SMS_FILE" envDefault:"sms.jsonl"`
+	// AllowedCountries holds country calling codes without plus sign, empty list allows any number.
+	AllowedCountries []string `env:"SMS_ALLOWED_COUNTRIES" envSeparator:","`
+
+	Twilio struct {
+		AccountSID string `env:"SMS_TWILIO_ACCOUNT_SID" envDefault:""`
+		AuthToken  string `env:"SMS_TWILIO_AUTH_TOKEN" envDefault:""`
+		BaseURL    string `env:"SMS_TWILIO_BASE_URL" envDefault:"https://api.twilio.com"`
+	} `yaml:"twilio"`
+
+	Vonage struct {
+		APIKey    string `env:"SMS_VONAGE_API_KEY" envDefault:""`
+		APISecret string `env:"SMS_VONAGE_API_SECRET" envDefault:""`
+		BaseURL   string `env:"SMS_VONAGE_BASE_URL" envDefault:"https://rest.nexmo.com"`
+	} `yaml:"vonage"`
+}
+
 type dbConfig struct {
 	Host     string `env:"POSTGRES_HOST" envDefault:"localhost"`
 	Port     int    `env:"POSTGRES_PORT" envDefault:"5432"`
@@ -98,6 +281,23 @@ type redisConfig struct {
 	Pass string `env:"REDIS_PASS" envDefault:""`
 }
 
+// RateLimitConfig maps policy name to "limit/window/key", where key is one of ip, user, email or client.
+// Routes and gRPC methods refer to policies by name, requests to unknown policy are not limited.
+type RateLimitConfig struct {
+	Policies map[string]string `env:"RATE_LIMIT_POLICIES" envDefault:"users_exists:20/1m/ip,auth_jwt:20/1m/ip,auth_email_send:5/10m/email,auth_recovery_send:3/1h/email,auth_magic_link_send:5/10m/email,auth_send_ip:30/1h/ip,auth_email_check:10/10m/email,auth_check_ip:60/10m/ip,auth_recovery_check:10/10m/ip,auth_mfa_verify:10/5m/ip,auth_discover:30/1m/ip,auth_qr:10/1m/ip"`
+}
+
+// CryptoConfig holds master key which wraps data keys used to encrypt secrets stored in database.
+// Key is base64 of 32 bytes, given as is or as path to file, e.g. mounted secret, one of them is required. New data key
+// is created every Rotation, values sealed with older keys are re-encrypted by Batch rows every ReencryptInterval.
+type CryptoConfig struct {
+	MasterKey         string        `env:"CRYPTO_MASTER_KEY"`
+	MasterKeyFile     string        `env:"CRYPTO_MASTER_KEY_FILE"`
+	Rotation          time.Duration `env:"CRYPTO_KEY_ROTATION" envDefault:"2160h"`
+	ReencryptInterval time.Duration `env:"CRYPTO_REENCRYPT_INTERVAL" envDefault:"1h"`
+	Batch             int           `env:"CRYPTO_REENCRYPT_BATCH" envDefault:"500"`
+}
+
 type prometheusConfig struct {
 	Port int `env:"SERVER_PROM_PORT" envDefault:"8085"`
 }
@@ -15,3 +15,30 @@ const (
 	AccessTokenDuration  = time.Minute * 30
 	RefreshTokenDuration = time.Hour * 24 * 7
 )
+
+const LDAPNegativeCacheTime = time.Minute
+
+const (
+	MFAChallengeTime = time.Minute * 5
+	MFAMaxAttempts   = 5
+	RecoveryCodesNum = 10
+)
+
+const (
+	LoginApprovalTime     = time.Minute * 2
+	LoginApprovalInterval = time.Second * 2
+)
+
+const QRLoginTime = time.Minute * 2
+
+const PasswordResetTime = time.Minute * 30
+
+const (
+	MagicLinkTime       = time.Minute * 10
+	MagicLinkCookieName = "magic_link"
+)
+
+const (
+	SMSRateLimit  = 5
+	SMSRateWindow = time.Hour
+)
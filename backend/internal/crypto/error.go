@@ -0,0 +1,17 @@
+package crypto
+
+import "errors"
+
+var (
+	// ErrInvalidMasterKey is error that indicates master key which is not base64 of 32 bytes.
+	ErrInvalidMasterKey = errors.New("invalid master key")
+
+	// ErrUnknownKey is error that indicates value sealed with data key missing in key ring.
+	ErrUnknownKey = errors.New("unknown data key")
+
+	// ErrMalformed is error that indicates value not in enc:v<version>:<payload> format.
+	ErrMalformed = errors.New("malformed sealed value")
+
+	// ErrDecrypt is error that indicates value which does not match key or associated data.
+	ErrDecrypt = errors.New("failed to decrypt value")
+)
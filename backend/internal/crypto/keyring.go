@@ -0,0 +1,257 @@
+package crypto
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/JMURv/sso/internal/config"
+	"go.uber.org/zap"
+)
+
+const (
+	keyLen    = 32
+	prefix    = "enc:v"
+	wrapAD    = "sso:data-key"
+	formatFmt = prefix + "%d:%s"
+)
+
+// DataKey is data encryption key sealed with master key, only this form is stored.
+type DataKey struct {
+	Version   int       `db:"version"`
+	Wrapped   string    `db:"wrapped"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// Store keeps wrapped data keys, version is assigned by store and grows with every new key.
+type Store interface {
+	ListDataKeys(ctx context.Context) ([]DataKey, error)
+	CreateDataKey(ctx context.Context, wrapped string) (*DataKey, error)
+}
+
+// KeyRing does envelope encryption: values are sealed with AES-GCM under versioned data keys,
+// data keys are sealed with master key. Newest data key is active, older ones are kept to open
+// values until they are re-encrypted. Sealed value looks like enc:v<version>:<base64 of nonce and ciphertext>.
+type KeyRing struct {
+	master cipher.AEAD
+
+	mu      sync.RWMutex
+	keys    map[int]cipher.AEAD
+	active  int
+	created time.Time
+}
+
+func New(conf config.Config) *KeyRing {
+	key, err := masterKey(conf.Crypto)
+	if err != nil {
+		zap.L().Fatal("failed to load master key, CRYPTO_MASTER_KEY or CRYPTO_MASTER_KEY_FILE must be set", zap.Error(err))
+	}
+
+	master, err := newAEAD(key)
+	if err != nil {
+		zap.L().Fatal("failed to create master cipher", zap.Error(err))
+	}
+
+	return &KeyRing{
+		master: master,
+		keys:   make(map[int]cipher.AEAD),
+	}
+}
+
+func masterKey(conf config.CryptoConfig) ([]byte, error) {
+	raw := conf.MasterKey
+	if conf.MasterKeyFile != "" {
+		data, err := os.ReadFile(conf.MasterKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		raw = string(data)
+	}
+
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(raw))
+	if err != nil || len(key) != keyLen {
+		return nil, ErrInvalidMasterKey
+	}
+	return key, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// Load unwraps every data key from store, the first one is created when store is empty.
+// It is safe to call again to pick up keys created by other instances.
+func (k *KeyRing) Load(ctx context.Context, s Store) error {
+	list, err := s.ListDataKeys(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(list) == 0 {
+		_, err = k.Rotate(ctx, s)
+		return err
+	}
+
+	for i := 0; i < len(list); i++ {
+		if err = k.add(list[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Rotate creates new data key and makes it active. Values sealed with previous keys stay readable.
+func (k *KeyRing) Rotate(ctx context.Context, s Store) (int, error) {
+	key := make([]byte, keyLen)
+	if _, err := rand.Read(key); err != nil {
+		return 0, err
+	}
+
+	nonce := make([]byte, k.master.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return 0, err
+	}
+
+	wrapped := base64.StdEncoding.EncodeToString(k.master.Seal(nonce, nonce, key, []byte(wrapAD)))
+	dk, err := s.CreateDataKey(ctx, wrapped)
+	if err != nil {
+		return 0, err
+	}
+
+	if err = k.add(*dk); err != nil {
+		return 0, err
+	}
+
+	zap.L().Info("data key has been created", zap.Int("version", dk.Version))
+	return dk.Version, nil
+}
+
+func (k *KeyRing) add(dk DataKey) error {
+	raw, err := base64.StdEncoding.DecodeString(dk.Wrapped)
+	ns := k.master.NonceSize()
+	if err != nil || len(raw) < ns {
+		return fmt.Errorf("%w: data key v%d", ErrMalformed, dk.Version)
+	}
+
+	key, err := k.master.Open(nil, raw[:ns], raw[ns:], []byte(wrapAD))
+	if err != nil {
+		return fmt.Errorf("%w: data key v%d, master key may be wrong", ErrDecrypt, dk.Version)
+	}
+
+	aead, err := newAEAD(key)
+	if err != nil {
+		return err
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	k.keys[dk.Version] = aead
+	if dk.Version > k.active {
+		k.active = dk.Version
+		k.created = dk.CreatedAt
+	}
+	return nil
+}
+
+// Active returns version of data key new values are sealed with and when it was created.
+func (k *KeyRing) Active() (int, time.Time) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	return k.active, k.created
+}
+
+// Encrypt seals value with active data key. Associated data binds value to its place,
+// e.g. table, column and row, so sealed value copied elsewhere cannot be opened.
+func (k *KeyRing) Encrypt(plain []byte, ad string) (string, error) {
+	k.mu.RLock()
+	version, aead := k.active, k.keys[k.active]
+	k.mu.RUnlock()
+
+	if aead == nil {
+		return "", ErrUnknownKey
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	out := aead.Seal(nonce, nonce, plain, []byte(ad))
+	return fmt.Sprintf(formatFmt, version, base64.RawURLEncoding.EncodeToString(out)), nil
+}
+
+// Decrypt opens value sealed by Encrypt with the same associated data.
+func (k *KeyRing) Decrypt(sealed, ad string) ([]byte, error) {
+	version, payload, ok := parse(sealed)
+	if !ok {
+		return nil, ErrMalformed
+	}
+
+	k.mu.RLock()
+	aead := k.keys[version]
+	k.mu.RUnlock()
+
+	if aead == nil {
+		return nil, fmt.Errorf("%w: v%d", ErrUnknownKey, version)
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(payload)
+	ns := aead.NonceSize()
+	if err != nil || len(raw) < ns {
+		return nil, ErrMalformed
+	}
+
+	plain, err := aead.Open(nil, raw[:ns], raw[ns:], []byte(ad))
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+	return plain, nil
+}
+
+// Version returns data key version value is sealed with, values stored before encryption was enabled have none.
+func Version(sealed string) (int, bool) {
+	version, _, ok := parse(sealed)
+	return version, ok
+}
+
+// IsSealed reports whether value has been sealed by KeyRing.
+func IsSealed(val string) bool {
+	_, ok := Version(val)
+	return ok
+}
+
+func parse(sealed string) (int, string, bool) {
+	if !strings.HasPrefix(sealed, prefix) {
+		return 0, "", false
+	}
+
+	ver, payload, ok := strings.Cut(sealed[len(prefix):], ":")
+	if !ok {
+		return 0, "", false
+	}
+
+	version, err := strconv.Atoi(ver)
+	if err != nil || version <= 0 {
+		return 0, "", false
+	}
+	return version, payload, true
+}
+
+// SealedPrefix is prefix of values sealed with given key version, handy for LIKE queries.
+func SealedPrefix(version int) string {
+	return fmt.Sprintf(formatFmt, version, "")
+}
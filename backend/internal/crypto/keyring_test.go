@@ -0,0 +1,136 @@
+package crypto
+
+import (
+	"context"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/JMURv/sso/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memStore struct {
+	keys []DataKey
+}
+
+func (s *memStore) ListDataKeys(_ context.Context) ([]DataKey, error) {
+	return s.keys, nil
+}
+
+func (s *memStore) CreateDataKey(_ context.Context, wrapped string) (*DataKey, error) {
+	dk := DataKey{Version: len(s.keys) + 1, Wrapped: wrapped, CreatedAt: time.Now()}
+	s.keys = append(s.keys, dk)
+	return &dk, nil
+}
+
+func newKeyRing(t *testing.T, s Store) *KeyRing {
+	t.Helper()
+
+	conf := config.Config{}
+	conf.Crypto.MasterKey = base64.StdEncoding.EncodeToString([]byte(strings.Repeat("k", keyLen)))
+	k := New(conf)
+	require.NoError(t, k.Load(context.Background(), s))
+	return k
+}
+
+func TestKeyRing_EncryptDecrypt(t *testing.T) {
+	k := newKeyRing(t, &memStore{})
+
+	sealed, err := k.Encrypt([]byte("secret"), "user_totp.secret:1")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(sealed, "enc:v1:"))
+	assert.NotContains(t, sealed, "secret")
+
+	plain, err := k.Decrypt(sealed, "user_totp.secret:1")
+	require.NoError(t, err)
+	assert.Equal(t, "secret", string(plain))
+
+	t.Run("Other associated data", func(t *testing.T) {
+		_, err := k.Decrypt(sealed, "user_totp.secret:2")
+		assert.ErrorIs(t, err, ErrDecrypt)
+	})
+
+	t.Run("Malformed", func(t *testing.T) {
+		_, err := k.Decrypt("plain value", "")
+		assert.ErrorIs(t, err, ErrMalformed)
+
+		_, err = k.Decrypt("enc:v1:!!!", "")
+		assert.ErrorIs(t, err, ErrMalformed)
+	})
+
+	t.Run("Unknown key", func(t *testing.T) {
+		_, err := k.Decrypt(strings.Replace(sealed, "enc:v1:", "enc:v7:", 1), "user_totp.secret:1")
+		assert.ErrorIs(t, err, ErrUnknownKey)
+	})
+}
+
+func TestKeyRing_Rotate(t *testing.T) {
+	ctx := context.Background()
+	s := &memStore{}
+	k := newKeyRing(t, s)
+
+	old, err := k.Encrypt([]byte("token"), "ad")
+	require.NoError(t, err)
+
+	version, err := k.Rotate(ctx, s)
+	require.NoError(t, err)
+	assert.Equal(t, 2, version)
+
+	active, _ := k.Active()
+	assert.Equal(t, 2, active)
+
+	sealed, err := k.Encrypt([]byte("token"), "ad")
+	require.NoError(t, err)
+	v, ok := Version(sealed)
+	assert.True(t, ok)
+	assert.Equal(t, 2, v)
+
+	plain, err := k.Decrypt(old, "ad")
+	require.NoError(t, err)
+	assert.Equal(t, "token", string(plain))
+
+	t.Run("Other instance", func(t *testing.T) {
+		other := newKeyRing(t, s)
+		active, _ := other.Active()
+		assert.Equal(t, 2, active)
+
+		plain, err := other.Decrypt(old, "ad")
+		require.NoError(t, err)
+		assert.Equal(t, "token", string(plain))
+	})
+
+	t.Run("Wrong master key", func(t *testing.T) {
+		conf := config.Config{}
+		conf.Crypto.MasterKey = base64.StdEncoding.EncodeToString([]byte(strings.Repeat("x", keyLen)))
+		assert.ErrorIs(t, New(conf).Load(ctx, s), ErrDecrypt)
+	})
+}
+
+func TestMasterKey(t *testing.T) {
+	key := base64.StdEncoding.EncodeToString([]byte(strings.Repeat("k", keyLen)))
+	path := filepath.Join(t.TempDir(), "master.key")
+	require.NoError(t, os.WriteFile(path, []byte(key+"\n"), 0o600))
+
+	res, err := masterKey(config.CryptoConfig{MasterKeyFile: path})
+	require.NoError(t, err)
+	assert.Len(t, res, keyLen)
+
+	_, err = masterKey(config.CryptoConfig{MasterKey: "c2hvcnQ="})
+	assert.ErrorIs(t, err, ErrInvalidMasterKey)
+}
+
+func TestVersion(t *testing.T) {
+	v, ok := Version("enc:v12:payload")
+	assert.True(t, ok)
+	assert.Equal(t, 12, v)
+
+	for _, val := range []string{"", "plain", "enc:v0:x", "enc:vx:x", "enc:v3"} {
+		assert.False(t, IsSealed(val), val)
+	}
+	assert.Equal(t, "enc:v3:", SealedPrefix(3))
+}
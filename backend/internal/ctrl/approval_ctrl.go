@@ -0,0 +1,245 @@
+package ctrl
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/JMURv/sso/internal/auth"
+	"github.com/JMURv/sso/internal/config"
+	"github.com/JMURv/sso/internal/dto"
+	md "github.com/JMURv/sso/internal/models"
+	"github.com/JMURv/sso/internal/repo"
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+)
+
+const (
+	approvalKey     = "approval:%s"
+	approvalUserKey = "approval:user:%s"
+	approvalUsedKey = "approval:used:%s"
+)
+
+const (
+	approvalPending  = "pending"
+	approvalApproved = "approved"
+	approvalDenied   = "denied"
+)
+
+const approvalChoicesNum = 3
+
+type loginApproval struct {
+	UserID    uuid.UUID `json:"user_id"`
+	Device    md.Device `json:"device"`
+	Number    int       `json:"number"`
+	Choices   []int     `json:"choices"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// requestLoginApproval parks login from unknown device until one of trusted devices approves it.
+// New device gets secret id to poll with, trusted devices see only its hash.
+// There is at most one pending request per user, new one replaces the previous.
+func (c *Controller) requestLoginApproval(ctx context.Context, d *dto.DeviceRequest, u *md.User, trusted int) (*dto.TokenPair, error) {
+	const op = "approval.requestLoginApproval.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	if trusted == 0 {
+		return nil, ErrNoTrustedDevice
+	}
+
+	id, err := randomToken()
+	if err != nil {
+		return nil, err
+	}
+
+	choices, err := approvalChoices()
+	if err != nil {
+		return nil, err
+	}
+
+	idx, err := rand.Int(rand.Reader, big.NewInt(approvalChoicesNum))
+	if err != nil {
+		return nil, err
+	}
+
+	bytes, err := json.Marshal(
+		loginApproval{
+			UserID:    u.ID,
+			Device:    auth.GenerateDevice(d),
+			Number:    choices[idx.Int64()],
+			Choices:   choices,
+			Status:    approvalPending,
+			CreatedAt: time.Now(),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	ref := hashToken(id)
+	c.cache.Set(ctx, config.LoginApprovalTime, fmt.Sprintf(approvalKey, ref), bytes)
+	c.cache.Set(ctx, config.LoginApprovalTime, fmt.Sprintf(approvalUserKey, u.ID), ref)
+
+	return &dto.TokenPair{
+		Approval: &dto.LoginApproval{
+			ID:     id,
+			Number: choices[idx.Int64()],
+		},
+	}, nil
+}
+
+// ListLoginApprovals returns logins waiting for approval from signed-in device.
+func (c *Controller) ListLoginApprovals(ctx context.Context, uid uuid.UUID) ([]dto.PendingLogin, error) {
+	const op = "approval.ListLoginApprovals.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res := make([]dto.PendingLogin, 0, 1)
+	ref := c.cache.GetStr(ctx, fmt.Sprintf(approvalUserKey, uid))
+	if ref == "" {
+		return res, nil
+	}
+
+	a := &loginApproval{}
+	if err := c.cache.GetToStruct(ctx, fmt.Sprintf(approvalKey, ref), a); err != nil || a.Status != approvalPending {
+		return res, nil
+	}
+
+	return append(
+		res, dto.PendingLogin{
+			ID:         ref,
+			DeviceType: a.Device.DeviceType,
+			OS:         a.Device.OS,
+			Browser:    a.Device.Browser,
+			IP:         a.Device.IP,
+			Choices:    a.Choices,
+			CreatedAt:  a.CreatedAt,
+		},
+	), nil
+}
+
+// ApproveLogin resolves pending login. Picking wrong number denies it,
+// so login cannot be approved by blindly tapping the prompt.
+func (c *Controller) ApproveLogin(ctx context.Context, uid uuid.UUID, id string, req *dto.ApproveLoginRequest) error {
+	const op = "approval.ApproveLogin.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	key := fmt.Sprintf(approvalKey, id)
+	a := &loginApproval{}
+	if err := c.cache.GetToStruct(ctx, key, a); err != nil {
+		return ErrNotFound
+	}
+
+	if a.UserID != uid || a.Status != approvalPending {
+		return ErrNotFound
+	}
+
+	var err error
+	switch {
+	case !req.Approve:
+		a.Status = approvalDenied
+	case req.Number != a.Number:
+		zap.L().Debug(
+			"login approval number mismatch",
+			zap.String("op", op),
+			zap.String("userID", uid.String()),
+		)
+		a.Status = approvalDenied
+		err = ErrCodeIsNotValid
+	default:
+		a.Status = approvalApproved
+	}
+
+	bytes, mErr := json.Marshal(a)
+	if mErr != nil {
+		return mErr
+	}
+
+	c.cache.Set(ctx, config.LoginApprovalTime, key, bytes)
+	c.cache.Delete(ctx, fmt.Sprintf(approvalUserKey, uid))
+	return err
+}
+
+// CheckLoginApproval is polled by the new device, tokens are issued once after approval.
+func (c *Controller) CheckLoginApproval(ctx context.Context, d *dto.DeviceRequest, id string) (*dto.TokenPair, error) {
+	const op = "approval.CheckLoginApproval.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	ref := hashToken(id)
+	key := fmt.Sprintf(approvalKey, ref)
+
+	a := &loginApproval{}
+	if err := c.cache.GetToStruct(ctx, key, a); err != nil {
+		return nil, ErrNotFound
+	}
+
+	if a.Device.ID != auth.GenerateDevice(d).ID {
+		zap.L().Debug(
+			"login approval polled from another device",
+			zap.String("op", op),
+			zap.String("userID", a.UserID.String()),
+		)
+		return nil, ErrNotFound
+	}
+
+	switch a.Status {
+	case approvalPending:
+		return nil, ErrApprovalPending
+	case approvalDenied:
+		c.cache.Delete(ctx, key)
+		return nil, ErrApprovalDenied
+	}
+
+	ok, err := c.cache.SetNX(ctx, config.LoginApprovalTime, fmt.Sprintf(approvalUsedKey, ref), 1)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		return nil, ErrCodeReused
+	}
+	c.cache.Delete(ctx, key)
+
+	u, err := c.repo.GetUserByID(ctx, a.UserID)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	pair, err := c.loginPair(ctx, d, u, md.AMRPassword, md.AMRMultiChannel, md.AMRMultiFactor)
+	if err != nil {
+		return nil, err
+	}
+	return &pair, nil
+}
+
+// approvalChoices returns distinct two-digit numbers to pick from on trusted device.
+func approvalChoices() ([]int, error) {
+	res := make([]int, 0, approvalChoicesNum)
+	for len(res) < approvalChoicesNum {
+		n, err := rand.Int(rand.Reader, big.NewInt(90))
+		if err != nil {
+			return nil, err
+		}
+
+		v, dup := int(n.Int64())+10, false
+		for i := 0; i < len(res); i++ {
+			dup = dup || res[i] == v
+		}
+
+		if !dup {
+			res = append(res, v)
+		}
+	}
+	return res, nil
+}
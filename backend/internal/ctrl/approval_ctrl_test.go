@@ -0,0 +1,219 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/JMURv/sso/internal/auth"
+	"github.com/JMURv/sso/internal/config"
+	"github.com/JMURv/sso/internal/dto"
+	md "github.com/JMURv/sso/internal/models"
+	"github.com/JMURv/sso/tests/mocks"
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestController_ApproveLogin(t *testing.T) {
+	mock := gomock.NewController(t)
+
+	mcache := mocks.NewMockCacheService(mock)
+	c := New(mocks.NewMockAppRepo(mock), mocks.NewMockCore(mock), mcache, nil, nil, nil)
+
+	ctx := context.Background()
+	uid := uuid.New()
+	ref := hashToken("id")
+	key := fmt.Sprintf(approvalKey, ref)
+	pending := loginApproval{UserID: uid, Number: 42, Choices: []int{17, 42, 93}, Status: approvalPending}
+
+	stored := func(a loginApproval) {
+		mcache.EXPECT().GetToStruct(gomock.Any(), key, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, dest any) error {
+				*dest.(*loginApproval) = a
+				return nil
+			},
+		)
+	}
+	resolved := func(status string) {
+		mcache.EXPECT().Set(gomock.Any(), config.LoginApprovalTime, key, gomock.Any()).Do(
+			func(_ context.Context, _ time.Duration, _ string, val any) {
+				a := &loginApproval{}
+				require.NoError(t, json.Unmarshal(val.([]byte), a))
+				assert.Equal(t, status, a.Status)
+			},
+		)
+		mcache.EXPECT().Delete(gomock.Any(), fmt.Sprintf(approvalUserKey, uid))
+	}
+
+	tests := []struct {
+		name   string
+		uid    uuid.UUID
+		req    *dto.ApproveLoginRequest
+		expect func()
+		err    error
+	}{
+		{
+			name: "Right number approves",
+			uid:  uid,
+			req:  &dto.ApproveLoginRequest{Approve: true, Number: 42},
+			expect: func() {
+				stored(pending)
+				resolved(approvalApproved)
+			},
+		},
+		{
+			name: "Wrong number denies",
+			uid:  uid,
+			req:  &dto.ApproveLoginRequest{Approve: true, Number: 17},
+			expect: func() {
+				stored(pending)
+				resolved(approvalDenied)
+			},
+			err: ErrCodeIsNotValid,
+		},
+		{
+			name: "Rejected",
+			uid:  uid,
+			req:  &dto.ApproveLoginRequest{Approve: false},
+			expect: func() {
+				stored(pending)
+				resolved(approvalDenied)
+			},
+		},
+		{
+			name: "Another user",
+			uid:  uuid.New(),
+			req:  &dto.ApproveLoginRequest{Approve: true, Number: 42},
+			expect: func() {
+				stored(pending)
+			},
+			err: ErrNotFound,
+		},
+		{
+			name: "Already resolved",
+			uid:  uid,
+			req:  &dto.ApproveLoginRequest{Approve: true, Number: 42},
+			expect: func() {
+				a := pending
+				a.Status = approvalDenied
+				stored(a)
+			},
+			err: ErrNotFound,
+		},
+		{
+			name: "Expired",
+			uid:  uid,
+			req:  &dto.ApproveLoginRequest{Approve: true, Number: 42},
+			expect: func() {
+				mcache.EXPECT().GetToStruct(gomock.Any(), key, gomock.Any()).Return(errors.New("miss"))
+			},
+			err: ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				assert.ErrorIs(t, c.ApproveLogin(ctx, tt.uid, ref, tt.req), tt.err)
+			},
+		)
+	}
+}
+
+func TestController_CheckLoginApproval(t *testing.T) {
+	mock := gomock.NewController(t)
+
+	mrepo := mocks.NewMockAppRepo(mock)
+	mau := mocks.NewMockCore(mock)
+	mcache := mocks.NewMockCacheService(mock)
+	c := New(mrepo, mau, mcache, nil, nil, nil)
+
+	ctx := context.Background()
+	d := &dto.DeviceRequest{IP: "127.0.0.1", UA: "test"}
+	u := &md.User{ID: uuid.New()}
+	id := "id"
+	ref := hashToken(id)
+	key := fmt.Sprintf(approvalKey, ref)
+
+	stored := func(status string, device md.Device) {
+		mcache.EXPECT().GetToStruct(gomock.Any(), key, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, dest any) error {
+				*dest.(*loginApproval) = loginApproval{UserID: u.ID, Device: device, Number: 42, Status: status}
+				return nil
+			},
+		)
+	}
+	redeem := func(ok bool) {
+		mcache.EXPECT().SetNX(gomock.Any(), config.LoginApprovalTime, fmt.Sprintf(approvalUsedKey, ref), 1).Return(ok, nil)
+	}
+
+	tests := []struct {
+		name   string
+		expect func()
+		err    error
+	}{
+		{
+			name: "Another device",
+			expect: func() {
+				stored(approvalApproved, auth.GenerateDevice(&dto.DeviceRequest{IP: "10.0.0.1", UA: "other"}))
+			},
+			err: ErrNotFound,
+		},
+		{
+			name: "Pending",
+			expect: func() {
+				stored(approvalPending, auth.GenerateDevice(d))
+			},
+			err: ErrApprovalPending,
+		},
+		{
+			name: "Denied",
+			expect: func() {
+				stored(approvalDenied, auth.GenerateDevice(d))
+				mcache.EXPECT().Delete(gomock.Any(), key)
+			},
+			err: ErrApprovalDenied,
+		},
+		{
+			name: "Approved",
+			expect: func() {
+				stored(approvalApproved, auth.GenerateDevice(d))
+				redeem(true)
+				mcache.EXPECT().Delete(gomock.Any(), key)
+				mrepo.EXPECT().GetUserByID(gomock.Any(), u.ID).Return(u, nil).Times(2)
+				mau.EXPECT().RiskEnabled().Return(false)
+				mau.EXPECT().PasswordMaxAge(gomock.Any()).Return(time.Duration(0))
+				mau.EXPECT().GenPair(gomock.Any(), u.ID, gomock.Any(), gomock.Any()).Return("access", "refresh", nil)
+				mau.EXPECT().GetRefreshTime().Return(time.Now().Add(time.Hour))
+				mrepo.EXPECT().CreateToken(gomock.Any(), u.ID, "refresh", gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "Second redemption",
+			expect: func() {
+				stored(approvalApproved, auth.GenerateDevice(d))
+				redeem(false)
+			},
+			err: ErrCodeReused,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				res, err := c.CheckLoginApproval(ctx, d, id)
+				assert.ErrorIs(t, err, tt.err)
+				if tt.err == nil {
+					assert.Equal(t, "access", res.Access)
+				}
+			},
+		)
+	}
+}
@@ -2,43 +2,56 @@ package ctrl
 
 import (
 	"context"
+	"crypto/subtle"
 	"errors"
 	"fmt"
-	"math/rand"
-	"strconv"
 	"time"
 
 	"github.com/JMURv/sso/internal/auth"
 	"github.com/JMURv/sso/internal/auth/jwt"
-	"github.com/JMURv/sso/internal/cache"
 	"github.com/JMURv/sso/internal/config"
 	"github.com/JMURv/sso/internal/dto"
 	md "github.com/JMURv/sso/internal/models"
 	"github.com/JMURv/sso/internal/repo"
+	"github.com/goccy/go-json"
 	"github.com/google/uuid"
 	"github.com/opentracing/opentracing-go"
 	"go.uber.org/zap"
 )
 
 const (
-	codeCacheKey     = "code:%v"
-	recoveryCacheKey = "recovery:%v"
+	resetKey     = "reset:%s"
+	resetUserKey = "reset:user:%s"
+	resetUsedKey = "reset:used:%s"
 )
 
+// passwordReset binds reset token to the password hash it was issued for,
+// so changing password invalidates outstanding tokens.
+type passwordReset struct {
+	UserID   uuid.UUID `json:"user_id"`
+	Password string    `json:"password"`
+}
+
 type authRepo interface {
 	CreateToken(ctx context.Context, userID uuid.UUID, hashedT string, expiresAt time.Time, device *md.Device) error
 	IsTokenValid(ctx context.Context, userID uuid.UUID, d *md.Device, token string) (bool, error)
 	RevokeAllTokens(ctx context.Context, userID uuid.UUID) error
 }
 
-func (c *Controller) GenPair(ctx context.Context, d *dto.DeviceRequest, uid uuid.UUID, p []md.Role) (dto.TokenPair, error) {
+// GenPair issues tokens for user who has just proven identity with given methods.
+func (c *Controller) GenPair(ctx context.Context, d *dto.DeviceRequest, uid uuid.UUID, p []md.Role, amr ...string) (dto.TokenPair, error) {
 	const op = "auth.GenPair.ctrl"
 	span, ctx := opentracing.StartSpanFromContext(ctx, op)
 	defer span.Finish()
 
 	var res dto.TokenPair
 
-	access, refresh, err := c.au.GenPair(ctx, uid, p)
+	restricted, err := c.passwordChangeRequired(ctx, uid, p, amr)
+	if err != nil {
+		return res, err
+	}
+
+	access, refresh, err := c.au.GenPair(ctx, uid, p, jwt.AuthInfo{AMR: amr, Time: time.Now(), PasswordChange: restricted})
 	if err != nil {
 		return res, err
 	}
@@ -50,6 +63,7 @@ func (c *Controller) GenPair(ctx context.Context, d *dto.DeviceRequest, uid uuid
 
 	res.Access = access
 	res.Refresh = refresh
+	res.PasswordChange = restricted
 
 	return res, nil
 }
@@ -58,27 +72,14 @@ func (c *Controller) Authenticate(ctx context.Context, d *dto.DeviceRequest, req
 	const op = "auth.Authenticate.ctrl"
 	span, ctx := opentracing.StartSpanFromContext(ctx, op)
 	defer span.Finish()
+	defer c.equalize(ctx, time.Now())
 
-	res, err := c.repo.GetUserByEmail(ctx, req.Email)
-	if err != nil && errors.Is(err, repo.ErrNotFound) {
-		return nil, ErrNotFound
-	} else if err != nil {
-		return nil, err
-	}
-
-	if err = c.au.ComparePasswords([]byte(res.Password), []byte(req.Password)); err != nil {
-		return nil, auth.ErrInvalidCredentials
-	}
-
-	pair, err := c.GenPair(ctx, d, res.ID, res.Roles)
+	res, err := c.checkCredentials(ctx, d, req.Email, req.Password)
 	if err != nil {
 		return nil, err
 	}
 
-	return &dto.TokenPair{
-		Access:  pair.Access,
-		Refresh: pair.Refresh,
-	}, nil
+	return c.completeLogin(ctx, d, res, md.AMRPassword)
 }
 
 func (c *Controller) Refresh(ctx context.Context, d *dto.DeviceRequest, req *dto.RefreshRequest) (*dto.TokenPair, error) {
@@ -107,7 +108,14 @@ func (c *Controller) Refresh(ctx context.Context, d *dto.DeviceRequest, req *dto
 		return nil, auth.ErrTokenRevoked
 	}
 
-	access, refresh, err := c.au.GenPair(ctx, claims.UID, claims.Roles)
+	// password may have expired since login, so restriction is evaluated again for every new pair
+	info := claims.Info()
+	info.PasswordChange, err = c.passwordChangeRequired(ctx, claims.UID, claims.Roles, claims.AMR)
+	if err != nil {
+		return nil, err
+	}
+
+	access, refresh, err := c.au.GenPair(ctx, claims.UID, claims.Roles, info)
 	if err != nil {
 		return nil, err
 	}
@@ -122,8 +130,9 @@ func (c *Controller) Refresh(ctx context.Context, d *dto.DeviceRequest, req *dto
 	}
 
 	return &dto.TokenPair{
-		Access:  access,
-		Refresh: refresh,
+		Access:         access,
+		Refresh:        refresh,
+		PasswordChange: info.PasswordChange,
 	}, nil
 }
 
@@ -152,123 +161,217 @@ func (c *Controller) Logout(ctx context.Context, uid uuid.UUID) error {
 	return nil
 }
 
-func (c *Controller) CheckForgotPasswordEmail(ctx context.Context, req *dto.CheckForgotPasswordEmailRequest) error {
+// CheckForgotPasswordEmail sets new password with reset token. Token works once and only while it is the latest
+// one issued for the user and the password it was issued for has not been changed since.
+func (c *Controller) CheckForgotPasswordEmail(ctx context.Context, d *dto.DeviceRequest, req *dto.CheckForgotPasswordEmailRequest) error {
 	const op = "auth.CheckForgotPasswordEmail.ctrl"
 	span, ctx := opentracing.StartSpanFromContext(ctx, op)
 	defer span.Finish()
 
-	storedCode, err := c.cache.GetInt(ctx, fmt.Sprintf(recoveryCacheKey, req.ID))
-	if err != nil {
+	hash := hashToken(req.Token)
+	key := fmt.Sprintf(resetKey, hash)
+	reset := &passwordReset{}
+	if err := c.cache.GetToStruct(ctx, key, reset); err != nil {
+		return ErrNotFound
+	}
+
+	var latest string
+	userKey := fmt.Sprintf(resetUserKey, reset.UserID)
+	if err := c.cache.GetToStruct(ctx, userKey, &latest); err != nil || latest != hash {
+		c.cache.Delete(ctx, key)
+		return ErrNotFound
+	}
+
+	u, err := c.repo.GetUserByID(ctx, reset.UserID)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		return ErrNotFound
+	} else if err != nil {
 		return err
 	}
 
-	if storedCode != req.Code {
+	if err = c.checkLockout(ctx, lockoutRecovery, u.Email, d.IP); err != nil {
+		return err
+	}
+
+	if subtle.ConstantTimeCompare([]byte(reset.Password), []byte(hashToken(u.Password))) != 1 {
 		zap.L().Debug(
-			"codes don't match",
+			"password changed after reset token was issued",
 			zap.String("op", op),
-			zap.Int("stored code", storedCode),
-			zap.Int("request code", req.Code),
+			zap.String("userID", u.ID.String()),
 		)
 
+		c.registerFailure(ctx, lockoutRecovery, u.Email, d.IP)
+		c.cache.Delete(ctx, key)
 		return ErrCodeIsNotValid
 	}
 
-	u, err := c.repo.GetUserByID(ctx, req.ID)
-	if err != nil && errors.Is(err, repo.ErrNotFound) {
-		return ErrNotFound
-	} else if err != nil {
+	if err = c.checkPassword(ctx, u.ID, req.Password, u.Name, u.Email); err != nil {
 		return err
 	}
 
+	ok, err := c.cache.SetNX(ctx, config.PasswordResetTime, fmt.Sprintf(resetUsedKey, hash), 1)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrCodeReused
+	}
+	c.cache.Delete(ctx, key)
+	c.cache.Delete(ctx, userKey)
+	c.resetFailures(ctx, lockoutRecovery, u.Email)
+
 	newPass, err := c.au.Hash(req.Password)
 	if err != nil {
 		return err
 	}
 
 	if err = c.repo.UpdateMe(
-		ctx, req.ID, &dto.UpdateUserRequest{
+		ctx, u.ID, &dto.UpdateUserRequest{
 			Name:     u.Name,
 			Email:    u.Email,
 			Password: newPass,
 			Avatar:   u.Avatar,
+			IsActive: u.IsActive,
+			IsEmail:  u.IsEmailVerified,
 		},
 	); err != nil {
 		return err
 	}
 
+	c.rememberPassword(ctx, u.ID, newPass)
 	if err = c.repo.RevokeAllTokens(ctx, u.ID); err != nil {
 		return err
 	}
 
-	c.cache.Delete(ctx, fmt.Sprintf(userCacheKey, req.ID))
-
+	c.cache.Delete(ctx, fmt.Sprintf(userCacheKey, u.ID))
+	go c.smtp.SendPasswordChangedEmail(ctx, u.Email, d.IP)
 	return nil
 }
 
-func (c *Controller) SendForgotPasswordEmail(ctx context.Context, email string) error {
+// SendForgotPasswordEmail sends password reset link, previously issued links stop working.
+func (c *Controller) SendForgotPasswordEmail(ctx context.Context, email, channel string) error {
 	const op = "auth.SendForgotPasswordEmail.ctrl"
 	span, ctx := opentracing.StartSpanFromContext(ctx, op)
 	defer span.Finish()
+	defer c.equalize(ctx, time.Now())
 
+	if channel == md.ChannelSMS && !c.sms.Enabled() {
+		return ErrSMSDisabled
+	}
+
+	// Unknown account and account without phone look like successfully sent link.
+	hide := c.au.AntiEnumeration().Enabled
 	res, err := c.repo.GetUserByEmail(ctx, email)
 	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		if hide {
+			return nil
+		}
 		return auth.ErrInvalidCredentials
 	} else if err != nil {
 		return err
 	}
 
-	rand.New(rand.NewSource(time.Now().UnixNano()))
-	code := rand.Intn(9999-1000+1) + 1000
+	if channel == md.ChannelSMS {
+		if res.Phone == "" {
+			if hide {
+				return nil
+			}
+			return ErrPhoneNotVerified
+		}
+		if err = c.allowSMS(ctx, res.Phone); err != nil {
+			return err
+		}
+	}
+
+	token, err := randomToken()
+	if err != nil {
+		return err
+	}
+
+	hash := hashToken(token)
+	bytes, err := json.Marshal(passwordReset{UserID: res.ID, Password: hashToken(res.Password)})
+	if err != nil {
+		return err
+	}
 
-	go c.smtp.SendForgotPasswordEmail(ctx, strconv.Itoa(code), res.ID.String(), email)
+	latest, err := json.Marshal(hash)
+	if err != nil {
+		return err
+	}
 
-	c.cache.Set(ctx, config.MinCacheTime, fmt.Sprintf(recoveryCacheKey, res.ID.String()), code)
+	c.cache.Set(ctx, config.PasswordResetTime, fmt.Sprintf(resetKey, hash), bytes)
+	c.cache.Set(ctx, config.PasswordResetTime, fmt.Sprintf(resetUserKey, res.ID), latest)
 
+	if channel == md.ChannelSMS {
+		go c.sms.SendForgotPasswordSMS(ctx, res.Phone, token)
+	} else {
+		go c.smtp.SendForgotPasswordEmail(ctx, token, email)
+	}
 	return nil
 }
 
-func (c *Controller) SendLoginCode(ctx context.Context, d *dto.DeviceRequest, email, password string) (dto.TokenPair, error) {
+func (c *Controller) SendLoginCode(ctx context.Context, d *dto.DeviceRequest, email, password, channel string) (dto.TokenPair, error) {
 	const op = "auth.SendLoginCode.ctrl"
 	span, ctx := opentracing.StartSpanFromContext(ctx, op)
 	defer span.Finish()
+	defer c.equalize(ctx, time.Now())
 
 	var tokens dto.TokenPair
-	res, err := c.repo.GetUserByEmail(ctx, email)
-	if err != nil && errors.Is(err, repo.ErrNotFound) {
+	if (channel == md.ChannelSMS || channel == md.ChannelVoice) && !c.sms.Enabled() {
+		return tokens, ErrSMSDisabled
+	}
+
+	res, err := c.checkCredentials(ctx, d, email, password)
+	if err != nil && errors.Is(err, ErrNotFound) {
 		return tokens, auth.ErrInvalidCredentials
 	} else if err != nil {
 		return tokens, err
 	}
 
-	if err = c.au.ComparePasswords([]byte(res.Password), []byte(password)); err != nil {
+	device := auth.GenerateDevice(d)
+	devs, err := c.repo.ListDevices(ctx, res.ID)
+	if err != nil {
 		return tokens, err
 	}
 
-	device := auth.GenerateDevice(d)
-	devs, err := c.repo.ListDevices(ctx, res.ID)
 	for i := 0; i < len(devs); i++ {
 		if devs[i].ID == device.ID {
-			access, refresh, err := c.au.GenPair(ctx, res.ID, res.Roles)
-			if err != nil {
-				return tokens, ErrWhileGeneratingToken
-			}
-
-			err = c.repo.CreateToken(ctx, res.ID, refresh, c.au.GetRefreshTime(), &device)
+			pair, err := c.completeLogin(ctx, d, res, md.AMRPassword)
 			if err != nil {
 				return tokens, err
 			}
+			return *pair, nil
+		}
+	}
+
+	if channel == md.ChannelDevice {
+		pair, err := c.requestLoginApproval(ctx, d, res, len(devs))
+		if err != nil {
+			return tokens, err
+		}
+		return *pair, nil
+	}
 
-			tokens.Access = access
-			tokens.Refresh = refresh
-			return tokens, nil
+	byPhone := channel == md.ChannelSMS || channel == md.ChannelVoice
+	if byPhone {
+		if res.Phone == "" {
+			return tokens, ErrPhoneNotVerified
+		}
+		if err = c.allowSMS(ctx, res.Phone); err != nil {
+			return tokens, err
 		}
 	}
 
-	rand.New(rand.NewSource(time.Now().UnixNano()))
-	code := rand.Intn(9999-1000+1) + 1000
+	code, err := c.issueOTP(ctx, otpLogin, email, d, "")
+	if err != nil {
+		return tokens, err
+	}
 
-	go c.smtp.SendLoginEmail(ctx, code, email)
-	c.cache.Set(ctx, config.MinCacheTime, fmt.Sprintf(codeCacheKey, email), code)
+	if byPhone {
+		go c.sms.SendLoginCode(ctx, channel, res.Phone, code)
+	} else {
+		go c.smtp.SendLoginEmail(ctx, code, email)
+	}
 	return tokens, nil
 }
 
@@ -276,17 +379,22 @@ func (c *Controller) CheckLoginCode(ctx context.Context, d *dto.DeviceRequest, r
 	const op = "auth.CheckLoginCode.ctrl"
 	span, ctx := opentracing.StartSpanFromContext(ctx, op)
 	defer span.Finish()
+	defer c.equalize(ctx, time.Now())
 
-	storedCode, err := c.cache.GetInt(ctx, fmt.Sprintf(codeCacheKey, req.Email))
-	if err != nil && errors.Is(err, cache.ErrNotFoundInCache) {
-		return nil, ErrNotFound
-	} else if err != nil {
+	if err := c.checkLockout(ctx, lockoutCode, req.Email, d.IP); err != nil {
 		return nil, err
 	}
 
-	if storedCode != req.Code {
-		return nil, ErrCodeIsNotValid
+	if _, err := c.verifyOTP(ctx, otpLogin, req.Email, req.Code, d); err != nil {
+		if errors.Is(err, ErrNotFound) && c.au.AntiEnumeration().Enabled {
+			err = ErrCodeIsNotValid
+		}
+		if errors.Is(err, ErrCodeIsNotValid) && c.registerFailure(ctx, lockoutCode, req.Email, d.IP) {
+			c.dropOTP(ctx, otpLogin, req.Email)
+		}
+		return nil, err
 	}
+	c.resetFailures(ctx, lockoutCode, req.Email)
 
 	res, err := c.repo.GetUserByEmail(ctx, req.Email)
 	if err != nil && errors.Is(err, repo.ErrNotFound) {
@@ -295,18 +403,5 @@ func (c *Controller) CheckLoginCode(ctx context.Context, d *dto.DeviceRequest, r
 		return nil, err
 	}
 
-	access, refresh, err := c.au.GenPair(ctx, res.ID, res.Roles)
-	if err != nil {
-		return nil, ErrWhileGeneratingToken
-	}
-
-	device := auth.GenerateDevice(d)
-	if err = c.repo.CreateToken(ctx, res.ID, refresh, c.au.GetRefreshTime(), &device); err != nil {
-		return nil, err
-	}
-
-	return &dto.TokenPair{
-		Access:  access,
-		Refresh: refresh,
-	}, nil
+	return c.completeLogin(ctx, d, res, md.AMROTP)
 }
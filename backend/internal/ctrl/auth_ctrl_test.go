@@ -0,0 +1,206 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/JMURv/sso/internal/auth/jwt"
+	"github.com/JMURv/sso/internal/config"
+	"github.com/JMURv/sso/internal/dto"
+	md "github.com/JMURv/sso/internal/models"
+	"github.com/JMURv/sso/tests/mocks"
+	gjwt "github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestController_CheckForgotPasswordEmail(t *testing.T) {
+	mock := gomock.NewController(t)
+
+	mrepo := mocks.NewMockAppRepo(mock)
+	mau := mocks.NewMockCore(mock)
+	mcache := mocks.NewMockCacheService(mock)
+	msmtp := mocks.NewMockEmailService(mock)
+	c := New(mrepo, mau, mcache, nil, msmtp, nil)
+
+	ctx := context.Background()
+	d := &dto.DeviceRequest{IP: "127.0.0.1", UA: "test"}
+	u := &md.User{ID: uuid.New(), Name: "John", Email: "john@example.com", Password: "hash", IsActive: true, IsEmailVerified: true}
+	req := &dto.CheckForgotPasswordEmailRequest{Token: "token", Password: "new-password"}
+	hash := hashToken(req.Token)
+	key := fmt.Sprintf(resetKey, hash)
+	userKey := fmt.Sprintf(resetUserKey, u.ID)
+	subj := accountSubject(lockoutRecovery, u.Email)
+	conf := config.LockoutConfig{RecoveryAttempts: 5, Duration: time.Minute}
+
+	issued := func(password, latest string) {
+		mcache.EXPECT().GetToStruct(gomock.Any(), key, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, dest any) error {
+				*dest.(*passwordReset) = passwordReset{UserID: u.ID, Password: hashToken(password)}
+				return nil
+			},
+		)
+		mcache.EXPECT().GetToStruct(gomock.Any(), userKey, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, dest any) error {
+				*dest.(*string) = latest
+				return nil
+			},
+		)
+	}
+	notLocked := func() {
+		mrepo.EXPECT().GetUserByID(gomock.Any(), u.ID).Return(u, nil)
+		mcache.EXPECT().GetInt(gomock.Any(), fmt.Sprintf(lockoutUntilKey, subj)).Return(0, errors.New("miss"))
+		mcache.EXPECT().GetInt(gomock.Any(), fmt.Sprintf(lockoutUntilKey, ipSubject(d.IP))).Return(0, errors.New("miss"))
+		mcache.EXPECT().GetInt(gomock.Any(), fmt.Sprintf(lockoutDelayKey, subj)).Return(0, errors.New("miss"))
+	}
+	validPassword := func() {
+		mau.EXPECT().PasswordHistory().Return(0).AnyTimes()
+		mau.EXPECT().ValidatePassword(req.Password, gomock.Any(), u.Name, u.Email).Return(nil)
+	}
+
+	tests := []struct {
+		name   string
+		expect func()
+		err    error
+	}{
+		{
+			name: "Unknown token",
+			expect: func() {
+				mcache.EXPECT().GetToStruct(gomock.Any(), key, gomock.Any()).Return(errors.New("miss"))
+			},
+			err: ErrNotFound,
+		},
+		{
+			name: "Superseded token",
+			expect: func() {
+				issued(u.Password, hashToken("newer"))
+				mcache.EXPECT().Delete(gomock.Any(), key)
+			},
+			err: ErrNotFound,
+		},
+		{
+			name: "Password changed after token was issued",
+			expect: func() {
+				issued("old-hash", hash)
+				notLocked()
+				mau.EXPECT().Lockout().Return(conf).Times(2)
+				mcache.EXPECT().Incr(gomock.Any(), conf.Duration, fmt.Sprintf(lockoutFailKey, subj)).Return(int64(1), nil)
+				mcache.EXPECT().Delete(gomock.Any(), key)
+			},
+			err: ErrCodeIsNotValid,
+		},
+		{
+			name: "Used token",
+			expect: func() {
+				issued(u.Password, hash)
+				notLocked()
+				validPassword()
+				mcache.EXPECT().SetNX(gomock.Any(), config.PasswordResetTime, fmt.Sprintf(resetUsedKey, hash), 1).Return(false, nil)
+			},
+			err: ErrCodeReused,
+		},
+		{
+			name: "Success keeps account state",
+			expect: func() {
+				issued(u.Password, hash)
+				notLocked()
+				validPassword()
+				mcache.EXPECT().SetNX(gomock.Any(), config.PasswordResetTime, fmt.Sprintf(resetUsedKey, hash), 1).Return(true, nil)
+				mcache.EXPECT().Delete(gomock.Any(), key)
+				mcache.EXPECT().Delete(gomock.Any(), userKey)
+				mcache.EXPECT().Delete(gomock.Any(), fmt.Sprintf(lockoutFailKey, subj))
+				mcache.EXPECT().Delete(gomock.Any(), fmt.Sprintf(lockoutDelayKey, subj))
+				mau.EXPECT().Hash(req.Password).Return("new-hash", nil)
+				mrepo.EXPECT().UpdateMe(
+					gomock.Any(), u.ID, &dto.UpdateUserRequest{
+						Name:     u.Name,
+						Email:    u.Email,
+						Password: "new-hash",
+						IsActive: true,
+						IsEmail:  true,
+					},
+				).Return(nil)
+				mrepo.EXPECT().RevokeAllTokens(gomock.Any(), u.ID).Return(nil)
+				mcache.EXPECT().Delete(gomock.Any(), fmt.Sprintf(userCacheKey, u.ID))
+
+				sent := make(chan struct{})
+				msmtp.EXPECT().SendPasswordChangedEmail(gomock.Any(), u.Email, d.IP).Do(
+					func(context.Context, string, string) {
+						close(sent)
+					},
+				)
+				t.Cleanup(func() { <-sent })
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				assert.ErrorIs(t, c.CheckForgotPasswordEmail(ctx, d, req), tt.err)
+			},
+		)
+	}
+}
+
+func TestController_Refresh(t *testing.T) {
+	mock := gomock.NewController(t)
+
+	mrepo := mocks.NewMockAppRepo(mock)
+	mau := mocks.NewMockCore(mock)
+	c := New(mrepo, mau, mocks.NewMockCacheService(mock), nil, nil, nil)
+
+	ctx := context.Background()
+	d := &dto.DeviceRequest{IP: "127.0.0.1", UA: "test"}
+	uid := uuid.New()
+	roles := []md.Role{{Name: "admin"}}
+	loginTime := time.Now().Add(-time.Hour)
+	req := &dto.RefreshRequest{Refresh: "old-refresh"}
+	claims := jwt.Claims{UID: uid, Roles: roles, AMR: []string{md.AMRPassword}, AuthTime: gjwt.NewNumericDate(loginTime)}
+
+	tests := []struct {
+		name      string
+		changedAt time.Time
+		restrict  bool
+	}{
+		{
+			name:      "Fresh password",
+			changedAt: time.Now().Add(-24 * time.Hour),
+		},
+		{
+			name:      "Password expired during session",
+			changedAt: time.Now().Add(-31 * 24 * time.Hour),
+			restrict:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				mau.EXPECT().ParseClaims(gomock.Any(), req.Refresh).Return(claims, nil)
+				mrepo.EXPECT().IsTokenValid(gomock.Any(), uid, gomock.Any(), req.Refresh).Return(true, nil)
+				mrepo.EXPECT().GetUserByID(gomock.Any(), uid).Return(&md.User{ID: uid, PasswordChangedAt: tt.changedAt}, nil)
+				mau.EXPECT().PasswordMaxAge(roles).Return(30 * 24 * time.Hour)
+				mau.EXPECT().GenPair(gomock.Any(), uid, roles, gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ uuid.UUID, _ []md.Role, info jwt.AuthInfo) (string, string, error) {
+						assert.Equal(t, tt.restrict, info.PasswordChange)
+						assert.True(t, info.Time.Equal(loginTime.Truncate(time.Second)), "auth_time must be kept")
+						return "access", "refresh", nil
+					},
+				)
+				mrepo.EXPECT().RevokeAllTokens(gomock.Any(), uid).Return(nil)
+				mau.EXPECT().GetRefreshTime().Return(time.Now().Add(time.Hour))
+				mrepo.EXPECT().CreateToken(gomock.Any(), uid, "refresh", gomock.Any(), gomock.Any()).Return(nil)
+
+				res, err := c.Refresh(ctx, d, req)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.restrict, res.PasswordChange)
+			},
+		)
+	}
+}
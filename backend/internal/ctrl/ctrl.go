@@ -20,39 +20,95 @@ import (
 type AppRepo interface {
 	authRepo
 	oauth2Repo
+	samlRepo
+	ldapRepo
+	realmRepo
+	totpRepo
+	recoveryRepo
+	passwordRepo
+	phoneRepo
 	waRepo
 	userRepo
 	permRepo
 	roleRepo
 	deviceRepo
+	riskRepo
 }
 
 type AppCtrl interface {
-	GenPair(ctx context.Context, d *dto.DeviceRequest, uid uuid.UUID, p []md.Role) (dto.TokenPair, error)
+	GenPair(ctx context.Context, d *dto.DeviceRequest, uid uuid.UUID, p []md.Role, amr ...string) (dto.TokenPair, error)
 	Authenticate(ctx context.Context, d *dto.DeviceRequest, req *dto.EmailAndPasswordRequest) (*dto.TokenPair, error)
 	Refresh(ctx context.Context, d *dto.DeviceRequest, req *dto.RefreshRequest) (*dto.TokenPair, error)
 	ParseClaims(ctx context.Context, token string) (jwt.Claims, error)
 	Logout(ctx context.Context, uid uuid.UUID) error
 
-	CheckForgotPasswordEmail(ctx context.Context, req *dto.CheckForgotPasswordEmailRequest) error
-	SendForgotPasswordEmail(ctx context.Context, email string) error
-	SendLoginCode(ctx context.Context, d *dto.DeviceRequest, email, password string) (dto.TokenPair, error)
+	CheckForgotPasswordEmail(ctx context.Context, d *dto.DeviceRequest, req *dto.CheckForgotPasswordEmailRequest) error
+	ChangePassword(ctx context.Context, d *dto.DeviceRequest, uid uuid.UUID, req *dto.ChangePasswordRequest) (*dto.TokenPair, error)
+	SendForgotPasswordEmail(ctx context.Context, email, channel string) error
+	SendLoginCode(ctx context.Context, d *dto.DeviceRequest, email, password, channel string) (dto.TokenPair, error)
 	CheckLoginCode(ctx context.Context, d *dto.DeviceRequest, req *dto.CheckLoginCodeRequest) (*dto.TokenPair, error)
+	SendMagicLink(ctx context.Context, email string) (string, error)
+	VerifyMagicLink(ctx context.Context, d *dto.DeviceRequest, token, nonce string) (*dto.TokenPair, error)
+
+	ListLoginApprovals(ctx context.Context, uid uuid.UUID) ([]dto.PendingLogin, error)
+	ApproveLogin(ctx context.Context, uid uuid.UUID, id string, req *dto.ApproveLoginRequest) error
+	CheckLoginApproval(ctx context.Context, d *dto.DeviceRequest, id string) (*dto.TokenPair, error)
+
+	StartQRLogin(ctx context.Context, d *dto.DeviceRequest) (*dto.QRLoginResponse, error)
+	GetQRLogin(ctx context.Context, code string) (*dto.QRLoginSession, error)
+	ConfirmQRLogin(ctx context.Context, uid uuid.UUID, d *dto.DeviceRequest, code string) error
+	CheckQRLogin(ctx context.Context, d *dto.DeviceRequest, id string) (*dto.TokenPair, error)
+
+	GetLockout(ctx context.Context, uid uuid.UUID) (*dto.LockoutStatus, error)
+	UnlockUser(ctx context.Context, uid uuid.UUID) error
+	UnlockIP(ctx context.Context, ip string) error
+	ListRiskEvents(ctx context.Context, uid uuid.UUID, page, size int) (*dto.PaginatedRiskEventResponse, error)
+
+	StartPhoneVerification(ctx context.Context, uid uuid.UUID, req *dto.PhoneRequest) error
+	ConfirmPhone(ctx context.Context, uid uuid.UUID, req *dto.ConfirmPhoneRequest) error
+	DeletePhone(ctx context.Context, uid uuid.UUID) error
 
 	GetOAuth2AuthURL(ctx context.Context, provider string) (*dto.StartProviderResponse, error)
 	HandleOAuth2Callback(ctx context.Context, d *dto.DeviceRequest, provider, code, state string) (*dto.HandleCallbackResponse, error)
 
 	GetOIDCAuthURL(ctx context.Context, provider string) (*dto.StartProviderResponse, error)
-	HandleOIDCCallback(ctx context.Context, d *dto.DeviceRequest, provider, code, state string) (*dto.HandleCallbackResponse, error)
+	HandleOIDCCallback(ctx context.Context, d *dto.DeviceRequest, provider, code, state, profile string) (*dto.HandleCallbackResponse, error)
+
+	ListSAMLProviders(ctx context.Context) ([]md.SAMLProvider, error)
+	CreateSAMLProvider(ctx context.Context, req *dto.CreateSAMLProviderRequest) (uint64, error)
+	DeleteSAMLProvider(ctx context.Context, name string) error
+	GetSAMLMetadata(ctx context.Context, provider string) ([]byte, error)
+	GetSAMLAuthURL(ctx context.Context, provider string) (*dto.StartProviderResponse, error)
+	HandleSAMLCallback(ctx context.Context, d *dto.DeviceRequest, provider, relayState string, r *http.Request) (*dto.HandleCallbackResponse, error)
+
+	DiscoverRealm(ctx context.Context, email string) (*dto.DiscoverRealmResponse, error)
+	ListRealmDomains(ctx context.Context) ([]md.RealmDomain, error)
+	CreateRealmDomain(ctx context.Context, req *dto.CreateRealmDomainRequest) (uint64, error)
+	DeleteRealmDomain(ctx context.Context, domain string) error
+
+	EnrollTOTP(ctx context.Context, uid uuid.UUID) (*dto.TOTPEnrollResponse, error)
+	ConfirmTOTP(ctx context.Context, uid uuid.UUID, code string) (*dto.RecoveryCodesResponse, error)
+	DisableTOTP(ctx context.Context, uid uuid.UUID, code string) error
+	VerifyTOTP(ctx context.Context, d *dto.DeviceRequest, req *dto.VerifyTOTPRequest) (*dto.TokenPair, error)
+	RegenerateRecoveryCodes(ctx context.Context, uid uuid.UUID) (*dto.RecoveryCodesResponse, error)
+	VerifyRecoveryCode(ctx context.Context, d *dto.DeviceRequest, req *dto.VerifyRecoveryCodeRequest) (*dto.TokenPair, error)
+	VerifyEmailCode(ctx context.Context, d *dto.DeviceRequest, req *dto.VerifyEmailCodeRequest) (*dto.TokenPair, error)
+	Reauthenticate(ctx context.Context, d *dto.DeviceRequest, uid uuid.UUID, req *dto.ReauthRequest) (*dto.TokenPair, error)
 
 	StartRegistration(ctx context.Context, uid uuid.UUID) (*protocol.CredentialCreation, error)
 	FinishRegistration(ctx context.Context, uid uuid.UUID, r *http.Request) error
+	UpdateWAMetadata(ctx context.Context, blob []byte) (*dto.WAMetadataResponse, error)
 	BeginLogin(ctx context.Context, email string) (*protocol.CredentialAssertion, error)
 	FinishLogin(ctx context.Context, email string, d dto.DeviceRequest, r *http.Request) (dto.TokenPair, error)
+	BeginDiscoverableLogin(ctx context.Context, mediation string) (*protocol.CredentialAssertion, error)
+	FinishDiscoverableLogin(ctx context.Context, d dto.DeviceRequest, r *http.Request) (dto.TokenPair, error)
 
 	GetUserForWA(ctx context.Context, uid uuid.UUID, email string) (*md.WebauthnUser, error)
 	StoreWASession(ctx context.Context, sessionType wa.SessionType, userID uuid.UUID, req *webauthn.SessionData) error
 	GetWASession(ctx context.Context, sessionType wa.SessionType, userID uuid.UUID) (*webauthn.SessionData, error)
+	ListPasskeys(ctx context.Context, uid uuid.UUID) ([]md.Passkey, error)
+	RenamePasskey(ctx context.Context, uid uuid.UUID, id string, req *dto.RenamePasskeyRequest) error
+	DeletePasskey(ctx context.Context, uid uuid.UUID, id string) error
 
 	userCtrl
 	permCtrl
@@ -75,14 +131,27 @@ type CacheService interface {
 	GetStr(ctx context.Context, key string) string
 	GetToStruct(ctx context.Context, key string, dest any) error
 	Set(ctx context.Context, t time.Duration, key string, val any)
+	SetNX(ctx context.Context, t time.Duration, key string, val any) (bool, error)
+	Incr(ctx context.Context, t time.Duration, key string) (int64, error)
 	Delete(ctx context.Context, key string)
 	InvalidateKeysByPattern(ctx context.Context, pattern string)
 }
 
 type EmailService interface {
-	SendLoginEmail(_ context.Context, code int, toEmail string)
-	SendForgotPasswordEmail(ctx context.Context, token, uid64, toEmail string)
+	SendLoginEmail(_ context.Context, code, toEmail string)
+	SendMagicLinkEmail(_ context.Context, token, toEmail string)
+	SendForgotPasswordEmail(ctx context.Context, token, toEmail string)
+	SendPasswordChangedEmail(_ context.Context, toEmail, ip string)
 	SendUserCredentials(_ context.Context, email, pass string)
+	SendRecoveryCodeUsedEmail(_ context.Context, toEmail, ip string, remaining int)
+}
+
+type SMSService interface {
+	Enabled() bool
+	Allowed(phone string) bool
+	SendLoginCode(ctx context.Context, channel, phone, code string)
+	SendPhoneVerificationCode(ctx context.Context, channel, phone, code string)
+	SendForgotPasswordSMS(ctx context.Context, phone, token string)
 }
 
 type Controller struct {
@@ -91,14 +160,16 @@ type Controller struct {
 	cache CacheService
 	s3    S3Service
 	smtp  EmailService
+	sms   SMSService
 }
 
-func New(repo AppRepo, au auth.Core, cache CacheService, s3 S3Service, smtp EmailService) *Controller {
+func New(repo AppRepo, au auth.Core, cache CacheService, s3 S3Service, smtp EmailService, sms SMSService) *Controller {
 	return &Controller{
 		repo:  repo,
 		au:    au,
 		cache: cache,
 		s3:    s3,
 		smtp:  smtp,
+		sms:   sms,
 	}
 }
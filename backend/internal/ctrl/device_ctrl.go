@@ -18,6 +18,7 @@ type deviceRepo interface {
 	ListDevices(ctx context.Context, uid uuid.UUID) ([]md.Device, error)
 	GetDevice(ctx context.Context, uid uuid.UUID, dID string) (*md.Device, error)
 	GetDeviceByID(ctx context.Context, dID string) (*md.Device, error)
+	UpsertDevice(ctx context.Context, uid uuid.UUID, device *md.Device) error
 	UpdateDevice(ctx context.Context, uid uuid.UUID, dID string, req *dto.UpdateDeviceRequest) error
 	DeleteDevice(ctx context.Context, uid uuid.UUID, deviceID string) error
 }
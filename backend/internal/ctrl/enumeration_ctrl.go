@@ -0,0 +1,49 @@
+package ctrl
+
+import (
+	"context"
+	"crypto/sha256"
+	"time"
+
+	md "github.com/JMURv/sso/internal/models"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/google/uuid"
+)
+
+const enumerationPasskey = "enumeration:passkey"
+
+// equalize holds response until anti-enumeration minimal duration passes since start,
+// so fast path for unknown account is not told apart from real work. Call it as
+// defer c.equalize(ctx, time.Now()) at the top of the method.
+func (c *Controller) equalize(ctx context.Context, start time.Time) {
+	conf := c.au.AntiEnumeration()
+	if !conf.Enabled {
+		return
+	}
+
+	wait := conf.MinDuration - time.Since(start)
+	if wait <= 0 {
+		return
+	}
+
+	t := time.NewTimer(wait)
+	defer t.Stop()
+	select {
+	case <-t.C:
+	case <-ctx.Done():
+	}
+}
+
+// decoyWAUser is shown to passkey login of unknown account or account without passkeys.
+// Credential ID is derived from email with secret key, so repeated requests get the same
+// options as for a real account and cannot be recomputed by the caller.
+func (c *Controller) decoyWAUser(email string) *md.WebauthnUser {
+	sum := sha256.Sum256([]byte(c.au.HashOTP(enumerationPasskey, email, "")))
+	return &md.WebauthnUser{
+		ID:    uuid.NewSHA1(uuid.NameSpaceOID, sum[:]),
+		Email: email,
+		Credentials: []webauthn.Credential{
+			{ID: sum[:]},
+		},
+	}
+}
@@ -16,3 +16,54 @@ var ErrCodeIsNotValid = errors.New("code is not valid")
 
 // ErrWhileGeneratingToken is returned when error while generating token.
 var ErrWhileGeneratingToken = errors.New("error while generating token")
+
+// ErrAssertionReplayed is returned when the same SAML assertion is presented twice.
+var ErrAssertionReplayed = errors.New("saml assertion has already been used")
+
+// ErrAccountNotLinked is returned when identity provider asserts email of existing account which it may not sign in to.
+var ErrAccountNotLinked = errors.New("account with this email is not linked to identity provider")
+
+// ErrPasswordLoginDisabled is returned when domain is forced to sign in through SSO.
+var ErrPasswordLoginDisabled = errors.New("password login is disabled for this domain")
+
+// ErrCodeReused is returned when one-time code has already been accepted.
+var ErrCodeReused = errors.New("code has already been used")
+
+// ErrChallengeNotFound is returned when MFA challenge is unknown, expired or bound to another device.
+var ErrChallengeNotFound = errors.New("mfa challenge not found")
+
+// ErrPasskeyNotValid is returned when passkey assertion cannot be verified.
+var ErrPasskeyNotValid = errors.New("passkey assertion is not valid")
+
+// ErrPhoneNotVerified is returned when code is requested by phone, but user has no verified number.
+var ErrPhoneNotVerified = errors.New("user has no verified phone number")
+
+// ErrSMSDisabled is returned when code or link is requested by phone, but SMS gateway is not configured.
+var ErrSMSDisabled = errors.New("sms and voice delivery is disabled")
+
+// ErrCountryNotAllowed is returned when phone number belongs to country outside of allow list.
+var ErrCountryNotAllowed = errors.New("phone number country is not allowed")
+
+// ErrTooManyRequests is returned when rate limit for the target is exceeded.
+var ErrTooManyRequests = errors.New("too many requests")
+
+// ErrBrowserMismatch is returned when magic link is opened outside of browser it was requested from.
+var ErrBrowserMismatch = errors.New("link was requested from another browser")
+
+// ErrNoTrustedDevice is returned when login approval is requested, but user has no signed-in device.
+var ErrNoTrustedDevice = errors.New("user has no trusted device")
+
+// ErrApprovalPending is returned when login is still waiting for approval from trusted device.
+var ErrApprovalPending = errors.New("login is waiting for approval")
+
+// ErrApprovalDenied is returned when login was denied on trusted device.
+var ErrApprovalDenied = errors.New("login was denied")
+
+// ErrAccountLocked is returned when account or IP is temporarily locked after too many failed attempts.
+var ErrAccountLocked = errors.New("too many failed attempts, try again later")
+
+// ErrRiskBlocked is returned when login is scored too risky to proceed.
+var ErrRiskBlocked = errors.New("login is blocked as too risky")
+
+// ErrNoSecondFactor is returned when recovery codes are requested by user without second factor they could replace.
+var ErrNoSecondFactor = errors.New("user has no second factor enrolled")
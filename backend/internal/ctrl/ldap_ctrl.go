@@ -0,0 +1,172 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/JMURv/sso/internal/auth"
+	"github.com/JMURv/sso/internal/auth/ldap"
+	"github.com/JMURv/sso/internal/config"
+	"github.com/JMURv/sso/internal/dto"
+	md "github.com/JMURv/sso/internal/models"
+	"github.com/JMURv/sso/internal/repo"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+)
+
+type ldapRepo interface {
+	IsLDAPUser(ctx context.Context, userID uuid.UUID) (bool, error)
+	SyncLDAPUser(ctx context.Context, userID uuid.UUID, req *dto.LDAPUser, managed []string) error
+}
+
+const ldapFailKey = "ldap:fail:%s"
+
+// checkCredentials verifies email and password, failed attempts are counted
+// per account and IP of the device.
+func (c *Controller) checkCredentials(ctx context.Context, d *dto.DeviceRequest, email, password string) (*md.User, error) {
+	const op = "auth.checkCredentials.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	if err := c.checkLockout(ctx, lockoutPassword, email, d.IP); err != nil {
+		return nil, err
+	}
+
+	res, err := c.verifyCredentials(ctx, email, password)
+	if errors.Is(err, auth.ErrInvalidCredentials) || errors.Is(err, ErrNotFound) {
+		c.registerFailure(ctx, lockoutPassword, email, d.IP)
+		return nil, err
+	} else if err != nil {
+		return nil, err
+	}
+
+	c.resetFailures(ctx, lockoutPassword, email)
+	return res, nil
+}
+
+// verifyCredentials verifies email and password against local hash or, for
+// directory accounts, against LDAP. Directory accounts are chosen by email
+// domain, realm mapping or by an existing link created on a previous directory login.
+func (c *Controller) verifyCredentials(ctx context.Context, email, password string) (*md.User, error) {
+	const op = "auth.verifyCredentials.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := c.repo.GetUserByEmail(ctx, email)
+	if err != nil && !errors.Is(err, repo.ErrNotFound) {
+		return nil, err
+	}
+
+	realm, err := c.findRealm(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+
+	if realm != nil && realm.ForceSSO && isFederated(realm.Method) {
+		return nil, ErrPasswordLoginDisabled
+	}
+
+	isLDAP := c.au.IsLDAPUser(email) || (realm != nil && realm.Method == md.LoginMethodLDAP)
+	if !isLDAP && res != nil {
+		if isLDAP, err = c.repo.IsLDAPUser(ctx, res.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	if isLDAP {
+		return c.authenticateLDAP(ctx, res, email, password)
+	}
+
+	if res == nil || res.Password == "" {
+		if c.au.AntiEnumeration().Enabled {
+			c.au.DummyCompare([]byte(password))
+			return nil, auth.ErrInvalidCredentials
+		}
+		if res == nil {
+			return nil, ErrNotFound
+		}
+	}
+
+	if err = c.au.ComparePasswords([]byte(res.Password), []byte(password)); err != nil {
+		return nil, auth.ErrInvalidCredentials
+	}
+
+	if c.au.NeedsRehash(res.Password) {
+		c.rehashPassword(ctx, res.ID, password)
+	}
+	return res, nil
+}
+
+// rehashPassword upgrades legacy or outdated hash right after successful login,
+// since it is the only moment plain password is known. Failure does not break login.
+func (c *Controller) rehashPassword(ctx context.Context, uid uuid.UUID, password string) {
+	const op = "auth.rehashPassword.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	hash, err := c.au.Hash(password)
+	if err != nil {
+		return
+	}
+
+	if err = c.repo.UpdatePasswordHash(ctx, uid, hash); err != nil {
+		zap.L().Error(
+			"failed to upgrade password hash",
+			zap.String("op", op),
+			zap.String("userID", uid.String()),
+			zap.Error(err),
+		)
+		return
+	}
+	c.cache.Delete(ctx, fmt.Sprintf(userCacheKey, uid))
+}
+
+func (c *Controller) authenticateLDAP(ctx context.Context, u *md.User, email, password string) (*md.User, error) {
+	const op = "auth.authenticateLDAP.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	failKey := fmt.Sprintf(ldapFailKey, c.au.LDAPFailureKey(email, password))
+	if _, err := c.cache.GetInt(ctx, failKey); err == nil {
+		return nil, auth.ErrInvalidCredentials
+	}
+
+	lu, err := c.au.LDAPAuthenticate(ctx, email, password)
+	if errors.Is(err, ldap.ErrInvalidCredentials) || errors.Is(err, ldap.ErrUserNotFound) {
+		c.cache.Set(ctx, config.LDAPNegativeCacheTime, failKey, 1)
+		return nil, auth.ErrInvalidCredentials
+	} else if err != nil {
+		return nil, err
+	}
+
+	if u == nil {
+		id, err := c.repo.CreateUser(
+			ctx, &dto.CreateUserRequest{
+				Name:     lu.Name,
+				Email:    email,
+				IsActive: true,
+				IsEmail:  true,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		zap.L().Info(
+			"provisioned user from ldap",
+			zap.String("op", op),
+			zap.String("userID", id.String()),
+			zap.String("dn", lu.DN),
+		)
+		u = &md.User{ID: id}
+	}
+
+	if err = c.repo.SyncLDAPUser(ctx, u.ID, lu, c.au.LDAPManagedRoles()); err != nil {
+		return nil, err
+	}
+	c.cache.Delete(ctx, fmt.Sprintf(userCacheKey, u.ID))
+
+	return c.repo.GetUserByID(ctx, u.ID)
+}
@@ -0,0 +1,247 @@
+package ctrl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/JMURv/sso/internal/config"
+	"github.com/JMURv/sso/internal/dto"
+	md "github.com/JMURv/sso/internal/models"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+)
+
+const (
+	lockoutPassword = "password"
+	lockoutCode     = "code"
+	lockoutRecovery = "recovery"
+	lockoutTOTP     = "totp"
+	lockoutMFA      = "mfa"
+)
+
+var lockoutMethods = []string{lockoutPassword, lockoutCode, lockoutRecovery, lockoutTOTP, lockoutMFA}
+
+const (
+	lockoutFailKey  = "lockout:fail:%s"
+	lockoutUntilKey = "lockout:until:%s"
+	lockoutDelayKey = "lockout:delay:%s"
+)
+
+// checkLockout rejects attempt before credentials are looked at, so locked
+// account cannot be probed any further.
+func (c *Controller) checkLockout(ctx context.Context, method, account, ip string) error {
+	const op = "lockout.checkLockout.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	acc := accountSubject(method, account)
+	subjects := []string{acc}
+	if ip != "" {
+		subjects = append(subjects, ipSubject(ip))
+	}
+
+	for _, subj := range subjects {
+		if _, err := c.cache.GetInt(ctx, fmt.Sprintf(lockoutUntilKey, subj)); err == nil {
+			return ErrAccountLocked
+		}
+	}
+
+	if _, err := c.cache.GetInt(ctx, fmt.Sprintf(lockoutDelayKey, acc)); err == nil {
+		return ErrTooManyRequests
+	}
+	return nil
+}
+
+// registerFailure counts failed attempt for account and IP, it reports whether account got locked.
+func (c *Controller) registerFailure(ctx context.Context, method, account, ip string) bool {
+	const op = "lockout.registerFailure.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	conf := c.au.Lockout()
+	locked := c.countFailure(ctx, accountSubject(method, account), lockoutLimit(conf, method))
+	if locked {
+		zap.L().Warn(
+			"account locked",
+			zap.String("op", op),
+			zap.String("method", method),
+			zap.String("account", account),
+			zap.String("ip", ip),
+			zap.Duration("duration", conf.Duration),
+		)
+	}
+
+	if ip != "" && c.countFailure(ctx, ipSubject(ip), conf.IPAttempts) {
+		zap.L().Warn(
+			"ip locked",
+			zap.String("op", op),
+			zap.String("method", method),
+			zap.String("ip", ip),
+			zap.Duration("duration", conf.Duration),
+		)
+	}
+	return locked
+}
+
+// countFailure locks subject once limit is reached, otherwise delays its next attempt.
+func (c *Controller) countFailure(ctx context.Context, subj string, limit int) bool {
+	if limit <= 0 {
+		return false
+	}
+
+	conf := c.au.Lockout()
+	n, err := c.cache.Incr(ctx, conf.Duration, fmt.Sprintf(lockoutFailKey, subj))
+	if err != nil {
+		return false
+	}
+
+	if n >= int64(limit) {
+		c.cache.Set(ctx, conf.Duration, fmt.Sprintf(lockoutUntilKey, subj), time.Now().Add(conf.Duration).Unix())
+		return true
+	}
+
+	delay := conf.BaseDelay
+	for i := int64(1); i < n && delay < conf.MaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > conf.MaxDelay {
+		delay = conf.MaxDelay
+	}
+
+	if delay > 0 {
+		c.cache.Set(ctx, delay, fmt.Sprintf(lockoutDelayKey, subj), 1)
+	}
+	return false
+}
+
+// resetFailures forgets failed attempts of account after successful login. IP counter
+// is kept, so one valid account does not unlock guessing of the others.
+func (c *Controller) resetFailures(ctx context.Context, method, account string) {
+	subj := accountSubject(method, account)
+	c.cache.Delete(ctx, fmt.Sprintf(lockoutFailKey, subj))
+	c.cache.Delete(ctx, fmt.Sprintf(lockoutDelayKey, subj))
+}
+
+// recentFailures sums failed attempts still counted for IP and for user in every login method.
+func (c *Controller) recentFailures(ctx context.Context, u *md.User, ip string) int {
+	subjects := []string{ipSubject(ip)}
+	for _, method := range lockoutMethods {
+		subjects = append(subjects, accountSubject(method, lockoutAccount(method, u)))
+	}
+
+	n := 0
+	for _, subj := range subjects {
+		if v, err := c.cache.GetInt(ctx, fmt.Sprintf(lockoutFailKey, subj)); err == nil {
+			n += v
+		}
+	}
+	return n
+}
+
+// GetLockout shows failed attempts and locks of the user for every login method.
+func (c *Controller) GetLockout(ctx context.Context, uid uuid.UUID) (*dto.LockoutStatus, error) {
+	const op = "lockout.GetLockout.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	u, err := c.GetUserByID(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &dto.LockoutStatus{Methods: make([]dto.LockoutEntry, 0, len(lockoutMethods))}
+	for _, method := range lockoutMethods {
+		subj := accountSubject(method, lockoutAccount(method, u))
+		entry := dto.LockoutEntry{Method: method}
+		if n, err := c.cache.GetInt(ctx, fmt.Sprintf(lockoutFailKey, subj)); err == nil {
+			entry.Failures = n
+		}
+
+		if until, err := c.cache.GetInt(ctx, fmt.Sprintf(lockoutUntilKey, subj)); err == nil {
+			t := time.Unix(int64(until), 0)
+			entry.LockedUntil = &t
+		}
+		res.Methods = append(res.Methods, entry)
+	}
+	return res, nil
+}
+
+// UnlockUser clears failed attempts and locks of the user for every login method.
+func (c *Controller) UnlockUser(ctx context.Context, uid uuid.UUID) error {
+	const op = "lockout.UnlockUser.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	u, err := c.GetUserByID(ctx, uid)
+	if err != nil {
+		return err
+	}
+
+	for _, method := range lockoutMethods {
+		c.unlock(ctx, accountSubject(method, lockoutAccount(method, u)))
+	}
+
+	zap.L().Info(
+		"account unlocked",
+		zap.String("op", op),
+		zap.String("userID", uid.String()),
+	)
+	return nil
+}
+
+// UnlockIP clears failed attempts and lock of the IP address.
+func (c *Controller) UnlockIP(ctx context.Context, ip string) error {
+	const op = "lockout.UnlockIP.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	c.unlock(ctx, ipSubject(ip))
+	zap.L().Info(
+		"ip unlocked",
+		zap.String("op", op),
+		zap.String("ip", ip),
+	)
+	return nil
+}
+
+func (c *Controller) unlock(ctx context.Context, subj string) {
+	c.cache.Delete(ctx, fmt.Sprintf(lockoutFailKey, subj))
+	c.cache.Delete(ctx, fmt.Sprintf(lockoutUntilKey, subj))
+	c.cache.Delete(ctx, fmt.Sprintf(lockoutDelayKey, subj))
+}
+
+func lockoutLimit(conf config.LockoutConfig, method string) int {
+	switch method {
+	case lockoutPassword:
+		return conf.PasswordAttempts
+	case lockoutCode:
+		return conf.CodeAttempts
+	case lockoutRecovery:
+		return conf.RecoveryAttempts
+	case lockoutTOTP:
+		return conf.TOTPAttempts
+	case lockoutMFA:
+		return conf.MFAAttempts
+	}
+	return 0
+}
+
+// lockoutAccount returns account which method counts failures for. Second factor
+// is checked for already known user, so it is counted by user ID.
+func lockoutAccount(method string, u *md.User) string {
+	if method == lockoutTOTP || method == lockoutMFA {
+		return u.ID.String()
+	}
+	return u.Email
+}
+
+func accountSubject(method, account string) string {
+	return method + ":" + strings.ToLower(account)
+}
+
+func ipSubject(ip string) string {
+	return "ip:" + ip
+}
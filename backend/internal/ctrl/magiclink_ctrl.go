@@ -0,0 +1,174 @@
+package ctrl
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/JMURv/sso/internal/config"
+	"github.com/JMURv/sso/internal/dto"
+	md "github.com/JMURv/sso/internal/models"
+	"github.com/JMURv/sso/internal/repo"
+	"github.com/goccy/go-json"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+)
+
+const (
+	magicLinkKey     = "magic:%s"
+	magicLinkUsedKey = "magic:used:%s"
+)
+
+type magicLink struct {
+	Email string `json:"email"`
+	Nonce string `json:"nonce"`
+}
+
+// SendMagicLink emails single-use login link. Returned nonce has to be kept by the
+// requesting browser, link is accepted only together with it.
+func (c *Controller) SendMagicLink(ctx context.Context, email string) (string, error) {
+	const op = "auth.SendMagicLink.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+	defer c.equalize(ctx, time.Now())
+
+	realm, err := c.findRealm(ctx, email)
+	if err != nil {
+		return "", err
+	}
+
+	if realm != nil && realm.ForceSSO && isFederated(realm.Method) {
+		return "", ErrPasswordLoginDisabled
+	}
+
+	_, err = c.repo.GetUserByEmail(ctx, email)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		if !c.au.SelfRegistration() {
+			if c.au.AntiEnumeration().Enabled {
+				// Nonce that no link will ever match, response looks like the link was sent.
+				return randomToken()
+			}
+			return "", ErrNotFound
+		}
+	} else if err != nil {
+		return "", err
+	}
+
+	token, err := randomToken()
+	if err != nil {
+		return "", err
+	}
+
+	nonce, err := randomToken()
+	if err != nil {
+		return "", err
+	}
+
+	bytes, err := json.Marshal(magicLink{Email: email, Nonce: hashToken(nonce)})
+	if err != nil {
+		return "", err
+	}
+
+	c.cache.Set(ctx, config.MagicLinkTime, fmt.Sprintf(magicLinkKey, hashToken(token)), bytes)
+	go c.smtp.SendMagicLinkEmail(ctx, token, email)
+	return nonce, nil
+}
+
+// VerifyMagicLink completes login for the link owner, user is created on the fly
+// when self-registration is enabled.
+func (c *Controller) VerifyMagicLink(ctx context.Context, d *dto.DeviceRequest, token, nonce string) (*dto.TokenPair, error) {
+	const op = "auth.VerifyMagicLink.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	hash := hashToken(token)
+	key := fmt.Sprintf(magicLinkKey, hash)
+
+	link := &magicLink{}
+	if err := c.cache.GetToStruct(ctx, key, link); err != nil {
+		return nil, ErrNotFound
+	}
+
+	if subtle.ConstantTimeCompare([]byte(link.Nonce), []byte(hashToken(nonce))) != 1 {
+		zap.L().Debug(
+			"magic link opened in another browser",
+			zap.String("op", op),
+		)
+		return nil, ErrBrowserMismatch
+	}
+
+	ok, err := c.cache.SetNX(ctx, config.MagicLinkTime, fmt.Sprintf(magicLinkUsedKey, hash), 1)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		return nil, ErrCodeReused
+	}
+	c.cache.Delete(ctx, key)
+
+	u, err := c.repo.GetUserByEmail(ctx, link.Email)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		if u, err = c.registerByEmail(ctx, link.Email); err != nil {
+			return nil, err
+		}
+	} else if err != nil {
+		return nil, err
+	}
+
+	return c.completeLogin(ctx, d, u, md.AMROTP)
+}
+
+// registerByEmail creates passwordless account, email is verified since user has just opened the link.
+func (c *Controller) registerByEmail(ctx context.Context, email string) (*md.User, error) {
+	const op = "auth.registerByEmail.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	if !c.au.SelfRegistration() {
+		return nil, ErrNotFound
+	}
+
+	id, err := c.repo.CreateUser(
+		ctx, &dto.CreateUserRequest{
+			Name:     email[:strings.LastIndex(email, "@")],
+			Email:    email,
+			IsActive: true,
+			IsEmail:  true,
+		},
+	)
+	if err != nil && errors.Is(err, repo.ErrAlreadyExists) {
+		return nil, ErrAlreadyExists
+	} else if err != nil {
+		return nil, err
+	}
+	go c.cache.InvalidateKeysByPattern(ctx, userPattern)
+
+	u, err := c.repo.GetUserByID(ctx, id)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func randomToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
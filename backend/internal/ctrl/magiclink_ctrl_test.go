@@ -0,0 +1,257 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/JMURv/sso/internal/config"
+	"github.com/JMURv/sso/internal/dto"
+	md "github.com/JMURv/sso/internal/models"
+	"github.com/JMURv/sso/internal/repo"
+	"github.com/JMURv/sso/tests/mocks"
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestController_SendMagicLink(t *testing.T) {
+	mock := gomock.NewController(t)
+
+	mrepo := mocks.NewMockAppRepo(mock)
+	mau := mocks.NewMockCore(mock)
+	mcache := mocks.NewMockCacheService(mock)
+	msmtp := mocks.NewMockEmailService(mock)
+	c := New(mrepo, mau, mcache, nil, msmtp, nil)
+
+	ctx := context.Background()
+	email := "john@example.com"
+	lookup := func(err error) {
+		mcache.EXPECT().GetToStruct(gomock.Any(), realmListKey, gomock.Any()).Return(nil)
+		if err != nil {
+			mrepo.EXPECT().GetUserByEmail(gomock.Any(), email).Return(nil, err)
+		} else {
+			mrepo.EXPECT().GetUserByEmail(gomock.Any(), email).Return(&md.User{Email: email}, nil)
+		}
+	}
+	enumeration := func(enabled bool, calls int) {
+		mau.EXPECT().AntiEnumeration().Return(config.AntiEnumerationConfig{Enabled: enabled}).Times(calls)
+	}
+
+	var link magicLink
+	sent := func() {
+		var key string
+		mcache.EXPECT().Set(gomock.Any(), config.MagicLinkTime, gomock.Any(), gomock.Any()).Do(
+			func(_ context.Context, _ time.Duration, k string, val any) {
+				require.NoError(t, json.Unmarshal(val.([]byte), &link))
+				key = k
+			},
+		)
+
+		done := make(chan struct{})
+		msmtp.EXPECT().SendMagicLinkEmail(gomock.Any(), gomock.Any(), email).Do(
+			func(_ context.Context, tok, _ string) {
+				assert.Equal(t, fmt.Sprintf(magicLinkKey, hashToken(tok)), key, "link is stored under token hash")
+				close(done)
+			},
+		)
+		t.Cleanup(func() { <-done })
+	}
+
+	tests := []struct {
+		name   string
+		expect func()
+		sent   bool
+		err    error
+	}{
+		{
+			name: "Existing user",
+			expect: func() {
+				enumeration(false, 1)
+				lookup(nil)
+				sent()
+			},
+			sent: true,
+		},
+		{
+			name: "Unknown user gets decoy nonce",
+			expect: func() {
+				enumeration(true, 2)
+				lookup(repo.ErrNotFound)
+				mau.EXPECT().SelfRegistration().Return(false)
+			},
+		},
+		{
+			name: "Unknown user without anti-enumeration",
+			expect: func() {
+				enumeration(false, 2)
+				lookup(repo.ErrNotFound)
+				mau.EXPECT().SelfRegistration().Return(false)
+			},
+			err: ErrNotFound,
+		},
+		{
+			name: "Unknown user with self-registration",
+			expect: func() {
+				enumeration(false, 1)
+				lookup(repo.ErrNotFound)
+				mau.EXPECT().SelfRegistration().Return(true)
+				sent()
+			},
+			sent: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				link = magicLink{}
+				tt.expect()
+				nonce, err := c.SendMagicLink(ctx, email)
+				assert.ErrorIs(t, err, tt.err)
+				if tt.err != nil {
+					return
+				}
+
+				// decoy nonce is indistinguishable from real one, but no link is stored for it
+				assert.NotEmpty(t, nonce)
+				if tt.sent {
+					assert.Equal(t, email, link.Email)
+					assert.Equal(t, hashToken(nonce), link.Nonce, "only nonce hash is stored")
+				}
+			},
+		)
+	}
+}
+
+func TestController_VerifyMagicLink(t *testing.T) {
+	mock := gomock.NewController(t)
+
+	mrepo := mocks.NewMockAppRepo(mock)
+	mau := mocks.NewMockCore(mock)
+	mcache := mocks.NewMockCacheService(mock)
+	c := New(mrepo, mau, mcache, nil, nil, nil)
+
+	ctx := context.Background()
+	d := &dto.DeviceRequest{IP: "127.0.0.1", UA: "test"}
+	token, nonce := "token", "nonce"
+	hash := hashToken(token)
+	key := fmt.Sprintf(magicLinkKey, hash)
+	u := &md.User{ID: uuid.New(), Name: "john", Email: "john@example.com"}
+
+	stored := func(nonce string) {
+		mcache.EXPECT().GetToStruct(gomock.Any(), key, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, dest any) error {
+				*dest.(*magicLink) = magicLink{Email: u.Email, Nonce: hashToken(nonce)}
+				return nil
+			},
+		)
+	}
+	redeemed := func() {
+		mcache.EXPECT().SetNX(gomock.Any(), config.MagicLinkTime, fmt.Sprintf(magicLinkUsedKey, hash), 1).Return(true, nil)
+		mcache.EXPECT().Delete(gomock.Any(), key)
+	}
+	login := func() {
+		mau.EXPECT().RiskEnabled().Return(false)
+		mrepo.EXPECT().GetTOTP(gomock.Any(), u.ID).Return(nil, repo.ErrNotFound)
+		mrepo.EXPECT().GetUserByID(gomock.Any(), u.ID).Return(u, nil)
+		mau.EXPECT().GenPair(gomock.Any(), u.ID, gomock.Any(), gomock.Any()).Return("access", "refresh", nil)
+		mau.EXPECT().GetRefreshTime().Return(time.Now().Add(time.Hour))
+		mrepo.EXPECT().CreateToken(gomock.Any(), u.ID, "refresh", gomock.Any(), gomock.Any()).Return(nil)
+	}
+
+	tests := []struct {
+		name   string
+		nonce  string
+		expect func()
+		err    error
+	}{
+		{
+			name:  "Expired link",
+			nonce: nonce,
+			expect: func() {
+				mcache.EXPECT().GetToStruct(gomock.Any(), key, gomock.Any()).Return(errors.New("miss"))
+			},
+			err: ErrNotFound,
+		},
+		{
+			name:  "Another browser",
+			nonce: "other-nonce",
+			expect: func() {
+				stored(nonce)
+			},
+			err: ErrBrowserMismatch,
+		},
+		{
+			name:  "Used link",
+			nonce: nonce,
+			expect: func() {
+				stored(nonce)
+				mcache.EXPECT().SetNX(gomock.Any(), config.MagicLinkTime, fmt.Sprintf(magicLinkUsedKey, hash), 1).Return(false, nil)
+			},
+			err: ErrCodeReused,
+		},
+		{
+			name:  "Existing user",
+			nonce: nonce,
+			expect: func() {
+				stored(nonce)
+				redeemed()
+				mrepo.EXPECT().GetUserByEmail(gomock.Any(), u.Email).Return(u, nil)
+				login()
+			},
+		},
+		{
+			name:  "Unknown user without self-registration",
+			nonce: nonce,
+			expect: func() {
+				stored(nonce)
+				redeemed()
+				mrepo.EXPECT().GetUserByEmail(gomock.Any(), u.Email).Return(nil, repo.ErrNotFound)
+				mau.EXPECT().SelfRegistration().Return(false)
+			},
+			err: ErrNotFound,
+		},
+		{
+			name:  "Unknown user with self-registration",
+			nonce: nonce,
+			expect: func() {
+				stored(nonce)
+				redeemed()
+				mrepo.EXPECT().GetUserByEmail(gomock.Any(), u.Email).Return(nil, repo.ErrNotFound)
+				mau.EXPECT().SelfRegistration().Return(true)
+				mrepo.EXPECT().CreateUser(
+					gomock.Any(), &dto.CreateUserRequest{Name: "john", Email: u.Email, IsActive: true, IsEmail: true},
+				).Return(u.ID, nil)
+
+				done := make(chan struct{})
+				mcache.EXPECT().InvalidateKeysByPattern(gomock.Any(), userPattern).Do(
+					func(context.Context, string) {
+						close(done)
+					},
+				)
+				t.Cleanup(func() { <-done })
+
+				mrepo.EXPECT().GetUserByID(gomock.Any(), u.ID).Return(u, nil)
+				login()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				res, err := c.VerifyMagicLink(ctx, d, token, tt.nonce)
+				assert.ErrorIs(t, err, tt.err)
+				if tt.err == nil {
+					assert.Equal(t, "access", res.Access)
+				}
+			},
+		)
+	}
+}
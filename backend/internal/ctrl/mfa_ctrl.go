@@ -0,0 +1,220 @@
+package ctrl
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/JMURv/sso/internal/auth"
+	"github.com/JMURv/sso/internal/config"
+	"github.com/JMURv/sso/internal/dto"
+	md "github.com/JMURv/sso/internal/models"
+	"github.com/JMURv/sso/internal/repo"
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+)
+
+const mfaChallengeKey = "mfa:challenge:%s"
+
+type mfaChallenge struct {
+	UserID   uuid.UUID `json:"user_id"`
+	DeviceID string    `json:"device_id"`
+	AMR      []string  `json:"amr"`
+	Attempts int       `json:"attempts"`
+}
+
+// completeLogin is the last step of every password based login. It issues tokens
+// right away, or MFA challenge when user has second factor enrolled. Risky login is
+// blocked or, when user has no second factor, challenged with code sent by email.
+// amr lists methods already passed, they are carried through the challenge.
+func (c *Controller) completeLogin(ctx context.Context, d *dto.DeviceRequest, u *md.User, amr ...string) (*dto.TokenPair, error) {
+	const op = "mfa.completeLogin.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	action, err := c.assessRisk(ctx, d, u)
+	if err != nil {
+		return nil, err
+	}
+
+	if action == md.RiskActionBlock {
+		return nil, ErrRiskBlocked
+	}
+
+	methods, err := c.mfaMethods(ctx, u.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(methods) == 0 && needsStepUp(ctx, action, amr) {
+		methods = append(methods, md.MFAMethodEmail)
+	}
+
+	if len(methods) == 0 {
+		pair, err := c.GenPair(ctx, d, u.ID, u.Roles, amr...)
+		if err != nil {
+			return nil, err
+		}
+		return &pair, nil
+	}
+
+	buf := make([]byte, 32)
+	if _, err = rand.Read(buf); err != nil {
+		return nil, err
+	}
+	token := base64.RawURLEncoding.EncodeToString(buf)
+
+	bytes, err := json.Marshal(
+		mfaChallenge{
+			UserID:   u.ID,
+			DeviceID: auth.GenerateDevice(d).ID,
+			AMR:      amr,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	c.cache.Set(ctx, config.MFAChallengeTime, fmt.Sprintf(mfaChallengeKey, token), bytes)
+
+	if slices.Contains(methods, md.MFAMethodEmail) {
+		// Code issued during resend cooldown is still valid, so it is not sent again.
+		code, err := c.issueOTP(ctx, otpMFA, u.Email, d, "")
+		if err != nil && !errors.Is(err, ErrTooManyRequests) {
+			return nil, err
+		} else if err == nil {
+			go c.smtp.SendLoginEmail(ctx, code, u.Email)
+		}
+	}
+
+	return &dto.TokenPair{
+		Challenge: &dto.MFAChallenge{
+			Token:   token,
+			Methods: methods,
+		},
+	}, nil
+}
+
+// loginPair is the last step of logins which cannot be continued with MFA challenge:
+// passkey, federated, QR and approved logins. It scores login like completeLogin does,
+// risky login is refused unless it already carries second factor. Captcha cannot be
+// shown on these paths and no secret is guessed there, so it is not asked for.
+func (c *Controller) loginPair(ctx context.Context, d *dto.DeviceRequest, u *md.User, amr ...string) (dto.TokenPair, error) {
+	const op = "mfa.loginPair.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	action, err := c.assessRisk(ctx, d, u)
+	if err != nil {
+		return dto.TokenPair{}, err
+	}
+
+	if action == md.RiskActionBlock || (action == md.RiskActionMFA && !hasSecondFactor(amr)) {
+		return dto.TokenPair{}, ErrRiskBlocked
+	}
+	return c.GenPair(ctx, d, u.ID, u.Roles, amr...)
+}
+
+func (c *Controller) mfaMethods(ctx context.Context, uid uuid.UUID) ([]string, error) {
+	methods := make([]string, 0, 2)
+
+	t, err := c.repo.GetTOTP(ctx, uid)
+	if err != nil && !errors.Is(err, repo.ErrNotFound) {
+		return nil, err
+	}
+
+	if t == nil || t.ConfirmedAt == nil {
+		return methods, nil
+	}
+	methods = append(methods, md.MFAMethodTOTP)
+
+	left, err := c.repo.CountRecoveryCodes(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	if left > 0 {
+		methods = append(methods, md.MFAMethodRecoveryCode)
+	}
+	return methods, nil
+}
+
+// passChallenge runs verify for user of the challenge and issues tokens on success,
+// method is added to amr of the first factor. Challenge is dropped once it is passed
+// or after too many failed attempts.
+func (c *Controller) passChallenge(
+	ctx context.Context,
+	d *dto.DeviceRequest,
+	token string,
+	method string,
+	verify func(uid uuid.UUID) error,
+) (*dto.TokenPair, error) {
+	const op = "mfa.passChallenge.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	key := fmt.Sprintf(mfaChallengeKey, token)
+	ch := &mfaChallenge{}
+	if err := c.cache.GetToStruct(ctx, key, ch); err != nil {
+		return nil, ErrChallengeNotFound
+	}
+
+	if ch.DeviceID != auth.GenerateDevice(d).ID {
+		zap.L().Debug(
+			"mfa challenge presented from another device",
+			zap.String("op", op),
+			zap.String("userID", ch.UserID.String()),
+		)
+		return nil, ErrChallengeNotFound
+	}
+
+	if err := verify(ch.UserID); err != nil {
+		ch.Attempts++
+		if ch.Attempts >= config.MFAMaxAttempts {
+			c.cache.Delete(ctx, key)
+		} else if bytes, err := json.Marshal(ch); err == nil {
+			c.cache.Set(ctx, config.MFAChallengeTime, key, bytes)
+		}
+		return nil, err
+	}
+	c.cache.Delete(ctx, key)
+
+	u, err := c.repo.GetUserByID(ctx, ch.UserID)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	pair, err := c.GenPair(ctx, d, u.ID, u.Roles, append(ch.AMR, method, md.AMRMultiFactor)...)
+	if err != nil {
+		return nil, err
+	}
+	return &pair, nil
+}
+
+// checkMFACode counts wrong recovery and email codes per user, so guessing
+// cannot be spread over fresh challenges.
+func (c *Controller) checkMFACode(ctx context.Context, uid uuid.UUID, ip string, verify func() error) error {
+	account := uid.String()
+	if err := c.checkLockout(ctx, lockoutMFA, account, ip); err != nil {
+		return err
+	}
+
+	err := verify()
+	if err != nil && errors.Is(err, ErrCodeIsNotValid) {
+		if c.registerFailure(ctx, lockoutMFA, account, ip) {
+			return ErrAccountLocked
+		}
+		return err
+	} else if err != nil {
+		return err
+	}
+
+	c.resetFailures(ctx, lockoutMFA, account)
+	return nil
+}
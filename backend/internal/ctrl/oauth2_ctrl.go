@@ -72,6 +72,15 @@ func (c *Controller) HandleOAuth2Callback(
 	user, err := c.repo.GetUserByOAuth2(ctx, provider, oauthUser.ProviderID)
 	if errors.Is(err, repo.ErrNotFound) {
 		user, err = c.repo.GetUserByEmail(ctx, oauthUser.Email)
+		if el, ok := pr.(providers.EmailLinker); ok && err == nil && !el.LinksByEmail() {
+			zap.L().Warn(
+				"Refused to link provider identity by email",
+				zap.String("op", op),
+				zap.String("provider", provider),
+				zap.String("providerID", oauthUser.ProviderID),
+			)
+			return nil, ErrAccountNotLinked
+		}
 		if errors.Is(err, repo.ErrNotFound) {
 			user = &md.User{
 				Name:   oauthUser.Name,
@@ -100,7 +109,7 @@ func (c *Controller) HandleOAuth2Callback(
 		return nil, err
 	}
 
-	pair, err := c.GenPair(ctx, d, user.ID, user.Roles)
+	pair, err := c.loginPair(ctx, d, user, md.AMRFederated)
 	if err != nil {
 		return nil, err
 	}
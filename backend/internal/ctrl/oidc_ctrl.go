@@ -33,7 +33,7 @@ func (c *Controller) GetOIDCAuthURL(ctx context.Context, provider string) (*dto.
 func (c *Controller) HandleOIDCCallback(
 	ctx context.Context,
 	d *dto.DeviceRequest,
-	provider, code, state string,
+	provider, code, state, profile string,
 ) (*dto.HandleCallbackResponse, error) {
 	const op = "auth.HandleOIDCCallback.ctrl"
 	span, ctx := opentracing.StartSpanFromContext(ctx, op)
@@ -60,9 +60,22 @@ func (c *Controller) HandleOIDCCallback(
 		return nil, err
 	}
 
+	if pp, ok := pr.(providers.ProfileProvider); ok && profile != "" {
+		pp.ParseProfile(profile, oauthUser)
+	}
+
 	user, err := c.repo.GetUserByOAuth2(ctx, provider, oauthUser.ProviderID)
 	if errors.Is(err, repo.ErrNotFound) {
 		user, err = c.repo.GetUserByEmail(ctx, oauthUser.Email)
+		if el, ok := pr.(providers.EmailLinker); ok && err == nil && !el.LinksByEmail() {
+			zap.L().Warn(
+				"Refused to link provider identity by email",
+				zap.String("op", op),
+				zap.String("provider", provider),
+				zap.String("providerID", oauthUser.ProviderID),
+			)
+			return nil, ErrAccountNotLinked
+		}
 		if errors.Is(err, repo.ErrNotFound) {
 			user = &md.User{
 				Name:   oauthUser.Name,
@@ -91,7 +104,7 @@ func (c *Controller) HandleOIDCCallback(
 		return nil, err
 	}
 
-	pair, err := c.GenPair(ctx, d, user.ID, user.Roles)
+	pair, err := c.loginPair(ctx, d, user, md.AMRFederated)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,129 @@
+package ctrl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/JMURv/sso/internal/auth/providers"
+	"github.com/JMURv/sso/internal/dto"
+	md "github.com/JMURv/sso/internal/models"
+	"github.com/JMURv/sso/internal/repo"
+	"github.com/JMURv/sso/tests/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type stubProvider struct {
+	res   *dto.ProviderResponse
+	links bool
+}
+
+func (p *stubProvider) AuthCodeURL(string) string {
+	return ""
+}
+
+func (p *stubProvider) Exchange(context.Context, string) (*dto.ProviderResponse, error) {
+	return p.res, nil
+}
+
+type stubLinkingProvider struct {
+	stubProvider
+}
+
+func (p *stubLinkingProvider) LinksByEmail() bool {
+	return p.links
+}
+
+func TestController_HandleOIDCCallback(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mrepo := mocks.NewMockAppRepo(mock)
+	mau := mocks.NewMockCore(mock)
+	c := New(mrepo, mau, mocks.NewMockCacheService(mock), nil, nil, nil)
+
+	ctx := context.Background()
+	d := &dto.DeviceRequest{IP: "127.0.0.1", UA: "test"}
+	uid := uuid.New()
+	refreshExp := time.Now().Add(time.Hour)
+	identity := &dto.ProviderResponse{ProviderID: "tenant:oid", Email: "admin@corp.com", Name: "Admin"}
+	genPair := func() {
+		mau.EXPECT().RiskEnabled().Return(false)
+		mrepo.EXPECT().GetUserByID(gomock.Any(), uid).Return(&md.User{ID: uid}, nil)
+		mau.EXPECT().GenPair(gomock.Any(), uid, gomock.Any(), gomock.Any()).Return("access", "refresh", nil)
+		mau.EXPECT().GetRefreshTime().Return(refreshExp)
+		mrepo.EXPECT().CreateToken(gomock.Any(), uid, "refresh", refreshExp, gomock.Any()).Return(nil)
+		mau.EXPECT().SuccessURL().Return("/")
+	}
+	exchange := func(pr providers.OAuthProvider) {
+		mau.EXPECT().ValidateSignedState("state", gomock.Any()).Return(nil)
+		mau.EXPECT().Get(providers.Microsoft, providers.OIDC).Return(pr, nil)
+	}
+
+	tests := []struct {
+		name   string
+		expect func()
+		err    error
+	}{
+		{
+			name: "Linked identity",
+			expect: func() {
+				exchange(&stubLinkingProvider{stubProvider{res: identity}})
+				mrepo.EXPECT().GetUserByOAuth2(gomock.Any(), "microsoft", identity.ProviderID).Return(&md.User{ID: uid}, nil)
+				mrepo.EXPECT().CreateOAuth2Connection(gomock.Any(), uid, "microsoft", identity).Return(nil)
+				genPair()
+			},
+		},
+		{
+			name: "Existing email is not linked",
+			expect: func() {
+				exchange(&stubLinkingProvider{stubProvider{res: identity}})
+				mrepo.EXPECT().GetUserByOAuth2(gomock.Any(), "microsoft", identity.ProviderID).Return(nil, repo.ErrNotFound)
+				mrepo.EXPECT().GetUserByEmail(gomock.Any(), identity.Email).Return(&md.User{ID: uuid.New()}, nil)
+			},
+			err: ErrAccountNotLinked,
+		},
+		{
+			name: "Existing email of trusted provider",
+			expect: func() {
+				exchange(&stubProvider{res: identity})
+				mrepo.EXPECT().GetUserByOAuth2(gomock.Any(), "microsoft", identity.ProviderID).Return(nil, repo.ErrNotFound)
+				mrepo.EXPECT().GetUserByEmail(gomock.Any(), identity.Email).Return(&md.User{ID: uid}, nil)
+				mrepo.EXPECT().CreateOAuth2Connection(gomock.Any(), uid, "microsoft", identity).Return(nil)
+				genPair()
+			},
+		},
+		{
+			name: "New account",
+			expect: func() {
+				exchange(&stubLinkingProvider{stubProvider{res: identity}})
+				mrepo.EXPECT().GetUserByOAuth2(gomock.Any(), "microsoft", identity.ProviderID).Return(nil, repo.ErrNotFound)
+				mrepo.EXPECT().GetUserByEmail(gomock.Any(), identity.Email).Return(nil, repo.ErrNotFound)
+				mrepo.EXPECT().CreateUser(
+					gomock.Any(), &dto.CreateUserRequest{Name: identity.Name, Email: identity.Email},
+				).Return(uid, nil)
+				mrepo.EXPECT().CreateOAuth2Connection(gomock.Any(), uid, "microsoft", identity).Return(nil)
+				genPair()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				res, err := c.HandleOIDCCallback(ctx, d, "microsoft", "code", "state", "")
+				if tt.err != nil {
+					assert.ErrorIs(t, err, tt.err)
+					return
+				}
+
+				require.NoError(t, err)
+				assert.Equal(t, "access", res.Access)
+			},
+		)
+	}
+}
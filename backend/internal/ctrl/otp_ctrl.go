@@ -0,0 +1,159 @@
+package ctrl
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/JMURv/sso/internal/auth"
+	"github.com/JMURv/sso/internal/cache"
+	"github.com/JMURv/sso/internal/dto"
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+)
+
+const (
+	otpLogin = "login"
+	otpPhone = "phone"
+	otpMFA   = "mfa"
+)
+
+const (
+	otpKey         = "otp:%s:%x"
+	otpCooldownKey = "otp:cooldown:%s:%x"
+	otpAttemptsKey = "otp:attempts:%s"
+	otpUsedKey     = "otp:used:%s"
+)
+
+// otpEntry never holds the code itself, only its hash bound to purpose and subject.
+// Payload keeps value confirmed by the code, e.g. phone number being verified.
+type otpEntry struct {
+	ID        string    `json:"id"`
+	Hash      string    `json:"hash"`
+	Device    string    `json:"device,omitempty"`
+	Payload   string    `json:"payload,omitempty"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// issueOTP generates code for purpose and subject, replacing previous one. When device is given,
+// code is accepted only from the same device. New code is not issued until resend cooldown passes.
+func (c *Controller) issueOTP(ctx context.Context, purpose, subject string, d *dto.DeviceRequest, payload string) (string, error) {
+	const op = "otp.issueOTP.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	subject = strings.ToLower(subject)
+	conf := c.au.OTP()
+	digest := otpDigest(subject)
+	if conf.ResendCooldown > 0 {
+		ok, err := c.cache.SetNX(ctx, conf.ResendCooldown, fmt.Sprintf(otpCooldownKey, purpose, digest), 1)
+		if err != nil {
+			return "", err
+		}
+		if !ok {
+			return "", ErrTooManyRequests
+		}
+	}
+
+	code, err := c.au.GenerateOTP()
+	if err != nil {
+		return "", err
+	}
+
+	entry := &otpEntry{
+		ID:        uuid.NewString(),
+		Hash:      c.au.HashOTP(purpose, subject, code),
+		Payload:   payload,
+		ExpiresAt: time.Now().Add(conf.TTL),
+	}
+	if d != nil {
+		entry.Device = auth.GenerateDevice(d).ID
+	}
+
+	bytes, err := json.Marshal(entry)
+	if err != nil {
+		return "", err
+	}
+
+	c.cache.Set(ctx, conf.TTL, fmt.Sprintf(otpKey, purpose, digest), bytes)
+	return code, nil
+}
+
+// verifyOTP accepts code once. Every attempt is counted, code is dropped once attempts run out.
+func (c *Controller) verifyOTP(ctx context.Context, purpose, subject, code string, d *dto.DeviceRequest) (*otpEntry, error) {
+	const op = "otp.verifyOTP.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	subject = strings.ToLower(subject)
+	conf := c.au.OTP()
+	key := fmt.Sprintf(otpKey, purpose, otpDigest(subject))
+	entry := &otpEntry{}
+	if err := c.cache.GetToStruct(ctx, key, entry); err != nil && errors.Is(err, cache.ErrNotFoundInCache) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	ttl := time.Until(entry.ExpiresAt)
+	if ttl <= 0 {
+		return nil, ErrNotFound
+	}
+
+	n, err := c.cache.Incr(ctx, ttl, fmt.Sprintf(otpAttemptsKey, entry.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	exhausted := conf.MaxAttempts > 0 && n >= int64(conf.MaxAttempts)
+	if conf.MaxAttempts > 0 && n > int64(conf.MaxAttempts) {
+		c.cache.Delete(ctx, key)
+		return nil, ErrCodeIsNotValid
+	}
+
+	device := ""
+	if d != nil {
+		device = auth.GenerateDevice(d).ID
+	}
+
+	if entry.Device != "" && entry.Device != device || !c.au.CompareOTP(entry.Hash, purpose, subject, code) {
+		zap.L().Debug(
+			"codes don't match",
+			zap.String("op", op),
+			zap.String("purpose", purpose),
+			zap.Int64("attempt", n),
+		)
+
+		if exhausted {
+			c.cache.Delete(ctx, key)
+		}
+		return nil, ErrCodeIsNotValid
+	}
+
+	ok, err := c.cache.SetNX(ctx, ttl, fmt.Sprintf(otpUsedKey, entry.ID), 1)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrCodeReused
+	}
+
+	c.cache.Delete(ctx, key)
+	return entry, nil
+}
+
+// dropOTP revokes pending code, e.g. when account gets locked.
+func (c *Controller) dropOTP(ctx context.Context, purpose, subject string) {
+	c.cache.Delete(ctx, fmt.Sprintf(otpKey, purpose, otpDigest(strings.ToLower(subject))))
+}
+
+// otpDigest keeps emails and phone numbers out of cache keys.
+func otpDigest(subject string) []byte {
+	sum := sha256.Sum256([]byte(subject))
+	return sum[:]
+}
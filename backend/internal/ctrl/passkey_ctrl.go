@@ -0,0 +1,94 @@
+package ctrl
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/JMURv/sso/internal/auth"
+	"github.com/JMURv/sso/internal/dto"
+	md "github.com/JMURv/sso/internal/models"
+	"github.com/JMURv/sso/internal/repo"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+)
+
+const (
+	defaultPasskeyName = "Passkey"
+	maxPasskeyName     = 64
+)
+
+func (c *Controller) ListPasskeys(ctx context.Context, uid uuid.UUID) ([]md.Passkey, error) {
+	const op = "passkeys.ListPasskeys.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := c.repo.ListPasskeys(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *Controller) RenamePasskey(ctx context.Context, uid uuid.UUID, id string, req *dto.RenamePasskeyRequest) error {
+	const op = "passkeys.RenamePasskey.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	credID, err := base64.RawURLEncoding.DecodeString(id)
+	if err != nil {
+		return ErrNotFound
+	}
+
+	err = c.repo.RenamePasskey(ctx, uid, credID, req.Name)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		return ErrNotFound
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+// DeletePasskey removes credential, user stops being passkey user with the last one.
+func (c *Controller) DeletePasskey(ctx context.Context, uid uuid.UUID, id string) error {
+	const op = "passkeys.DeletePasskey.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	credID, err := base64.RawURLEncoding.DecodeString(id)
+	if err != nil {
+		return ErrNotFound
+	}
+
+	err = c.repo.DeleteWACredential(ctx, uid, credID)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		return ErrNotFound
+	} else if err != nil {
+		return err
+	}
+
+	c.cache.Delete(ctx, fmt.Sprintf(userCacheKey, uid))
+	go c.cache.InvalidateKeysByPattern(ctx, userPattern)
+	return nil
+}
+
+// passkeyName gives new credential readable name like "Chrome on Windows 10".
+func passkeyName(ua string) string {
+	d := auth.GenerateDevice(&dto.DeviceRequest{UA: ua})
+
+	name := defaultPasskeyName
+	switch {
+	case d.Browser != "" && d.OS != "":
+		name = d.Browser + " on " + d.OS
+	case d.Browser != "":
+		name = d.Browser
+	case d.OS != "":
+		name = d.OS
+	}
+
+	if len(name) > maxPasskeyName {
+		name = name[:maxPasskeyName]
+	}
+	return name
+}
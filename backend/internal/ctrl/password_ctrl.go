@@ -0,0 +1,152 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/JMURv/sso/internal/dto"
+	md "github.com/JMURv/sso/internal/models"
+	"github.com/JMURv/sso/internal/repo"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+)
+
+type passwordRepo interface {
+	ListPasswordHistory(ctx context.Context, userID uuid.UUID, limit int) ([]string, error)
+	AddPasswordHistory(ctx context.Context, userID uuid.UUID, hash string, keep int) error
+}
+
+// checkPassword enforces password policy. Personal holds user name, email and so on,
+// history is looked up only for existing users.
+func (c *Controller) checkPassword(ctx context.Context, uid uuid.UUID, pswd string, personal ...string) error {
+	const op = "password.checkPassword.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	var history []string
+	if n := c.au.PasswordHistory(); uid != uuid.Nil && n > 0 {
+		var err error
+		if history, err = c.repo.ListPasswordHistory(ctx, uid, n); err != nil {
+			return err
+		}
+	}
+
+	return c.au.ValidatePassword(pswd, history, personal...)
+}
+
+// rememberPassword records hash of newly set password. Failure only weakens reuse check, so it is not returned.
+func (c *Controller) rememberPassword(ctx context.Context, uid uuid.UUID, hash string) {
+	const op = "password.rememberPassword.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	n := c.au.PasswordHistory()
+	if n == 0 {
+		return
+	}
+
+	if err := c.repo.AddPasswordHistory(ctx, uid, hash, n); err != nil {
+		zap.L().Error(
+			"failed to add password history",
+			zap.String("op", op),
+			zap.String("userID", uid.String()),
+			zap.Error(err),
+		)
+	}
+}
+
+// passwordChangeRequired tells whether new session is restricted to changing password. It is so when password
+// was set by admin or emailed to user, or when user signed in with password older than max age of any own role.
+func (c *Controller) passwordChangeRequired(ctx context.Context, uid uuid.UUID, roles []md.Role, amr []string) (bool, error) {
+	const op = "password.passwordChangeRequired.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	u, err := c.repo.GetUserByID(ctx, uid)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		return false, ErrNotFound
+	} else if err != nil {
+		return false, err
+	}
+
+	if u.MustChangePassword {
+		return true, nil
+	}
+
+	if !slices.Contains(amr, md.AMRPassword) {
+		return false, nil
+	}
+
+	maxAge := c.au.PasswordMaxAge(roles)
+	if maxAge > 0 && time.Since(u.PasswordChangedAt) > maxAge {
+		zap.L().Debug(
+			"password has expired",
+			zap.String("op", op),
+			zap.String("userID", uid.String()),
+			zap.Time("changedAt", u.PasswordChangedAt),
+		)
+		return true, nil
+	}
+	return false, nil
+}
+
+// ChangePassword replaces password of signed-in user, it is the only action allowed for sessions
+// restricted by passwordChangeRequired. Other sessions are signed out and new unrestricted pair is issued.
+func (c *Controller) ChangePassword(ctx context.Context, d *dto.DeviceRequest, uid uuid.UUID, req *dto.ChangePasswordRequest) (*dto.TokenPair, error) {
+	const op = "password.ChangePassword.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	u, err := c.repo.GetUserByID(ctx, uid)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	if _, err = c.checkCredentials(ctx, d, u.Email, req.CurrentPassword); err != nil {
+		return nil, err
+	}
+
+	if err = c.checkPassword(ctx, u.ID, req.Password, u.Name, u.Email); err != nil {
+		return nil, err
+	}
+
+	hash, err := c.au.Hash(req.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = c.repo.UpdateMe(
+		ctx, u.ID, &dto.UpdateUserRequest{
+			Name:     u.Name,
+			Email:    u.Email,
+			Password: hash,
+			Avatar:   u.Avatar,
+			IsActive: u.IsActive,
+			IsEmail:  u.IsEmailVerified,
+		},
+	); err != nil && errors.Is(err, repo.ErrNotFound) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	c.rememberPassword(ctx, u.ID, hash)
+	if err = c.repo.RevokeAllTokens(ctx, u.ID); err != nil {
+		return nil, err
+	}
+
+	c.cache.Delete(ctx, fmt.Sprintf(userCacheKey, u.ID))
+	go c.smtp.SendPasswordChangedEmail(ctx, u.Email, d.IP)
+
+	res, err := c.GenPair(ctx, d, u.ID, u.Roles, md.AMRPassword)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
@@ -0,0 +1,117 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/JMURv/sso/internal/config"
+	"github.com/JMURv/sso/internal/dto"
+	md "github.com/JMURv/sso/internal/models"
+	"github.com/JMURv/sso/internal/repo"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+)
+
+const smsRateKey = "sms:rate:%s"
+
+type phoneRepo interface {
+	SetPhone(ctx context.Context, userID uuid.UUID, phone string) error
+	DeletePhone(ctx context.Context, userID uuid.UUID) error
+}
+
+// StartPhoneVerification sends code to the number, it is stored for user only after code is confirmed.
+func (c *Controller) StartPhoneVerification(ctx context.Context, uid uuid.UUID, req *dto.PhoneRequest) error {
+	const op = "phone.StartPhoneVerification.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	if !c.sms.Enabled() {
+		return ErrSMSDisabled
+	}
+
+	if err := c.allowSMS(ctx, req.Phone); err != nil {
+		return err
+	}
+
+	code, err := c.issueOTP(ctx, otpPhone, uid.String(), nil, req.Phone)
+	if err != nil {
+		return err
+	}
+
+	channel := req.Channel
+	if channel == "" {
+		channel = md.ChannelSMS
+	}
+
+	go c.sms.SendPhoneVerificationCode(ctx, channel, req.Phone, code)
+	return nil
+}
+
+func (c *Controller) ConfirmPhone(ctx context.Context, uid uuid.UUID, req *dto.ConfirmPhoneRequest) error {
+	const op = "phone.ConfirmPhone.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	v, err := c.verifyOTP(ctx, otpPhone, uid.String(), req.Code, nil)
+	if err != nil {
+		return err
+	}
+
+	err = c.repo.SetPhone(ctx, uid, v.Payload)
+	if err != nil && errors.Is(err, repo.ErrAlreadyExists) {
+		return ErrAlreadyExists
+	} else if err != nil && errors.Is(err, repo.ErrNotFound) {
+		return ErrNotFound
+	} else if err != nil {
+		return err
+	}
+
+	c.cache.Delete(ctx, fmt.Sprintf(userCacheKey, uid))
+	go c.cache.InvalidateKeysByPattern(ctx, userPattern)
+	return nil
+}
+
+func (c *Controller) DeletePhone(ctx context.Context, uid uuid.UUID) error {
+	const op = "phone.DeletePhone.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	err := c.repo.DeletePhone(ctx, uid)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		return ErrNotFound
+	} else if err != nil {
+		return err
+	}
+
+	c.cache.Delete(ctx, fmt.Sprintf(userCacheKey, uid))
+	go c.cache.InvalidateKeysByPattern(ctx, userPattern)
+	return nil
+}
+
+// allowSMS checks country allow list and per-number rate limit before anything is sent to the phone.
+func (c *Controller) allowSMS(ctx context.Context, phone string) error {
+	const op = "phone.allowSMS.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	if !c.sms.Allowed(phone) {
+		return ErrCountryNotAllowed
+	}
+
+	n, err := c.cache.Incr(ctx, config.SMSRateWindow, fmt.Sprintf(smsRateKey, phone))
+	if err != nil {
+		return err
+	}
+
+	if n > config.SMSRateLimit {
+		zap.L().Debug(
+			"sms rate limit exceeded",
+			zap.String("op", op),
+			zap.Int64("count", n),
+		)
+		return ErrTooManyRequests
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/JMURv/sso/internal/auth"
+	"github.com/JMURv/sso/internal/config"
+	"github.com/JMURv/sso/internal/dto"
+	md "github.com/JMURv/sso/internal/models"
+	"github.com/JMURv/sso/internal/repo"
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+)
+
+const (
+	qrLoginKey     = "qr:%s"
+	qrLoginUsedKey = "qr:used:%s"
+)
+
+type qrLogin struct {
+	UserID    uuid.UUID `json:"user_id"`
+	Device    md.Device `json:"device"`
+	Confirmed bool      `json:"confirmed"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// StartQRLogin creates login session for the new device. It polls with secret id,
+// while QR code carries only its hash, so scanning the code is not enough to get tokens.
+func (c *Controller) StartQRLogin(ctx context.Context, d *dto.DeviceRequest) (*dto.QRLoginResponse, error) {
+	const op = "qr.StartQRLogin.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	id, err := randomToken()
+	if err != nil {
+		return nil, err
+	}
+
+	bytes, err := json.Marshal(qrLogin{Device: auth.GenerateDevice(d), CreatedAt: time.Now()})
+	if err != nil {
+		return nil, err
+	}
+
+	ref := hashToken(id)
+	url := c.au.QRLoginURL(ref)
+	png, err := auth.EncodeQR(url)
+	if err != nil {
+		zap.L().Error(
+			"failed to render qr code",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	c.cache.Set(ctx, config.QRLoginTime, fmt.Sprintf(qrLoginKey, ref), bytes)
+	return &dto.QRLoginResponse{
+		ID:        id,
+		URL:       url,
+		QR:        png,
+		ExpiresAt: time.Now().Add(config.QRLoginTime),
+	}, nil
+}
+
+// GetQRLogin shows on the phone which device is going to be signed in.
+func (c *Controller) GetQRLogin(ctx context.Context, code string) (*dto.QRLoginSession, error) {
+	const op = "qr.GetQRLogin.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	s := &qrLogin{}
+	if err := c.cache.GetToStruct(ctx, fmt.Sprintf(qrLoginKey, code), s); err != nil || s.Confirmed {
+		return nil, ErrNotFound
+	}
+
+	return &dto.QRLoginSession{
+		Code:       code,
+		DeviceType: s.Device.DeviceType,
+		OS:         s.Device.OS,
+		Browser:    s.Device.Browser,
+		IP:         s.Device.IP,
+		CreatedAt:  s.CreatedAt,
+	}, nil
+}
+
+// ConfirmQRLogin binds session to the user signed in on the phone, phone is recorded as device too.
+func (c *Controller) ConfirmQRLogin(ctx context.Context, uid uuid.UUID, d *dto.DeviceRequest, code string) error {
+	const op = "qr.ConfirmQRLogin.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	key := fmt.Sprintf(qrLoginKey, code)
+	s := &qrLogin{}
+	if err := c.cache.GetToStruct(ctx, key, s); err != nil || s.Confirmed {
+		return ErrNotFound
+	}
+
+	phone := auth.GenerateDevice(d)
+	if err := c.repo.UpsertDevice(ctx, uid, &phone); err != nil {
+		return err
+	}
+
+	s.UserID = uid
+	s.Confirmed = true
+	bytes, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	c.cache.Set(ctx, config.QRLoginTime, key, bytes)
+	return nil
+}
+
+// CheckQRLogin is polled by the new device, tokens are issued once after confirmation.
+func (c *Controller) CheckQRLogin(ctx context.Context, d *dto.DeviceRequest, id string) (*dto.TokenPair, error) {
+	const op = "qr.CheckQRLogin.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	ref := hashToken(id)
+	key := fmt.Sprintf(qrLoginKey, ref)
+
+	s := &qrLogin{}
+	if err := c.cache.GetToStruct(ctx, key, s); err != nil {
+		return nil, ErrNotFound
+	}
+
+	if s.Device.ID != auth.GenerateDevice(d).ID {
+		zap.L().Debug(
+			"qr login polled from another device",
+			zap.String("op", op),
+		)
+		return nil, ErrNotFound
+	}
+
+	if !s.Confirmed {
+		return nil, ErrApprovalPending
+	}
+
+	ok, err := c.cache.SetNX(ctx, config.QRLoginTime, fmt.Sprintf(qrLoginUsedKey, ref), 1)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		return nil, ErrCodeReused
+	}
+	c.cache.Delete(ctx, key)
+
+	u, err := c.repo.GetUserByID(ctx, s.UserID)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	pair, err := c.loginPair(ctx, d, u, md.AMRMultiChannel)
+	if err != nil {
+		return nil, err
+	}
+	return &pair, nil
+}
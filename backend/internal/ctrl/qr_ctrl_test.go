@@ -0,0 +1,210 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/JMURv/sso/internal/auth"
+	"github.com/JMURv/sso/internal/config"
+	"github.com/JMURv/sso/internal/dto"
+	md "github.com/JMURv/sso/internal/models"
+	"github.com/JMURv/sso/tests/mocks"
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestController_StartQRLogin(t *testing.T) {
+	mock := gomock.NewController(t)
+
+	mau := mocks.NewMockCore(mock)
+	mcache := mocks.NewMockCacheService(mock)
+	c := New(mocks.NewMockAppRepo(mock), mau, mcache, nil, nil, nil)
+
+	d := &dto.DeviceRequest{IP: "127.0.0.1", UA: "test"}
+
+	var code, key string
+	s := &qrLogin{}
+	mau.EXPECT().QRLoginURL(gomock.Any()).DoAndReturn(
+		func(c string) string {
+			code = c
+			return "https://sso.example.com/qr/?code=" + c
+		},
+	)
+	mcache.EXPECT().Set(gomock.Any(), config.QRLoginTime, gomock.Any(), gomock.Any()).Do(
+		func(_ context.Context, _ time.Duration, k string, val any) {
+			key = k
+			require.NoError(t, json.Unmarshal(val.([]byte), s))
+		},
+	)
+
+	res, err := c.StartQRLogin(context.Background(), d)
+	require.NoError(t, err)
+	assert.NotEmpty(t, res.QR)
+	assert.Equal(t, hashToken(res.ID), code, "qr code carries only hash of polling id")
+	assert.NotContains(t, res.URL, res.ID)
+	assert.Equal(t, fmt.Sprintf(qrLoginKey, code), key)
+	assert.Equal(t, auth.GenerateDevice(d).ID, s.Device.ID)
+	assert.False(t, s.Confirmed)
+}
+
+func TestController_ConfirmQRLogin(t *testing.T) {
+	mock := gomock.NewController(t)
+
+	mrepo := mocks.NewMockAppRepo(mock)
+	mcache := mocks.NewMockCacheService(mock)
+	c := New(mrepo, mocks.NewMockCore(mock), mcache, nil, nil, nil)
+
+	ctx := context.Background()
+	uid := uuid.New()
+	phone := &dto.DeviceRequest{IP: "192.168.0.2", UA: "phone"}
+	code := hashToken("id")
+	key := fmt.Sprintf(qrLoginKey, code)
+
+	stored := func(confirmed bool) {
+		mcache.EXPECT().GetToStruct(gomock.Any(), key, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, dest any) error {
+				*dest.(*qrLogin) = qrLogin{Confirmed: confirmed}
+				return nil
+			},
+		)
+	}
+
+	tests := []struct {
+		name   string
+		expect func()
+		err    error
+	}{
+		{
+			name: "Success records phone",
+			expect: func() {
+				stored(false)
+				mrepo.EXPECT().UpsertDevice(gomock.Any(), uid, gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ uuid.UUID, dev *md.Device) error {
+						assert.Equal(t, auth.GenerateDevice(phone).ID, dev.ID)
+						return nil
+					},
+				)
+				mcache.EXPECT().Set(gomock.Any(), config.QRLoginTime, key, gomock.Any()).Do(
+					func(_ context.Context, _ time.Duration, _ string, val any) {
+						s := &qrLogin{}
+						require.NoError(t, json.Unmarshal(val.([]byte), s))
+						assert.True(t, s.Confirmed)
+						assert.Equal(t, uid, s.UserID)
+					},
+				)
+			},
+		},
+		{
+			name: "Already confirmed",
+			expect: func() {
+				stored(true)
+			},
+			err: ErrNotFound,
+		},
+		{
+			name: "Expired",
+			expect: func() {
+				mcache.EXPECT().GetToStruct(gomock.Any(), key, gomock.Any()).Return(errors.New("miss"))
+			},
+			err: ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				assert.ErrorIs(t, c.ConfirmQRLogin(ctx, uid, phone, code), tt.err)
+			},
+		)
+	}
+}
+
+func TestController_CheckQRLogin(t *testing.T) {
+	mock := gomock.NewController(t)
+
+	mrepo := mocks.NewMockAppRepo(mock)
+	mau := mocks.NewMockCore(mock)
+	mcache := mocks.NewMockCacheService(mock)
+	c := New(mrepo, mau, mcache, nil, nil, nil)
+
+	ctx := context.Background()
+	d := &dto.DeviceRequest{IP: "127.0.0.1", UA: "test"}
+	u := &md.User{ID: uuid.New()}
+	id := "id"
+	ref := hashToken(id)
+	key := fmt.Sprintf(qrLoginKey, ref)
+
+	stored := func(confirmed bool, device md.Device) {
+		mcache.EXPECT().GetToStruct(gomock.Any(), key, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, dest any) error {
+				*dest.(*qrLogin) = qrLogin{UserID: u.ID, Device: device, Confirmed: confirmed}
+				return nil
+			},
+		)
+	}
+	redeem := func(ok bool) {
+		mcache.EXPECT().SetNX(gomock.Any(), config.QRLoginTime, fmt.Sprintf(qrLoginUsedKey, ref), 1).Return(ok, nil)
+	}
+
+	tests := []struct {
+		name   string
+		expect func()
+		err    error
+	}{
+		{
+			name: "Another device",
+			expect: func() {
+				stored(true, auth.GenerateDevice(&dto.DeviceRequest{IP: "10.0.0.1", UA: "other"}))
+			},
+			err: ErrNotFound,
+		},
+		{
+			name: "Pending",
+			expect: func() {
+				stored(false, auth.GenerateDevice(d))
+			},
+			err: ErrApprovalPending,
+		},
+		{
+			name: "Confirmed",
+			expect: func() {
+				stored(true, auth.GenerateDevice(d))
+				redeem(true)
+				mcache.EXPECT().Delete(gomock.Any(), key)
+				mrepo.EXPECT().GetUserByID(gomock.Any(), u.ID).Return(u, nil).Times(2)
+				mau.EXPECT().RiskEnabled().Return(false)
+				mau.EXPECT().GenPair(gomock.Any(), u.ID, gomock.Any(), gomock.Any()).Return("access", "refresh", nil)
+				mau.EXPECT().GetRefreshTime().Return(time.Now().Add(time.Hour))
+				mrepo.EXPECT().CreateToken(gomock.Any(), u.ID, "refresh", gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "Second redemption",
+			expect: func() {
+				stored(true, auth.GenerateDevice(d))
+				redeem(false)
+			},
+			err: ErrCodeReused,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				res, err := c.CheckQRLogin(ctx, d, id)
+				assert.ErrorIs(t, err, tt.err)
+				if tt.err == nil {
+					assert.Equal(t, "access", res.Access)
+				}
+			},
+		)
+	}
+}
@@ -0,0 +1,177 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/JMURv/sso/internal/config"
+	"github.com/JMURv/sso/internal/dto"
+	md "github.com/JMURv/sso/internal/models"
+	"github.com/JMURv/sso/internal/repo"
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+)
+
+type realmRepo interface {
+	ListRealmDomains(ctx context.Context) ([]md.RealmDomain, error)
+	CreateRealmDomain(ctx context.Context, req *dto.CreateRealmDomainRequest) (uint64, error)
+	DeleteRealmDomain(ctx context.Context, domain string) error
+	GetUserConnection(ctx context.Context, userID uuid.UUID) (string, string, error)
+}
+
+const realmListKey = "realms-list"
+
+func (c *Controller) ListRealmDomains(ctx context.Context) ([]md.RealmDomain, error) {
+	const op = "realms.ListRealmDomains.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	var cached []md.RealmDomain
+	if err := c.cache.GetToStruct(ctx, realmListKey, &cached); err == nil {
+		return cached, nil
+	}
+
+	res, err := c.repo.ListRealmDomains(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var bytes []byte
+	if bytes, err = json.Marshal(res); err == nil {
+		c.cache.Set(ctx, config.DefaultCacheTime, realmListKey, bytes)
+	}
+	return res, nil
+}
+
+func (c *Controller) CreateRealmDomain(ctx context.Context, req *dto.CreateRealmDomainRequest) (uint64, error) {
+	const op = "realms.CreateRealmDomain.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := c.repo.CreateRealmDomain(ctx, req)
+	if err != nil && errors.Is(err, repo.ErrAlreadyExists) {
+		return 0, ErrAlreadyExists
+	} else if err != nil {
+		return 0, err
+	}
+
+	c.cache.Delete(ctx, realmListKey)
+	return res, nil
+}
+
+func (c *Controller) DeleteRealmDomain(ctx context.Context, domain string) error {
+	const op = "realms.DeleteRealmDomain.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	err := c.repo.DeleteRealmDomain(ctx, domain)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		return ErrNotFound
+	} else if err != nil {
+		return err
+	}
+
+	c.cache.Delete(ctx, realmListKey)
+	return nil
+}
+
+// DiscoverRealm tells the client how the owner of email should sign in. Domain mappings win.
+// Per-user methods (passkey, email code, federated connection) are answered only when
+// anti-enumeration is off, otherwise accounts are never looked up and domains without
+// mapping get the password method, so the answer does not reveal whether account exists.
+func (c *Controller) DiscoverRealm(ctx context.Context, email string) (*dto.DiscoverRealmResponse, error) {
+	const op = "realms.DiscoverRealm.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	realm, err := c.findRealm(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+
+	if realm != nil {
+		return realmResponse(realm.Method, realm.Connection), nil
+	}
+
+	if c.au.AntiEnumeration().Enabled || c.au.IsLDAPUser(email) {
+		return realmResponse(md.LoginMethodPassword, ""), nil
+	}
+	return c.userRealm(ctx, email)
+}
+
+// userRealm picks login method from account state, unknown users get the password method.
+func (c *Controller) userRealm(ctx context.Context, email string) (*dto.DiscoverRealmResponse, error) {
+	u, err := c.repo.GetUserByEmail(ctx, email)
+	if errors.Is(err, repo.ErrNotFound) {
+		return realmResponse(md.LoginMethodPassword, ""), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if u.IsWA {
+		return realmResponse(md.LoginMethodPasskey, ""), nil
+	}
+
+	if u.Password != "" {
+		return realmResponse(md.LoginMethodPassword, ""), nil
+	}
+
+	if ok, err := c.repo.IsLDAPUser(ctx, u.ID); err != nil {
+		return nil, err
+	} else if ok {
+		return realmResponse(md.LoginMethodPassword, ""), nil
+	}
+
+	method, provider, err := c.repo.GetUserConnection(ctx, u.ID)
+	if errors.Is(err, repo.ErrNotFound) {
+		return realmResponse(md.LoginMethodEmailCode, ""), nil
+	} else if err != nil {
+		return nil, err
+	}
+	return realmResponse(method, provider), nil
+}
+
+// findRealm returns mapping for email domain or its closest parent domain.
+func (c *Controller) findRealm(ctx context.Context, email string) (*md.RealmDomain, error) {
+	idx := strings.LastIndex(email, "@")
+	if idx == -1 {
+		return nil, nil
+	}
+
+	realms, err := c.ListRealmDomains(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var res *md.RealmDomain
+	domain := strings.ToLower(email[idx+1:])
+	for i := 0; i < len(realms); i++ {
+		if domain != realms[i].Domain && !strings.HasSuffix(domain, "."+realms[i].Domain) {
+			continue
+		}
+
+		if res == nil || len(realms[i].Domain) > len(res.Domain) {
+			res = &realms[i]
+		}
+	}
+	return res, nil
+}
+
+func realmResponse(method, connection string) *dto.DiscoverRealmResponse {
+	res := &dto.DiscoverRealmResponse{Method: method}
+	switch method {
+	case md.LoginMethodOAuth2, md.LoginMethodOIDC, md.LoginMethodSAML:
+		res.Connection = connection
+		res.URL = fmt.Sprintf("/auth/%s/%s/start", method, connection)
+	case md.LoginMethodLDAP:
+		res.Method = md.LoginMethodPassword
+	}
+	return res
+}
+
+func isFederated(method string) bool {
+	return method == md.LoginMethodOAuth2 || method == md.LoginMethodOIDC || method == md.LoginMethodSAML
+}
@@ -0,0 +1,133 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/JMURv/sso/internal/config"
+	"github.com/JMURv/sso/internal/dto"
+	md "github.com/JMURv/sso/internal/models"
+	"github.com/JMURv/sso/internal/repo"
+	"github.com/JMURv/sso/tests/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestController_DiscoverRealm(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mrepo := mocks.NewMockAppRepo(mock)
+	mau := mocks.NewMockCore(mock)
+	mcache := mocks.NewMockCacheService(mock)
+	c := New(mrepo, mau, mcache, nil, nil, nil)
+
+	realms := []md.RealmDomain{
+		{Domain: "corp.com", Method: md.LoginMethodSAML, Connection: "corp"},
+		{Domain: "dev.corp.com", Method: md.LoginMethodLDAP},
+	}
+
+	uid := uuid.New()
+	email := "john@gmail.com"
+	enumeration := func(enabled bool) {
+		mau.EXPECT().AntiEnumeration().Return(config.AntiEnumerationConfig{Enabled: enabled})
+		if !enabled {
+			mau.EXPECT().IsLDAPUser(email).Return(false)
+		}
+	}
+
+	tests := []struct {
+		name   string
+		email  string
+		expect func()
+		res    *dto.DiscoverRealmResponse
+	}{
+		{
+			name:   "Mapped domain",
+			email:  "john@corp.com",
+			expect: func() {},
+			res: &dto.DiscoverRealmResponse{
+				Method:     md.LoginMethodSAML,
+				Connection: "corp",
+				URL:        "/auth/saml/corp/start",
+			},
+		},
+		{
+			name:   "Closest parent domain",
+			email:  "john@dev.corp.com",
+			expect: func() {},
+			res:    &dto.DiscoverRealmResponse{Method: md.LoginMethodPassword},
+		},
+		{
+			// Accounts are never looked up, so registered and unknown emails get the same answer
+			name:  "Unmapped domain with anti-enumeration",
+			email: email,
+			expect: func() {
+				enumeration(true)
+			},
+			res: &dto.DiscoverRealmResponse{Method: md.LoginMethodPassword},
+		},
+		{
+			name:  "Passkey user",
+			email: email,
+			expect: func() {
+				enumeration(false)
+				mrepo.EXPECT().GetUserByEmail(gomock.Any(), email).Return(&md.User{ID: uid, IsWA: true}, nil)
+			},
+			res: &dto.DiscoverRealmResponse{Method: md.LoginMethodPasskey},
+		},
+		{
+			name:  "Federated user",
+			email: email,
+			expect: func() {
+				enumeration(false)
+				mrepo.EXPECT().GetUserByEmail(gomock.Any(), email).Return(&md.User{ID: uid}, nil)
+				mrepo.EXPECT().IsLDAPUser(gomock.Any(), uid).Return(false, nil)
+				mrepo.EXPECT().GetUserConnection(gomock.Any(), uid).Return(md.LoginMethodSAML, "corp", nil)
+			},
+			res: &dto.DiscoverRealmResponse{
+				Method:     md.LoginMethodSAML,
+				Connection: "corp",
+				URL:        "/auth/saml/corp/start",
+			},
+		},
+		{
+			name:  "Passwordless user",
+			email: email,
+			expect: func() {
+				enumeration(false)
+				mrepo.EXPECT().GetUserByEmail(gomock.Any(), email).Return(&md.User{ID: uid}, nil)
+				mrepo.EXPECT().IsLDAPUser(gomock.Any(), uid).Return(false, nil)
+				mrepo.EXPECT().GetUserConnection(gomock.Any(), uid).Return("", "", repo.ErrNotFound)
+			},
+			res: &dto.DiscoverRealmResponse{Method: md.LoginMethodEmailCode},
+		},
+		{
+			name:  "Unknown user",
+			email: email,
+			expect: func() {
+				enumeration(false)
+				mrepo.EXPECT().GetUserByEmail(gomock.Any(), email).Return(nil, repo.ErrNotFound)
+			},
+			res: &dto.DiscoverRealmResponse{Method: md.LoginMethodPassword},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				mcache.EXPECT().GetToStruct(gomock.Any(), realmListKey, gomock.Any()).Return(errors.New("miss"))
+				mrepo.EXPECT().ListRealmDomains(gomock.Any()).Return(realms, nil)
+				mcache.EXPECT().Set(gomock.Any(), gomock.Any(), realmListKey, gomock.Any())
+
+				res, err := c.DiscoverRealm(context.Background(), tt.email)
+				require.NoError(t, err)
+				assert.Equal(t, tt.res, res)
+			},
+		)
+	}
+}
@@ -0,0 +1,66 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+
+	"github.com/JMURv/sso/internal/auth"
+	"github.com/JMURv/sso/internal/dto"
+	md "github.com/JMURv/sso/internal/models"
+	"github.com/JMURv/sso/internal/repo"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+)
+
+// Reauthenticate issues fresh tokens with new auth_time for logged-in user, so
+// sensitive actions are unlocked for configured reauth time. Passkey re-authentication
+// goes through regular WebAuthn login.
+func (c *Controller) Reauthenticate(ctx context.Context, d *dto.DeviceRequest, uid uuid.UUID, req *dto.ReauthRequest) (*dto.TokenPair, error) {
+	const op = "auth.Reauthenticate.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	u, err := c.repo.GetUserByID(ctx, uid)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	var method string
+	if req.Password != "" {
+		res, err := c.checkCredentials(ctx, d, u.Email, req.Password)
+		if err != nil && errors.Is(err, ErrNotFound) {
+			return nil, auth.ErrInvalidCredentials
+		} else if err != nil {
+			return nil, err
+		}
+
+		if res.ID != u.ID {
+			zap.L().Error(
+				"credentials belong to another user",
+				zap.String("op", op),
+				zap.String("userID", uid.String()),
+			)
+			return nil, auth.ErrInvalidCredentials
+		}
+		method = md.AMRPassword
+	} else {
+		t, err := c.confirmedTOTP(ctx, uid)
+		if err != nil {
+			return nil, err
+		}
+
+		if err = c.checkTOTP(ctx, t, req.Code); err != nil {
+			return nil, err
+		}
+		method = md.AMROTP
+	}
+
+	pair, err := c.GenPair(ctx, d, u.ID, u.Roles, method)
+	if err != nil {
+		return nil, err
+	}
+	return &pair, nil
+}
@@ -0,0 +1,103 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+
+	"github.com/JMURv/sso/internal/auth"
+	"github.com/JMURv/sso/internal/config"
+	"github.com/JMURv/sso/internal/dto"
+	md "github.com/JMURv/sso/internal/models"
+	"github.com/JMURv/sso/internal/repo"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+)
+
+type recoveryRepo interface {
+	ReplaceRecoveryCodes(ctx context.Context, userID uuid.UUID, hashes []string) error
+	UseRecoveryCode(ctx context.Context, userID uuid.UUID, hash string, d *md.Device) error
+	CountRecoveryCodes(ctx context.Context, userID uuid.UUID) (int, error)
+}
+
+// RegenerateRecoveryCodes issues new set of codes, previous set stops working. Codes are
+// accepted only in place of TOTP, so they are not issued to users without confirmed TOTP.
+func (c *Controller) RegenerateRecoveryCodes(ctx context.Context, uid uuid.UUID) (*dto.RecoveryCodesResponse, error) {
+	const op = "recovery.RegenerateRecoveryCodes.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	if _, err := c.confirmedTOTP(ctx, uid); err != nil && errors.Is(err, ErrNotFound) {
+		return nil, ErrNoSecondFactor
+	} else if err != nil {
+		return nil, err
+	}
+
+	return c.newRecoveryCodes(ctx, uid)
+}
+
+func (c *Controller) VerifyRecoveryCode(ctx context.Context, d *dto.DeviceRequest, req *dto.VerifyRecoveryCodeRequest) (*dto.TokenPair, error) {
+	const op = "recovery.VerifyRecoveryCode.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	return c.passChallenge(
+		ctx, d, req.Challenge, md.AMROTP, func(uid uuid.UUID) error {
+			err := c.checkMFACode(
+				ctx, uid, d.IP, func() error {
+					device := auth.GenerateDevice(d)
+					err := c.repo.UseRecoveryCode(ctx, uid, auth.HashRecoveryCode(req.Code), &device)
+					if err != nil && errors.Is(err, repo.ErrNotFound) {
+						return ErrCodeIsNotValid
+					}
+					return err
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			c.notifyRecoveryCodeUsed(ctx, uid, d.IP)
+			return nil
+		},
+	)
+}
+
+func (c *Controller) newRecoveryCodes(ctx context.Context, uid uuid.UUID) (*dto.RecoveryCodesResponse, error) {
+	codes, err := auth.GenerateRecoveryCodes(config.RecoveryCodesNum)
+	if err != nil {
+		return nil, err
+	}
+
+	hashes := make([]string, len(codes))
+	for i := range codes {
+		hashes[i] = auth.HashRecoveryCode(codes[i])
+	}
+
+	if err = c.repo.ReplaceRecoveryCodes(ctx, uid, hashes); err != nil {
+		return nil, err
+	}
+	return &dto.RecoveryCodesResponse{Codes: codes}, nil
+}
+
+func (c *Controller) notifyRecoveryCodeUsed(ctx context.Context, uid uuid.UUID, ip string) {
+	const op = "recovery.notifyRecoveryCodeUsed.ctrl"
+
+	u, err := c.repo.GetUserByID(ctx, uid)
+	if err != nil {
+		zap.L().Error(
+			"failed to get user for notification",
+			zap.String("op", op),
+			zap.String("userID", uid.String()),
+			zap.Error(err),
+		)
+		return
+	}
+
+	remaining, err := c.repo.CountRecoveryCodes(ctx, uid)
+	if err != nil {
+		return
+	}
+
+	go c.smtp.SendRecoveryCodeUsedEmail(ctx, u.Email, ip, remaining)
+}
@@ -0,0 +1,158 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/JMURv/sso/internal/auth"
+	"github.com/JMURv/sso/internal/config"
+	"github.com/JMURv/sso/internal/dto"
+	md "github.com/JMURv/sso/internal/models"
+	"github.com/JMURv/sso/internal/repo"
+	"github.com/JMURv/sso/tests/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestController_VerifyRecoveryCode(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mrepo := mocks.NewMockAppRepo(mock)
+	mau := mocks.NewMockCore(mock)
+	mcache := mocks.NewMockCacheService(mock)
+	c := New(mrepo, mau, mcache, nil, nil, nil)
+
+	ctx := context.Background()
+	d := &dto.DeviceRequest{IP: "127.0.0.1", UA: "test"}
+	uid := uuid.New()
+	conf := config.LockoutConfig{MFAAttempts: 5, IPAttempts: 50, Duration: time.Minute}
+	subj := accountSubject(lockoutMFA, uid.String())
+	req := &dto.VerifyRecoveryCodeRequest{Challenge: "challenge", Code: "code"}
+	challenge := func() {
+		mcache.EXPECT().GetToStruct(gomock.Any(), fmt.Sprintf(mfaChallengeKey, req.Challenge), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, dest any) error {
+				*dest.(*mfaChallenge) = mfaChallenge{UserID: uid, DeviceID: auth.GenerateDevice(d).ID}
+				return nil
+			},
+		)
+	}
+	notLocked := func() {
+		mcache.EXPECT().GetInt(gomock.Any(), fmt.Sprintf(lockoutUntilKey, subj)).Return(0, errors.New("miss"))
+		mcache.EXPECT().GetInt(gomock.Any(), fmt.Sprintf(lockoutUntilKey, ipSubject(d.IP))).Return(0, errors.New("miss"))
+		mcache.EXPECT().GetInt(gomock.Any(), fmt.Sprintf(lockoutDelayKey, subj)).Return(0, errors.New("miss"))
+	}
+	wrongCode := func() {
+		mrepo.EXPECT().UseRecoveryCode(gomock.Any(), uid, auth.HashRecoveryCode(req.Code), gomock.Any()).Return(repo.ErrNotFound)
+		mau.EXPECT().Lockout().Return(conf).Times(3)
+		mcache.EXPECT().Incr(gomock.Any(), conf.Duration, fmt.Sprintf(lockoutFailKey, ipSubject(d.IP))).Return(int64(1), nil)
+	}
+	attempted := func() {
+		mcache.EXPECT().Set(gomock.Any(), config.MFAChallengeTime, fmt.Sprintf(mfaChallengeKey, req.Challenge), gomock.Any())
+	}
+
+	tests := []struct {
+		name   string
+		expect func()
+		err    error
+	}{
+		{
+			name: "Wrong code is counted for user",
+			expect: func() {
+				challenge()
+				notLocked()
+				wrongCode()
+				mcache.EXPECT().Incr(gomock.Any(), conf.Duration, fmt.Sprintf(lockoutFailKey, subj)).Return(int64(1), nil)
+				attempted()
+			},
+			err: ErrCodeIsNotValid,
+		},
+		{
+			name: "Last wrong code locks user",
+			expect: func() {
+				challenge()
+				notLocked()
+				wrongCode()
+				mcache.EXPECT().Incr(gomock.Any(), conf.Duration, fmt.Sprintf(lockoutFailKey, subj)).Return(int64(5), nil)
+				mcache.EXPECT().Set(gomock.Any(), conf.Duration, fmt.Sprintf(lockoutUntilKey, subj), gomock.Any())
+				attempted()
+			},
+			err: ErrAccountLocked,
+		},
+		{
+			name: "Locked user is not checked",
+			expect: func() {
+				challenge()
+				mcache.EXPECT().GetInt(gomock.Any(), fmt.Sprintf(lockoutUntilKey, subj)).Return(1, nil)
+				attempted()
+			},
+			err: ErrAccountLocked,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				_, err := c.VerifyRecoveryCode(ctx, d, req)
+				assert.ErrorIs(t, err, tt.err)
+			},
+		)
+	}
+}
+
+func TestController_RegenerateRecoveryCodes(t *testing.T) {
+	mock := gomock.NewController(t)
+
+	mrepo := mocks.NewMockAppRepo(mock)
+	c := New(mrepo, mocks.NewMockCore(mock), mocks.NewMockCacheService(mock), nil, nil, nil)
+
+	ctx := context.Background()
+	uid := uuid.New()
+	confirmed := time.Now()
+
+	tests := []struct {
+		name   string
+		expect func()
+		err    error
+	}{
+		{
+			name: "Confirmed TOTP",
+			expect: func() {
+				mrepo.EXPECT().GetTOTP(gomock.Any(), uid).Return(&md.TOTP{UserID: uid, ConfirmedAt: &confirmed}, nil)
+				mrepo.EXPECT().ReplaceRecoveryCodes(gomock.Any(), uid, gomock.Len(config.RecoveryCodesNum)).Return(nil)
+			},
+		},
+		{
+			name: "Unconfirmed TOTP",
+			expect: func() {
+				mrepo.EXPECT().GetTOTP(gomock.Any(), uid).Return(&md.TOTP{UserID: uid}, nil)
+			},
+			err: ErrNoSecondFactor,
+		},
+		{
+			name: "Passkey only user",
+			expect: func() {
+				mrepo.EXPECT().GetTOTP(gomock.Any(), uid).Return(nil, repo.ErrNotFound)
+			},
+			err: ErrNoSecondFactor,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				res, err := c.RegenerateRecoveryCodes(ctx, uid)
+				assert.ErrorIs(t, err, tt.err)
+				if tt.err == nil {
+					assert.Len(t, res.Codes, config.RecoveryCodesNum)
+				}
+			},
+		)
+	}
+}
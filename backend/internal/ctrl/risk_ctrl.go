@@ -0,0 +1,141 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/JMURv/sso/internal/auth"
+	"github.com/JMURv/sso/internal/auth/risk"
+	"github.com/JMURv/sso/internal/dto"
+	md "github.com/JMURv/sso/internal/models"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+)
+
+type riskRepo interface {
+	CreateRiskEvent(ctx context.Context, e *md.RiskEvent) error
+	ListRiskEvents(ctx context.Context, uid uuid.UUID, page, size int) (*dto.PaginatedRiskEventResponse, error)
+}
+
+// assessRisk scores login of user who has passed the first factor. Known devices and
+// the latest activity come from user devices, failures from lockout counters.
+// Every assessment is stored for audit, failure to store it does not break login.
+func (c *Controller) assessRisk(ctx context.Context, d *dto.DeviceRequest, u *md.User) (string, error) {
+	const op = "risk.assessRisk.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	if !c.au.RiskEnabled() {
+		return md.RiskActionAllow, nil
+	}
+
+	devs, err := c.repo.ListDevices(ctx, u.ID)
+	if err != nil {
+		return "", err
+	}
+
+	device := auth.GenerateDevice(d)
+	s := risk.Signals{
+		IP:       d.IP,
+		Failures: c.recentFailures(ctx, u, d.IP),
+		Now:      time.Now(),
+	}
+	for i := 0; i < len(devs); i++ {
+		if devs[i].ID == device.ID {
+			s.KnownDevice = true
+		}
+		if s.Last == nil || devs[i].LastActive.After(s.Last.At) {
+			s.Last = &risk.Sighting{IP: devs[i].IP, At: devs[i].LastActive}
+		}
+	}
+
+	res := c.au.AssessRisk(s)
+	_ = c.repo.CreateRiskEvent(
+		ctx, &md.RiskEvent{
+			UserID:   u.ID,
+			DeviceID: device.ID,
+			IP:       d.IP,
+			Country:  res.Country,
+			Score:    res.Score,
+			Action:   res.Action,
+			Reasons:  res.Reasons,
+		},
+	)
+
+	if res.Action != md.RiskActionAllow {
+		zap.L().Info(
+			"risky login",
+			zap.String("op", op),
+			zap.String("userID", u.ID.String()),
+			zap.String("ip", d.IP),
+			zap.String("country", res.Country),
+			zap.Int("score", res.Score),
+			zap.String("action", res.Action),
+			zap.Strings("reasons", res.Reasons),
+		)
+	}
+	return res.Action, nil
+}
+
+// needsStepUp reports whether risk action asks for second factor which login has not provided yet.
+// Captcha is passed by handler, when it was not checked second factor is asked instead.
+func needsStepUp(ctx context.Context, action string, amr []string) bool {
+	if hasSecondFactor(amr) {
+		return false
+	}
+
+	captcha, _ := ctx.Value("captcha").(bool)
+	return action == md.RiskActionMFA || (action == md.RiskActionCaptcha && !captcha)
+}
+
+// hasSecondFactor reports whether login has passed code, hardware key or another device.
+func hasSecondFactor(amr []string) bool {
+	for _, m := range []string{md.AMROTP, md.AMRHardwareKey, md.AMRMultiFactor, md.AMRMultiChannel} {
+		if slices.Contains(amr, m) {
+			return true
+		}
+	}
+	return false
+}
+
+// VerifyEmailCode passes MFA challenge with code sent by email, it is offered
+// when risky login requires second factor and user has none enrolled.
+func (c *Controller) VerifyEmailCode(ctx context.Context, d *dto.DeviceRequest, req *dto.VerifyEmailCodeRequest) (*dto.TokenPair, error) {
+	const op = "risk.VerifyEmailCode.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	return c.passChallenge(
+		ctx, d, req.Challenge, md.AMROTP, func(uid uuid.UUID) error {
+			u, err := c.GetUserByID(ctx, uid)
+			if err != nil {
+				return err
+			}
+
+			return c.checkMFACode(
+				ctx, uid, d.IP, func() error {
+					_, err := c.verifyOTP(ctx, otpMFA, u.Email, req.Code, d)
+					if err != nil && errors.Is(err, ErrNotFound) {
+						return ErrCodeIsNotValid
+					}
+					return err
+				},
+			)
+		},
+	)
+}
+
+// ListRiskEvents shows risk assessments of user logins, the latest first.
+func (c *Controller) ListRiskEvents(ctx context.Context, uid uuid.UUID, page, size int) (*dto.PaginatedRiskEventResponse, error) {
+	const op = "risk.ListRiskEvents.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	if _, err := c.GetUserByID(ctx, uid); err != nil {
+		return nil, err
+	}
+	return c.repo.ListRiskEvents(ctx, uid, page, size)
+}
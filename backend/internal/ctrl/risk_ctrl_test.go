@@ -0,0 +1,101 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JMURv/sso/internal/auth/risk"
+	"github.com/JMURv/sso/internal/dto"
+	md "github.com/JMURv/sso/internal/models"
+	"github.com/JMURv/sso/tests/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestController_loginPair(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mrepo := mocks.NewMockAppRepo(mock)
+	mau := mocks.NewMockCore(mock)
+	mcache := mocks.NewMockCacheService(mock)
+	c := New(mrepo, mau, mcache, nil, nil, nil)
+
+	ctx := context.Background()
+	d := &dto.DeviceRequest{IP: "127.0.0.1", UA: "test"}
+	u := &md.User{ID: uuid.New(), Email: "john@example.com"}
+	refreshExp := time.Now().Add(time.Hour)
+	assess := func(action string) {
+		mau.EXPECT().RiskEnabled().Return(true)
+		mrepo.EXPECT().ListDevices(gomock.Any(), u.ID).Return(nil, nil)
+		mcache.EXPECT().GetInt(gomock.Any(), gomock.Any()).Return(0, errors.New("miss")).AnyTimes()
+		mau.EXPECT().AssessRisk(gomock.Any()).Return(risk.Assessment{Action: action})
+		mrepo.EXPECT().CreateRiskEvent(gomock.Any(), gomock.Any()).Return(nil)
+	}
+	genPair := func() {
+		mrepo.EXPECT().GetUserByID(gomock.Any(), u.ID).Return(u, nil)
+		mau.EXPECT().GenPair(gomock.Any(), u.ID, gomock.Any(), gomock.Any()).Return("access", "refresh", nil)
+		mau.EXPECT().GetRefreshTime().Return(refreshExp)
+		mrepo.EXPECT().CreateToken(gomock.Any(), u.ID, "refresh", refreshExp, gomock.Any()).Return(nil)
+	}
+
+	tests := []struct {
+		name   string
+		amr    []string
+		expect func()
+		err    error
+	}{
+		{
+			name: "Captcha is not asked",
+			amr:  []string{md.AMRFederated},
+			expect: func() {
+				assess(md.RiskActionCaptcha)
+				genPair()
+			},
+		},
+		{
+			name: "Step-up without second factor",
+			amr:  []string{md.AMRFederated},
+			expect: func() {
+				assess(md.RiskActionMFA)
+			},
+			err: ErrRiskBlocked,
+		},
+		{
+			name: "Step-up with hardware key",
+			amr:  []string{md.AMRHardwareKey},
+			expect: func() {
+				assess(md.RiskActionMFA)
+				genPair()
+			},
+		},
+		{
+			name: "Blocked",
+			amr:  []string{md.AMRHardwareKey},
+			expect: func() {
+				assess(md.RiskActionBlock)
+			},
+			err: ErrRiskBlocked,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				res, err := c.loginPair(ctx, d, u, tt.amr...)
+				if tt.err != nil {
+					assert.ErrorIs(t, err, tt.err)
+					return
+				}
+
+				require.NoError(t, err)
+				assert.Equal(t, "access", res.Access)
+			},
+		)
+	}
+}
@@ -0,0 +1,303 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/JMURv/sso/internal/cache"
+	"github.com/JMURv/sso/internal/config"
+	"github.com/JMURv/sso/internal/dto"
+	md "github.com/JMURv/sso/internal/models"
+	"github.com/JMURv/sso/internal/repo"
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+)
+
+type samlRepo interface {
+	ListSAMLProviders(ctx context.Context) ([]md.SAMLProvider, error)
+	GetSAMLProvider(ctx context.Context, name string) (*md.SAMLProvider, error)
+	CreateSAMLProvider(ctx context.Context, entityID string, req *dto.CreateSAMLProviderRequest) (uint64, error)
+	DeleteSAMLProvider(ctx context.Context, name string) error
+	GetUserBySAML(ctx context.Context, provider, nameID string) (*md.User, error)
+	CreateSAMLConnection(ctx context.Context, userID uuid.UUID, provider string, req *dto.SAMLAssertion) error
+}
+
+const (
+	samlRequestKey   = "saml:request:%s"
+	samlAssertionKey = "saml:assertion:%s:%s"
+)
+
+func (c *Controller) ListSAMLProviders(ctx context.Context) ([]md.SAMLProvider, error) {
+	const op = "saml.ListSAMLProviders.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	return c.repo.ListSAMLProviders(ctx)
+}
+
+func (c *Controller) CreateSAMLProvider(ctx context.Context, req *dto.CreateSAMLProviderRequest) (uint64, error) {
+	const op = "saml.CreateSAMLProvider.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	entityID, err := c.au.ParseIDPMetadata([]byte(req.Metadata))
+	if err != nil {
+		return 0, err
+	}
+
+	for i := 0; i < len(req.LinkDomains); i++ {
+		req.LinkDomains[i] = strings.ToLower(strings.TrimSpace(req.LinkDomains[i]))
+	}
+
+	res, err := c.repo.CreateSAMLProvider(ctx, entityID, req)
+	if err != nil && errors.Is(err, repo.ErrAlreadyExists) {
+		return 0, ErrAlreadyExists
+	} else if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
+func (c *Controller) DeleteSAMLProvider(ctx context.Context, name string) error {
+	const op = "saml.DeleteSAMLProvider.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	err := c.repo.DeleteSAMLProvider(ctx, name)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		return ErrNotFound
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *Controller) GetSAMLMetadata(ctx context.Context, provider string) ([]byte, error) {
+	const op = "saml.GetSAMLMetadata.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	p, err := c.getSAMLProvider(ctx, provider)
+	if err != nil {
+		return nil, err
+	}
+	return c.au.SAMLMetadata(p)
+}
+
+func (c *Controller) GetSAMLAuthURL(ctx context.Context, provider string) (*dto.StartProviderResponse, error) {
+	const op = "saml.GetSAMLAuthURL.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	p, err := c.getSAMLProvider(ctx, provider)
+	if err != nil {
+		return nil, err
+	}
+
+	state := c.au.GenerateSignedState()
+	req, err := c.au.SAMLAuthURL(p, state)
+	if err != nil {
+		return nil, err
+	}
+
+	bytes, err := json.Marshal(req.RequestID)
+	if err != nil {
+		zap.L().Error(
+			"failed to marshal request id",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	c.cache.Set(ctx, config.MinCacheTime, fmt.Sprintf(samlRequestKey, state), bytes)
+	return &dto.StartProviderResponse{
+		URL: req.URL,
+	}, nil
+}
+
+func (c *Controller) HandleSAMLCallback(
+	ctx context.Context,
+	d *dto.DeviceRequest,
+	provider, relayState string,
+	r *http.Request,
+) (*dto.HandleCallbackResponse, error) {
+	const op = "saml.HandleSAMLCallback.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	p, err := c.getSAMLProvider(ctx, provider)
+	if err != nil {
+		return nil, err
+	}
+
+	var requestIDs []string
+	if relayState != "" || !p.AllowIDPInitiated {
+		if err = c.au.ValidateSignedState(relayState, config.MinCacheTime); err != nil {
+			return nil, err
+		}
+
+		var requestID string
+		key := fmt.Sprintf(samlRequestKey, relayState)
+		if err = c.cache.GetToStruct(ctx, key, &requestID); err != nil {
+			if errors.Is(err, cache.ErrNotFoundInCache) {
+				return nil, ErrNotFound
+			}
+			return nil, err
+		}
+
+		c.cache.Delete(ctx, key)
+		requestIDs = append(requestIDs, requestID)
+	}
+
+	assertion, err := c.au.ParseSAMLResponse(p, r, requestIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	ok, err := c.cache.SetNX(
+		ctx,
+		max(time.Until(assertion.ExpiresAt), config.MinCacheTime),
+		fmt.Sprintf(samlAssertionKey, p.Name, assertion.ID),
+		assertion.NameID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		zap.L().Warn(
+			"saml assertion replay detected",
+			zap.String("op", op),
+			zap.String("provider", p.Name),
+			zap.String("assertionID", assertion.ID),
+		)
+		return nil, ErrAssertionReplayed
+	}
+
+	email := samlAttribute(assertion, p.AttributeMap.Email)
+	if email == "" {
+		email = assertion.NameID
+	}
+
+	user, err := c.repo.GetUserBySAML(ctx, p.Name, assertion.NameID)
+	if errors.Is(err, repo.ErrNotFound) {
+		user, err = c.repo.GetUserByEmail(ctx, email)
+		if err == nil && !inDomains(email, p.LinkDomains) {
+			zap.L().Warn(
+				"saml assertion for account which is not linked",
+				zap.String("op", op),
+				zap.String("provider", p.Name),
+				zap.String("userID", user.ID.String()),
+			)
+			return nil, ErrAccountNotLinked
+		} else if errors.Is(err, repo.ErrNotFound) {
+			user = &md.User{
+				Name:   samlAttribute(assertion, p.AttributeMap.Name),
+				Email:  email,
+				Avatar: samlAttribute(assertion, p.AttributeMap.Avatar),
+			}
+
+			id, err := c.repo.CreateUser(
+				ctx, &dto.CreateUserRequest{
+					Name:   user.Name,
+					Email:  user.Email,
+					Avatar: user.Avatar,
+				},
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			user.ID = id
+		} else if err != nil {
+			return nil, err
+		}
+	} else if err != nil {
+		return nil, err
+	}
+
+	if roles := samlRoles(assertion, p); len(roles) > 0 {
+		if err = c.repo.AddUserRolesByName(ctx, user.ID, roles); err != nil {
+			return nil, err
+		}
+
+		user, err = c.repo.GetUserByID(ctx, user.ID)
+		if err != nil {
+			return nil, err
+		}
+		c.cache.Delete(ctx, fmt.Sprintf(userCacheKey, user.ID))
+	}
+
+	if err = c.repo.CreateSAMLConnection(ctx, user.ID, p.Name, assertion); err != nil {
+		return nil, err
+	}
+
+	pair, err := c.loginPair(ctx, d, user, md.AMRFederated)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.HandleCallbackResponse{
+		Access:     pair.Access,
+		Refresh:    pair.Refresh,
+		SuccessURL: c.au.SuccessURL(),
+	}, nil
+}
+
+func (c *Controller) getSAMLProvider(ctx context.Context, name string) (*md.SAMLProvider, error) {
+	p, err := c.repo.GetSAMLProvider(ctx, name)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// inDomains reports whether email belongs to one of domains or their subdomains.
+func inDomains(email string, domains []string) bool {
+	idx := strings.LastIndex(email, "@")
+	if idx == -1 {
+		return false
+	}
+
+	domain := strings.ToLower(email[idx+1:])
+	for i := 0; i < len(domains); i++ {
+		if domain == domains[i] || strings.HasSuffix(domain, "."+domains[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+func samlAttribute(a *dto.SAMLAssertion, name string) string {
+	if name == "" {
+		return ""
+	}
+
+	if vals := a.Attributes[name]; len(vals) > 0 {
+		return vals[0]
+	}
+	return ""
+}
+
+func samlRoles(a *dto.SAMLAssertion, p *md.SAMLProvider) []string {
+	if p.AttributeMap.Groups == "" || len(p.RoleMap) == 0 {
+		return nil
+	}
+
+	res := make([]string, 0, len(p.RoleMap))
+	for _, group := range a.Attributes[p.AttributeMap.Groups] {
+		if role, ok := p.RoleMap[group]; ok {
+			res = append(res, role)
+		}
+	}
+	return res
+}
@@ -0,0 +1,155 @@
+package ctrl
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/JMURv/sso/internal/config"
+	"github.com/JMURv/sso/internal/dto"
+	md "github.com/JMURv/sso/internal/models"
+	"github.com/JMURv/sso/internal/repo"
+	"github.com/JMURv/sso/tests/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestController_HandleSAMLCallback(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mrepo := mocks.NewMockAppRepo(mock)
+	mau := mocks.NewMockCore(mock)
+	mcache := mocks.NewMockCacheService(mock)
+	c := New(mrepo, mau, mcache, nil, nil, nil)
+
+	ctx := context.Background()
+	d := &dto.DeviceRequest{IP: "127.0.0.1", UA: "test"}
+	r := &http.Request{}
+	uid := uuid.New()
+	refreshExp := time.Now().Add(time.Hour)
+	p := &md.SAMLProvider{
+		Name:              "corp",
+		AllowIDPInitiated: true,
+		LinkDomains:       []string{"corp.com"},
+	}
+	assertion := func(email string, expires time.Time) *dto.SAMLAssertion {
+		return &dto.SAMLAssertion{ID: "assertion", NameID: email, ExpiresAt: expires}
+	}
+	genPair := func() {
+		mau.EXPECT().RiskEnabled().Return(false)
+		mrepo.EXPECT().GetUserByID(gomock.Any(), uid).Return(&md.User{ID: uid}, nil)
+		mau.EXPECT().GenPair(gomock.Any(), uid, gomock.Any(), gomock.Any()).Return("access", "refresh", nil)
+		mau.EXPECT().GetRefreshTime().Return(refreshExp)
+		mrepo.EXPECT().CreateToken(gomock.Any(), uid, "refresh", refreshExp, gomock.Any()).Return(nil)
+		mau.EXPECT().SuccessURL().Return("/")
+	}
+
+	tests := []struct {
+		name   string
+		expect func()
+		err    error
+	}{
+		{
+			name: "Linked account",
+			expect: func() {
+				a := assertion("john@partner.com", time.Now().Add(time.Minute))
+				mrepo.EXPECT().GetSAMLProvider(gomock.Any(), p.Name).Return(p, nil)
+				mau.EXPECT().ParseSAMLResponse(p, r, nil).Return(a, nil)
+				mcache.EXPECT().SetNX(gomock.Any(), gomock.Any(), gomock.Any(), a.NameID).Return(true, nil)
+				mrepo.EXPECT().GetUserBySAML(gomock.Any(), p.Name, a.NameID).Return(&md.User{ID: uid}, nil)
+				mrepo.EXPECT().CreateSAMLConnection(gomock.Any(), uid, p.Name, a).Return(nil)
+				genPair()
+			},
+		},
+		{
+			name: "Existing account of link domain",
+			expect: func() {
+				a := assertion("john@eu.corp.com", time.Now().Add(time.Minute))
+				mrepo.EXPECT().GetSAMLProvider(gomock.Any(), p.Name).Return(p, nil)
+				mau.EXPECT().ParseSAMLResponse(p, r, nil).Return(a, nil)
+				mcache.EXPECT().SetNX(gomock.Any(), gomock.Any(), gomock.Any(), a.NameID).Return(true, nil)
+				mrepo.EXPECT().GetUserBySAML(gomock.Any(), p.Name, a.NameID).Return(nil, repo.ErrNotFound)
+				mrepo.EXPECT().GetUserByEmail(gomock.Any(), a.NameID).Return(&md.User{ID: uid}, nil)
+				mrepo.EXPECT().CreateSAMLConnection(gomock.Any(), uid, p.Name, a).Return(nil)
+				genPair()
+			},
+		},
+		{
+			name: "Existing account of other domain",
+			expect: func() {
+				a := assertion("admin@yourcorp.com", time.Now().Add(time.Minute))
+				mrepo.EXPECT().GetSAMLProvider(gomock.Any(), p.Name).Return(p, nil)
+				mau.EXPECT().ParseSAMLResponse(p, r, nil).Return(a, nil)
+				mcache.EXPECT().SetNX(gomock.Any(), gomock.Any(), gomock.Any(), a.NameID).Return(true, nil)
+				mrepo.EXPECT().GetUserBySAML(gomock.Any(), p.Name, a.NameID).Return(nil, repo.ErrNotFound)
+				mrepo.EXPECT().GetUserByEmail(gomock.Any(), a.NameID).Return(&md.User{ID: uuid.New()}, nil)
+			},
+			err: ErrAccountNotLinked,
+		},
+		{
+			name: "New account",
+			expect: func() {
+				a := assertion("jane@partner.com", time.Now().Add(time.Minute))
+				mrepo.EXPECT().GetSAMLProvider(gomock.Any(), p.Name).Return(p, nil)
+				mau.EXPECT().ParseSAMLResponse(p, r, nil).Return(a, nil)
+				mcache.EXPECT().SetNX(gomock.Any(), gomock.Any(), gomock.Any(), a.NameID).Return(true, nil)
+				mrepo.EXPECT().GetUserBySAML(gomock.Any(), p.Name, a.NameID).Return(nil, repo.ErrNotFound)
+				mrepo.EXPECT().GetUserByEmail(gomock.Any(), a.NameID).Return(nil, repo.ErrNotFound)
+				mrepo.EXPECT().CreateUser(gomock.Any(), &dto.CreateUserRequest{Email: a.NameID}).Return(uid, nil)
+				mrepo.EXPECT().CreateSAMLConnection(gomock.Any(), uid, p.Name, a).Return(nil)
+				genPair()
+			},
+		},
+		{
+			name: "Expired assertion",
+			expect: func() {
+				a := assertion("john@partner.com", time.Time{})
+				mrepo.EXPECT().GetSAMLProvider(gomock.Any(), p.Name).Return(p, nil)
+				mau.EXPECT().ParseSAMLResponse(p, r, nil).Return(a, nil)
+				mcache.EXPECT().SetNX(gomock.Any(), config.MinCacheTime, gomock.Any(), a.NameID).Return(false, nil)
+			},
+			err: ErrAssertionReplayed,
+		},
+		{
+			name: "Replayed assertion",
+			expect: func() {
+				a := assertion("john@partner.com", time.Now().Add(time.Hour))
+				mrepo.EXPECT().GetSAMLProvider(gomock.Any(), p.Name).Return(p, nil)
+				mau.EXPECT().ParseSAMLResponse(p, r, nil).Return(a, nil)
+				mcache.EXPECT().SetNX(gomock.Any(), gomock.Any(), "saml:assertion:corp:assertion", a.NameID).Return(false, nil)
+			},
+			err: ErrAssertionReplayed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				res, err := c.HandleSAMLCallback(ctx, d, p.Name, "", r)
+				if tt.err != nil {
+					assert.ErrorIs(t, err, tt.err)
+					return
+				}
+
+				require.NoError(t, err)
+				assert.Equal(t, "access", res.Access)
+				assert.Equal(t, "refresh", res.Refresh)
+			},
+		)
+	}
+}
+
+func TestInDomains(t *testing.T) {
+	domains := []string{"corp.com"}
+	assert.True(t, inDomains("john@corp.com", domains))
+	assert.True(t, inDomains("john@EU.Corp.com", domains))
+	assert.False(t, inDomains("john@yourcorp.com", domains))
+	assert.False(t, inDomains("john@corp.com.evil.io", domains))
+	assert.False(t, inDomains("corp.com", domains))
+	assert.False(t, inDomains("john@corp.com", nil))
+}
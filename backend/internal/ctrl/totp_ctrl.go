@@ -0,0 +1,167 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+
+	"github.com/JMURv/sso/internal/auth/totp"
+	"github.com/JMURv/sso/internal/dto"
+	md "github.com/JMURv/sso/internal/models"
+	"github.com/JMURv/sso/internal/repo"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+)
+
+type totpRepo interface {
+	GetTOTP(ctx context.Context, userID uuid.UUID) (*md.TOTP, error)
+	UpsertTOTP(ctx context.Context, userID uuid.UUID, secret string) error
+	ConfirmTOTP(ctx context.Context, userID uuid.UUID, step int64) error
+	UseTOTPStep(ctx context.Context, userID uuid.UUID, step int64) (bool, error)
+	DeleteTOTP(ctx context.Context, userID uuid.UUID) error
+}
+
+func (c *Controller) EnrollTOTP(ctx context.Context, uid uuid.UUID) (*dto.TOTPEnrollResponse, error) {
+	const op = "totp.EnrollTOTP.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	u, err := c.repo.GetUserByID(ctx, uid)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	res, secret, err := c.au.GenerateTOTP(u.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.repo.UpsertTOTP(ctx, uid, secret)
+	if err != nil && errors.Is(err, repo.ErrAlreadyExists) {
+		return nil, ErrAlreadyExists
+	} else if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ConfirmTOTP enables pending enrollment and returns fresh set of recovery codes.
+func (c *Controller) ConfirmTOTP(ctx context.Context, uid uuid.UUID, code string) (*dto.RecoveryCodesResponse, error) {
+	const op = "totp.ConfirmTOTP.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	t, err := c.repo.GetTOTP(ctx, uid)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	if t.ConfirmedAt != nil {
+		return nil, ErrAlreadyExists
+	}
+
+	step, err := c.au.ValidateTOTP(t.Secret, code)
+	if err != nil && errors.Is(err, totp.ErrInvalidCode) {
+		return nil, ErrCodeIsNotValid
+	} else if err != nil {
+		return nil, err
+	}
+
+	err = c.repo.ConfirmTOTP(ctx, uid, step)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return c.newRecoveryCodes(ctx, uid)
+}
+
+func (c *Controller) DisableTOTP(ctx context.Context, uid uuid.UUID, code string) error {
+	const op = "totp.DisableTOTP.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	t, err := c.confirmedTOTP(ctx, uid)
+	if err != nil {
+		return err
+	}
+
+	if err = c.checkTOTP(ctx, t, code); err != nil {
+		return err
+	}
+
+	err = c.repo.DeleteTOTP(ctx, uid)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		return ErrNotFound
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *Controller) VerifyTOTP(ctx context.Context, d *dto.DeviceRequest, req *dto.VerifyTOTPRequest) (*dto.TokenPair, error) {
+	const op = "totp.VerifyTOTP.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	return c.passChallenge(
+		ctx, d, req.Challenge, md.AMROTP, func(uid uuid.UUID) error {
+			t, err := c.confirmedTOTP(ctx, uid)
+			if err != nil {
+				return err
+			}
+			return c.checkTOTP(ctx, t, req.Code)
+		},
+	)
+}
+
+func (c *Controller) confirmedTOTP(ctx context.Context, uid uuid.UUID) (*md.TOTP, error) {
+	t, err := c.repo.GetTOTP(ctx, uid)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	if t.ConfirmedAt == nil {
+		return nil, ErrNotFound
+	}
+	return t, nil
+}
+
+// checkTOTP validates code and records its time step, so the same code
+// cannot be replayed while it is still inside the drift window. Wrong codes are
+// counted per user across login, re-authentication and disabling, so guessing
+// cannot be spread over fresh challenges.
+func (c *Controller) checkTOTP(ctx context.Context, t *md.TOTP, code string) error {
+	account := t.UserID.String()
+	if err := c.checkLockout(ctx, lockoutTOTP, account, ""); err != nil {
+		return err
+	}
+
+	step, err := c.au.ValidateTOTP(t.Secret, code)
+	if err != nil && errors.Is(err, totp.ErrInvalidCode) {
+		if c.registerFailure(ctx, lockoutTOTP, account, "") {
+			return ErrAccountLocked
+		}
+		return ErrCodeIsNotValid
+	} else if err != nil {
+		return err
+	}
+
+	ok, err := c.repo.UseTOTPStep(ctx, t.UserID, step)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		return ErrCodeReused
+	}
+
+	c.resetFailures(ctx, lockoutTOTP, account)
+	return nil
+}
@@ -0,0 +1,99 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/JMURv/sso/internal/auth/totp"
+	"github.com/JMURv/sso/internal/config"
+	md "github.com/JMURv/sso/internal/models"
+	"github.com/JMURv/sso/tests/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestController_DisableTOTP(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mrepo := mocks.NewMockAppRepo(mock)
+	mau := mocks.NewMockCore(mock)
+	mcache := mocks.NewMockCacheService(mock)
+	c := New(mrepo, mau, mcache, nil, nil, nil)
+
+	ctx := context.Background()
+	uid := uuid.New()
+	now := time.Now()
+	conf := config.LockoutConfig{TOTPAttempts: 5, Duration: time.Minute}
+	subj := accountSubject(lockoutTOTP, uid.String())
+	enrolled := func() {
+		mrepo.EXPECT().GetTOTP(gomock.Any(), uid).Return(&md.TOTP{UserID: uid, Secret: "secret", ConfirmedAt: &now}, nil)
+	}
+	notLocked := func() {
+		mcache.EXPECT().GetInt(gomock.Any(), fmt.Sprintf(lockoutUntilKey, subj)).Return(0, errors.New("miss"))
+		mcache.EXPECT().GetInt(gomock.Any(), fmt.Sprintf(lockoutDelayKey, subj)).Return(0, errors.New("miss"))
+	}
+
+	tests := []struct {
+		name   string
+		expect func()
+		err    error
+	}{
+		{
+			name: "Success",
+			expect: func() {
+				enrolled()
+				notLocked()
+				mau.EXPECT().ValidateTOTP("secret", "123456").Return(int64(42), nil)
+				mrepo.EXPECT().UseTOTPStep(gomock.Any(), uid, int64(42)).Return(true, nil)
+				mcache.EXPECT().Delete(gomock.Any(), fmt.Sprintf(lockoutFailKey, subj))
+				mcache.EXPECT().Delete(gomock.Any(), fmt.Sprintf(lockoutDelayKey, subj))
+				mrepo.EXPECT().DeleteTOTP(gomock.Any(), uid).Return(nil)
+			},
+		},
+		{
+			name: "Wrong code is counted for user",
+			expect: func() {
+				enrolled()
+				notLocked()
+				mau.EXPECT().ValidateTOTP("secret", "123456").Return(int64(0), totp.ErrInvalidCode)
+				mau.EXPECT().Lockout().Return(conf).Times(2)
+				mcache.EXPECT().Incr(gomock.Any(), conf.Duration, fmt.Sprintf(lockoutFailKey, subj)).Return(int64(1), nil)
+			},
+			err: ErrCodeIsNotValid,
+		},
+		{
+			name: "Last wrong code locks user",
+			expect: func() {
+				enrolled()
+				notLocked()
+				mau.EXPECT().ValidateTOTP("secret", "123456").Return(int64(0), totp.ErrInvalidCode)
+				mau.EXPECT().Lockout().Return(conf).Times(2)
+				mcache.EXPECT().Incr(gomock.Any(), conf.Duration, fmt.Sprintf(lockoutFailKey, subj)).Return(int64(5), nil)
+				mcache.EXPECT().Set(gomock.Any(), conf.Duration, fmt.Sprintf(lockoutUntilKey, subj), gomock.Any())
+			},
+			err: ErrAccountLocked,
+		},
+		{
+			name: "Locked user is not checked",
+			expect: func() {
+				enrolled()
+				mcache.EXPECT().GetInt(gomock.Any(), fmt.Sprintf(lockoutUntilKey, subj)).Return(1, nil)
+			},
+			err: ErrAccountLocked,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				assert.ErrorIs(t, c.DisableTOTP(ctx, uid, "123456"), tt.err)
+			},
+		)
+	}
+}
@@ -33,7 +33,9 @@ type userRepo interface {
 	CreateUser(ctx context.Context, req *dto.CreateUserRequest) (uuid.UUID, error)
 	UpdateUser(ctx context.Context, id uuid.UUID, req *dto.UpdateUserRequest) error
 	UpdateMe(ctx context.Context, id uuid.UUID, req *dto.UpdateUserRequest) error
+	UpdatePasswordHash(ctx context.Context, id uuid.UUID, hash string) error
 	DeleteUser(ctx context.Context, userID uuid.UUID) error
+	AddUserRolesByName(ctx context.Context, id uuid.UUID, roles []string) error
 }
 
 const (
@@ -135,12 +137,21 @@ func (c *Controller) CreateUser(ctx context.Context, u *dto.CreateUserRequest, f
 	span, ctx := opentracing.StartSpanFromContext(ctx, op)
 	defer span.Finish()
 
+	if err := c.checkPassword(ctx, uuid.Nil, u.Password, u.Name, u.Email); err != nil {
+		return nil, err
+	}
+
+	plain := u.Password
 	hash, err := c.au.Hash(u.Password)
 	if err != nil {
 		return nil, err
 	}
 	u.Password = hash
 
+	if u.SendCredentials {
+		u.MustChangePassword = true
+	}
+
 	if len(file.File) > 0 {
 		url, err := c.s3.UploadFile(ctx, file)
 		if err != nil {
@@ -156,6 +167,11 @@ func (c *Controller) CreateUser(ctx context.Context, u *dto.CreateUserRequest, f
 		return nil, err
 	}
 
+	c.rememberPassword(ctx, id, hash)
+	if u.SendCredentials {
+		go c.smtp.SendUserCredentials(ctx, u.Email, plain)
+	}
+
 	go c.cache.InvalidateKeysByPattern(ctx, userPattern)
 	return &dto.CreateUserResponse{
 		ID: id,
@@ -168,6 +184,10 @@ func (c *Controller) UpdateUser(ctx context.Context, id uuid.UUID, req *dto.Upda
 	defer span.Finish()
 
 	if req.Password != "" {
+		if err := c.checkPassword(ctx, id, req.Password, req.Name, req.Email); err != nil {
+			return err
+		}
+
 		hash, err := c.au.Hash(req.Password)
 		if err != nil {
 			return err
@@ -190,6 +210,10 @@ func (c *Controller) UpdateUser(ctx context.Context, id uuid.UUID, req *dto.Upda
 		return err
 	}
 
+	if req.Password != "" {
+		c.rememberPassword(ctx, id, req.Password)
+	}
+
 	c.cache.Delete(ctx, fmt.Sprintf(userCacheKey, id))
 	go c.cache.InvalidateKeysByPattern(ctx, userPattern)
 	return nil
@@ -218,6 +242,10 @@ func (c *Controller) UpdateMe(ctx context.Context, id uuid.UUID, req *dto.Update
 	defer span.Finish()
 
 	if req.Password != "" {
+		if err := c.checkPassword(ctx, id, req.Password, req.Name, req.Email); err != nil {
+			return err
+		}
+
 		hash, err := c.au.Hash(req.Password)
 		if err != nil {
 			return err
@@ -240,6 +268,10 @@ func (c *Controller) UpdateMe(ctx context.Context, id uuid.UUID, req *dto.Update
 		return err
 	}
 
+	if req.Password != "" {
+		c.rememberPassword(ctx, id, req.Password)
+	}
+
 	c.cache.Delete(ctx, fmt.Sprintf(userCacheKey, id))
 	go c.cache.InvalidateKeysByPattern(ctx, userPattern)
 	return nil
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	wa "github.com/JMURv/sso/internal/auth/webauthn"
 	"github.com/JMURv/sso/internal/cache"
@@ -23,8 +24,11 @@ import (
 
 type waRepo interface {
 	GetWACredentials(ctx context.Context, userID uuid.UUID) ([]webauthn.Credential, error)
-	CreateWACredential(ctx context.Context, userID uuid.UUID, cred *webauthn.Credential) error
+	CreateWACredential(ctx context.Context, userID uuid.UUID, name string, cred *webauthn.Credential) error
 	UpdateWACredential(ctx context.Context, cred *webauthn.Credential) error
+	ListPasskeys(ctx context.Context, userID uuid.UUID) ([]md.Passkey, error)
+	RenamePasskey(ctx context.Context, userID uuid.UUID, id []byte, name string) error
+	DeleteWACredential(ctx context.Context, userID uuid.UUID, id []byte) error
 }
 
 const (
@@ -42,9 +46,14 @@ func (c *Controller) StartRegistration(ctx context.Context, uid uuid.UUID) (*pro
 	}
 
 	opts, sess, err := c.au.BeginRegistration(
-		user, func(credCreationOpts *protocol.PublicKeyCredentialCreationOptions) {
-			credCreationOpts.CredentialExcludeList = user.ExcludeCredentialDescriptorList()
-		},
+		user, append(
+			[]webauthn.RegistrationOption{
+				func(credCreationOpts *protocol.PublicKeyCredentialCreationOptions) {
+					credCreationOpts.CredentialExcludeList = user.ExcludeCredentialDescriptorList()
+				},
+				webauthn.WithResidentKeyRequirement(protocol.ResidentKeyRequirementPreferred),
+			}, c.au.RegistrationOptions(user.Roles)...,
+		)...,
 	)
 	if err != nil {
 		zap.L().Error(
@@ -92,7 +101,18 @@ func (c *Controller) FinishRegistration(ctx context.Context, uid uuid.UUID, r *h
 		return err
 	}
 
-	if err = c.repo.CreateWACredential(ctx, uid, credential); err != nil {
+	if err = c.au.VerifyPolicy(ctx, user.Roles, credential); err != nil {
+		zap.L().Info(
+			"credential rejected by policy",
+			zap.String("op", op),
+			zap.String("uid", uid.String()),
+			zap.Binary("aaguid", credential.Authenticator.AAGUID),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	if err = c.repo.CreateWACredential(ctx, uid, passkeyName(r.UserAgent()), credential); err != nil {
 		return err
 	}
 
@@ -101,16 +121,41 @@ func (c *Controller) FinishRegistration(ctx context.Context, uid uuid.UUID, r *h
 	return nil
 }
 
+// UpdateWAMetadata replaces authenticator metadata with uploaded FIDO MDS blob.
+func (c *Controller) UpdateWAMetadata(ctx context.Context, blob []byte) (*dto.WAMetadataResponse, error) {
+	const op = "webauthn.UpdateWAMetadata.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := c.au.UpdateMetadata(blob)
+	if err != nil {
+		zap.L().Error(
+			"failed to update authenticator metadata",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	return res, nil
+}
+
 func (c *Controller) BeginLogin(ctx context.Context, email string) (*protocol.CredentialAssertion, error) {
 	const op = "webauthn.BeginLogin.ctrl"
 	span, ctx := opentracing.StartSpanFromContext(ctx, op)
 	defer span.Finish()
+	defer c.equalize(ctx, time.Now())
 
 	user, err := c.GetUserForWA(ctx, uuid.Nil, email)
-	if err != nil {
+	if err != nil && errors.Is(err, ErrNotFound) && c.au.AntiEnumeration().Enabled {
+		return c.beginDecoyLogin(email)
+	} else if err != nil {
 		return nil, err
 	}
 
+	if len(user.Credentials) == 0 && c.au.AntiEnumeration().Enabled {
+		return c.beginDecoyLogin(email)
+	}
+
 	opts, sess, err := c.au.BeginLogin(user)
 	if err != nil {
 		if strings.Contains(err.Error(), "Found no credentials for user") {
@@ -132,6 +177,16 @@ func (c *Controller) BeginLogin(ctx context.Context, email string) (*protocol.Cr
 	return opts, nil
 }
 
+// beginDecoyLogin returns assertion options for decoy credential, session is not stored,
+// so finishing such login fails the same way as wrong assertion does.
+func (c *Controller) beginDecoyLogin(email string) (*protocol.CredentialAssertion, error) {
+	opts, _, err := c.au.BeginLogin(c.decoyWAUser(email))
+	if err != nil {
+		return nil, err
+	}
+	return opts, nil
+}
+
 func (c *Controller) FinishLogin(ctx context.Context, email string, d dto.DeviceRequest, r *http.Request) (dto.TokenPair, error) {
 	const op = "webauthn.FinishLogin.ctrl"
 	span, ctx := opentracing.StartSpanFromContext(ctx, op)
@@ -161,6 +216,90 @@ func (c *Controller) FinishLogin(ctx context.Context, email string, d dto.Device
 		return res, err
 	}
 
+	return c.completePasskeyLogin(ctx, &d, user, cred)
+}
+
+// BeginDiscoverableLogin starts passkey login without knowing the user. Authenticator
+// picks resident credential itself and returns user handle with the assertion.
+func (c *Controller) BeginDiscoverableLogin(ctx context.Context, mediation string) (*protocol.CredentialAssertion, error) {
+	const op = "webauthn.BeginDiscoverableLogin.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	opts, sess, err := c.au.BeginDiscoverableMediatedLogin(protocol.CredentialMediationRequirement(mediation))
+	if err != nil {
+		zap.L().Error(
+			"failed to begin discoverable login",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	bytes, err := json.Marshal(sess)
+	if err != nil {
+		return nil, err
+	}
+
+	// There is no user yet, so session is found by its challenge echoed in client data.
+	c.cache.Set(ctx, config.MinCacheTime, fmt.Sprintf(webauthnSessionKey, wa.DiscoverableLogin, sess.Challenge), bytes)
+	return opts, nil
+}
+
+func (c *Controller) FinishDiscoverableLogin(ctx context.Context, d dto.DeviceRequest, r *http.Request) (dto.TokenPair, error) {
+	const op = "webauthn.FinishDiscoverableLogin.ctrl"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	var res dto.TokenPair
+	parsed, err := protocol.ParseCredentialRequestResponse(r)
+	if err != nil {
+		zap.L().Debug(
+			"failed to parse assertion",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+		return res, ErrPasskeyNotValid
+	}
+
+	key := fmt.Sprintf(webauthnSessionKey, wa.DiscoverableLogin, parsed.Response.CollectedClientData.Challenge)
+	sess := webauthn.SessionData{}
+	if err = c.cache.GetToStruct(ctx, key, &sess); err != nil {
+		return res, ErrNotFound
+	}
+	c.cache.Delete(ctx, key)
+
+	var user *md.WebauthnUser
+	_, cred, err := c.au.ValidatePasskeyLogin(
+		func(_, userHandle []byte) (webauthn.User, error) {
+			uid, err := uuid.FromBytes(userHandle)
+			if err != nil {
+				return nil, err
+			}
+
+			user, err = c.GetUserForWA(ctx, uid, "")
+			if err != nil {
+				return nil, err
+			}
+			return user, nil
+		}, sess, parsed,
+	)
+	if err != nil {
+		zap.L().Debug(
+			"failed to validate discoverable login",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+		return res, ErrPasskeyNotValid
+	}
+
+	return c.completePasskeyLogin(ctx, &d, user, cred)
+}
+
+func (c *Controller) completePasskeyLogin(ctx context.Context, d *dto.DeviceRequest, user *md.WebauthnUser, cred *webauthn.Credential) (dto.TokenPair, error) {
+	const op = "webauthn.completePasskeyLogin.ctrl"
+
+	var res dto.TokenPair
 	if cred.Authenticator.CloneWarning {
 		zap.L().Error(
 			"credential appears to be cloned",
@@ -170,11 +309,12 @@ func (c *Controller) FinishLogin(ctx context.Context, email string, d dto.Device
 		return res, errors.New("credential appears to be cloned") // http.StatusForbiddens
 	}
 
-	if err = c.repo.UpdateWACredential(ctx, cred); err != nil {
+	if err := c.repo.UpdateWACredential(ctx, cred); err != nil {
 		return res, err
 	}
 
-	res, err = c.GenPair(ctx, &d, user.ID, user.Roles)
+	u := &md.User{ID: user.ID, Email: user.Email, Roles: user.Roles}
+	res, err := c.loginPair(ctx, d, u, md.AMRHardwareKey)
 	if err != nil {
 		return res, err
 	}
@@ -0,0 +1,184 @@
+package ctrl
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	wa "github.com/JMURv/sso/internal/auth/webauthn"
+	"github.com/JMURv/sso/internal/config"
+	"github.com/JMURv/sso/internal/dto"
+	md "github.com/JMURv/sso/internal/models"
+	"github.com/JMURv/sso/tests/mocks"
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestController_StartRegistration(t *testing.T) {
+	mock := gomock.NewController(t)
+
+	mrepo := mocks.NewMockAppRepo(mock)
+	mau := mocks.NewMockCore(mock)
+	mcache := mocks.NewMockCacheService(mock)
+	c := New(mrepo, mau, mcache, nil, nil, nil)
+
+	u := &md.User{ID: uuid.New(), Email: "john@example.com"}
+	mrepo.EXPECT().GetUserByID(gomock.Any(), u.ID).Return(u, nil)
+	mrepo.EXPECT().GetWACredentials(gomock.Any(), u.ID).Return(nil, nil)
+	mau.EXPECT().RegistrationOptions(gomock.Any()).Return(nil)
+	mau.EXPECT().BeginRegistration(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ webauthn.User, opts ...webauthn.RegistrationOption) (*protocol.CredentialCreation, *webauthn.SessionData, error) {
+			res := &protocol.PublicKeyCredentialCreationOptions{}
+			for i := range opts {
+				opts[i](res)
+			}
+			// security keys without free resident slots must still be able to register
+			assert.Equal(t, protocol.ResidentKeyRequirementPreferred, res.AuthenticatorSelection.ResidentKey)
+			return &protocol.CredentialCreation{Response: *res}, &webauthn.SessionData{Challenge: "challenge"}, nil
+		},
+	)
+	mcache.EXPECT().Set(gomock.Any(), config.MinCacheTime, fmt.Sprintf(webauthnSessionKey, wa.Register, u.ID), gomock.Any())
+
+	_, err := c.StartRegistration(context.Background(), u.ID)
+	assert.NoError(t, err)
+}
+
+func TestController_FinishDiscoverableLogin(t *testing.T) {
+	mock := gomock.NewController(t)
+
+	mrepo := mocks.NewMockAppRepo(mock)
+	mau := mocks.NewMockCore(mock)
+	mcache := mocks.NewMockCacheService(mock)
+	c := New(mrepo, mau, mcache, nil, nil, nil)
+
+	ctx := context.Background()
+	d := dto.DeviceRequest{IP: "127.0.0.1", UA: "test"}
+	u := &md.User{ID: uuid.New(), Email: "john@example.com"}
+	cred := &webauthn.Credential{ID: []byte("cred")}
+	challenge := "challenge"
+	key := fmt.Sprintf(webauthnSessionKey, wa.DiscoverableLogin, challenge)
+
+	session := func() {
+		mcache.EXPECT().GetToStruct(gomock.Any(), key, gomock.Any()).Return(nil)
+		mcache.EXPECT().Delete(gomock.Any(), key)
+	}
+	// validate runs lookup by user handle like the library does after checking signature
+	validate := func() {
+		mau.EXPECT().ValidatePasskeyLogin(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(handler webauthn.DiscoverableUserHandler, _ webauthn.SessionData, p *protocol.ParsedCredentialAssertionData) (webauthn.User, *webauthn.Credential, error) {
+				user, err := handler(p.RawID, p.Response.UserHandle)
+				if err != nil {
+					return nil, nil, err
+				}
+				return user, cred, nil
+			},
+		)
+	}
+
+	tests := []struct {
+		name   string
+		handle []byte
+		expect func()
+		err    error
+	}{
+		{
+			name:   "User found by handle",
+			handle: u.ID[:],
+			expect: func() {
+				session()
+				validate()
+				mrepo.EXPECT().GetUserByID(gomock.Any(), u.ID).Return(u, nil).Times(2)
+				mrepo.EXPECT().GetWACredentials(gomock.Any(), u.ID).Return([]webauthn.Credential{*cred}, nil)
+				mrepo.EXPECT().UpdateWACredential(gomock.Any(), cred).Return(nil)
+				mau.EXPECT().RiskEnabled().Return(false)
+				mau.EXPECT().GenPair(gomock.Any(), u.ID, gomock.Any(), gomock.Any()).Return("access", "refresh", nil)
+				mau.EXPECT().GetRefreshTime().Return(time.Now().Add(time.Hour))
+				mrepo.EXPECT().CreateToken(gomock.Any(), u.ID, "refresh", gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:   "Unknown user handle",
+			handle: u.ID[:],
+			expect: func() {
+				session()
+				validate()
+				mrepo.EXPECT().GetUserByID(gomock.Any(), u.ID).Return(nil, errors.New("not found"))
+			},
+			err: ErrPasskeyNotValid,
+		},
+		{
+			name:   "Malformed user handle",
+			handle: []byte("handle"),
+			expect: func() {
+				session()
+				validate()
+			},
+			err: ErrPasskeyNotValid,
+		},
+		{
+			name:   "Unknown challenge",
+			handle: u.ID[:],
+			expect: func() {
+				mcache.EXPECT().GetToStruct(gomock.Any(), key, gomock.Any()).Return(errors.New("miss"))
+			},
+			err: ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				res, err := c.FinishDiscoverableLogin(ctx, d, assertionRequest(t, challenge, tt.handle))
+				assert.ErrorIs(t, err, tt.err)
+				if tt.err == nil {
+					assert.Equal(t, "access", res.Access)
+				}
+			},
+		)
+	}
+}
+
+// assertionRequest builds request with unsigned assertion, parsing does not check signature.
+func assertionRequest(t *testing.T, challenge string, handle []byte) *http.Request {
+	t.Helper()
+
+	enc := base64.RawURLEncoding.EncodeToString
+	clientData, err := json.Marshal(
+		map[string]string{
+			"type":      "webauthn.get",
+			"challenge": challenge,
+			"origin":    "http://localhost",
+		},
+	)
+	require.NoError(t, err)
+
+	// rp id hash, user present flag and zero sign counter
+	authData := append(make([]byte, 32), 0x01, 0, 0, 0, 0)
+	body, err := json.Marshal(
+		map[string]any{
+			"id":    enc([]byte("cred")),
+			"rawId": enc([]byte("cred")),
+			"type":  "public-key",
+			"response": map[string]string{
+				"clientDataJSON":    enc(clientData),
+				"authenticatorData": enc(authData),
+				"signature":         enc([]byte("signature")),
+				"userHandle":        enc(handle),
+			},
+		},
+	)
+	require.NoError(t, err)
+	return httptest.NewRequest(http.MethodPost, "/api/auth/webauthn/login/discoverable/finish", bytes.NewReader(body))
+}
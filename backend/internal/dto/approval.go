@@ -0,0 +1,28 @@
+package dto
+
+import "time"
+
+// LoginApproval is shown on the new device, user has to pick the same number on trusted one.
+type LoginApproval struct {
+	ID     string `json:"id"`
+	Number int    `json:"number"`
+}
+
+type PendingLogin struct {
+	ID         string    `json:"id"`
+	DeviceType string    `json:"device_type"`
+	OS         string    `json:"os"`
+	Browser    string    `json:"browser"`
+	IP         string    `json:"ip"`
+	Choices    []int     `json:"choices"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type ApproveLoginRequest struct {
+	Approve bool `json:"approve"`
+	Number  int  `json:"number" validate:"required_if=Approve true"`
+}
+
+type CheckLoginApprovalRequest struct {
+	ID string `json:"id" validate:"required"`
+}
@@ -1,7 +1,5 @@
 package dto
 
-import "github.com/google/uuid"
-
 type DeviceRequest struct {
 	IP string `json:"ip"`
 	UA string `json:"ua"`
@@ -14,6 +12,16 @@ type TokenRequest struct {
 type TokenPair struct {
 	Access  string `json:"access"`
 	Refresh string `json:"refresh"`
+	// Challenge is returned instead of tokens when user has to pass second factor.
+	Challenge *MFAChallenge `json:"challenge,omitempty"`
+	// Approval is returned instead of tokens when login waits for trusted device.
+	Approval *LoginApproval `json:"approval,omitempty"`
+	// PasswordChange is set when tokens only allow to change expired or temporary password.
+	PasswordChange bool `json:"password_change_required,omitempty"`
+}
+
+type PasswordChangeResponse struct {
+	PasswordChange bool `json:"password_change_required"`
 }
 
 type RefreshRequest struct {
@@ -30,11 +38,21 @@ type LoginCodeRequest struct {
 	Email    string `json:"email"    validate:"required,email"`
 	Password string `json:"password" validate:"required"`
 	Token    string `json:"token"    validate:"required"`
+	Channel  string `json:"channel"  validate:"omitempty,oneof=email sms voice device"`
 }
 
 type CheckLoginCodeRequest struct {
 	Email string `json:"email" validate:"required,email"`
-	Code  int    `json:"code"  validate:"required"`
+	Code  string `json:"code"  validate:"required"`
+}
+
+type MagicLinkRequest struct {
+	Email string `json:"email" validate:"required,email"`
+	Token string `json:"token" validate:"required"`
+}
+
+type VerifyMagicLinkRequest struct {
+	Token string `json:"token" validate:"required"`
 }
 
 type CheckEmailRequest struct {
@@ -46,12 +64,17 @@ type CheckEmailResponse struct {
 }
 
 type CheckForgotPasswordEmailRequest struct {
-	Password string    `json:"password" validate:"required"`
-	ID       uuid.UUID `json:"uidb64"   validate:"required"`
-	Code     int       `json:"token"    validate:"required"`
+	Password string `json:"password" validate:"required"`
+	Token    string `json:"token"    validate:"required"`
+}
+
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password" validate:"required"`
+	Password        string `json:"password"         validate:"required"`
 }
 
 type SendForgotPasswordEmail struct {
-	Email string `json:"email" validate:"required,email"`
-	Token string `json:"token" validate:"required"`
+	Email   string `json:"email"   validate:"required,email"`
+	Token   string `json:"token"   validate:"required"`
+	Channel string `json:"channel" validate:"omitempty,oneof=email sms"`
 }
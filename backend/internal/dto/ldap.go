@@ -0,0 +1,9 @@
+package dto
+
+type LDAPUser struct {
+	DN     string   `json:"dn"`
+	Email  string   `json:"email"`
+	Name   string   `json:"name"`
+	Groups []string `json:"groups"`
+	Roles  []string `json:"roles"`
+}
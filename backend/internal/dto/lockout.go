@@ -0,0 +1,13 @@
+package dto
+
+import "time"
+
+type LockoutStatus struct {
+	Methods []LockoutEntry `json:"methods"`
+}
+
+type LockoutEntry struct {
+	Method      string     `json:"method"`
+	Failures    int        `json:"failures"`
+	LockedUntil *time.Time `json:"locked_until,omitempty"`
+}
@@ -0,0 +1,41 @@
+package dto
+
+type MFAChallenge struct {
+	Token   string   `json:"token"`
+	Methods []string `json:"methods"`
+}
+
+type TOTPEnrollResponse struct {
+	Secret string `json:"secret"`
+	URI    string `json:"uri"`
+	QR     []byte `json:"qr" swaggertype:"string" format:"base64"`
+}
+
+type TOTPCodeRequest struct {
+	Code string `json:"code" validate:"required,numeric,len=6"`
+}
+
+type VerifyTOTPRequest struct {
+	Challenge string `json:"challenge" validate:"required"`
+	Code      string `json:"code"      validate:"required,numeric,len=6"`
+}
+
+type VerifyEmailCodeRequest struct {
+	Challenge string `json:"challenge" validate:"required"`
+	Code      string `json:"code"      validate:"required"`
+}
+
+type RecoveryCodesResponse struct {
+	Codes []string `json:"codes"`
+}
+
+type VerifyRecoveryCodeRequest struct {
+	Challenge string `json:"challenge" validate:"required"`
+	Code      string `json:"code"      validate:"required"`
+}
+
+// ReauthRequest proves identity of already logged-in user with either password or TOTP code.
+type ReauthRequest struct {
+	Password string `json:"password" validate:"required_without=Code"`
+	Code     string `json:"code"     validate:"required_without=Password,omitempty,numeric,len=6"`
+}
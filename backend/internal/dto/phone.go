@@ -0,0 +1,10 @@
+package dto
+
+type PhoneRequest struct {
+	Phone   string `json:"phone"   validate:"required,e164"`
+	Channel string `json:"channel" validate:"omitempty,oneof=sms voice"`
+}
+
+type ConfirmPhoneRequest struct {
+	Code string `json:"code" validate:"required"`
+}
@@ -0,0 +1,23 @@
+package dto
+
+import "time"
+
+type QRLoginResponse struct {
+	ID        string    `json:"id"`
+	URL       string    `json:"url"`
+	QR        []byte    `json:"qr"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type QRLoginSession struct {
+	Code       string    `json:"code"`
+	DeviceType string    `json:"device_type"`
+	OS         string    `json:"os"`
+	Browser    string    `json:"browser"`
+	IP         string    `json:"ip"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type CheckQRLoginRequest struct {
+	ID string `json:"id" validate:"required"`
+}
@@ -0,0 +1,14 @@
+package dto
+
+type CreateRealmDomainRequest struct {
+	Domain     string `json:"domain" validate:"required,fqdn"`
+	Method     string `json:"method" validate:"required,oneof=password passkey oauth2 oidc saml ldap email_code"`
+	Connection string `json:"connection" validate:"required_if=Method oauth2,required_if=Method oidc,required_if=Method saml"`
+	ForceSSO   bool   `json:"force_sso"`
+}
+
+type DiscoverRealmResponse struct {
+	Method     string `json:"method"`
+	Connection string `json:"connection,omitempty"`
+	URL        string `json:"url,omitempty"`
+}
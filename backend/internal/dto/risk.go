@@ -0,0 +1,11 @@
+package dto
+
+import md "github.com/JMURv/sso/internal/models"
+
+type PaginatedRiskEventResponse struct {
+	Data        []md.RiskEvent `json:"data"`
+	Count       int64          `json:"count"`
+	TotalPages  int            `json:"total_pages"`
+	CurrentPage int            `json:"current_page"`
+	HasNextPage bool           `json:"has_next_page"`
+}
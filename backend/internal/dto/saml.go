@@ -0,0 +1,30 @@
+package dto
+
+import (
+	"time"
+
+	md "github.com/JMURv/sso/internal/models"
+)
+
+type CreateSAMLProviderRequest struct {
+	Name              string              `json:"name" validate:"required,alphanum"`
+	Metadata          string              `json:"metadata" validate:"required"`
+	AttributeMap      md.SAMLAttributeMap `json:"attribute_map"`
+	RoleMap           map[string]string   `json:"role_map"`
+	AllowIDPInitiated bool                `json:"allow_idp_initiated"`
+	LinkDomains       []string            `json:"link_domains"`
+}
+
+type SAMLAuthRequest struct {
+	URL       string `json:"url"`
+	RequestID string `json:"request_id"`
+}
+
+type SAMLAssertion struct {
+	ID           string              `json:"id"`
+	Issuer       string              `json:"issuer"`
+	NameID       string              `json:"name_id"`
+	SessionIndex string              `json:"session_index"`
+	Attributes   map[string][]string `json:"attributes"`
+	ExpiresAt    time.Time           `json:"expires_at"`
+}
@@ -21,6 +21,10 @@ type CreateUserRequest struct {
 	IsActive bool     `json:"is_active"`
 	IsEmail  bool     `json:"is_email_verified"`
 	Roles    []uint64 `json:"roles"`
+	// MustChangePassword lets user in only to change password on the first login.
+	MustChangePassword bool `json:"must_change_password"`
+	// SendCredentials emails the password to user, such password always has to be changed.
+	SendCredentials bool `json:"send_credentials"`
 }
 
 type UpdateUserRequest struct {
@@ -31,6 +35,8 @@ type UpdateUserRequest struct {
 	IsActive bool     `json:"is_active"`
 	IsEmail  bool     `json:"is_email_verified"`
 	Roles    []uint64 `json:"roles"`
+	// MustChangePassword is applied only together with new password.
+	MustChangePassword bool `json:"must_change_password"`
 }
 
 type CreateUserResponse struct {
@@ -1,6 +1,18 @@
 package dto
 
+import "time"
+
 type LoginStartRequest struct {
 	Email string `json:"email" validate:"required,email"`
 	Token string `json:"token" validate:"required"`
 }
+
+type RenamePasskeyRequest struct {
+	Name string `json:"name" validate:"required,max=64"`
+}
+
+type WAMetadataResponse struct {
+	Number     int       `json:"number"`
+	NextUpdate time.Time `json:"next_update"`
+	Entries    int       `json:"entries"`
+}
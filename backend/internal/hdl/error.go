@@ -3,10 +3,14 @@ package hdl
 import "errors"
 
 var (
-	ErrInternal      = errors.New("internal error")
-	ErrDecodeRequest = errors.New("decode request")
-	ErrNoDeviceInfo  = errors.New("no device info provided")
-	ErrFileTooLarge  = errors.New("file too large")
+	ErrInternal       = errors.New("internal error")
+	ErrDecodeRequest  = errors.New("decode request")
+	ErrNoDeviceInfo   = errors.New("no device info provided")
+	ErrFileTooLarge   = errors.New("file too large")
+	ErrReauthRequired = errors.New("recent authentication required")
+	ErrNotAuthorized  = errors.New("not authorized")
+
+	ErrPasswordChangeRequired = errors.New("password change required")
 )
 
 var (
@@ -3,10 +3,10 @@ package grpc
 import (
 	"context"
 	"errors"
-	"strconv"
 
 	pb "github.com/JMURv/sso/api/grpc/v1/gen"
 	"github.com/JMURv/sso/internal/auth"
+	"github.com/JMURv/sso/internal/auth/password"
 	"github.com/JMURv/sso/internal/ctrl"
 	"github.com/JMURv/sso/internal/dto"
 	"github.com/JMURv/sso/internal/hdl"
@@ -25,7 +25,11 @@ func (h *Handler) Authenticate(ctx context.Context, req *pb.SSO_EmailAndPassword
 		return nil, status.Errorf(codes.InvalidArgument, hdl.ErrDecodeRequest.Error())
 	}
 
-	d := utils.ParseDeviceFromContext(ctx)
+	d, err := utils.ParseDeviceFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
 	r := &dto.EmailAndPasswordRequest{
 		Email:    req.Email,
 		Password: req.Password,
@@ -39,13 +43,19 @@ func (h *Handler) Authenticate(ctx context.Context, req *pb.SSO_EmailAndPassword
 		if errors.Is(err, ctrl.ErrNotFound) {
 			return nil, status.Errorf(codes.NotFound, err.Error())
 		}
+		if errors.Is(err, auth.ErrInvalidCredentials) {
+			return nil, status.Errorf(codes.Unauthenticated, err.Error())
+		}
+		if errors.Is(err, ctrl.ErrPasswordLoginDisabled) || errors.Is(err, ctrl.ErrRiskBlocked) {
+			return nil, status.Errorf(codes.PermissionDenied, err.Error())
+		}
+		if errors.Is(err, ctrl.ErrAccountLocked) || errors.Is(err, ctrl.ErrTooManyRequests) {
+			return nil, status.Errorf(codes.ResourceExhausted, err.Error())
+		}
 		return nil, status.Errorf(codes.Internal, hdl.ErrInternal.Error())
 	}
 
-	return &pb.SSO_TokenPair{
-		Access:  res.Access,
-		Refresh: res.Refresh,
-	}, nil
+	return mapper.TokenPairToProto(res), nil
 }
 
 func (h *Handler) ParseClaims(ctx context.Context, req *pb.SSO_StringMsg) (*pb.SSO_ParseClaimsRes, error) {
@@ -61,12 +71,20 @@ func (h *Handler) ParseClaims(ctx context.Context, req *pb.SSO_StringMsg) (*pb.S
 		return nil, status.Errorf(codes.Internal, hdl.ErrInternal.Error())
 	}
 
+	var authTime int64
+	if res.AuthTime != nil {
+		authTime = res.AuthTime.UnixMilli()
+	}
+
 	return &pb.SSO_ParseClaimsRes{
-		Uid:   res.UID.String(),
-		Roles: mapper.ListRolesToProto(res.Roles),
-		Exp:   res.ExpiresAt.UnixMilli(),
-		Iat:   res.IssuedAt.UnixMilli(),
-		Sub:   res.Subject,
+		Uid:      res.UID.String(),
+		Roles:    mapper.ListRolesToProto(res.Roles),
+		Exp:      res.ExpiresAt.UnixMilli(),
+		Iat:      res.IssuedAt.UnixMilli(),
+		Sub:      res.Subject,
+		Amr:      res.AMR,
+		Acr:      res.ACR,
+		AuthTime: authTime,
 	}, nil
 }
 
@@ -76,7 +94,11 @@ func (h *Handler) Refresh(ctx context.Context, req *pb.SSO_RefreshRequest) (*pb.
 		return nil, status.Errorf(codes.InvalidArgument, hdl.ErrDecodeRequest.Error())
 	}
 
-	d := utils.ParseDeviceFromContext(ctx)
+	d, err := utils.ParseDeviceFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
 	r := &dto.RefreshRequest{Refresh: req.Refresh}
 	if err := validation.V.Struct(r); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
@@ -90,8 +112,9 @@ func (h *Handler) Refresh(ctx context.Context, req *pb.SSO_RefreshRequest) (*pb.
 		return nil, status.Errorf(codes.Internal, hdl.ErrInternal.Error())
 	}
 	return &pb.SSO_TokenPair{
-		Access:  res.Access,
-		Refresh: res.Refresh,
+		Access:                 res.Access,
+		Refresh:                res.Refresh,
+		PasswordChangeRequired: res.PasswordChange,
 	}, nil
 }
 
@@ -100,35 +123,49 @@ func (h *Handler) SendLoginCode(ctx context.Context, req *pb.SSO_SendLoginCodeRe
 		return nil, status.Errorf(codes.InvalidArgument, hdl.ErrDecodeRequest.Error())
 	}
 
-	d := utils.ParseDeviceFromContext(ctx)
-	r := &dto.LoginCodeRequest{Email: req.Email, Password: req.Password}
+	d, err := utils.ParseDeviceFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	r := &dto.LoginCodeRequest{Email: req.Email, Password: req.Password, Channel: req.Channel}
 	if err := validation.V.Struct(r); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
-	res, err := h.ctrl.SendLoginCode(ctx, &d, r.Email, r.Password)
+	res, err := h.ctrl.SendLoginCode(ctx, &d, r.Email, r.Password, r.Channel)
 	if err != nil {
-		if errors.Is(err, auth.ErrInvalidCredentials) {
+		if errors.Is(err, auth.ErrInvalidCredentials) || errors.Is(err, ctrl.ErrPhoneNotVerified) || errors.Is(err, ctrl.ErrNoTrustedDevice) {
 			return nil, status.Errorf(codes.InvalidArgument, err.Error())
 		}
+		if errors.Is(err, ctrl.ErrPasswordLoginDisabled) ||
+			errors.Is(err, ctrl.ErrCountryNotAllowed) ||
+			errors.Is(err, ctrl.ErrSMSDisabled) ||
+			errors.Is(err, ctrl.ErrRiskBlocked) {
+			return nil, status.Errorf(codes.PermissionDenied, err.Error())
+		}
+		if errors.Is(err, ctrl.ErrTooManyRequests) || errors.Is(err, ctrl.ErrAccountLocked) {
+			return nil, status.Errorf(codes.ResourceExhausted, err.Error())
+		}
 		zap.L().Error("failed to send login code", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, hdl.ErrInternal.Error())
 	}
-	return &pb.SSO_TokenPair{
-		Access:  res.Access,
-		Refresh: res.Refresh,
-	}, nil
+	return mapper.TokenPairToProto(&res), nil
 }
 
 func (h *Handler) CheckLoginCode(ctx context.Context, req *pb.SSO_CheckLoginCodeReq) (*pb.SSO_TokenPair, error) {
 	email, code := req.Email, req.Code
-	if email == "" || code == 0 {
+	if email == "" || code == "" {
 		zap.L().Error("failed to decode request")
 		return nil, status.Errorf(codes.InvalidArgument, hdl.ErrDecodeRequest.Error())
 	}
 
-	d := utils.ParseDeviceFromContext(ctx)
-	r := &dto.CheckLoginCodeRequest{Email: req.Email, Code: int(req.Code)}
+	d, err := utils.ParseDeviceFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	r := &dto.CheckLoginCodeRequest{Email: req.Email, Code: req.Code}
 	if err := validation.V.Struct(r); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
@@ -138,13 +175,235 @@ func (h *Handler) CheckLoginCode(ctx context.Context, req *pb.SSO_CheckLoginCode
 		if errors.Is(err, ctrl.ErrNotFound) {
 			return nil, status.Errorf(codes.NotFound, err.Error())
 		}
+		if errors.Is(err, ctrl.ErrCodeIsNotValid) || errors.Is(err, ctrl.ErrCodeReused) {
+			return nil, status.Errorf(codes.Unauthenticated, err.Error())
+		}
+		if errors.Is(err, ctrl.ErrRiskBlocked) {
+			return nil, status.Errorf(codes.PermissionDenied, err.Error())
+		}
+		if errors.Is(err, ctrl.ErrAccountLocked) || errors.Is(err, ctrl.ErrTooManyRequests) {
+			return nil, status.Errorf(codes.ResourceExhausted, err.Error())
+		}
 		zap.L().Error("failed to check login code", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, hdl.ErrInternal.Error())
 	}
-	return &pb.SSO_TokenPair{
-		Access:  res.Access,
-		Refresh: res.Refresh,
-	}, nil
+	return mapper.TokenPairToProto(res), nil
+}
+
+// SendMagicLink returns nonce which client has to present together with token from the email.
+func (h *Handler) SendMagicLink(ctx context.Context, req *pb.SSO_EmailMsg) (*pb.SSO_StringMsg, error) {
+	if req == nil || req.Email == "" {
+		zap.L().Error("failed to decode request")
+		return nil, status.Errorf(codes.InvalidArgument, hdl.ErrDecodeRequest.Error())
+	}
+
+	nonce, err := h.ctrl.SendMagicLink(ctx, req.Email)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, ctrl.ErrPasswordLoginDisabled) {
+			return nil, status.Errorf(codes.PermissionDenied, err.Error())
+		}
+		zap.L().Error("failed to send magic link", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, hdl.ErrInternal.Error())
+	}
+	return &pb.SSO_StringMsg{String_: nonce}, nil
+}
+
+func (h *Handler) VerifyMagicLink(ctx context.Context, req *pb.SSO_VerifyMagicLinkReq) (*pb.SSO_TokenPair, error) {
+	if req == nil || req.Token == "" || req.Nonce == "" {
+		zap.L().Error("failed to decode request")
+		return nil, status.Errorf(codes.InvalidArgument, hdl.ErrDecodeRequest.Error())
+	}
+
+	d, err := utils.ParseDeviceFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	res, err := h.ctrl.VerifyMagicLink(ctx, &d, req.Token, req.Nonce)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, ctrl.ErrBrowserMismatch) || errors.Is(err, ctrl.ErrRiskBlocked) {
+			return nil, status.Errorf(codes.PermissionDenied, err.Error())
+		}
+		if errors.Is(err, ctrl.ErrCodeReused) {
+			return nil, status.Errorf(codes.Unauthenticated, err.Error())
+		}
+		zap.L().Error("failed to verify magic link", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, hdl.ErrInternal.Error())
+	}
+	return mapper.TokenPairToProto(res), nil
+}
+
+func (h *Handler) ListLoginApprovals(ctx context.Context, _ *pb.SSO_Empty) (*pb.SSO_ListPendingLoginsRes, error) {
+	uid, ok := ctx.Value("uid").(uuid.UUID)
+	if !ok {
+		zap.L().Error("failed to get uid from context")
+		return nil, status.Errorf(codes.Unauthenticated, hdl.ErrFailedToParseUUID.Error())
+	}
+
+	res, err := h.ctrl.ListLoginApprovals(ctx, uid)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, hdl.ErrInternal.Error())
+	}
+	return &pb.SSO_ListPendingLoginsRes{Data: mapper.PendingLoginsToProto(res)}, nil
+}
+
+func (h *Handler) ApproveLogin(ctx context.Context, req *pb.SSO_ApproveLoginReq) (*pb.SSO_Empty, error) {
+	uid, ok := ctx.Value("uid").(uuid.UUID)
+	if !ok {
+		zap.L().Error("failed to get uid from context")
+		return nil, status.Errorf(codes.Unauthenticated, hdl.ErrFailedToParseUUID.Error())
+	}
+
+	if req == nil || req.Id == "" {
+		zap.L().Error("failed to decode request")
+		return nil, status.Errorf(codes.InvalidArgument, hdl.ErrDecodeRequest.Error())
+	}
+
+	r := &dto.ApproveLoginRequest{Approve: req.Approve, Number: int(req.Number)}
+	if err := validation.V.Struct(r); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	err := h.ctrl.ApproveLogin(ctx, uid, req.Id, r)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, ctrl.ErrCodeIsNotValid) {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, hdl.ErrInternal.Error())
+	}
+	return &pb.SSO_Empty{}, nil
+}
+
+// CheckLoginApproval answers Unavailable while login is pending, so client can retry.
+func (h *Handler) CheckLoginApproval(ctx context.Context, req *pb.SSO_StringMsg) (*pb.SSO_TokenPair, error) {
+	if req == nil || req.String_ == "" {
+		zap.L().Error("failed to decode request")
+		return nil, status.Errorf(codes.InvalidArgument, hdl.ErrDecodeRequest.Error())
+	}
+
+	d, err := utils.ParseDeviceFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	res, err := h.ctrl.CheckLoginApproval(ctx, &d, req.String_)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrApprovalPending) {
+			return nil, status.Errorf(codes.Unavailable, err.Error())
+		}
+		if errors.Is(err, ctrl.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, ctrl.ErrApprovalDenied) || errors.Is(err, ctrl.ErrRiskBlocked) {
+			return nil, status.Errorf(codes.PermissionDenied, err.Error())
+		}
+		if errors.Is(err, ctrl.ErrCodeReused) {
+			return nil, status.Errorf(codes.Unauthenticated, err.Error())
+		}
+		zap.L().Error("failed to check login approval", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, hdl.ErrInternal.Error())
+	}
+	return mapper.TokenPairToProto(res), nil
+}
+
+func (h *Handler) StartQRLogin(ctx context.Context, _ *pb.SSO_Empty) (*pb.SSO_QRLoginRes, error) {
+	d, err := utils.ParseDeviceFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	res, err := h.ctrl.StartQRLogin(ctx, &d)
+	if err != nil {
+		zap.L().Error("failed to start qr login", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, hdl.ErrInternal.Error())
+	}
+	return mapper.QRLoginToProto(res), nil
+}
+
+func (h *Handler) GetQRLogin(ctx context.Context, req *pb.SSO_StringMsg) (*pb.SSO_QRLoginSession, error) {
+	if _, ok := ctx.Value("uid").(uuid.UUID); !ok {
+		zap.L().Error("failed to get uid from context")
+		return nil, status.Errorf(codes.Unauthenticated, hdl.ErrFailedToParseUUID.Error())
+	}
+
+	if req == nil || req.String_ == "" {
+		zap.L().Error("failed to decode request")
+		return nil, status.Errorf(codes.InvalidArgument, hdl.ErrDecodeRequest.Error())
+	}
+
+	res, err := h.ctrl.GetQRLogin(ctx, req.String_)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, err.Error())
+	}
+	return mapper.QRLoginSessionToProto(res), nil
+}
+
+func (h *Handler) ConfirmQRLogin(ctx context.Context, req *pb.SSO_StringMsg) (*pb.SSO_Empty, error) {
+	uid, ok := ctx.Value("uid").(uuid.UUID)
+	if !ok {
+		zap.L().Error("failed to get uid from context")
+		return nil, status.Errorf(codes.Unauthenticated, hdl.ErrFailedToParseUUID.Error())
+	}
+
+	if req == nil || req.String_ == "" {
+		zap.L().Error("failed to decode request")
+		return nil, status.Errorf(codes.InvalidArgument, hdl.ErrDecodeRequest.Error())
+	}
+
+	d, err := utils.ParseDeviceFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	err = h.ctrl.ConfirmQRLogin(ctx, uid, &d, req.String_)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, hdl.ErrInternal.Error())
+	}
+	return &pb.SSO_Empty{}, nil
+}
+
+// CheckQRLogin answers Unavailable until login is confirmed from the phone.
+func (h *Handler) CheckQRLogin(ctx context.Context, req *pb.SSO_StringMsg) (*pb.SSO_TokenPair, error) {
+	if req == nil || req.String_ == "" {
+		zap.L().Error("failed to decode request")
+		return nil, status.Errorf(codes.InvalidArgument, hdl.ErrDecodeRequest.Error())
+	}
+
+	d, err := utils.ParseDeviceFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	res, err := h.ctrl.CheckQRLogin(ctx, &d, req.String_)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrApprovalPending) {
+			return nil, status.Errorf(codes.Unavailable, err.Error())
+		}
+		if errors.Is(err, ctrl.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, ctrl.ErrCodeReused) {
+			return nil, status.Errorf(codes.Unauthenticated, err.Error())
+		}
+		if errors.Is(err, ctrl.ErrRiskBlocked) {
+			return nil, status.Errorf(codes.PermissionDenied, err.Error())
+		}
+		zap.L().Error("failed to check qr login", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, hdl.ErrInternal.Error())
+	}
+	return mapper.TokenPairToProto(res), nil
 }
 
 func (h *Handler) SendForgotPasswordEmail(ctx context.Context, req *pb.SSO_EmailMsg) (*pb.SSO_Empty, error) {
@@ -158,11 +417,20 @@ func (h *Handler) SendForgotPasswordEmail(ctx context.Context, req *pb.SSO_Email
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
-	err := h.ctrl.SendForgotPasswordEmail(ctx, r.Email)
+	err := h.ctrl.SendForgotPasswordEmail(ctx, r.Email, req.Channel)
 	if err != nil {
 		if errors.Is(err, auth.ErrInvalidCredentials) {
 			return nil, status.Errorf(codes.NotFound, err.Error())
 		}
+		if errors.Is(err, ctrl.ErrPhoneNotVerified) {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, ctrl.ErrCountryNotAllowed) || errors.Is(err, ctrl.ErrSMSDisabled) {
+			return nil, status.Errorf(codes.PermissionDenied, err.Error())
+		}
+		if errors.Is(err, ctrl.ErrTooManyRequests) {
+			return nil, status.Errorf(codes.ResourceExhausted, err.Error())
+		}
 		zap.L().Error("failed to send forgot password email", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, hdl.ErrInternal.Error())
 	}
@@ -170,34 +438,106 @@ func (h *Handler) SendForgotPasswordEmail(ctx context.Context, req *pb.SSO_Email
 }
 
 func (h *Handler) CheckForgotPasswordEmail(ctx context.Context, req *pb.SSO_CheckForgotPasswordEmailReq) (*pb.SSO_Empty, error) {
-	uid, err := uuid.Parse(req.Id)
+	r := &dto.CheckForgotPasswordEmailRequest{Password: req.Password, Token: req.Token}
+	if err := validation.V.Struct(r); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	d, err := utils.ParseDeviceFromContext(ctx)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, ctrl.ErrParseUUID.Error())
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
-	code, err := strconv.Atoi(req.Code)
+	err = h.ctrl.CheckForgotPasswordEmail(ctx, &d, r)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, ctrl.ErrParseUUID.Error())
+		if errors.Is(err, ctrl.ErrCodeIsNotValid) || errors.Is(err, ctrl.ErrCodeReused) || errors.Is(err, password.ErrPolicy) {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, ctrl.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, ctrl.ErrNotFound.Error())
+		}
+		if errors.Is(err, ctrl.ErrAccountLocked) || errors.Is(err, ctrl.ErrTooManyRequests) {
+			return nil, status.Errorf(codes.ResourceExhausted, err.Error())
+		}
+		zap.L().Error("failed to check forgot password email", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, hdl.ErrInternal.Error())
 	}
 
-	r := &dto.CheckForgotPasswordEmailRequest{Password: req.Password, ID: uid, Code: code}
-	if err = validation.V.Struct(r); err != nil {
+	return &pb.SSO_Empty{}, nil
+}
+
+func (h *Handler) ChangePassword(ctx context.Context, req *pb.SSO_ChangePasswordReq) (*pb.SSO_TokenPair, error) {
+	uid, ok := ctx.Value("uid").(uuid.UUID)
+	if !ok {
+		zap.L().Error("failed to get uid from context")
+		return nil, status.Errorf(codes.Unauthenticated, hdl.ErrFailedToParseUUID.Error())
+	}
+
+	r := &dto.ChangePasswordRequest{CurrentPassword: req.CurrentPassword, Password: req.Password}
+	if err := validation.V.Struct(r); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
-	err = h.ctrl.CheckForgotPasswordEmail(ctx, r)
+	d, err := utils.ParseDeviceFromContext(ctx)
 	if err != nil {
-		if errors.Is(err, ctrl.ErrCodeIsNotValid) {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	res, err := h.ctrl.ChangePassword(ctx, &d, uid, r)
+	if err != nil {
+		if errors.Is(err, auth.ErrInvalidCredentials) {
+			return nil, status.Errorf(codes.Unauthenticated, err.Error())
+		}
+		if errors.Is(err, password.ErrPolicy) {
 			return nil, status.Errorf(codes.InvalidArgument, err.Error())
 		}
 		if errors.Is(err, ctrl.ErrNotFound) {
 			return nil, status.Errorf(codes.NotFound, ctrl.ErrNotFound.Error())
 		}
-		zap.L().Error("failed to check forgot password email", zap.Error(err))
+		if errors.Is(err, ctrl.ErrAccountLocked) || errors.Is(err, ctrl.ErrTooManyRequests) {
+			return nil, status.Errorf(codes.ResourceExhausted, err.Error())
+		}
+		zap.L().Error("failed to change password", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, hdl.ErrInternal.Error())
 	}
 
-	return &pb.SSO_Empty{}, nil
+	return mapper.TokenPairToProto(res), nil
+}
+
+func (h *Handler) Reauthenticate(ctx context.Context, req *pb.SSO_ReauthReq) (*pb.SSO_TokenPair, error) {
+	uid, ok := ctx.Value("uid").(uuid.UUID)
+	if !ok {
+		zap.L().Error("failed to get uid from context")
+		return nil, status.Errorf(codes.Unauthenticated, hdl.ErrFailedToParseUUID.Error())
+	}
+
+	d, err := utils.ParseDeviceFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	r := &dto.ReauthRequest{Password: req.GetPassword(), Code: req.GetCode()}
+	if err := validation.V.Struct(r); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	res, err := h.ctrl.Reauthenticate(ctx, &d, uid, r)
+	if err != nil {
+		if errors.Is(err, auth.ErrInvalidCredentials) ||
+			errors.Is(err, ctrl.ErrCodeIsNotValid) ||
+			errors.Is(err, ctrl.ErrCodeReused) {
+			return nil, status.Errorf(codes.Unauthenticated, err.Error())
+		}
+		if errors.Is(err, ctrl.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, ctrl.ErrAccountLocked) || errors.Is(err, ctrl.ErrTooManyRequests) {
+			return nil, status.Errorf(codes.ResourceExhausted, err.Error())
+		}
+		zap.L().Error("failed to reauthenticate", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, hdl.ErrInternal.Error())
+	}
+	return mapper.TokenPairToProto(res), nil
 }
 
 func (h *Handler) Logout(ctx context.Context, _ *pb.SSO_Empty) (*pb.SSO_Empty, error) {
@@ -1,27 +1,35 @@
 package grpc
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net"
+	"time"
 
 	"github.com/JMURv/sso/api/grpc/v1/gen"
 	"github.com/JMURv/sso/internal/auth"
 	"github.com/JMURv/sso/internal/ctrl"
+	"github.com/JMURv/sso/internal/hdl"
 	"github.com/JMURv/sso/internal/hdl/grpc/interceptors"
 	metrics "github.com/JMURv/sso/internal/observability/metrics/prometheus"
+	"github.com/JMURv/sso/internal/ratelimit"
 	pm "github.com/grpc-ecosystem/go-grpc-middleware/providers/prometheus"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
 )
 
 type Handler struct {
 	gen.AuthServer
 	gen.UsersServer
 	gen.DevicesServer
+	gen.PasskeysServer
+	gen.PhonesServer
 	gen.PermissionServer
 	gen.RoleServer
 	srv  *grpc.Server
@@ -30,17 +38,35 @@ type Handler struct {
 	au   auth.Core
 }
 
-func New(name string, ctrl ctrl.AppCtrl, au auth.Core) *Handler {
+// rateLimited maps methods to rate limit policies, policies are shared with HTTP routes.
+var rateLimited = map[string][]string{
+	gen.Auth_Authenticate_FullMethodName:             {"auth_jwt"},
+	gen.Auth_SendLoginCode_FullMethodName:            {"auth_send_ip", "auth_email_send"},
+	gen.Auth_SendMagicLink_FullMethodName:            {"auth_send_ip", "auth_magic_link_send"},
+	gen.Auth_SendForgotPasswordEmail_FullMethodName:  {"auth_send_ip", "auth_recovery_send"},
+	gen.Auth_CheckLoginCode_FullMethodName:           {"auth_check_ip", "auth_email_check"},
+	gen.Auth_CheckForgotPasswordEmail_FullMethodName: {"auth_recovery_check"},
+	gen.Auth_VerifyTOTP_FullMethodName:               {"auth_mfa_verify"},
+	gen.Auth_VerifyRecoveryCode_FullMethodName:       {"auth_mfa_verify"},
+	gen.Auth_VerifyEmailCode_FullMethodName:          {"auth_mfa_verify"},
+	gen.Auth_StartQRLogin_FullMethodName:             {"auth_qr"},
+	gen.Users_ExistUser_FullMethodName:               {"users_exists"},
+}
+
+func New(name string, ctrl ctrl.AppCtrl, au auth.Core, rl *ratelimit.Limiter) *Handler {
 	srv := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
+			interceptors.Recovery(),
 			interceptors.Auth(au),
 			interceptors.Device(),
 			interceptors.LogTraceMetrics(),
+			interceptors.RateLimit(rl, rateLimited),
 			metrics.SrvMetrics.UnaryServerInterceptor(
 				pm.WithExemplarFromContext(metrics.Exemplar),
 			),
 		),
 		grpc.ChainStreamInterceptor(
+			interceptors.StreamRecovery(),
 			metrics.SrvMetrics.StreamServerInterceptor(
 				pm.WithExemplarFromContext(metrics.Exemplar),
 			),
@@ -63,6 +89,8 @@ func (h *Handler) Start(port int) {
 	gen.RegisterAuthServer(h.srv, h)
 	gen.RegisterUsersServer(h.srv, h)
 	gen.RegisterDevicesServer(h.srv, h)
+	gen.RegisterPasskeysServer(h.srv, h)
+	gen.RegisterPhonesServer(h.srv, h)
 	gen.RegisterPermissionServer(h.srv, h)
 	gen.RegisterRoleServer(h.srv, h)
 	grpc_health_v1.RegisterHealthServer(h.srv, h.hsrv)
@@ -86,3 +114,13 @@ func (h *Handler) Close() error {
 	h.srv.GracefulStop()
 	return nil
 }
+
+// recentAuth rejects sensitive call unless user has logged in or passed Reauthenticate
+// within configured reauth time, like Reauth middleware of HTTP routes.
+func (h *Handler) recentAuth(ctx context.Context) error {
+	authTime, ok := ctx.Value("auth_time").(time.Time)
+	if !ok || time.Since(authTime) > h.au.ReauthTime() {
+		return status.Errorf(codes.PermissionDenied, hdl.ErrReauthRequired.Error())
+	}
+	return nil
+}
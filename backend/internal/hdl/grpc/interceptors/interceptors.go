@@ -4,15 +4,24 @@ import (
 	"context"
 	"time"
 
+	pb "github.com/JMURv/sso/api/grpc/v1/gen"
 	"github.com/JMURv/sso/internal/auth"
+	"github.com/JMURv/sso/internal/hdl"
 	metrics "github.com/JMURv/sso/internal/observability/metrics/prometheus"
 	"github.com/opentracing/opentracing-go"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
+// restrictedMethods are the only methods allowed for tokens restricted to password change.
+var restrictedMethods = map[string]bool{
+	pb.Auth_ChangePassword_FullMethodName: true,
+	pb.Auth_Logout_FullMethodName:         true,
+}
+
 func Auth(au auth.Core) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
 		md, ok := metadata.FromIncomingContext(ctx)
@@ -37,7 +46,13 @@ func Auth(au auth.Core) grpc.UnaryServerInterceptor {
 			return handler(ctx, req)
 		}
 
+		if claims.PasswordChange && !restrictedMethods[info.FullMethod] {
+			return nil, status.Errorf(codes.PermissionDenied, hdl.ErrPasswordChangeRequired.Error())
+		}
+
 		ctx = context.WithValue(ctx, "uid", claims.UID)
+		ctx = context.WithValue(ctx, "roles", claims.Roles)
+		ctx = context.WithValue(ctx, "auth_time", claims.Info().Time)
 		return handler(ctx, req)
 	}
 }
@@ -63,28 +78,65 @@ func LogTraceMetrics() grpc.UnaryServerInterceptor {
 	}
 }
 
+// Device stores user agent and address of the connection, ip metadata is set by the caller and is not trusted.
+// Metadata keys are lowercased by gRPC.
 func Device() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if ip := clientIP(ctx); ip != "" {
+			ctx = context.WithValue(ctx, "ip", ip)
+		} else {
+			zap.L().Debug("missing peer address")
+		}
+
 		md, ok := metadata.FromIncomingContext(ctx)
 		if !ok {
 			zap.L().Debug("missing metadata")
 			return handler(ctx, req)
 		}
 
-		ua := md["User-Agent"]
-		if len(ua) == 0 {
-			zap.L().Error("missing user agent header")
+		ua := md.Get("user-agent")
+		if len(ua) == 0 || ua[0] == "" {
+			zap.L().Debug("missing user agent header")
 			return handler(ctx, req)
 		}
 
-		ip := md["IP"]
-		if len(ua) == 0 {
-			zap.L().Error("missing ip header")
-			return handler(ctx, req)
-		}
+		ctx = context.WithValue(ctx, "ua", ua[0])
+		return handler(ctx, req)
+	}
+}
 
-		ctx = context.WithValue(ctx, "ua", ua)
-		ctx = context.WithValue(ctx, "ip", ip)
+// Recovery turns panic of a handler into Internal error, so one bad call does not take server down.
+func Recovery() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (res any, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				zap.L().Error(
+					"panic in grpc handler",
+					zap.String("method", info.FullMethod),
+					zap.Any("panic", r),
+					zap.Stack("stack"),
+				)
+				err = status.Errorf(codes.Internal, hdl.ErrInternal.Error())
+			}
+		}()
 		return handler(ctx, req)
 	}
 }
+
+// StreamRecovery is Recovery for streaming calls.
+func StreamRecovery() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				zap.L().Error(
+					"panic in grpc stream handler",
+					zap.String("method", info.FullMethod),
+					zap.Any("panic", r),
+					zap.Stack("stack"),
+				)
+				err = status.Errorf(codes.Internal, hdl.ErrInternal.Error())
+			}
+		}()
+		return handler(srv, ss)
+	}
+}
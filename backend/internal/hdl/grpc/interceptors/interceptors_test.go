@@ -0,0 +1,72 @@
+package interceptors
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func TestDevice(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/sso.Auth/Authenticate"}
+	addr := &net.TCPAddr{IP: net.ParseIP("203.0.113.7"), Port: 5555}
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		ip   any
+		ua   any
+	}{
+		{
+			name: "Peer address and user agent",
+			ctx: metadata.NewIncomingContext(
+				peer.NewContext(context.Background(), &peer.Peer{Addr: addr}),
+				metadata.Pairs("user-agent", "test-agent", "ip", "198.51.100.1", "x-forwarded-for", "198.51.100.1"),
+			),
+			ip: "203.0.113.7",
+			ua: "test-agent",
+		},
+		{
+			name: "Missing metadata",
+			ctx:  peer.NewContext(context.Background(), &peer.Peer{Addr: addr}),
+			ip:   "203.0.113.7",
+		},
+		{
+			name: "Missing peer",
+			ctx:  metadata.NewIncomingContext(context.Background(), metadata.Pairs("user-agent", "test-agent")),
+			ua:   "test-agent",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				_, err := Device()(
+					tt.ctx, nil, info, func(ctx context.Context, req any) (any, error) {
+						assert.Equal(t, tt.ip, ctx.Value("ip"))
+						assert.Equal(t, tt.ua, ctx.Value("ua"))
+						return nil, nil
+					},
+				)
+				assert.NoError(t, err)
+			},
+		)
+	}
+}
+
+func TestRecovery(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/sso.Auth/Authenticate"}
+	res, err := Recovery()(
+		context.Background(), nil, info, func(ctx context.Context, req any) (any, error) {
+			panic("boom")
+		},
+	)
+	assert.Nil(t, res)
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
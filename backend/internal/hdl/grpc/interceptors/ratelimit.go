@@ -0,0 +1,111 @@
+package interceptors
+
+import (
+	"context"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/JMURv/sso/internal/ratelimit"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// RateLimit rejects call with ResourceExhausted once any of policies of the method is exhausted.
+// Methods maps full method name to policy names, rate limit state is sent back in headers.
+func RateLimit(l *ratelimit.Limiter, methods map[string][]string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		var res ratelimit.Result
+		for _, name := range methods[info.FullMethod] {
+			p, ok := l.Policy(name)
+			if !ok {
+				continue
+			}
+
+			cur := l.Allow(ctx, name, rateLimitSubject(ctx, req, p.Key))
+			if cur.Limit == 0 {
+				continue
+			}
+
+			if res.Limit == 0 || !cur.Allowed || (res.Allowed && cur.Remaining < res.Remaining) {
+				res = cur
+			}
+			if !cur.Allowed {
+				break
+			}
+		}
+
+		if res.Limit == 0 {
+			return handler(ctx, req)
+		}
+
+		reset := strconv.Itoa(int(math.Ceil(res.Reset.Seconds())))
+		md := metadata.Pairs(
+			"ratelimit-limit", strconv.Itoa(res.Limit),
+			"ratelimit-remaining", strconv.Itoa(res.Remaining),
+			"ratelimit-reset", reset,
+		)
+		if !res.Allowed {
+			md.Set("retry-after", reset)
+			_ = grpc.SetHeader(ctx, md)
+			return nil, status.Error(codes.ResourceExhausted, ratelimit.ErrLimitExceeded.Error())
+		}
+
+		_ = grpc.SetHeader(ctx, md)
+		return handler(ctx, req)
+	}
+}
+
+// rateLimitSubject falls back to client IP when call has no value for key,
+// so calls without email or user cannot bypass the limit.
+func rateLimitSubject(ctx context.Context, req any, key ratelimit.Key) string {
+	switch key {
+	case ratelimit.KeyUser:
+		if uid, ok := ctx.Value("uid").(uuid.UUID); ok && uid != uuid.Nil {
+			return uid.String()
+		}
+	case ratelimit.KeyEmail:
+		if r, ok := req.(interface{ GetEmail() string }); ok && r.GetEmail() != "" {
+			return strings.ToLower(strings.TrimSpace(r.GetEmail()))
+		}
+	case ratelimit.KeyClient:
+		if client := clientCert(ctx); client != "" {
+			return client
+		}
+	}
+	return clientIP(ctx)
+}
+
+// clientCert identifies client by verified TLS certificate, metadata is set by the caller
+// and would let it pick a fresh bucket on every call.
+func clientCert(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return ""
+	}
+	return info.State.VerifiedChains[0][0].Subject.CommonName
+}
+
+// clientIP takes address of the connection, x-real-ip metadata is not trusted.
+func clientIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	if host, _, err := net.SplitHostPort(p.Addr.String()); err == nil {
+		return host
+	}
+	return p.Addr.String()
+}
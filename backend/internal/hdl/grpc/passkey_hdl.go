@@ -0,0 +1,84 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/JMURv/sso/api/grpc/v1/gen"
+	"github.com/JMURv/sso/internal/ctrl"
+	"github.com/JMURv/sso/internal/dto"
+	"github.com/JMURv/sso/internal/hdl"
+	"github.com/JMURv/sso/internal/hdl/validation"
+	"github.com/JMURv/sso/internal/models/mapper"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *Handler) ListPasskeys(ctx context.Context, _ *pb.SSO_Empty) (*pb.SSO_ListPasskeysResponse, error) {
+	uid, ok := ctx.Value("uid").(uuid.UUID)
+	if !ok {
+		zap.L().Error("failed to get uid from context")
+		return nil, status.Errorf(codes.Unauthenticated, hdl.ErrFailedToParseUUID.Error())
+	}
+
+	res, err := h.ctrl.ListPasskeys(ctx, uid)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, hdl.ErrInternal.Error())
+	}
+	return &pb.SSO_ListPasskeysResponse{Data: mapper.ListPasskeysToProto(res)}, nil
+}
+
+func (h *Handler) RenamePasskey(ctx context.Context, req *pb.SSO_RenamePasskeyRequest) (*pb.SSO_Empty, error) {
+	uid, ok := ctx.Value("uid").(uuid.UUID)
+	if !ok {
+		zap.L().Error("failed to get uid from context")
+		return nil, status.Errorf(codes.Unauthenticated, hdl.ErrFailedToParseUUID.Error())
+	}
+
+	if req == nil || req.Id == "" {
+		zap.L().Error("failed to decode request")
+		return nil, status.Errorf(codes.InvalidArgument, hdl.ErrDecodeRequest.Error())
+	}
+
+	r := &dto.RenamePasskeyRequest{Name: req.Name}
+	if err := validation.V.Struct(r); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	err := h.ctrl.RenamePasskey(ctx, uid, req.Id, r)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, hdl.ErrInternal.Error())
+	}
+	return &pb.SSO_Empty{}, nil
+}
+
+func (h *Handler) DeletePasskey(ctx context.Context, req *pb.SSO_StringMsg) (*pb.SSO_Empty, error) {
+	uid, ok := ctx.Value("uid").(uuid.UUID)
+	if !ok {
+		zap.L().Error("failed to get uid from context")
+		return nil, status.Errorf(codes.Unauthenticated, hdl.ErrFailedToParseUUID.Error())
+	}
+
+	if err := h.recentAuth(ctx); err != nil {
+		return nil, err
+	}
+
+	if req == nil || req.String_ == "" {
+		zap.L().Error("failed to decode request")
+		return nil, status.Errorf(codes.InvalidArgument, hdl.ErrDecodeRequest.Error())
+	}
+
+	err := h.ctrl.DeletePasskey(ctx, uid, req.String_)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, hdl.ErrInternal.Error())
+	}
+	return &pb.SSO_Empty{}, nil
+}
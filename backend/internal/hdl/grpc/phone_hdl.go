@@ -0,0 +1,99 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/JMURv/sso/api/grpc/v1/gen"
+	"github.com/JMURv/sso/internal/ctrl"
+	"github.com/JMURv/sso/internal/dto"
+	"github.com/JMURv/sso/internal/hdl"
+	"github.com/JMURv/sso/internal/hdl/validation"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *Handler) StartPhoneVerification(ctx context.Context, req *pb.SSO_PhoneRequest) (*pb.SSO_Empty, error) {
+	uid, ok := ctx.Value("uid").(uuid.UUID)
+	if !ok {
+		zap.L().Error("failed to get uid from context")
+		return nil, status.Errorf(codes.Unauthenticated, hdl.ErrFailedToParseUUID.Error())
+	}
+
+	if err := h.recentAuth(ctx); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		zap.L().Error("failed to decode request")
+		return nil, status.Errorf(codes.InvalidArgument, hdl.ErrDecodeRequest.Error())
+	}
+
+	r := &dto.PhoneRequest{Phone: req.Phone, Channel: req.Channel}
+	if err := validation.V.Struct(r); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	err := h.ctrl.StartPhoneVerification(ctx, uid, r)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrCountryNotAllowed) || errors.Is(err, ctrl.ErrSMSDisabled) {
+			return nil, status.Errorf(codes.PermissionDenied, err.Error())
+		}
+		if errors.Is(err, ctrl.ErrTooManyRequests) {
+			return nil, status.Errorf(codes.ResourceExhausted, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, hdl.ErrInternal.Error())
+	}
+	return &pb.SSO_Empty{}, nil
+}
+
+func (h *Handler) ConfirmPhone(ctx context.Context, req *pb.SSO_ConfirmPhoneRequest) (*pb.SSO_Empty, error) {
+	uid, ok := ctx.Value("uid").(uuid.UUID)
+	if !ok {
+		zap.L().Error("failed to get uid from context")
+		return nil, status.Errorf(codes.Unauthenticated, hdl.ErrFailedToParseUUID.Error())
+	}
+
+	if req == nil || req.Code == "" {
+		zap.L().Error("failed to decode request")
+		return nil, status.Errorf(codes.InvalidArgument, hdl.ErrDecodeRequest.Error())
+	}
+
+	err := h.ctrl.ConfirmPhone(ctx, uid, &dto.ConfirmPhoneRequest{Code: req.Code})
+	if err != nil {
+		if errors.Is(err, ctrl.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, ctrl.ErrCodeIsNotValid) || errors.Is(err, ctrl.ErrCodeReused) {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, ctrl.ErrAlreadyExists) {
+			return nil, status.Errorf(codes.AlreadyExists, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, hdl.ErrInternal.Error())
+	}
+	return &pb.SSO_Empty{}, nil
+}
+
+func (h *Handler) DeletePhone(ctx context.Context, _ *pb.SSO_Empty) (*pb.SSO_Empty, error) {
+	uid, ok := ctx.Value("uid").(uuid.UUID)
+	if !ok {
+		zap.L().Error("failed to get uid from context")
+		return nil, status.Errorf(codes.Unauthenticated, hdl.ErrFailedToParseUUID.Error())
+	}
+
+	if err := h.recentAuth(ctx); err != nil {
+		return nil, err
+	}
+
+	err := h.ctrl.DeletePhone(ctx, uid)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, hdl.ErrInternal.Error())
+	}
+	return &pb.SSO_Empty{}, nil
+}
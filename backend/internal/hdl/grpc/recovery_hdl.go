@@ -0,0 +1,68 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/JMURv/sso/api/grpc/v1/gen"
+	"github.com/JMURv/sso/internal/ctrl"
+	"github.com/JMURv/sso/internal/dto"
+	"github.com/JMURv/sso/internal/hdl"
+	"github.com/JMURv/sso/internal/hdl/grpc/utils"
+	"github.com/JMURv/sso/internal/hdl/validation"
+	"github.com/JMURv/sso/internal/models/mapper"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *Handler) RegenerateRecoveryCodes(ctx context.Context, _ *pb.SSO_Empty) (*pb.SSO_RecoveryCodes, error) {
+	uid, ok := ctx.Value("uid").(uuid.UUID)
+	if !ok {
+		zap.L().Error("failed to get uid from context")
+		return nil, status.Errorf(codes.Unauthenticated, hdl.ErrFailedToParseUUID.Error())
+	}
+
+	if err := h.recentAuth(ctx); err != nil {
+		return nil, err
+	}
+
+	res, err := h.ctrl.RegenerateRecoveryCodes(ctx, uid)
+	if err != nil && errors.Is(err, ctrl.ErrNoSecondFactor) {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	} else if err != nil {
+		zap.L().Error("failed to regenerate recovery codes", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, hdl.ErrInternal.Error())
+	}
+	return &pb.SSO_RecoveryCodes{Codes: res.Codes}, nil
+}
+
+func (h *Handler) VerifyRecoveryCode(ctx context.Context, req *pb.SSO_VerifyRecoveryCodeReq) (*pb.SSO_TokenPair, error) {
+	if req == nil {
+		zap.L().Error("failed to decode request")
+		return nil, status.Errorf(codes.InvalidArgument, hdl.ErrDecodeRequest.Error())
+	}
+
+	d, err := utils.ParseDeviceFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	r := &dto.VerifyRecoveryCodeRequest{Challenge: req.Challenge, Code: req.Code}
+	if err := validation.V.Struct(r); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	res, err := h.ctrl.VerifyRecoveryCode(ctx, &d, r)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrCodeIsNotValid) ||
+			errors.Is(err, ctrl.ErrChallengeNotFound) ||
+			errors.Is(err, ctrl.ErrNotFound) {
+			return nil, status.Errorf(codes.Unauthenticated, err.Error())
+		}
+		zap.L().Error("failed to verify recovery code", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, hdl.ErrInternal.Error())
+	}
+	return mapper.TokenPairToProto(res), nil
+}
@@ -0,0 +1,189 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/JMURv/sso/api/grpc/v1/gen"
+	"github.com/JMURv/sso/internal/auth/jwt"
+	"github.com/JMURv/sso/internal/ctrl"
+	"github.com/JMURv/sso/internal/dto"
+	"github.com/JMURv/sso/tests/mocks"
+	gjwt "github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// startTestServer serves handler with the whole interceptor chain on loopback, so calls get real peer address.
+func startTestServer(t *testing.T, h *Handler) pb.AuthClient {
+	t.Helper()
+	pb.RegisterAuthServer(h.srv, h)
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() { _ = h.srv.Serve(lis) }()
+	t.Cleanup(h.srv.Stop)
+
+	conn, err := grpc.NewClient(
+		lis.Addr().String(),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUserAgent("test-agent"),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return pb.NewAuthClient(conn)
+}
+
+func TestHandler_VerifyRecoveryCode(t *testing.T) {
+	mock := gomock.NewController(t)
+	mctrl := mocks.NewMockAppCtrl(mock)
+	mau := mocks.NewMockCore(mock)
+	client := startTestServer(t, New("sso", mctrl, mau, nil))
+
+	// ip metadata is set by the caller, device must carry address of the connection
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "ip", "198.51.100.1")
+	req := &pb.SSO_VerifyRecoveryCodeReq{Challenge: "challenge", Code: "code"}
+	d := &dto.DeviceRequest{IP: "127.0.0.1", UA: "test-agent"}
+	r := &dto.VerifyRecoveryCodeRequest{Challenge: req.Challenge, Code: req.Code}
+
+	tests := []struct {
+		name   string
+		req    *pb.SSO_VerifyRecoveryCodeReq
+		expect func()
+		code   codes.Code
+	}{
+		{
+			name: "Success",
+			req:  req,
+			expect: func() {
+				mctrl.EXPECT().VerifyRecoveryCode(gomock.Any(), deviceMatcher{d}, r).
+					Return(&dto.TokenPair{Access: "access", Refresh: "refresh"}, nil)
+			},
+			code: codes.OK,
+		},
+		{
+			name: "Invalid code",
+			req:  req,
+			expect: func() {
+				mctrl.EXPECT().VerifyRecoveryCode(gomock.Any(), deviceMatcher{d}, r).
+					Return(nil, ctrl.ErrCodeIsNotValid)
+			},
+			code: codes.Unauthenticated,
+		},
+		{
+			name:   "Missing code",
+			req:    &pb.SSO_VerifyRecoveryCodeReq{Challenge: "challenge"},
+			expect: func() {},
+			code:   codes.InvalidArgument,
+		},
+		{
+			name: "Internal error",
+			req:  req,
+			expect: func() {
+				mctrl.EXPECT().VerifyRecoveryCode(gomock.Any(), deviceMatcher{d}, r).
+					Return(nil, errors.New("test-err"))
+			},
+			code: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				res, err := client.VerifyRecoveryCode(ctx, tt.req)
+				assert.Equal(t, tt.code, status.Code(err))
+				if tt.code == codes.OK {
+					assert.Equal(t, "access", res.Access)
+				}
+			},
+		)
+	}
+}
+
+func TestHandler_RegenerateRecoveryCodes(t *testing.T) {
+	mock := gomock.NewController(t)
+	mctrl := mocks.NewMockAppCtrl(mock)
+	mau := mocks.NewMockCore(mock)
+	client := startTestServer(t, New("sso", mctrl, mau, nil))
+	mau.EXPECT().ReauthTime().Return(10 * time.Minute).AnyTimes()
+
+	uid := uuid.New()
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer token")
+	claims := func(authTime time.Time) jwt.Claims {
+		return jwt.Claims{UID: uid, AuthTime: gjwt.NewNumericDate(authTime)}
+	}
+
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		expect func()
+		code   codes.Code
+	}{
+		{
+			name: "Success",
+			ctx:  ctx,
+			expect: func() {
+				mau.EXPECT().ParseClaims(gomock.Any(), "token").Return(claims(time.Now()), nil)
+				mctrl.EXPECT().RegenerateRecoveryCodes(gomock.Any(), uid).
+					Return(&dto.RecoveryCodesResponse{Codes: []string{"code"}}, nil)
+			},
+			code: codes.OK,
+		},
+		{
+			name: "Stale login",
+			ctx:  ctx,
+			expect: func() {
+				mau.EXPECT().ParseClaims(gomock.Any(), "token").Return(claims(time.Now().Add(-time.Hour)), nil)
+			},
+			code: codes.PermissionDenied,
+		},
+		{
+			name:   "Unauthenticated",
+			ctx:    context.Background(),
+			expect: func() {},
+			code:   codes.Unauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				res, err := client.RegenerateRecoveryCodes(tt.ctx, &pb.SSO_Empty{})
+				assert.Equal(t, tt.code, status.Code(err))
+				if tt.code == codes.OK {
+					assert.Equal(t, []string{"code"}, res.Codes)
+				}
+			},
+		)
+	}
+}
+
+// deviceMatcher compares device by value, gRPC client appends its version to user agent.
+type deviceMatcher struct {
+	d *dto.DeviceRequest
+}
+
+func (m deviceMatcher) Matches(x any) bool {
+	d, ok := x.(*dto.DeviceRequest)
+	if !ok {
+		return false
+	}
+	return d.IP == m.d.IP && strings.HasPrefix(d.UA, m.d.UA)
+}
+
+func (m deviceMatcher) String() string {
+	return "device " + m.d.IP + " " + m.d.UA
+}
@@ -0,0 +1,47 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/JMURv/sso/api/grpc/v1/gen"
+	"github.com/JMURv/sso/internal/ctrl"
+	"github.com/JMURv/sso/internal/dto"
+	"github.com/JMURv/sso/internal/hdl"
+	"github.com/JMURv/sso/internal/hdl/grpc/utils"
+	"github.com/JMURv/sso/internal/hdl/validation"
+	"github.com/JMURv/sso/internal/models/mapper"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *Handler) VerifyEmailCode(ctx context.Context, req *pb.SSO_VerifyEmailCodeReq) (*pb.SSO_TokenPair, error) {
+	if req == nil {
+		zap.L().Error("failed to decode request")
+		return nil, status.Errorf(codes.InvalidArgument, hdl.ErrDecodeRequest.Error())
+	}
+
+	d, err := utils.ParseDeviceFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	r := &dto.VerifyEmailCodeRequest{Challenge: req.Challenge, Code: req.Code}
+	if err := validation.V.Struct(r); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	res, err := h.ctrl.VerifyEmailCode(ctx, &d, r)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrCodeIsNotValid) ||
+			errors.Is(err, ctrl.ErrCodeReused) ||
+			errors.Is(err, ctrl.ErrChallengeNotFound) ||
+			errors.Is(err, ctrl.ErrNotFound) {
+			return nil, status.Errorf(codes.Unauthenticated, err.Error())
+		}
+		zap.L().Error("failed to verify email code", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, hdl.ErrInternal.Error())
+	}
+	return mapper.TokenPairToProto(res), nil
+}
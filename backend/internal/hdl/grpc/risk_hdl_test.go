@@ -0,0 +1,54 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/JMURv/sso/api/grpc/v1/gen"
+	"github.com/JMURv/sso/internal/ctrl"
+	"github.com/JMURv/sso/internal/dto"
+	"github.com/JMURv/sso/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Risk signals are looked up by device IP, caller must not be able to pick it with metadata.
+func TestHandler_RiskDevice(t *testing.T) {
+	mock := gomock.NewController(t)
+	mctrl := mocks.NewMockAppCtrl(mock)
+	mau := mocks.NewMockCore(mock)
+	client := startTestServer(t, New("sso", mctrl, mau, nil))
+
+	ctx := metadata.AppendToOutgoingContext(
+		context.Background(),
+		"ip", "198.51.100.1",
+		"x-forwarded-for", "198.51.100.1",
+		"x-real-ip", "198.51.100.1",
+	)
+	d := &dto.DeviceRequest{IP: "127.0.0.1", UA: "test-agent"}
+
+	t.Run(
+		"CheckLoginCode", func(t *testing.T) {
+			r := &dto.CheckLoginCodeRequest{Email: "test@example.com", Code: "123456"}
+			mctrl.EXPECT().CheckLoginCode(gomock.Any(), deviceMatcher{d}, r).Return(nil, ctrl.ErrRiskBlocked)
+
+			_, err := client.CheckLoginCode(ctx, &pb.SSO_CheckLoginCodeReq{Email: r.Email, Code: r.Code})
+			assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		},
+	)
+
+	t.Run(
+		"VerifyEmailCode", func(t *testing.T) {
+			r := &dto.VerifyEmailCodeRequest{Challenge: "challenge", Code: "code"}
+			mctrl.EXPECT().VerifyEmailCode(gomock.Any(), deviceMatcher{d}, r).
+				Return(&dto.TokenPair{Access: "access", Refresh: "refresh"}, nil)
+
+			res, err := client.VerifyEmailCode(ctx, &pb.SSO_VerifyEmailCodeReq{Challenge: r.Challenge, Code: r.Code})
+			assert.NoError(t, err)
+			assert.Equal(t, "access", res.Access)
+		},
+	)
+}
@@ -0,0 +1,147 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/JMURv/sso/api/grpc/v1/gen"
+	"github.com/JMURv/sso/internal/ctrl"
+	"github.com/JMURv/sso/internal/dto"
+	"github.com/JMURv/sso/internal/hdl"
+	"github.com/JMURv/sso/internal/hdl/grpc/utils"
+	"github.com/JMURv/sso/internal/hdl/validation"
+	"github.com/JMURv/sso/internal/models/mapper"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *Handler) EnrollTOTP(ctx context.Context, _ *pb.SSO_Empty) (*pb.SSO_TOTPEnrollRes, error) {
+	uid, ok := ctx.Value("uid").(uuid.UUID)
+	if !ok {
+		zap.L().Error("failed to get uid from context")
+		return nil, status.Errorf(codes.Unauthenticated, hdl.ErrFailedToParseUUID.Error())
+	}
+
+	if err := h.recentAuth(ctx); err != nil {
+		return nil, err
+	}
+
+	res, err := h.ctrl.EnrollTOTP(ctx, uid)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, ctrl.ErrAlreadyExists) {
+			return nil, status.Errorf(codes.AlreadyExists, err.Error())
+		}
+		zap.L().Error("failed to enroll totp", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, hdl.ErrInternal.Error())
+	}
+
+	return &pb.SSO_TOTPEnrollRes{
+		Secret: res.Secret,
+		Uri:    res.URI,
+		Qr:     res.QR,
+	}, nil
+}
+
+func (h *Handler) ConfirmTOTP(ctx context.Context, req *pb.SSO_TOTPCodeReq) (*pb.SSO_RecoveryCodes, error) {
+	uid, ok := ctx.Value("uid").(uuid.UUID)
+	if !ok {
+		zap.L().Error("failed to get uid from context")
+		return nil, status.Errorf(codes.Unauthenticated, hdl.ErrFailedToParseUUID.Error())
+	}
+
+	if err := h.recentAuth(ctx); err != nil {
+		return nil, err
+	}
+
+	r := &dto.TOTPCodeRequest{Code: req.GetCode()}
+	if err := validation.V.Struct(r); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	res, err := h.ctrl.ConfirmTOTP(ctx, uid, r.Code)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrCodeIsNotValid) {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, ctrl.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, ctrl.ErrAlreadyExists) {
+			return nil, status.Errorf(codes.AlreadyExists, err.Error())
+		}
+		zap.L().Error("failed to confirm totp", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, hdl.ErrInternal.Error())
+	}
+	return &pb.SSO_RecoveryCodes{Codes: res.Codes}, nil
+}
+
+func (h *Handler) DisableTOTP(ctx context.Context, req *pb.SSO_TOTPCodeReq) (*pb.SSO_Empty, error) {
+	uid, ok := ctx.Value("uid").(uuid.UUID)
+	if !ok {
+		zap.L().Error("failed to get uid from context")
+		return nil, status.Errorf(codes.Unauthenticated, hdl.ErrFailedToParseUUID.Error())
+	}
+
+	if err := h.recentAuth(ctx); err != nil {
+		return nil, err
+	}
+
+	r := &dto.TOTPCodeRequest{Code: req.GetCode()}
+	if err := validation.V.Struct(r); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	err := h.ctrl.DisableTOTP(ctx, uid, r.Code)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrCodeIsNotValid) || errors.Is(err, ctrl.ErrCodeReused) {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, ctrl.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, ctrl.ErrAccountLocked) || errors.Is(err, ctrl.ErrTooManyRequests) {
+			return nil, status.Errorf(codes.ResourceExhausted, err.Error())
+		}
+		zap.L().Error("failed to disable totp", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, hdl.ErrInternal.Error())
+	}
+	return &pb.SSO_Empty{}, nil
+}
+
+func (h *Handler) VerifyTOTP(ctx context.Context, req *pb.SSO_VerifyTOTPReq) (*pb.SSO_TokenPair, error) {
+	if req == nil {
+		zap.L().Error("failed to decode request")
+		return nil, status.Errorf(codes.InvalidArgument, hdl.ErrDecodeRequest.Error())
+	}
+
+	d, err := utils.ParseDeviceFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	r := &dto.VerifyTOTPRequest{Challenge: req.Challenge, Code: req.Code}
+	if err := validation.V.Struct(r); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	res, err := h.ctrl.VerifyTOTP(ctx, &d, r)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrCodeIsNotValid) ||
+			errors.Is(err, ctrl.ErrCodeReused) ||
+			errors.Is(err, ctrl.ErrChallengeNotFound) ||
+			errors.Is(err, ctrl.ErrNotFound) {
+			return nil, status.Errorf(codes.Unauthenticated, err.Error())
+		}
+		if errors.Is(err, ctrl.ErrAccountLocked) || errors.Is(err, ctrl.ErrTooManyRequests) {
+			return nil, status.Errorf(codes.ResourceExhausted, err.Error())
+		}
+		zap.L().Error("failed to verify totp", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, hdl.ErrInternal.Error())
+	}
+	return mapper.TokenPairToProto(res), nil
+}
@@ -5,10 +5,12 @@ import (
 	"errors"
 
 	pb "github.com/JMURv/sso/api/grpc/v1/gen"
+	"github.com/JMURv/sso/internal/auth/password"
 	"github.com/JMURv/sso/internal/ctrl"
 	"github.com/JMURv/sso/internal/dto"
 	"github.com/JMURv/sso/internal/hdl"
 	"github.com/JMURv/sso/internal/hdl/validation"
+	md "github.com/JMURv/sso/internal/models"
 	utils "github.com/JMURv/sso/internal/models/mapper"
 	"github.com/JMURv/sso/internal/repo/s3"
 	"github.com/google/uuid"
@@ -18,6 +20,15 @@ import (
 )
 
 func (h *Handler) ExistUser(ctx context.Context, req *pb.SSO_ExistUserRequest) (*pb.SSO_ExistUserResponse, error) {
+	if _, ok := ctx.Value("uid").(uuid.UUID); !ok {
+		return nil, status.Errorf(codes.Unauthenticated, hdl.ErrFailedToGetUUID.Error())
+	}
+
+	roles, _ := ctx.Value("roles").([]md.Role)
+	if !isAdmin(roles) {
+		return nil, status.Errorf(codes.PermissionDenied, hdl.ErrNotAuthorized.Error())
+	}
+
 	res, err := h.ctrl.IsUserExist(ctx, req.Email)
 	if err != nil {
 		if errors.Is(err, ctrl.ErrNotFound) {
@@ -83,6 +94,9 @@ func (h *Handler) CreateUser(ctx context.Context, req *pb.SSO_CreateUserReq) (*p
 		IsActive: req.IsActive,
 		IsEmail:  req.IsEmailVerified,
 		Roles:    req.Roles,
+
+		MustChangePassword: req.MustChangePassword,
+		SendCredentials:    req.SendCredentials,
 	}
 
 	if err := validation.V.Struct(r); err != nil {
@@ -94,6 +108,9 @@ func (h *Handler) CreateUser(ctx context.Context, req *pb.SSO_CreateUserReq) (*p
 		if errors.Is(err, ctrl.ErrAlreadyExists) {
 			return nil, status.Errorf(codes.AlreadyExists, err.Error())
 		}
+		if errors.Is(err, password.ErrPolicy) {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Errorf(codes.Internal, hdl.ErrInternal.Error())
 	}
 	return &pb.SSO_CreateUserRes{
@@ -125,6 +142,10 @@ func (h *Handler) UpdateUser(ctx context.Context, req *pb.SSO_UpdateUserReq) (*p
 		return nil, status.Errorf(codes.InvalidArgument, ctrl.ErrParseUUID.Error())
 	}
 
+	if err := h.recentAuth(ctx); err != nil {
+		return nil, err
+	}
+
 	r := &dto.UpdateUserRequest{
 		Name:     req.Name,
 		Email:    req.Email,
@@ -133,6 +154,8 @@ func (h *Handler) UpdateUser(ctx context.Context, req *pb.SSO_UpdateUserReq) (*p
 		IsActive: req.IsActive,
 		IsEmail:  req.IsEmailVerified,
 		Roles:    req.Roles,
+
+		MustChangePassword: req.MustChangePassword,
 	}
 	if err := validation.V.Struct(r); err != nil {
 		zap.L().Error("failed to validate obj", zap.Error(err))
@@ -144,6 +167,9 @@ func (h *Handler) UpdateUser(ctx context.Context, req *pb.SSO_UpdateUserReq) (*p
 		if errors.Is(err, ctrl.ErrNotFound) {
 			return nil, status.Errorf(codes.NotFound, err.Error())
 		}
+		if errors.Is(err, password.ErrPolicy) {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Errorf(codes.Internal, hdl.ErrInternal.Error())
 	}
 	return &pb.SSO_UuidMsg{Uuid: uid.String()}, nil
@@ -156,9 +182,22 @@ func (h *Handler) DeleteUser(ctx context.Context, _ *pb.SSO_UuidMsg) (*pb.SSO_Em
 		return nil, status.Errorf(codes.InvalidArgument, ctrl.ErrParseUUID.Error())
 	}
 
+	if err := h.recentAuth(ctx); err != nil {
+		return nil, err
+	}
+
 	err := h.ctrl.DeleteUser(ctx, uid)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, hdl.ErrInternal.Error())
 	}
 	return &pb.SSO_Empty{}, nil
 }
+
+func isAdmin(roles []md.Role) bool {
+	for i := 0; i < len(roles); i++ {
+		if roles[i].Name == "admin" {
+			return true
+		}
+	}
+	return false
+}
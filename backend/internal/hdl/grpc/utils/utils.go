@@ -4,11 +4,23 @@ import (
 	"context"
 
 	"github.com/JMURv/sso/internal/dto"
+	"github.com/JMURv/sso/internal/hdl"
 )
 
-func ParseDeviceFromContext(ctx context.Context) dto.DeviceRequest {
-	return dto.DeviceRequest{
-		UA: ctx.Value("ua").(string),
-		IP: ctx.Value("ip").(string),
+// ParseDeviceFromContext returns device stored by Device interceptor.
+func ParseDeviceFromContext(ctx context.Context) (dto.DeviceRequest, error) {
+	ip, ok := ctx.Value("ip").(string)
+	if !ok || ip == "" {
+		return dto.DeviceRequest{}, hdl.ErrNoDeviceInfo
+	}
+
+	ua, ok := ctx.Value("ua").(string)
+	if !ok || ua == "" {
+		return dto.DeviceRequest{}, hdl.ErrNoDeviceInfo
 	}
+
+	return dto.DeviceRequest{
+		IP: ip,
+		UA: ua,
+	}, nil
 }
@@ -0,0 +1,230 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/JMURv/sso/internal/config"
+	"github.com/JMURv/sso/internal/ctrl"
+	"github.com/JMURv/sso/internal/dto"
+	"github.com/JMURv/sso/internal/hdl"
+	mid "github.com/JMURv/sso/internal/hdl/http/middleware"
+	"github.com/JMURv/sso/internal/hdl/http/utils"
+	"github.com/go-chi/chi/v5"
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+func (h *Handler) RegisterApprovalRoutes() {
+	h.router.With(mid.Auth(h.au)).Get("/auth/approvals", h.listLoginApprovals)
+	h.router.With(mid.Auth(h.au)).Get("/auth/approvals/stream", h.streamLoginApprovals)
+	h.router.With(mid.Auth(h.au)).Post("/auth/approvals/{id}", h.approveLogin)
+	h.router.With(mid.Device).Post("/auth/approvals/check", h.checkLoginApproval)
+}
+
+// listLoginApprovals godoc
+//
+//	@Summary		List pending logins
+//	@Description	Logins from unknown devices waiting for approval from one of signed-in devices
+//	@Tags			LoginApproval
+//	@Produce		json
+//	@Param			Authorization	header		string	true	"Authorization token"
+//	@Success		200				{array}		[]dto.PendingLogin
+//	@Failure		500				{object}	utils.ErrorsResponse	"internal error"
+//	@Router			/auth/approvals [get]
+func (h *Handler) listLoginApprovals(w http.ResponseWriter, r *http.Request) {
+	uid, ok := r.Context().Value("uid").(uuid.UUID)
+	if uid == uuid.Nil || !ok {
+		zap.L().Error(
+			hdl.ErrFailedToParseUUID.Error(),
+			zap.Any("uid", r.Context().Value("uid")),
+		)
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrFailedToParseUUID)
+		return
+	}
+
+	res, err := h.ctrl.ListLoginApprovals(r.Context(), uid)
+	if err != nil {
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, http.StatusOK, res)
+}
+
+// streamLoginApprovals godoc
+//
+//	@Summary		Stream pending logins
+//	@Description	Server-sent events with pending logins, "approval" event is sent every time the list changes
+//	@Tags			LoginApproval
+//	@Produce		text/event-stream
+//	@Param			Authorization	header		string	true	"Authorization token"
+//	@Success		200				{array}		[]dto.PendingLogin
+//	@Failure		500				{object}	utils.ErrorsResponse	"internal error"
+//	@Router			/auth/approvals/stream [get]
+func (h *Handler) streamLoginApprovals(w http.ResponseWriter, r *http.Request) {
+	uid, ok := r.Context().Value("uid").(uuid.UUID)
+	if uid == uuid.Nil || !ok {
+		zap.L().Error(
+			hdl.ErrFailedToParseUUID.Error(),
+			zap.Any("uid", r.Context().Value("uid")),
+		)
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrFailedToParseUUID)
+		return
+	}
+
+	rc := http.NewResponseController(w)
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil {
+		zap.L().Error("failed to disable write deadline", zap.Error(err))
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+
+	ticker := time.NewTicker(config.LoginApprovalInterval)
+	defer ticker.Stop()
+
+	var last []byte
+	for {
+		res, err := h.ctrl.ListLoginApprovals(r.Context(), uid)
+		if err != nil {
+			return
+		}
+
+		data, err := json.Marshal(res)
+		if err != nil {
+			return
+		}
+
+		if string(data) != string(last) {
+			if _, err = fmt.Fprintf(w, "event: approval\ndata: %s\n\n", data); err != nil {
+				return
+			}
+			if err = rc.Flush(); err != nil {
+				return
+			}
+			last = data
+		}
+
+		select {
+		case <-r.Context().Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// approveLogin godoc
+//
+//	@Summary		Approve or deny pending login
+//	@Description	Resolve login from unknown device. Number shown on that device has to be picked, wrong number denies the login
+//	@Tags			LoginApproval
+//	@Param			id	path	string	true	"Pending login ID"
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization	header		string						true	"Authorization token"
+//	@Param			body			body		dto.ApproveLoginRequest		true	"decision and picked number"
+//	@Success		200				{object}	nil							"OK"
+//	@Failure		400				{object}	utils.ErrorsResponse		"bad payload or wrong number"
+//	@Failure		404				{object}	utils.ErrorsResponse		"pending login not found"
+//	@Failure		500				{object}	utils.ErrorsResponse		"internal error"
+//	@Router			/auth/approvals/{id} [post]
+func (h *Handler) approveLogin(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		zap.L().Debug(
+			hdl.ErrToRetrievePathArg.Error(),
+			zap.String("path", r.URL.Path),
+		)
+		utils.ErrResponse(w, http.StatusBadRequest, hdl.ErrToRetrievePathArg)
+		return
+	}
+
+	uid, ok := r.Context().Value("uid").(uuid.UUID)
+	if uid == uuid.Nil || !ok {
+		zap.L().Error(
+			hdl.ErrFailedToParseUUID.Error(),
+			zap.Any("uid", r.Context().Value("uid")),
+		)
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrFailedToParseUUID)
+		return
+	}
+
+	req := &dto.ApproveLoginRequest{}
+	if ok = utils.ParseAndValidate(w, r, req); !ok {
+		return
+	}
+
+	err := h.ctrl.ApproveLogin(r.Context(), uid, id, req)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrNotFound) {
+			utils.ErrResponse(w, http.StatusNotFound, err)
+			return
+		} else if errors.Is(err, ctrl.ErrCodeIsNotValid) {
+			utils.ErrResponse(w, http.StatusBadRequest, err)
+			return
+		}
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	utils.StatusResponse(w, http.StatusOK)
+}
+
+// checkLoginApproval godoc
+//
+//	@Summary		Check login approval
+//	@Description	Polled by the new device. Returns tokens once login is approved on trusted device
+//	@Tags			LoginApproval
+//	@Accept			json
+//	@Produce		json
+//	@Param			X-Real-IP	header		string							true	"Client real IP address"
+//	@Param			User-Agent	header		string							true	"Client User-Agent"
+//	@Param			body		body		dto.CheckLoginApprovalRequest	true	"approval ID"
+//	@Success		200			{object}	dto.TokenPair
+//	@Success		202			{object}	nil								"still pending"
+//	@Failure		400			{object}	utils.ErrorsResponse			"missing device info or bad payload"
+//	@Failure		401			{object}	utils.ErrorsResponse			"tokens have already been issued"
+//	@Failure		403			{object}	utils.ErrorsResponse			"login denied or too risky"
+//	@Failure		404			{object}	utils.ErrorsResponse			"approval not found or expired"
+//	@Failure		500			{object}	utils.ErrorsResponse			"internal error"
+//	@Router			/auth/approvals/check [post]
+func (h *Handler) checkLoginApproval(w http.ResponseWriter, r *http.Request) {
+	d, ok := utils.ParseDeviceByRequest(r)
+	if !ok {
+		utils.ErrResponse(w, http.StatusBadRequest, hdl.ErrNoDeviceInfo)
+		return
+	}
+
+	req := &dto.CheckLoginApprovalRequest{}
+	if ok = utils.ParseAndValidate(w, r, req); !ok {
+		return
+	}
+
+	res, err := h.ctrl.CheckLoginApproval(r.Context(), &d, req.ID)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrApprovalPending) {
+			utils.StatusResponse(w, http.StatusAccepted)
+			return
+		} else if errors.Is(err, ctrl.ErrNotFound) {
+			utils.ErrResponse(w, http.StatusNotFound, err)
+			return
+		} else if errors.Is(err, ctrl.ErrApprovalDenied) || errors.Is(err, ctrl.ErrRiskBlocked) {
+			utils.ErrResponse(w, http.StatusForbidden, err)
+			return
+		} else if errors.Is(err, ctrl.ErrCodeReused) {
+			utils.ErrResponse(w, http.StatusUnauthorized, err)
+			return
+		}
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	loginResponse(w, res)
+}
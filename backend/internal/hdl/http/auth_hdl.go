@@ -1,12 +1,14 @@
 package http
 
 import (
+	"context"
 	"errors"
 	"net/http"
 
 	"github.com/JMURv/sso/internal/auth"
 	"github.com/JMURv/sso/internal/auth/captcha"
 	_ "github.com/JMURv/sso/internal/auth/jwt"
+	"github.com/JMURv/sso/internal/auth/password"
 	"github.com/JMURv/sso/internal/config"
 	"github.com/JMURv/sso/internal/ctrl"
 	"github.com/JMURv/sso/internal/dto"
@@ -18,14 +20,18 @@ import (
 )
 
 func (h *Handler) RegisterAuthRoutes() {
-	h.router.With(mid.Device).Post("/auth/jwt", h.authenticate)
+	h.router.With(mid.RateLimit(h.rl, "auth_jwt"), mid.Device).Post("/auth/jwt", h.authenticate)
 	h.router.Post("/auth/jwt/parse", h.parseClaims)
 	h.router.With(mid.Device).Post("/auth/jwt/refresh", h.refresh)
-	h.router.With(mid.Device).Post("/auth/email/send", h.sendLoginCode)
-	h.router.With(mid.Device).Post("/auth/email/check", h.checkLoginCode)
-	h.router.Post("/auth/recovery/send", h.sendForgotPasswordEmail)
-	h.router.Post("/auth/recovery/check", h.checkForgotPasswordEmail)
-	h.router.With(mid.Auth(h.au)).Post("/auth/logout", h.logout)
+	h.router.With(mid.RateLimit(h.rl, "auth_send_ip", "auth_email_send"), mid.Device).Post("/auth/email/send", h.sendLoginCode)
+	h.router.With(mid.RateLimit(h.rl, "auth_check_ip", "auth_email_check"), mid.Device).Post("/auth/email/check", h.checkLoginCode)
+	h.router.With(mid.RateLimit(h.rl, "auth_send_ip", "auth_magic_link_send")).Post("/auth/magic-link/send", h.sendMagicLink)
+	h.router.With(mid.Device).Post("/auth/magic-link/verify", h.verifyMagicLink)
+	h.router.With(mid.RateLimit(h.rl, "auth_send_ip", "auth_recovery_send")).Post("/auth/recovery/send", h.sendForgotPasswordEmail)
+	h.router.With(mid.RateLimit(h.rl, "auth_recovery_check"), mid.Device).Post("/auth/recovery/check", h.checkForgotPasswordEmail)
+	h.router.With(mid.AuthRestricted(h.au), mid.Device).Post("/auth/password/change", h.changePassword)
+	h.router.With(mid.Auth(h.au), mid.Device).Post("/auth/reauth", h.reauthenticate)
+	h.router.With(mid.AuthRestricted(h.au)).Post("/auth/logout", h.logout)
 }
 
 // authenticate godoc
@@ -39,9 +45,13 @@ func (h *Handler) RegisterAuthRoutes() {
 //	@Param			User-Agent	header		string						true	"Client User-Agent"
 //	@Param			body		body		dto.EmailAndPasswordRequest	true	"email, password, reCAPTCHA token"
 //	@Success		200			{object}	dto.TokenPair
+//	@Success		202			{object}	dto.MFAChallenge		"second factor required"
 //	@Failure		400			{object}	utils.ErrorsResponse	"missing device info or bad payload"
 //	@Failure		401			{object}	utils.ErrorsResponse	"invalid credentials or reCAPTCHA"
+//	@Failure		403			{object}	utils.ErrorsResponse	"password login disabled for domain or login is too risky"
 //	@Failure		404			{object}	utils.ErrorsResponse	"user not found"
+//	@Failure		423			{object}	utils.ErrorsResponse	"account or IP locked after failed attempts"
+//	@Failure		429			{object}	utils.ErrorsResponse	"next attempt is delayed or rate limit exceeded"
 //	@Failure		500			{object}	utils.ErrorsResponse	"internal error"
 //	@Router			/auth/jwt [post]
 func (h *Handler) authenticate(w http.ResponseWriter, r *http.Request) {
@@ -67,7 +77,8 @@ func (h *Handler) authenticate(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	res, err := h.ctrl.Authenticate(r.Context(), &d, req)
+	ctx := context.WithValue(r.Context(), "captcha", true)
+	res, err := h.ctrl.Authenticate(ctx, &d, req)
 	if err != nil {
 		if errors.Is(err, ctrl.ErrNotFound) {
 			utils.ErrResponse(w, http.StatusNotFound, err)
@@ -77,12 +88,23 @@ func (h *Handler) authenticate(w http.ResponseWriter, r *http.Request) {
 			utils.ErrResponse(w, http.StatusUnauthorized, err)
 			return
 		}
+		if errors.Is(err, ctrl.ErrPasswordLoginDisabled) || errors.Is(err, ctrl.ErrRiskBlocked) {
+			utils.ErrResponse(w, http.StatusForbidden, err)
+			return
+		}
+		if errors.Is(err, ctrl.ErrAccountLocked) {
+			utils.ErrResponse(w, http.StatusLocked, err)
+			return
+		}
+		if errors.Is(err, ctrl.ErrTooManyRequests) {
+			utils.ErrResponse(w, http.StatusTooManyRequests, err)
+			return
+		}
 		utils.ErrResponse(w, http.StatusInternalServerError, err)
 		return
 	}
 
-	utils.SetAuthCookies(w, res.Access, res.Refresh)
-	utils.StatusResponse(w, http.StatusOK)
+	loginResponse(w, res)
 }
 
 // refresh godoc
@@ -133,6 +155,10 @@ func (h *Handler) refresh(w http.ResponseWriter, r *http.Request) {
 	}
 
 	utils.SetAuthCookies(w, res.Access, res.Refresh)
+	if res.PasswordChange {
+		utils.SuccessResponse(w, http.StatusOK, dto.PasswordChangeResponse{PasswordChange: true})
+		return
+	}
 	utils.StatusResponse(w, http.StatusOK)
 }
 
@@ -168,6 +194,75 @@ func (h *Handler) parseClaims(w http.ResponseWriter, r *http.Request) {
 	utils.SuccessResponse(w, http.StatusOK, res)
 }
 
+// reauthenticate godoc
+//
+//	@Summary		Re-authenticate current user
+//	@Description	Confirms identity with password or TOTP code and resets JWT cookies with fresh auth_time. Required before sensitive actions when login is older than a few minutes
+//	@Tags			Authentication
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization	header		string					true	"Authorization token"
+//	@Param			X-Real-IP		header		string					true	"Client real IP address"
+//	@Param			User-Agent		header		string					true	"Client User-Agent"
+//	@Param			body			body		dto.ReauthRequest		true	"password or TOTP code"
+//	@Success		200				{object}	dto.TokenPair
+//	@Failure		400				{object}	utils.ErrorsResponse	"missing device info or bad payload"
+//	@Failure		401				{object}	utils.ErrorsResponse	"invalid credentials or code"
+//	@Failure		404				{object}	utils.ErrorsResponse	"user not found or totp is not enabled"
+//	@Failure		423				{object}	utils.ErrorsResponse	"account or IP locked after failed attempts"
+//	@Failure		429				{object}	utils.ErrorsResponse	"next attempt is delayed"
+//	@Failure		500				{object}	utils.ErrorsResponse	"internal error"
+//	@Router			/auth/reauth [post]
+func (h *Handler) reauthenticate(w http.ResponseWriter, r *http.Request) {
+	uid, ok := r.Context().Value("uid").(uuid.UUID)
+	if !ok {
+		zap.L().Error(
+			hdl.ErrFailedToParseUUID.Error(),
+			zap.Any("uid", r.Context().Value("uid")),
+			zap.Error(hdl.ErrFailedToParseUUID),
+		)
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	d, ok := utils.ParseDeviceByRequest(r)
+	if !ok {
+		utils.ErrResponse(w, http.StatusBadRequest, hdl.ErrNoDeviceInfo)
+		return
+	}
+
+	req := &dto.ReauthRequest{}
+	if ok = utils.ParseAndValidate(w, r, req); !ok {
+		return
+	}
+
+	res, err := h.ctrl.Reauthenticate(r.Context(), &d, uid, req)
+	if err != nil {
+		if errors.Is(err, auth.ErrInvalidCredentials) ||
+			errors.Is(err, ctrl.ErrCodeIsNotValid) ||
+			errors.Is(err, ctrl.ErrCodeReused) {
+			utils.ErrResponse(w, http.StatusUnauthorized, err)
+			return
+		}
+		if errors.Is(err, ctrl.ErrNotFound) {
+			utils.ErrResponse(w, http.StatusNotFound, err)
+			return
+		}
+		if errors.Is(err, ctrl.ErrAccountLocked) {
+			utils.ErrResponse(w, http.StatusLocked, err)
+			return
+		}
+		if errors.Is(err, ctrl.ErrTooManyRequests) {
+			utils.ErrResponse(w, http.StatusTooManyRequests, err)
+			return
+		}
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	loginResponse(w, res)
+}
+
 // logout godoc
 //
 //	@Summary		Logout user
@@ -230,17 +325,22 @@ func (h *Handler) logout(w http.ResponseWriter, r *http.Request) {
 
 // sendLoginCode godoc
 //
-//	@Summary		Send login code via email
-//	@Description	Verify reCAPTCHA, then send a one-time code to the user’s email. May return tokens if password also valid.
+//	@Summary		Send login code via email, SMS, voice call or ask trusted device
+//	@Description	Verify reCAPTCHA, then send a one-time code to the user’s email or verified phone, or ask signed-in device to approve the login. May return tokens if password also valid.
 //	@Tags			EmailAuth
 //	@Accept			json
 //	@Produce		json
 //	@Param			X-Real-IP	header		string					true	"Client real IP address"
 //	@Param			User-Agent	header		string					true	"Client User-Agent"
-//	@Param			body		body		dto.LoginCodeRequest	true	"email, password, reCAPTCHA token"
+//	@Param			body		body		dto.LoginCodeRequest	true	"email, password, reCAPTCHA token, delivery channel"
 //	@Success		200			{object}	dto.TokenPair
-//	@Failure		400			{object}	utils.ErrorsResponse	"missing device info or bad payload"
+//	@Success		202			{object}	dto.MFAChallenge		"second factor required"
+//	@Success		202			{object}	dto.LoginApproval		"waiting for approval on trusted device"
+//	@Failure		400			{object}	utils.ErrorsResponse	"missing device info, bad payload, no verified phone or trusted device"
 //	@Failure		401			{object}	utils.ErrorsResponse	"invalid credentials or reCAPTCHA"
+//	@Failure		403			{object}	utils.ErrorsResponse	"password login disabled, phone country not allowed or login is too risky"
+//	@Failure		423			{object}	utils.ErrorsResponse	"account or IP locked after failed attempts"
+//	@Failure		429			{object}	utils.ErrorsResponse	"too many codes sent, next attempt is delayed or rate limit exceeded"
 //	@Failure		500			{object}	utils.ErrorsResponse	"internal error"
 //	@Router			/auth/email/send [post]
 func (h *Handler) sendLoginCode(w http.ResponseWriter, r *http.Request) {
@@ -266,19 +366,34 @@ func (h *Handler) sendLoginCode(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	res, err := h.ctrl.SendLoginCode(r.Context(), &d, req.Email, req.Password)
+	ctx := context.WithValue(r.Context(), "captcha", true)
+	res, err := h.ctrl.SendLoginCode(ctx, &d, req.Email, req.Password, req.Channel)
 	if err != nil {
 		if errors.Is(err, auth.ErrInvalidCredentials) {
 			utils.ErrResponse(w, http.StatusNotFound, err)
 			return
+		} else if errors.Is(err, ctrl.ErrPasswordLoginDisabled) ||
+			errors.Is(err, ctrl.ErrCountryNotAllowed) ||
+			errors.Is(err, ctrl.ErrSMSDisabled) ||
+			errors.Is(err, ctrl.ErrRiskBlocked) {
+			utils.ErrResponse(w, http.StatusForbidden, err)
+			return
+		} else if errors.Is(err, ctrl.ErrPhoneNotVerified) || errors.Is(err, ctrl.ErrNoTrustedDevice) {
+			utils.ErrResponse(w, http.StatusBadRequest, err)
+			return
+		} else if errors.Is(err, ctrl.ErrTooManyRequests) {
+			utils.ErrResponse(w, http.StatusTooManyRequests, err)
+			return
+		} else if errors.Is(err, ctrl.ErrAccountLocked) {
+			utils.ErrResponse(w, http.StatusLocked, err)
+			return
 		} else {
 			utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
 			return
 		}
 	}
 
-	utils.SetAuthCookies(w, res.Access, res.Refresh)
-	utils.StatusResponse(w, http.StatusOK)
+	loginResponse(w, &res)
 }
 
 // checkLoginCode godoc
@@ -292,8 +407,13 @@ func (h *Handler) sendLoginCode(w http.ResponseWriter, r *http.Request) {
 //	@Param			User-Agent	header		string						true	"Client User-Agent"
 //	@Param			body		body		dto.CheckLoginCodeRequest	true	"code, reCAPTCHA token"
 //	@Success		200			{object}	dto.TokenPair
+//	@Success		202			{object}	dto.MFAChallenge		"second factor required"
 //	@Failure		400			{object}	utils.ErrorsResponse	"missing device info or bad payload"
+//	@Failure		401			{object}	utils.ErrorsResponse	"invalid code"
+//	@Failure		403			{object}	utils.ErrorsResponse	"login is too risky"
 //	@Failure		404			{object}	utils.ErrorsResponse	"code not found"
+//	@Failure		423			{object}	utils.ErrorsResponse	"account or IP locked after failed attempts, code is invalidated"
+//	@Failure		429			{object}	utils.ErrorsResponse	"next attempt is delayed or rate limit exceeded"
 //	@Failure		500			{object}	utils.ErrorsResponse	"internal error"
 //	@Router			/auth/email/check [post]
 func (h *Handler) checkLoginCode(w http.ResponseWriter, r *http.Request) {
@@ -313,26 +433,170 @@ func (h *Handler) checkLoginCode(w http.ResponseWriter, r *http.Request) {
 		if errors.Is(err, ctrl.ErrNotFound) {
 			utils.ErrResponse(w, http.StatusNotFound, err)
 			return
+		} else if errors.Is(err, ctrl.ErrCodeIsNotValid) || errors.Is(err, ctrl.ErrCodeReused) {
+			utils.ErrResponse(w, http.StatusUnauthorized, err)
+			return
+		} else if errors.Is(err, ctrl.ErrRiskBlocked) {
+			utils.ErrResponse(w, http.StatusForbidden, err)
+			return
+		} else if errors.Is(err, ctrl.ErrAccountLocked) {
+			utils.ErrResponse(w, http.StatusLocked, err)
+			return
+		} else if errors.Is(err, ctrl.ErrTooManyRequests) {
+			utils.ErrResponse(w, http.StatusTooManyRequests, err)
+			return
 		} else {
 			utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
 			return
 		}
 	}
 
-	utils.SetAuthCookies(w, res.Access, res.Refresh)
-	utils.StatusResponse(w, http.StatusOK)
+	loginResponse(w, res)
+}
+
+// sendMagicLink godoc
+//
+//	@Summary		Send magic login link via email
+//	@Description	Verify reCAPTCHA, then email single-use login link. Link works only in the browser that got the nonce cookie
+//	@Tags			EmailAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		dto.MagicLinkRequest	true	"email, reCAPTCHA token"
+//	@Success		202		{object}	nil						"Accepted"
+//	@Failure		401		{object}	utils.ErrorsResponse	"invalid reCAPTCHA"
+//	@Failure		403		{object}	utils.ErrorsResponse	"domain is forced to sign in through SSO"
+//	@Failure		404		{object}	utils.ErrorsResponse	"user not found and self-registration disabled"
+//	@Failure		429		{object}	utils.ErrorsResponse	"rate limit exceeded"
+//	@Failure		500		{object}	utils.ErrorsResponse	"internal error"
+//	@Router			/auth/magic-link/send [post]
+func (h *Handler) sendMagicLink(w http.ResponseWriter, r *http.Request) {
+	req := &dto.MagicLinkRequest{}
+	if ok := utils.ParseAndValidate(w, r, req); !ok {
+		return
+	}
+
+	valid, err := h.au.VerifyRecaptcha(req.Token, captcha.MagicLink)
+	if err != nil {
+		utils.ErrResponse(w, http.StatusInternalServerError, captcha.ErrVerificationFailed)
+		return
+	}
+
+	if !valid {
+		utils.ErrResponse(w, http.StatusUnauthorized, captcha.ErrValidationFailed)
+		return
+	}
+
+	nonce, err := h.ctrl.SendMagicLink(r.Context(), req.Email)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrNotFound) {
+			utils.ErrResponse(w, http.StatusNotFound, err)
+			return
+		} else if errors.Is(err, ctrl.ErrPasswordLoginDisabled) {
+			utils.ErrResponse(w, http.StatusForbidden, err)
+			return
+		} else {
+			utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+			return
+		}
+	}
+
+	// Lax, because the link is opened from mail client, not from our own pages.
+	http.SetCookie(
+		w, &http.Cookie{
+			Name:     config.MagicLinkCookieName,
+			Value:    nonce,
+			MaxAge:   int(config.MagicLinkTime.Seconds()),
+			HttpOnly: true,
+			Secure:   true,
+			Path:     "/",
+			SameSite: http.SameSiteLaxMode,
+		},
+	)
+
+	utils.StatusResponse(w, http.StatusAccepted)
+}
+
+// verifyMagicLink godoc
+//
+//	@Summary		Verify magic login link
+//	@Description	Exchange token from the emailed link for JWT tokens. Requires nonce cookie set when the link was requested
+//	@Tags			EmailAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			X-Real-IP	header		string							true	"Client real IP address"
+//	@Param			User-Agent	header		string							true	"Client User-Agent"
+//	@Param			body		body		dto.VerifyMagicLinkRequest		true	"token from the link"
+//	@Success		200			{object}	dto.TokenPair
+//	@Success		202			{object}	dto.MFAChallenge				"second factor required"
+//	@Failure		400			{object}	utils.ErrorsResponse			"missing device info or bad payload"
+//	@Failure		401			{object}	utils.ErrorsResponse			"link has already been used"
+//	@Failure		403			{object}	utils.ErrorsResponse			"link was requested from another browser or login is too risky"
+//	@Failure		404			{object}	utils.ErrorsResponse			"link not found or expired"
+//	@Failure		500			{object}	utils.ErrorsResponse			"internal error"
+//	@Router			/auth/magic-link/verify [post]
+func (h *Handler) verifyMagicLink(w http.ResponseWriter, r *http.Request) {
+	d, ok := utils.ParseDeviceByRequest(r)
+	if !ok {
+		utils.ErrResponse(w, http.StatusBadRequest, hdl.ErrNoDeviceInfo)
+		return
+	}
+
+	req := &dto.VerifyMagicLinkRequest{}
+	if ok = utils.ParseAndValidate(w, r, req); !ok {
+		return
+	}
+
+	cookie, err := r.Cookie(config.MagicLinkCookieName)
+	if err != nil {
+		utils.ErrResponse(w, http.StatusForbidden, ctrl.ErrBrowserMismatch)
+		return
+	}
+
+	res, err := h.ctrl.VerifyMagicLink(r.Context(), &d, req.Token, cookie.Value)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrNotFound) {
+			utils.ErrResponse(w, http.StatusNotFound, err)
+			return
+		} else if errors.Is(err, ctrl.ErrBrowserMismatch) || errors.Is(err, ctrl.ErrRiskBlocked) {
+			utils.ErrResponse(w, http.StatusForbidden, err)
+			return
+		} else if errors.Is(err, ctrl.ErrCodeReused) {
+			utils.ErrResponse(w, http.StatusUnauthorized, err)
+			return
+		} else {
+			utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+			return
+		}
+	}
+
+	http.SetCookie(
+		w, &http.Cookie{
+			Name:     config.MagicLinkCookieName,
+			Value:    "",
+			MaxAge:   -1,
+			HttpOnly: true,
+			Secure:   true,
+			Path:     "/",
+			SameSite: http.SameSiteLaxMode,
+		},
+	)
+
+	loginResponse(w, res)
 }
 
 // sendForgotPasswordEmail godoc
 //
 //	@Summary		Send forgot‐password email
-//	@Description	Verify reCAPTCHA and send recovery email
+//	@Description	Verify reCAPTCHA and send single-use password reset link by email or SMS, previous links stop working
 //	@Tags			PasswordRecovery
 //	@Accept			json
 //	@Produce		json
-//	@Param			body	body		dto.SendForgotPasswordEmail	true	"email, reCAPTCHA token"
+//	@Param			body	body		dto.SendForgotPasswordEmail	true	"email, reCAPTCHA token, delivery channel"
 //	@Success		200		{object}	nil							"OK"
+//	@Failure		400		{object}	utils.ErrorsResponse		"no verified phone"
+//	@Failure		403		{object}	utils.ErrorsResponse		"phone country not allowed"
 //	@Failure		404		{object}	utils.ErrorsResponse		"email not found"
+//	@Failure		429		{object}	utils.ErrorsResponse		"too many messages sent to phone or rate limit exceeded"
 //	@Failure		500		{object}	utils.ErrorsResponse		"internal error"
 //	@Router			/auth/recovery/send [post]
 func (h *Handler) sendForgotPasswordEmail(w http.ResponseWriter, r *http.Request) {
@@ -352,11 +616,20 @@ func (h *Handler) sendForgotPasswordEmail(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	err = h.ctrl.SendForgotPasswordEmail(r.Context(), req.Email)
+	err = h.ctrl.SendForgotPasswordEmail(r.Context(), req.Email, req.Channel)
 	if err != nil {
 		if errors.Is(err, ctrl.ErrNotFound) {
 			utils.ErrResponse(w, http.StatusNotFound, err)
 			return
+		} else if errors.Is(err, ctrl.ErrPhoneNotVerified) {
+			utils.ErrResponse(w, http.StatusBadRequest, err)
+			return
+		} else if errors.Is(err, ctrl.ErrCountryNotAllowed) || errors.Is(err, ctrl.ErrSMSDisabled) {
+			utils.ErrResponse(w, http.StatusForbidden, err)
+			return
+		} else if errors.Is(err, ctrl.ErrTooManyRequests) {
+			utils.ErrResponse(w, http.StatusTooManyRequests, err)
+			return
 		} else {
 			utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
 			return
@@ -368,36 +641,151 @@ func (h *Handler) sendForgotPasswordEmail(w http.ResponseWriter, r *http.Request
 
 // checkForgotPasswordEmail godoc
 //
-//	@Summary		Check forgot‐password code
-//	@Description	Validate a recovery code
+//	@Summary		Reset password
+//	@Description	Set new password with token from reset link. All sessions are revoked and confirmation email is sent
 //	@Tags			PasswordRecovery
 //	@Accept			json
 //	@Produce		json
-//	@Param			body	body		dto.CheckForgotPasswordEmailRequest	true	"code"
-//	@Success		200		{object}	nil									"OK"
-//	@Failure		401		{object}	utils.ErrorsResponse				"invalid code"
-//	@Failure		404		{object}	utils.ErrorsResponse				"code not found"
-//	@Failure		500		{object}	utils.ErrorsResponse				"internal error"
+//	@Param			X-Real-IP	header		string								true	"Client real IP address"
+//	@Param			User-Agent	header		string								true	"Client User-Agent"
+//	@Param			body		body		dto.CheckForgotPasswordEmailRequest	true	"reset token and new password"
+//	@Success		200			{object}	nil									"OK"
+//	@Failure		400			{object}	utils.ErrorsResponse				"missing device info or password policy violation"
+//	@Failure		401			{object}	utils.ErrorsResponse				"password changed since token was issued or token already used"
+//	@Failure		404			{object}	utils.ErrorsResponse				"token not found, expired or superseded by newer one"
+//	@Failure		423			{object}	utils.ErrorsResponse				"account or IP locked after failed attempts"
+//	@Failure		429			{object}	utils.ErrorsResponse				"next attempt is delayed or rate limit exceeded"
+//	@Failure		500			{object}	utils.ErrorsResponse				"internal error"
 //	@Router			/auth/recovery/check [post]
 func (h *Handler) checkForgotPasswordEmail(w http.ResponseWriter, r *http.Request) {
+	d, ok := utils.ParseDeviceByRequest(r)
+	if !ok {
+		utils.ErrResponse(w, http.StatusBadRequest, hdl.ErrNoDeviceInfo)
+		return
+	}
+
 	req := &dto.CheckForgotPasswordEmailRequest{}
-	if ok := utils.ParseAndValidate(w, r, req); !ok {
+	if ok = utils.ParseAndValidate(w, r, req); !ok {
 		return
 	}
 
-	err := h.ctrl.CheckForgotPasswordEmail(r.Context(), req)
+	err := h.ctrl.CheckForgotPasswordEmail(r.Context(), &d, req)
 	if err != nil {
 		if errors.Is(err, ctrl.ErrNotFound) {
 			utils.ErrResponse(w, http.StatusNotFound, err)
 			return
 		}
-		if errors.Is(err, ctrl.ErrCodeIsNotValid) {
+		if errors.Is(err, password.ErrPolicy) {
+			utils.ErrResponse(w, http.StatusBadRequest, err)
+			return
+		}
+		if errors.Is(err, ctrl.ErrCodeIsNotValid) || errors.Is(err, ctrl.ErrCodeReused) {
 			utils.ErrResponse(w, http.StatusUnauthorized, hdl.ErrInternal)
 			return
 		}
+		if errors.Is(err, ctrl.ErrAccountLocked) {
+			utils.ErrResponse(w, http.StatusLocked, err)
+			return
+		}
+		if errors.Is(err, ctrl.ErrTooManyRequests) {
+			utils.ErrResponse(w, http.StatusTooManyRequests, err)
+			return
+		}
 		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
 		return
 	}
 
 	utils.StatusResponse(w, http.StatusOK)
 }
+
+// changePassword godoc
+//
+//	@Summary		Change password
+//	@Description	Replace password of signed-in user. Also accepts session restricted to password change,
+//	@Description	which is given when password was set by admin or has expired. Other sessions are revoked and new cookies are set
+//	@Tags			Authentication
+//	@Accept			json
+//	@Produce		json
+//	@Param			X-Real-IP	header		string						true	"Client real IP address"
+//	@Param			User-Agent	header		string						true	"Client User-Agent"
+//	@Param			body		body		dto.ChangePasswordRequest	true	"current and new password"
+//	@Success		200			{object}	nil							"OK"
+//	@Failure		400			{object}	utils.ErrorsResponse		"missing device info or password policy violation"
+//	@Failure		401			{object}	utils.ErrorsResponse		"current password is invalid"
+//	@Failure		404			{object}	utils.ErrorsResponse		"user not found"
+//	@Failure		423			{object}	utils.ErrorsResponse		"account or IP locked after failed attempts"
+//	@Failure		429			{object}	utils.ErrorsResponse		"next attempt is delayed"
+//	@Failure		500			{object}	utils.ErrorsResponse		"internal error"
+//	@Router			/auth/password/change [post]
+func (h *Handler) changePassword(w http.ResponseWriter, r *http.Request) {
+	uid, ok := r.Context().Value("uid").(uuid.UUID)
+	if !ok {
+		zap.L().Error(
+			hdl.ErrFailedToGetUUID.Error(),
+			zap.Any("uid", r.Context().Value("uid")),
+		)
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	d, ok := utils.ParseDeviceByRequest(r)
+	if !ok {
+		utils.ErrResponse(w, http.StatusBadRequest, hdl.ErrNoDeviceInfo)
+		return
+	}
+
+	req := &dto.ChangePasswordRequest{}
+	if ok = utils.ParseAndValidate(w, r, req); !ok {
+		return
+	}
+
+	res, err := h.ctrl.ChangePassword(r.Context(), &d, uid, req)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrNotFound) {
+			utils.ErrResponse(w, http.StatusNotFound, err)
+			return
+		}
+		if errors.Is(err, auth.ErrInvalidCredentials) {
+			utils.ErrResponse(w, http.StatusUnauthorized, err)
+			return
+		}
+		if errors.Is(err, password.ErrPolicy) {
+			utils.ErrResponse(w, http.StatusBadRequest, err)
+			return
+		}
+		if errors.Is(err, ctrl.ErrAccountLocked) {
+			utils.ErrResponse(w, http.StatusLocked, err)
+			return
+		}
+		if errors.Is(err, ctrl.ErrTooManyRequests) {
+			utils.ErrResponse(w, http.StatusTooManyRequests, err)
+			return
+		}
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	loginResponse(w, res)
+}
+
+// loginResponse sets auth cookies, or returns MFA challenge when login
+// has to be completed with second factor. Cookies restricted to password change
+// are set along with body telling client to ask for new password.
+func loginResponse(w http.ResponseWriter, res *dto.TokenPair) {
+	if res.Challenge != nil {
+		utils.SuccessResponse(w, http.StatusAccepted, res.Challenge)
+		return
+	}
+
+	if res.Approval != nil {
+		utils.SuccessResponse(w, http.StatusAccepted, res.Approval)
+		return
+	}
+
+	utils.SetAuthCookies(w, res.Access, res.Refresh)
+	if res.PasswordChange {
+		utils.SuccessResponse(w, http.StatusOK, dto.PasswordChangeResponse{PasswordChange: true})
+		return
+	}
+	utils.StatusResponse(w, http.StatusOK)
+}
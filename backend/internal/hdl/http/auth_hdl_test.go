@@ -26,7 +26,7 @@ func TestHandler_Authenticate(t *testing.T) {
 	testErr := errors.New("test-err")
 	mctrl := mocks.NewMockAppCtrl(mock)
 	auth := mocks.NewMockCore(mock)
-	h := New(mctrl, auth)
+	h := New(mctrl, auth, nil, nil)
 
 	tests := []struct {
 		name       string
@@ -3,6 +3,7 @@ package http
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/JMURv/sso/internal/ctrl"
 	mid "github.com/JMURv/sso/internal/hdl/http/middleware"
 	"github.com/JMURv/sso/internal/hdl/http/utils"
+	"github.com/JMURv/sso/internal/ratelimit"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 	httpSwagger "github.com/swaggo/http-swagger"
@@ -18,18 +20,28 @@ import (
 )
 
 type Handler struct {
-	router *chi.Mux
-	srv    *http.Server
-	ctrl   ctrl.AppCtrl
-	au     auth.Core
+	router  *chi.Mux
+	srv     *http.Server
+	ctrl    ctrl.AppCtrl
+	au      auth.Core
+	rl      *ratelimit.Limiter
+	proxies []*net.IPNet
 }
 
-func New(ctrl ctrl.AppCtrl, au auth.Core) *Handler {
+// New creates handler, forwarding headers are honoured only for requests from proxies.
+func New(ctrl ctrl.AppCtrl, au auth.Core, rl *ratelimit.Limiter, proxies []string) *Handler {
+	trusted, err := mid.ParseProxies(proxies)
+	if err != nil {
+		zap.L().Fatal("Failed to parse trusted proxies", zap.Error(err))
+	}
+
 	r := chi.NewRouter()
 	return &Handler{
-		router: r,
-		ctrl:   ctrl,
-		au:     au,
+		router:  r,
+		ctrl:    ctrl,
+		au:      au,
+		rl:      rl,
+		proxies: trusted,
 	}
 }
 
@@ -38,7 +50,7 @@ func (h *Handler) Start(port int) {
 		mid.Logger(zap.L()),
 		middleware.StripSlashes,
 		middleware.RequestID,
-		middleware.RealIP,
+		mid.RealIP(h.proxies),
 		middleware.Recoverer,
 		mid.Prometheus,
 		mid.OT,
@@ -47,7 +59,18 @@ func (h *Handler) Start(port int) {
 	h.RegisterAuthRoutes()
 	h.RegisterOAuth2Routes()
 	h.RegisterOIDCRoutes()
+	h.RegisterMockIDPRoutes()
+	h.RegisterSAMLRoutes()
+	h.RegisterRealmRoutes()
+	h.RegisterTOTPRoutes()
+	h.RegisterRecoveryRoutes()
 	h.RegisterWebAuthnRoutes()
+	h.RegisterPasskeyRoutes()
+	h.RegisterPhoneRoutes()
+	h.RegisterApprovalRoutes()
+	h.RegisterQRLoginRoutes()
+	h.RegisterLockoutRoutes()
+	h.RegisterRiskRoutes()
 
 	h.RegisterUserRoutes()
 	h.RegisterPermRoutes()
@@ -80,6 +103,11 @@ func (h *Handler) Start(port int) {
 	}
 }
 
+// ServeHTTP serves registered routes without starting the server, e.g. in tests.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.router.ServeHTTP(w, r)
+}
+
 func (h *Handler) Close(ctx context.Context) error {
 	return h.srv.Shutdown(ctx)
 }
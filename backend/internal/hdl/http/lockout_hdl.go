@@ -0,0 +1,122 @@
+package http
+
+import (
+	"errors"
+	"net"
+	"net/http"
+
+	"github.com/JMURv/sso/internal/ctrl"
+	_ "github.com/JMURv/sso/internal/dto"
+	"github.com/JMURv/sso/internal/hdl"
+	mid "github.com/JMURv/sso/internal/hdl/http/middleware"
+	"github.com/JMURv/sso/internal/hdl/http/utils"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+func (h *Handler) RegisterLockoutRoutes() {
+	h.router.With(mid.Auth(h.au), mid.Admin).Get("/users/{id}/lockout", h.getLockout)
+	h.router.With(mid.Auth(h.au), mid.Admin).Delete("/users/{id}/lockout", h.unlockUser)
+	h.router.With(mid.Auth(h.au), mid.Admin).Delete("/lockouts/ip/{ip}", h.unlockIP)
+}
+
+// getLockout godoc
+//
+//	@Summary		Get user lockout
+//	@Description	Failed attempts and active locks of the user for every login method
+//	@Tags			Lockout
+//	@Param			id	path	string	true	"User UUID"
+//	@Produce		json
+//	@Param			Authorization	header		string	true	"Authorization token"
+//	@Success		200				{object}	dto.LockoutStatus
+//	@Failure		403				{object}	utils.ErrorsResponse	"not authorized"
+//	@Failure		404				{object}	utils.ErrorsResponse	"user not found"
+//	@Failure		500				{object}	utils.ErrorsResponse	"internal error"
+//	@Router			/users/{id}/lockout [get]
+func (h *Handler) getLockout(w http.ResponseWriter, r *http.Request) {
+	uid, err := uuid.Parse(chi.URLParam(r, "id"))
+	if uid == uuid.Nil || err != nil {
+		zap.L().Error(
+			hdl.ErrFailedToParseUUID.Error(),
+			zap.String("path", r.URL.Path),
+			zap.Error(err),
+		)
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrFailedToParseUUID)
+		return
+	}
+
+	res, err := h.ctrl.GetLockout(r.Context(), uid)
+	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
+		utils.ErrResponse(w, http.StatusNotFound, err)
+		return
+	} else if err != nil {
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, http.StatusOK, res)
+}
+
+// unlockUser godoc
+//
+//	@Summary		Unlock user
+//	@Description	Clear failed attempts and locks of the user for every login method
+//	@Tags			Lockout
+//	@Param			id				path		string	true	"User UUID"
+//	@Param			Authorization	header		string	true	"Authorization token"
+//	@Success		204				{object}	nil		"OK"
+//	@Failure		403				{object}	utils.ErrorsResponse	"not authorized"
+//	@Failure		404				{object}	utils.ErrorsResponse	"user not found"
+//	@Failure		500				{object}	utils.ErrorsResponse	"internal error"
+//	@Router			/users/{id}/lockout [delete]
+func (h *Handler) unlockUser(w http.ResponseWriter, r *http.Request) {
+	uid, err := uuid.Parse(chi.URLParam(r, "id"))
+	if uid == uuid.Nil || err != nil {
+		zap.L().Error(
+			hdl.ErrFailedToParseUUID.Error(),
+			zap.String("path", r.URL.Path),
+			zap.Error(err),
+		)
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrFailedToParseUUID)
+		return
+	}
+
+	err = h.ctrl.UnlockUser(r.Context(), uid)
+	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
+		utils.ErrResponse(w, http.StatusNotFound, err)
+		return
+	} else if err != nil {
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	utils.StatusResponse(w, http.StatusNoContent)
+}
+
+// unlockIP godoc
+//
+//	@Summary		Unlock IP address
+//	@Description	Clear failed attempts and lock of the IP address
+//	@Tags			Lockout
+//	@Param			ip				path		string	true	"IP address"
+//	@Param			Authorization	header		string	true	"Authorization token"
+//	@Success		204				{object}	nil		"OK"
+//	@Failure		400				{object}	utils.ErrorsResponse	"invalid IP address"
+//	@Failure		403				{object}	utils.ErrorsResponse	"not authorized"
+//	@Failure		500				{object}	utils.ErrorsResponse	"internal error"
+//	@Router			/lockouts/ip/{ip} [delete]
+func (h *Handler) unlockIP(w http.ResponseWriter, r *http.Request) {
+	ip := chi.URLParam(r, "ip")
+	if net.ParseIP(ip) == nil {
+		utils.ErrResponse(w, http.StatusBadRequest, ErrInvalidURL)
+		return
+	}
+
+	if err := h.ctrl.UnlockIP(r.Context(), ip); err != nil {
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	utils.StatusResponse(w, http.StatusNoContent)
+}
@@ -4,8 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
-	"strings"
 	"time"
 
 	"github.com/JMURv/sso/internal/auth"
@@ -22,6 +22,16 @@ import (
 )
 
 func Auth(au auth.Core) func(http.Handler) http.Handler {
+	return authenticate(au, false)
+}
+
+// AuthRestricted also lets in tokens restricted to password change, see jwt.Claims.PasswordChange.
+// It guards only endpoints user needs in such state: changing password and signing out.
+func AuthRestricted(au auth.Core) func(http.Handler) http.Handler {
+	return authenticate(au, true)
+}
+
+func authenticate(au auth.Core, restricted bool) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(
 			func(w http.ResponseWriter, r *http.Request) {
@@ -43,14 +53,37 @@ func Auth(au auth.Core) func(http.Handler) http.Handler {
 					return
 				}
 
+				if claims.PasswordChange && !restricted {
+					utils.ErrResponse(w, http.StatusForbidden, hdl.ErrPasswordChangeRequired)
+					return
+				}
+
 				ctx := context.WithValue(r.Context(), "uid", claims.UID)
 				ctx = context.WithValue(ctx, "roles", claims.Roles)
+				ctx = context.WithValue(ctx, "auth_time", claims.Info().Time)
 				next.ServeHTTP(w, r.WithContext(ctx))
 			},
 		)
 	}
 }
 
+// Reauth allows request only when user has logged in or passed /auth/reauth
+// within configured reauth time. Must be used after Auth.
+func Reauth(au auth.Core) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				authTime, ok := r.Context().Value("auth_time").(time.Time)
+				if !ok || time.Since(authTime) > au.ReauthTime() {
+					utils.ErrResponse(w, http.StatusForbidden, hdl.ErrReauthRequired)
+					return
+				}
+				next.ServeHTTP(w, r)
+			},
+		)
+	}
+}
+
 var ErrNotAuthorized = errors.New("not authorized")
 
 func CheckRights(c ctrl.AppCtrl) func(http.Handler) http.Handler {
@@ -109,23 +142,43 @@ func CheckRights(c ctrl.AppCtrl) func(http.Handler) http.Handler {
 	}
 }
 
+func Admin(next http.Handler) http.Handler {
+	return http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			roles, ok := r.Context().Value("roles").([]md.Role)
+			if !ok {
+				zap.L().Error(
+					hdl.ErrFailedToParseRoles.Error(),
+					zap.Any("uid", r.Context().Value("uid")),
+				)
+				utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrFailedToParseRoles)
+				return
+			}
+
+			for i := 0; i < len(roles); i++ {
+				if roles[i].Name == "admin" {
+					next.ServeHTTP(w, r)
+					return
+				}
+			}
+
+			utils.ErrResponse(w, http.StatusForbidden, ErrNotAuthorized)
+		},
+	)
+}
+
 var (
 	ErrIPIsIncorrect = errors.New("ip is incorrect")
 	ErrUAIsIncorrect = errors.New("user agent is incorrect")
 )
 
+// Device stores client address and user agent. Address is the one rate limits use, it is taken from
+// the connection or from forwarding headers of trusted proxy, see RealIP.
 func Device(next http.Handler) http.Handler {
 	return http.HandlerFunc(
 		func(w http.ResponseWriter, r *http.Request) {
-			ip := r.RemoteAddr
-			if ip == "" {
-				utils.ErrResponse(w, http.StatusForbidden, ErrIPIsIncorrect)
-				return
-			}
-
-			ip = strings.Split(ip, ":")[0]
-			splitIP := strings.Split(ip, ".")
-			if len(splitIP) != 4 {
+			ip := clientIP(r)
+			if net.ParseIP(ip) == nil {
 				utils.ErrResponse(w, http.StatusForbidden, ErrIPIsIncorrect)
 				return
 			}
@@ -153,6 +206,11 @@ func NewLoggingResponseWriter(w http.ResponseWriter) *LoggingResponseWriter {
 	return &LoggingResponseWriter{w, http.StatusOK}
 }
 
+// Unwrap lets http.ResponseController reach underlying writer, e.g. to flush event streams.
+func (lrw *LoggingResponseWriter) Unwrap() http.ResponseWriter {
+	return lrw.ResponseWriter
+}
+
 func (lrw *LoggingResponseWriter) WriteHeader(code int) {
 	lrw.statusCode = code
 	lrw.ResponseWriter.WriteHeader(code)
@@ -0,0 +1,80 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDevice(t *testing.T) {
+	proxies, err := ParseProxies([]string{"10.0.0.0/8"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		remote string
+		xff    string
+		status int
+		ip     string
+	}{
+		{
+			name:   "Spoofed X-Forwarded-For is ignored",
+			remote: "203.0.113.7:5555",
+			xff:    "198.51.100.1",
+			status: http.StatusOK,
+			ip:     "203.0.113.7",
+		},
+		{
+			name:   "Forwarded by trusted proxy",
+			remote: "10.0.0.2:5555",
+			xff:    "198.51.100.1",
+			status: http.StatusOK,
+			ip:     "198.51.100.1",
+		},
+		{
+			name:   "IPv6",
+			remote: "[2001:db8::1]:5555",
+			status: http.StatusOK,
+			ip:     "2001:db8::1",
+		},
+		{
+			name:   "Invalid address",
+			remote: "unknown",
+			status: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				var ip any
+				h := RealIP(proxies)(
+					Device(
+						http.HandlerFunc(
+							func(w http.ResponseWriter, r *http.Request) {
+								ip = r.Context().Value("ip")
+							},
+						),
+					),
+				)
+
+				req := httptest.NewRequest(http.MethodPost, "/api/auth/jwt", nil)
+				req.RemoteAddr = tt.remote
+				req.Header.Set("User-Agent", "test-agent")
+				if tt.xff != "" {
+					req.Header.Set("X-Forwarded-For", tt.xff)
+				}
+
+				w := httptest.NewRecorder()
+				h.ServeHTTP(w, req)
+				assert.Equal(t, tt.status, w.Code)
+				if tt.ip != "" {
+					assert.Equal(t, tt.ip, ip)
+				}
+			},
+		)
+	}
+}
@@ -0,0 +1,95 @@
+package middleware
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// ParseProxies parses trusted proxy networks given as CIDR or single address.
+func ParseProxies(raw []string) ([]*net.IPNet, error) {
+	proxies := make([]*net.IPNet, 0, len(raw))
+	for _, val := range raw {
+		val = strings.TrimSpace(val)
+		if val == "" {
+			continue
+		}
+
+		if !strings.Contains(val, "/") {
+			ip := net.ParseIP(val)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy: %s", val)
+			}
+
+			bits := 8 * net.IPv4len
+			if ip.To4() == nil {
+				bits = 8 * net.IPv6len
+			}
+			proxies = append(proxies, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+
+		_, network, err := net.ParseCIDR(val)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy: %s: %w", val, err)
+		}
+		proxies = append(proxies, network)
+	}
+	return proxies, nil
+}
+
+// RealIP replaces RemoteAddr with client address from X-Forwarded-For or X-Real-IP,
+// but only when connection comes from one of trusted proxies. Headers of other
+// requests are set by the caller, who could pick a fresh address on every request.
+func RealIP(proxies []*net.IPNet) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				if trusted(proxies, clientIP(r)) {
+					if ip := forwardedIP(r, proxies); ip != "" {
+						r.RemoteAddr = ip
+					}
+				}
+				next.ServeHTTP(w, r)
+			},
+		)
+	}
+}
+
+// forwardedIP walks X-Forwarded-For from the right, addresses appended by trusted proxies
+// are skipped and the first one left is the client. Entries to the left of it are not trusted.
+func forwardedIP(r *http.Request, proxies []*net.IPNet) string {
+	if xff := r.Header.Values("X-Forwarded-For"); len(xff) > 0 {
+		hops := strings.Split(strings.Join(xff, ","), ",")
+		for i := len(hops) - 1; i >= 0; i-- {
+			ip := net.ParseIP(strings.TrimSpace(hops[i]))
+			if ip == nil {
+				return ""
+			}
+			if !trusted(proxies, ip.String()) {
+				return ip.String()
+			}
+		}
+		return ""
+	}
+
+	if ip := net.ParseIP(strings.TrimSpace(r.Header.Get("X-Real-IP"))); ip != nil {
+		return ip.String()
+	}
+	return ""
+}
+
+func trusted(proxies []*net.IPNet, addr string) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+
+	for _, p := range proxies {
+		if p.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
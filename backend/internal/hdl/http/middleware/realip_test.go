@@ -0,0 +1,103 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/JMURv/sso/internal/ratelimit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseProxies(t *testing.T) {
+	proxies, err := ParseProxies([]string{"10.0.0.0/8", " 192.168.1.1 ", "", "::1"})
+	require.NoError(t, err)
+	require.Len(t, proxies, 3)
+	assert.Equal(t, "10.0.0.0/8", proxies[0].String())
+	assert.Equal(t, "192.168.1.1/32", proxies[1].String())
+	assert.Equal(t, "::1/128", proxies[2].String())
+
+	for _, val := range []string{"10.0.0.0/33", "proxy", "10.0.0"} {
+		_, err = ParseProxies([]string{val})
+		assert.Error(t, err, val)
+	}
+}
+
+func TestRealIP(t *testing.T) {
+	proxies, err := ParseProxies([]string{"10.0.0.0/8"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		remote string
+		header map[string]string
+		key    string
+	}{
+		{
+			name:   "Direct request",
+			remote: "203.0.113.7:5555",
+			key:    "203.0.113.7",
+		},
+		{
+			name:   "Spoofed X-Forwarded-For from untrusted peer",
+			remote: "203.0.113.7:5555",
+			header: map[string]string{"X-Forwarded-For": "198.51.100.1"},
+			key:    "203.0.113.7",
+		},
+		{
+			name:   "Spoofed X-Real-IP from untrusted peer",
+			remote: "203.0.113.7:5555",
+			header: map[string]string{"X-Real-IP": "198.51.100.1"},
+			key:    "203.0.113.7",
+		},
+		{
+			name:   "Trusted proxy",
+			remote: "10.0.0.2:5555",
+			header: map[string]string{"X-Forwarded-For": "203.0.113.7"},
+			key:    "203.0.113.7",
+		},
+		{
+			name:   "Trusted proxy keeps spoofed hops out",
+			remote: "10.0.0.2:5555",
+			header: map[string]string{"X-Forwarded-For": "198.51.100.1, 203.0.113.7, 10.0.0.3"},
+			key:    "203.0.113.7",
+		},
+		{
+			name:   "Trusted proxy with X-Real-IP",
+			remote: "10.0.0.2:5555",
+			header: map[string]string{"X-Real-IP": "203.0.113.7"},
+			key:    "203.0.113.7",
+		},
+		{
+			name:   "Trusted proxy with malformed header",
+			remote: "10.0.0.2:5555",
+			header: map[string]string{"X-Forwarded-For": "not-an-ip"},
+			key:    "10.0.0.2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				var key string
+				h := RealIP(proxies)(
+					http.HandlerFunc(
+						func(w http.ResponseWriter, r *http.Request) {
+							key = rateLimitSubject(r, ratelimit.KeyIP)
+						},
+					),
+				)
+
+				req := httptest.NewRequest(http.MethodPost, "/api/auth/jwt", nil)
+				req.RemoteAddr = tt.remote
+				for k, v := range tt.header {
+					req.Header.Set(k, v)
+				}
+
+				h.ServeHTTP(httptest.NewRecorder(), req)
+				assert.Equal(t, tt.key, key)
+			},
+		)
+	}
+}
@@ -0,0 +1,9 @@
+package http
+
+// RegisterMockIDPRoutes mounts built-in mock IdP when it is enabled in config.
+// Provider `mock` of both OAuth2 and OIDC flows talks to it.
+func (h *Handler) RegisterMockIDPRoutes() {
+	if idp := h.au.MockIDP(); idp != nil {
+		h.router.Handle("/mock-idp/*", idp)
+	}
+}
@@ -58,6 +58,7 @@ func (h *Handler) startOAuth2(w http.ResponseWriter, r *http.Request) {
 //	@Param			User-Agent	header		string					true	"Client User-Agent"
 //	@Success		307			{object}	nil						"Redirect to success URL"
 //	@Failure		400			{object}	utils.ErrorsResponse	"invalid request or missing device info"
+//	@Failure		403			{object}	utils.ErrorsResponse	"login is too risky"
 //	@Failure		404			{object}	utils.ErrorsResponse	"provider not supported or resource not found"
 //	@Failure		500			{object}	utils.ErrorsResponse	"internal error"
 //	@Router			/auth/oauth2/{provider}/callback [get]
@@ -83,6 +84,10 @@ func (h *Handler) handleOAuth2Callback(w http.ResponseWriter, r *http.Request) {
 			utils.ErrResponse(w, http.StatusNotFound, err)
 			return
 		}
+		if errors.Is(err, ctrl.ErrRiskBlocked) {
+			utils.ErrResponse(w, http.StatusForbidden, err)
+			return
+		}
 		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
 		return
 	}
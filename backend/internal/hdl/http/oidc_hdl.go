@@ -1,8 +1,10 @@
 package http
 
 import (
+	"errors"
 	"net/http"
 
+	"github.com/JMURv/sso/internal/ctrl"
 	"github.com/JMURv/sso/internal/hdl"
 	mid "github.com/JMURv/sso/internal/hdl/http/middleware"
 	"github.com/JMURv/sso/internal/hdl/http/utils"
@@ -12,6 +14,7 @@ import (
 func (h *Handler) RegisterOIDCRoutes() {
 	h.router.Get("/auth/oidc/{provider}/start", h.startOIDC)
 	h.router.With(mid.Device).Get("/auth/oidc/{provider}/callback", h.handleOIDCCallback)
+	h.router.With(mid.Device).Post("/auth/oidc/{provider}/callback", h.handleOIDCFormPost)
 }
 
 // startOIDC godoc
@@ -56,7 +59,9 @@ func (h *Handler) startOIDC(w http.ResponseWriter, r *http.Request) {
 //	@Param			User-Agent	header		string					true	"Client User-Agent"
 //	@Success		307			{object}	nil						"Redirect to success URL"
 //	@Failure		400			{object}	utils.ErrorsResponse	"invalid request or missing device info"
+//	@Failure		403			{object}	utils.ErrorsResponse	"login is too risky"
 //	@Failure		404			{object}	utils.ErrorsResponse	"provider not supported or resource not found"
+//	@Failure		409			{object}	utils.ErrorsResponse	"account with this email is not linked to provider"
 //	@Failure		500			{object}	utils.ErrorsResponse	"internal error"
 //	@Router			/auth/oidc/{provider}/callback [get]
 func (h *Handler) handleOIDCCallback(w http.ResponseWriter, r *http.Request) {
@@ -74,8 +79,14 @@ func (h *Handler) handleOIDCCallback(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	res, err := h.ctrl.HandleOIDCCallback(r.Context(), &d, provider, code, state)
-	if err != nil {
+	res, err := h.ctrl.HandleOIDCCallback(r.Context(), &d, provider, code, state, "")
+	if err != nil && errors.Is(err, ctrl.ErrAccountNotLinked) {
+		utils.ErrResponse(w, http.StatusConflict, err)
+		return
+	} else if err != nil && errors.Is(err, ctrl.ErrRiskBlocked) {
+		utils.ErrResponse(w, http.StatusForbidden, err)
+		return
+	} else if err != nil {
 		utils.ErrResponse(w, http.StatusInternalServerError, err)
 		return
 	}
@@ -83,3 +94,58 @@ func (h *Handler) handleOIDCCallback(w http.ResponseWriter, r *http.Request) {
 	utils.SetAuthCookies(w, res.Access, res.Refresh)
 	http.Redirect(w, r, res.SuccessURL, http.StatusTemporaryRedirect)
 }
+
+// handleOIDCFormPost godoc
+//
+//	@Summary		Handle OIDC provider form_post callback
+//	@Description	Same as GET callback, but for providers which post response as form (e.g. Apple). Optional `user` field carries profile sent on first login
+//	@Tags			OIDC
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Param			provider	path		string					true	"OIDC provider identifier"
+//	@Param			code		formData	string					true	"Authorization code returned by provider"
+//	@Param			state		formData	string					false	"State parameter for CSRF mitigation"
+//	@Param			user		formData	string					false	"User profile JSON posted by provider"
+//	@Param			X-Real-IP	header		string					true	"Client real IP address"
+//	@Param			User-Agent	header		string					true	"Client User-Agent"
+//	@Success		303			{object}	nil						"Redirect to success URL"
+//	@Failure		400			{object}	utils.ErrorsResponse	"invalid request or missing device info"
+//	@Failure		403			{object}	utils.ErrorsResponse	"login is too risky"
+//	@Failure		409			{object}	utils.ErrorsResponse	"account with this email is not linked to provider"
+//	@Failure		500			{object}	utils.ErrorsResponse	"internal error"
+//	@Router			/auth/oidc/{provider}/callback [post]
+func (h *Handler) handleOIDCFormPost(w http.ResponseWriter, r *http.Request) {
+	provider := chi.URLParam(r, "provider")
+	if provider == "" {
+		utils.ErrResponse(w, http.StatusBadRequest, ErrInvalidURL)
+		return
+	}
+
+	if err := r.ParseForm(); err != nil {
+		utils.ErrResponse(w, http.StatusBadRequest, hdl.ErrDecodeRequest)
+		return
+	}
+
+	d, ok := utils.ParseDeviceByRequest(r)
+	if !ok {
+		utils.ErrResponse(w, http.StatusBadRequest, hdl.ErrNoDeviceInfo)
+		return
+	}
+
+	res, err := h.ctrl.HandleOIDCCallback(
+		r.Context(), &d, provider, r.PostForm.Get("code"), r.PostForm.Get("state"), r.PostForm.Get("user"),
+	)
+	if err != nil && errors.Is(err, ctrl.ErrAccountNotLinked) {
+		utils.ErrResponse(w, http.StatusConflict, err)
+		return
+	} else if err != nil && errors.Is(err, ctrl.ErrRiskBlocked) {
+		utils.ErrResponse(w, http.StatusForbidden, err)
+		return
+	} else if err != nil {
+		utils.ErrResponse(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	utils.SetAuthCookies(w, res.Access, res.Refresh)
+	http.Redirect(w, r, res.SuccessURL, http.StatusSeeOther)
+}
@@ -0,0 +1,154 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/JMURv/sso/internal/ctrl"
+	"github.com/JMURv/sso/internal/dto"
+	"github.com/JMURv/sso/internal/hdl"
+	mid "github.com/JMURv/sso/internal/hdl/http/middleware"
+	"github.com/JMURv/sso/internal/hdl/http/utils"
+	_ "github.com/JMURv/sso/internal/models"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+func (h *Handler) RegisterPasskeyRoutes() {
+	h.router.With(mid.Auth(h.au)).Get("/users/me/passkeys", h.listPasskeys)
+	h.router.With(mid.Auth(h.au)).Put("/users/me/passkeys/{id}", h.renamePasskey)
+	h.router.With(mid.Auth(h.au), mid.Reauth(h.au)).Delete("/users/me/passkeys/{id}", h.deletePasskey)
+}
+
+// listPasskeys godoc
+//
+//	@Summary		List passkeys of the authenticated user
+//	@Description	Retrieve registered WebAuthn credentials with their names, transports and backup state
+//	@Tags			WebAuthn
+//	@Produce		json
+//	@Param			Authorization	header		string	true	"Authorization token"
+//	@Success		200				{array}		[]models.Passkey
+//	@Failure		500				{object}	utils.ErrorsResponse	"internal error"
+//	@Router			/users/me/passkeys [get]
+func (h *Handler) listPasskeys(w http.ResponseWriter, r *http.Request) {
+	uid, ok := r.Context().Value("uid").(uuid.UUID)
+	if uid == uuid.Nil || !ok {
+		zap.L().Error(
+			hdl.ErrFailedToParseUUID.Error(),
+			zap.Any("uid", r.Context().Value("uid")),
+		)
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrFailedToParseUUID)
+		return
+	}
+
+	res, err := h.ctrl.ListPasskeys(r.Context(), uid)
+	if err != nil {
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, http.StatusOK, res)
+}
+
+// renamePasskey godoc
+//
+//	@Summary		Rename a passkey
+//	@Description	Change display name of a WebAuthn credential owned by the current user
+//	@Tags			WebAuthn
+//	@Param			id	path	string	true	"Base64url credential ID"
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization	header		string						true	"Authorization token"
+//	@Param			body			body		dto.RenamePasskeyRequest	true	"new name"
+//	@Success		200				{object}	nil							"OK"
+//	@Failure		400				{object}	utils.ErrorsResponse		"invalid passkey ID or payload"
+//	@Failure		404				{object}	utils.ErrorsResponse		"passkey not found"
+//	@Failure		500				{object}	utils.ErrorsResponse		"internal error"
+//	@Router			/users/me/passkeys/{id} [put]
+func (h *Handler) renamePasskey(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		zap.L().Debug(
+			hdl.ErrToRetrievePathArg.Error(),
+			zap.String("path", r.URL.Path),
+		)
+		utils.ErrResponse(w, http.StatusBadRequest, hdl.ErrToRetrievePathArg)
+		return
+	}
+
+	uid, ok := r.Context().Value("uid").(uuid.UUID)
+	if uid == uuid.Nil || !ok {
+		zap.L().Error(
+			hdl.ErrFailedToParseUUID.Error(),
+			zap.Any("uid", r.Context().Value("uid")),
+		)
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrFailedToParseUUID)
+		return
+	}
+
+	req := &dto.RenamePasskeyRequest{}
+	if ok = utils.ParseAndValidate(w, r, req); !ok {
+		return
+	}
+
+	err := h.ctrl.RenamePasskey(r.Context(), uid, id, req)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrNotFound) {
+			utils.ErrResponse(w, http.StatusNotFound, err)
+			return
+		}
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	utils.StatusResponse(w, http.StatusOK)
+}
+
+// deletePasskey godoc
+//
+//	@Summary		Delete a passkey
+//	@Description	Remove WebAuthn credential owned by the current user. Requires recent authentication
+//	@Tags			WebAuthn
+//	@Param			id	path	string	true	"Base64url credential ID"
+//	@Produce		json
+//	@Param			Authorization	header		string					true	"Authorization token"
+//	@Success		204				{object}	nil						"No Content"
+//	@Failure		400				{object}	utils.ErrorsResponse	"invalid passkey ID"
+//	@Failure		403				{object}	utils.ErrorsResponse	"recent authentication required"
+//	@Failure		404				{object}	utils.ErrorsResponse	"passkey not found"
+//	@Failure		500				{object}	utils.ErrorsResponse	"internal error"
+//	@Router			/users/me/passkeys/{id} [delete]
+func (h *Handler) deletePasskey(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		zap.L().Debug(
+			hdl.ErrToRetrievePathArg.Error(),
+			zap.String("path", r.URL.Path),
+		)
+		utils.ErrResponse(w, http.StatusBadRequest, hdl.ErrToRetrievePathArg)
+		return
+	}
+
+	uid, ok := r.Context().Value("uid").(uuid.UUID)
+	if uid == uuid.Nil || !ok {
+		zap.L().Error(
+			hdl.ErrFailedToParseUUID.Error(),
+			zap.Any("uid", r.Context().Value("uid")),
+		)
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrFailedToParseUUID)
+		return
+	}
+
+	err := h.ctrl.DeletePasskey(r.Context(), uid, id)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrNotFound) {
+			utils.ErrResponse(w, http.StatusNotFound, err)
+			return
+		}
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	utils.StatusResponse(w, http.StatusNoContent)
+}
@@ -0,0 +1,153 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/JMURv/sso/internal/ctrl"
+	"github.com/JMURv/sso/internal/dto"
+	"github.com/JMURv/sso/internal/hdl"
+	mid "github.com/JMURv/sso/internal/hdl/http/middleware"
+	"github.com/JMURv/sso/internal/hdl/http/utils"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+func (h *Handler) RegisterPhoneRoutes() {
+	h.router.With(mid.Auth(h.au), mid.Reauth(h.au)).Post("/users/me/phone", h.startPhoneVerification)
+	h.router.With(mid.Auth(h.au)).Post("/users/me/phone/verify", h.confirmPhone)
+	h.router.With(mid.Auth(h.au), mid.Reauth(h.au)).Delete("/users/me/phone", h.deletePhone)
+}
+
+// startPhoneVerification godoc
+//
+//	@Summary		Start phone number verification
+//	@Description	Send verification code to the number by SMS or voice call. Requires recent authentication
+//	@Tags			Phone
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization	header		string					true	"Authorization token"
+//	@Param			body			body		dto.PhoneRequest		true	"phone in E.164 format and delivery channel"
+//	@Success		202				{object}	nil						"Accepted"
+//	@Failure		400				{object}	utils.ErrorsResponse	"bad payload"
+//	@Failure		403				{object}	utils.ErrorsResponse	"recent authentication required or country not allowed"
+//	@Failure		429				{object}	utils.ErrorsResponse	"too many codes sent to phone"
+//	@Failure		500				{object}	utils.ErrorsResponse	"internal error"
+//	@Router			/users/me/phone [post]
+func (h *Handler) startPhoneVerification(w http.ResponseWriter, r *http.Request) {
+	uid, ok := r.Context().Value("uid").(uuid.UUID)
+	if uid == uuid.Nil || !ok {
+		zap.L().Error(
+			hdl.ErrFailedToParseUUID.Error(),
+			zap.Any("uid", r.Context().Value("uid")),
+		)
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrFailedToParseUUID)
+		return
+	}
+
+	req := &dto.PhoneRequest{}
+	if ok = utils.ParseAndValidate(w, r, req); !ok {
+		return
+	}
+
+	err := h.ctrl.StartPhoneVerification(r.Context(), uid, req)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrCountryNotAllowed) || errors.Is(err, ctrl.ErrSMSDisabled) {
+			utils.ErrResponse(w, http.StatusForbidden, err)
+			return
+		} else if errors.Is(err, ctrl.ErrTooManyRequests) {
+			utils.ErrResponse(w, http.StatusTooManyRequests, err)
+			return
+		}
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	utils.StatusResponse(w, http.StatusAccepted)
+}
+
+// confirmPhone godoc
+//
+//	@Summary		Confirm phone number
+//	@Description	Check verification code and store the number as verified attribute of the user
+//	@Tags			Phone
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization	header		string						true	"Authorization token"
+//	@Param			body			body		dto.ConfirmPhoneRequest		true	"verification code"
+//	@Success		200				{object}	nil							"OK"
+//	@Failure		400				{object}	utils.ErrorsResponse		"bad payload or invalid code"
+//	@Failure		404				{object}	utils.ErrorsResponse		"verification not started or expired"
+//	@Failure		409				{object}	utils.ErrorsResponse		"phone belongs to another user"
+//	@Failure		500				{object}	utils.ErrorsResponse		"internal error"
+//	@Router			/users/me/phone/verify [post]
+func (h *Handler) confirmPhone(w http.ResponseWriter, r *http.Request) {
+	uid, ok := r.Context().Value("uid").(uuid.UUID)
+	if uid == uuid.Nil || !ok {
+		zap.L().Error(
+			hdl.ErrFailedToParseUUID.Error(),
+			zap.Any("uid", r.Context().Value("uid")),
+		)
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrFailedToParseUUID)
+		return
+	}
+
+	req := &dto.ConfirmPhoneRequest{}
+	if ok = utils.ParseAndValidate(w, r, req); !ok {
+		return
+	}
+
+	err := h.ctrl.ConfirmPhone(r.Context(), uid, req)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrNotFound) {
+			utils.ErrResponse(w, http.StatusNotFound, err)
+			return
+		} else if errors.Is(err, ctrl.ErrCodeIsNotValid) || errors.Is(err, ctrl.ErrCodeReused) {
+			utils.ErrResponse(w, http.StatusBadRequest, err)
+			return
+		} else if errors.Is(err, ctrl.ErrAlreadyExists) {
+			utils.ErrResponse(w, http.StatusConflict, err)
+			return
+		}
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	utils.StatusResponse(w, http.StatusOK)
+}
+
+// deletePhone godoc
+//
+//	@Summary		Delete phone number
+//	@Description	Remove verified phone number of the current user. Requires recent authentication
+//	@Tags			Phone
+//	@Produce		json
+//	@Param			Authorization	header		string					true	"Authorization token"
+//	@Success		204				{object}	nil						"No Content"
+//	@Failure		403				{object}	utils.ErrorsResponse	"recent authentication required"
+//	@Failure		404				{object}	utils.ErrorsResponse	"user has no phone"
+//	@Failure		500				{object}	utils.ErrorsResponse	"internal error"
+//	@Router			/users/me/phone [delete]
+func (h *Handler) deletePhone(w http.ResponseWriter, r *http.Request) {
+	uid, ok := r.Context().Value("uid").(uuid.UUID)
+	if uid == uuid.Nil || !ok {
+		zap.L().Error(
+			hdl.ErrFailedToParseUUID.Error(),
+			zap.Any("uid", r.Context().Value("uid")),
+		)
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrFailedToParseUUID)
+		return
+	}
+
+	err := h.ctrl.DeletePhone(r.Context(), uid)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrNotFound) {
+			utils.ErrResponse(w, http.StatusNotFound, err)
+			return
+		}
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	utils.StatusResponse(w, http.StatusNoContent)
+}
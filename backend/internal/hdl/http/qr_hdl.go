@@ -0,0 +1,189 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/JMURv/sso/internal/ctrl"
+	"github.com/JMURv/sso/internal/dto"
+	"github.com/JMURv/sso/internal/hdl"
+	mid "github.com/JMURv/sso/internal/hdl/http/middleware"
+	"github.com/JMURv/sso/internal/hdl/http/utils"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+func (h *Handler) RegisterQRLoginRoutes() {
+	h.router.With(mid.RateLimit(h.rl, "auth_qr"), mid.Device).Post("/auth/qr", h.startQRLogin)
+	h.router.With(mid.Device).Post("/auth/qr/check", h.checkQRLogin)
+	h.router.With(mid.Auth(h.au)).Get("/auth/qr/{code}", h.getQRLogin)
+	h.router.With(mid.Auth(h.au), mid.Device).Post("/auth/qr/{code}/confirm", h.confirmQRLogin)
+}
+
+// startQRLogin godoc
+//
+//	@Summary		Start QR code login
+//	@Description	Create short-lived login session for this device. QR code is scanned by the phone where user is signed in
+//	@Tags			QRLogin
+//	@Produce		json
+//	@Param			X-Real-IP	header		string	true	"Client real IP address"
+//	@Param			User-Agent	header		string	true	"Client User-Agent"
+//	@Success		201			{object}	dto.QRLoginResponse
+//	@Failure		400			{object}	utils.ErrorsResponse	"missing device info"
+//	@Failure		429			{object}	utils.ErrorsResponse	"rate limit exceeded"
+//	@Failure		500			{object}	utils.ErrorsResponse	"internal error"
+//	@Router			/auth/qr [post]
+func (h *Handler) startQRLogin(w http.ResponseWriter, r *http.Request) {
+	d, ok := utils.ParseDeviceByRequest(r)
+	if !ok {
+		utils.ErrResponse(w, http.StatusBadRequest, hdl.ErrNoDeviceInfo)
+		return
+	}
+
+	res, err := h.ctrl.StartQRLogin(r.Context(), &d)
+	if err != nil {
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, http.StatusCreated, res)
+}
+
+// checkQRLogin godoc
+//
+//	@Summary		Check QR code login
+//	@Description	Polled by the device that shows QR code. Returns tokens once login is confirmed from the phone
+//	@Tags			QRLogin
+//	@Accept			json
+//	@Produce		json
+//	@Param			X-Real-IP	header		string						true	"Client real IP address"
+//	@Param			User-Agent	header		string						true	"Client User-Agent"
+//	@Param			body		body		dto.CheckQRLoginRequest		true	"login session ID"
+//	@Success		200			{object}	dto.TokenPair
+//	@Success		202			{object}	nil							"not confirmed yet"
+//	@Failure		400			{object}	utils.ErrorsResponse		"missing device info or bad payload"
+//	@Failure		401			{object}	utils.ErrorsResponse		"tokens have already been issued"
+//	@Failure		403			{object}	utils.ErrorsResponse		"login is too risky"
+//	@Failure		404			{object}	utils.ErrorsResponse		"session not found or expired"
+//	@Failure		500			{object}	utils.ErrorsResponse		"internal error"
+//	@Router			/auth/qr/check [post]
+func (h *Handler) checkQRLogin(w http.ResponseWriter, r *http.Request) {
+	d, ok := utils.ParseDeviceByRequest(r)
+	if !ok {
+		utils.ErrResponse(w, http.StatusBadRequest, hdl.ErrNoDeviceInfo)
+		return
+	}
+
+	req := &dto.CheckQRLoginRequest{}
+	if ok = utils.ParseAndValidate(w, r, req); !ok {
+		return
+	}
+
+	res, err := h.ctrl.CheckQRLogin(r.Context(), &d, req.ID)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrApprovalPending) {
+			utils.StatusResponse(w, http.StatusAccepted)
+			return
+		} else if errors.Is(err, ctrl.ErrNotFound) {
+			utils.ErrResponse(w, http.StatusNotFound, err)
+			return
+		} else if errors.Is(err, ctrl.ErrCodeReused) {
+			utils.ErrResponse(w, http.StatusUnauthorized, err)
+			return
+		} else if errors.Is(err, ctrl.ErrRiskBlocked) {
+			utils.ErrResponse(w, http.StatusForbidden, err)
+			return
+		}
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	loginResponse(w, res)
+}
+
+// getQRLogin godoc
+//
+//	@Summary		Get QR code login session
+//	@Description	Show on the phone which device is going to be signed in
+//	@Tags			QRLogin
+//	@Param			code	path	string	true	"Code from QR"
+//	@Produce		json
+//	@Param			Authorization	header		string	true	"Authorization token"
+//	@Success		200				{object}	dto.QRLoginSession
+//	@Failure		404				{object}	utils.ErrorsResponse	"session not found or expired"
+//	@Router			/auth/qr/{code} [get]
+func (h *Handler) getQRLogin(w http.ResponseWriter, r *http.Request) {
+	code := chi.URLParam(r, "code")
+	if code == "" {
+		zap.L().Debug(
+			hdl.ErrToRetrievePathArg.Error(),
+			zap.String("path", r.URL.Path),
+		)
+		utils.ErrResponse(w, http.StatusBadRequest, hdl.ErrToRetrievePathArg)
+		return
+	}
+
+	res, err := h.ctrl.GetQRLogin(r.Context(), code)
+	if err != nil {
+		utils.ErrResponse(w, http.StatusNotFound, err)
+		return
+	}
+
+	utils.SuccessResponse(w, http.StatusOK, res)
+}
+
+// confirmQRLogin godoc
+//
+//	@Summary		Confirm QR code login
+//	@Description	Sign in the device that shows QR code as the current user
+//	@Tags			QRLogin
+//	@Param			code	path	string	true	"Code from QR"
+//	@Produce		json
+//	@Param			Authorization	header		string					true	"Authorization token"
+//	@Param			X-Real-IP		header		string					true	"Client real IP address"
+//	@Param			User-Agent		header		string					true	"Client User-Agent"
+//	@Success		200				{object}	nil						"OK"
+//	@Failure		400				{object}	utils.ErrorsResponse	"missing device info"
+//	@Failure		404				{object}	utils.ErrorsResponse	"session not found or expired"
+//	@Failure		500				{object}	utils.ErrorsResponse	"internal error"
+//	@Router			/auth/qr/{code}/confirm [post]
+func (h *Handler) confirmQRLogin(w http.ResponseWriter, r *http.Request) {
+	code := chi.URLParam(r, "code")
+	if code == "" {
+		zap.L().Debug(
+			hdl.ErrToRetrievePathArg.Error(),
+			zap.String("path", r.URL.Path),
+		)
+		utils.ErrResponse(w, http.StatusBadRequest, hdl.ErrToRetrievePathArg)
+		return
+	}
+
+	uid, ok := r.Context().Value("uid").(uuid.UUID)
+	if uid == uuid.Nil || !ok {
+		zap.L().Error(
+			hdl.ErrFailedToParseUUID.Error(),
+			zap.Any("uid", r.Context().Value("uid")),
+		)
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrFailedToParseUUID)
+		return
+	}
+
+	d, ok := utils.ParseDeviceByRequest(r)
+	if !ok {
+		utils.ErrResponse(w, http.StatusBadRequest, hdl.ErrNoDeviceInfo)
+		return
+	}
+
+	err := h.ctrl.ConfirmQRLogin(r.Context(), uid, &d, code)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrNotFound) {
+			utils.ErrResponse(w, http.StatusNotFound, err)
+			return
+		}
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	utils.StatusResponse(w, http.StatusOK)
+}
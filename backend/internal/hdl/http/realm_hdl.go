@@ -0,0 +1,135 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/JMURv/sso/internal/ctrl"
+	"github.com/JMURv/sso/internal/dto"
+	"github.com/JMURv/sso/internal/hdl"
+	mid "github.com/JMURv/sso/internal/hdl/http/middleware"
+	"github.com/JMURv/sso/internal/hdl/http/utils"
+	_ "github.com/JMURv/sso/internal/models"
+	"github.com/go-chi/chi/v5"
+)
+
+func (h *Handler) RegisterRealmRoutes() {
+	h.router.With(mid.RateLimit(h.rl, "auth_discover")).Post("/auth/discover", h.discoverRealm)
+
+	h.router.With(mid.Auth(h.au), mid.Admin).Get("/auth/realms", h.listRealmDomains)
+	h.router.With(mid.Auth(h.au), mid.Admin).Post("/auth/realms", h.createRealmDomain)
+	h.router.With(mid.Auth(h.au), mid.Admin).Delete("/auth/realms/{domain}", h.deleteRealmDomain)
+}
+
+// discoverRealm godoc
+//
+//	@Summary		Discover login method
+//	@Description	Returns how the user with given email should sign in. Mapped email domain wins, per-user method (passkey, email code or federated connection) is returned only when anti-enumeration is off
+//	@Tags			Realm
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		dto.CheckEmailRequest	true	"Email payload"
+//	@Success		200		{object}	dto.DiscoverRealmResponse
+//	@Failure		400		{object}	utils.ErrorsResponse	"invalid request"
+//	@Failure		429		{object}	utils.ErrorsResponse	"rate limit exceeded"
+//	@Failure		500		{object}	utils.ErrorsResponse	"internal error"
+//	@Router			/auth/discover [post]
+func (h *Handler) discoverRealm(w http.ResponseWriter, r *http.Request) {
+	req := &dto.CheckEmailRequest{}
+	if ok := utils.ParseAndValidate(w, r, req); !ok {
+		return
+	}
+
+	res, err := h.ctrl.DiscoverRealm(r.Context(), req.Email)
+	if err != nil {
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, http.StatusOK, res)
+}
+
+// listRealmDomains godoc
+//
+//	@Summary		List realm domains
+//	@Description	Retrieve all email domain to login method mappings
+//	@Tags			Realm
+//	@Produce		json
+//	@Param			Authorization	header		string	true	"Authorization token"
+//	@Success		200				{array}		models.RealmDomain
+//	@Failure		403				{object}	utils.ErrorsResponse	"not authorized"
+//	@Failure		500				{object}	utils.ErrorsResponse	"internal error"
+//	@Router			/auth/realms [get]
+func (h *Handler) listRealmDomains(w http.ResponseWriter, r *http.Request) {
+	res, err := h.ctrl.ListRealmDomains(r.Context())
+	if err != nil {
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, http.StatusOK, res)
+}
+
+// createRealmDomain godoc
+//
+//	@Summary		Create realm domain
+//	@Description	Map an email domain to a login method, optionally forcing SSO for it
+//	@Tags			Realm
+//	@Accept			json
+//	@Produce		json
+//	@Param			body			body		dto.CreateRealmDomainRequest	true	"Mapping details"
+//	@Param			Authorization	header		string							true	"Authorization token"
+//	@Success		201				{int}		"Mapping ID"
+//	@Failure		400				{object}	utils.ErrorsResponse	"invalid request"
+//	@Failure		403				{object}	utils.ErrorsResponse	"not authorized"
+//	@Failure		409				{object}	utils.ErrorsResponse	"domain already mapped"
+//	@Failure		500				{object}	utils.ErrorsResponse	"internal error"
+//	@Router			/auth/realms [post]
+func (h *Handler) createRealmDomain(w http.ResponseWriter, r *http.Request) {
+	req := &dto.CreateRealmDomainRequest{}
+	if ok := utils.ParseAndValidate(w, r, req); !ok {
+		return
+	}
+
+	res, err := h.ctrl.CreateRealmDomain(r.Context(), req)
+	if err != nil && errors.Is(err, ctrl.ErrAlreadyExists) {
+		utils.ErrResponse(w, http.StatusConflict, err)
+		return
+	} else if err != nil {
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, http.StatusCreated, res)
+}
+
+// deleteRealmDomain godoc
+//
+//	@Summary		Delete realm domain
+//	@Description	Remove an email domain mapping
+//	@Tags			Realm
+//	@Param			domain			path		string	true	"Email domain"
+//	@Param			Authorization	header		string	true	"Authorization token"
+//	@Success		204				{object}	nil		"OK"
+//	@Failure		403				{object}	utils.ErrorsResponse	"not authorized"
+//	@Failure		404				{object}	utils.ErrorsResponse	"domain not found"
+//	@Failure		500				{object}	utils.ErrorsResponse	"internal error"
+//	@Router			/auth/realms/{domain} [delete]
+func (h *Handler) deleteRealmDomain(w http.ResponseWriter, r *http.Request) {
+	domain := chi.URLParam(r, "domain")
+	if domain == "" {
+		utils.ErrResponse(w, http.StatusBadRequest, ErrInvalidURL)
+		return
+	}
+
+	err := h.ctrl.DeleteRealmDomain(r.Context(), domain)
+	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
+		utils.ErrResponse(w, http.StatusNotFound, err)
+		return
+	} else if err != nil {
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	utils.StatusResponse(w, http.StatusNoContent)
+}
@@ -0,0 +1,98 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/JMURv/sso/internal/ctrl"
+	"github.com/JMURv/sso/internal/dto"
+	"github.com/JMURv/sso/internal/hdl"
+	mid "github.com/JMURv/sso/internal/hdl/http/middleware"
+	"github.com/JMURv/sso/internal/hdl/http/utils"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+func (h *Handler) RegisterRecoveryRoutes() {
+	h.router.With(mid.Auth(h.au), mid.Reauth(h.au)).Post("/auth/mfa/recovery-codes", h.regenerateRecoveryCodes)
+	h.router.With(mid.RateLimit(h.rl, "auth_mfa_verify"), mid.Device).Post("/auth/mfa/recovery/verify", h.verifyRecoveryCode)
+}
+
+// regenerateRecoveryCodes godoc
+//
+//	@Summary		Regenerate recovery codes
+//	@Description	Issues new set of one-time recovery codes. Previous set, used codes included, stops working. Codes replace TOTP, so it must be enabled
+//	@Tags			MFA
+//	@Produce		json
+//	@Param			Authorization	header		string	true	"Authorization token"
+//	@Success		200				{object}	dto.RecoveryCodesResponse
+//	@Failure		400				{object}	utils.ErrorsResponse	"totp is not enabled"
+//	@Failure		403				{object}	utils.ErrorsResponse	"recent authentication required"
+//	@Failure		500				{object}	utils.ErrorsResponse	"internal error"
+//	@Router			/auth/mfa/recovery-codes [post]
+func (h *Handler) regenerateRecoveryCodes(w http.ResponseWriter, r *http.Request) {
+	uid, ok := r.Context().Value("uid").(uuid.UUID)
+	if !ok {
+		zap.L().Error(
+			hdl.ErrFailedToParseUUID.Error(),
+			zap.Any("uid", r.Context().Value("uid")),
+			zap.Error(hdl.ErrFailedToParseUUID),
+		)
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	res, err := h.ctrl.RegenerateRecoveryCodes(r.Context(), uid)
+	if err != nil && errors.Is(err, ctrl.ErrNoSecondFactor) {
+		utils.ErrResponse(w, http.StatusBadRequest, err)
+		return
+	} else if err != nil {
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, http.StatusOK, res)
+}
+
+// verifyRecoveryCode godoc
+//
+//	@Summary		Pass MFA challenge with recovery code
+//	@Description	Completes login which returned MFA challenge, sets JWT cookies. Code is burned and owner is notified by email
+//	@Tags			MFA
+//	@Accept			json
+//	@Produce		json
+//	@Param			X-Real-IP	header		string							true	"Client real IP address"
+//	@Param			User-Agent	header		string							true	"Client User-Agent"
+//	@Param			body		body		dto.VerifyRecoveryCodeRequest	true	"challenge token and recovery code"
+//	@Success		200			{object}	nil								"OK"
+//	@Failure		400			{object}	utils.ErrorsResponse			"missing device info or bad payload"
+//	@Failure		401			{object}	utils.ErrorsResponse			"invalid or used code, unknown challenge"
+//	@Failure		429			{object}	utils.ErrorsResponse			"rate limit exceeded"
+//	@Failure		500			{object}	utils.ErrorsResponse			"internal error"
+//	@Router			/auth/mfa/recovery/verify [post]
+func (h *Handler) verifyRecoveryCode(w http.ResponseWriter, r *http.Request) {
+	d, ok := utils.ParseDeviceByRequest(r)
+	if !ok {
+		utils.ErrResponse(w, http.StatusBadRequest, hdl.ErrNoDeviceInfo)
+		return
+	}
+
+	req := &dto.VerifyRecoveryCodeRequest{}
+	if ok = utils.ParseAndValidate(w, r, req); !ok {
+		return
+	}
+
+	res, err := h.ctrl.VerifyRecoveryCode(r.Context(), &d, req)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrCodeIsNotValid) ||
+			errors.Is(err, ctrl.ErrChallengeNotFound) ||
+			errors.Is(err, ctrl.ErrNotFound) {
+			utils.ErrResponse(w, http.StatusUnauthorized, err)
+			return
+		}
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	loginResponse(w, res)
+}
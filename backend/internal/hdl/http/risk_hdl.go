@@ -0,0 +1,104 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/JMURv/sso/internal/ctrl"
+	"github.com/JMURv/sso/internal/dto"
+	"github.com/JMURv/sso/internal/hdl"
+	mid "github.com/JMURv/sso/internal/hdl/http/middleware"
+	"github.com/JMURv/sso/internal/hdl/http/utils"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+func (h *Handler) RegisterRiskRoutes() {
+	h.router.With(mid.RateLimit(h.rl, "auth_mfa_verify"), mid.Device).Post("/auth/mfa/email/verify", h.verifyEmailCode)
+	h.router.With(mid.Auth(h.au), mid.Admin).Get("/users/{id}/risk-events", h.listRiskEvents)
+}
+
+// verifyEmailCode godoc
+//
+//	@Summary		Pass MFA challenge with code sent by email
+//	@Description	Completes risky login of user without second factor enrolled, sets JWT cookies
+//	@Tags			MFA
+//	@Accept			json
+//	@Produce		json
+//	@Param			X-Real-IP	header		string						true	"Client real IP address"
+//	@Param			User-Agent	header		string						true	"Client User-Agent"
+//	@Param			body		body		dto.VerifyEmailCodeRequest	true	"challenge token and code"
+//	@Success		200			{object}	nil							"OK"
+//	@Failure		400			{object}	utils.ErrorsResponse		"missing device info or bad payload"
+//	@Failure		401			{object}	utils.ErrorsResponse		"invalid or reused code, unknown challenge"
+//	@Failure		429			{object}	utils.ErrorsResponse		"rate limit exceeded"
+//	@Failure		500			{object}	utils.ErrorsResponse		"internal error"
+//	@Router			/auth/mfa/email/verify [post]
+func (h *Handler) verifyEmailCode(w http.ResponseWriter, r *http.Request) {
+	d, ok := utils.ParseDeviceByRequest(r)
+	if !ok {
+		utils.ErrResponse(w, http.StatusBadRequest, hdl.ErrNoDeviceInfo)
+		return
+	}
+
+	req := &dto.VerifyEmailCodeRequest{}
+	if ok = utils.ParseAndValidate(w, r, req); !ok {
+		return
+	}
+
+	res, err := h.ctrl.VerifyEmailCode(r.Context(), &d, req)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrCodeIsNotValid) ||
+			errors.Is(err, ctrl.ErrCodeReused) ||
+			errors.Is(err, ctrl.ErrChallengeNotFound) ||
+			errors.Is(err, ctrl.ErrNotFound) {
+			utils.ErrResponse(w, http.StatusUnauthorized, err)
+			return
+		}
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	loginResponse(w, res)
+}
+
+// listRiskEvents godoc
+//
+//	@Summary		List login risk assessments
+//	@Description	Score, decision and reasons of every login of the user, the latest first
+//	@Tags			Risk
+//	@Param			id		path	string	true	"User UUID"
+//	@Param			page	query	int		false	"Page number"
+//	@Param			size	query	int		false	"Page size"
+//	@Produce		json
+//	@Param			Authorization	header		string	true	"Authorization token"
+//	@Success		200				{object}	dto.PaginatedRiskEventResponse
+//	@Failure		403				{object}	utils.ErrorsResponse	"not authorized"
+//	@Failure		404				{object}	utils.ErrorsResponse	"user not found"
+//	@Failure		500				{object}	utils.ErrorsResponse	"internal error"
+//	@Router			/users/{id}/risk-events [get]
+func (h *Handler) listRiskEvents(w http.ResponseWriter, r *http.Request) {
+	uid, err := uuid.Parse(chi.URLParam(r, "id"))
+	if uid == uuid.Nil || err != nil {
+		zap.L().Error(
+			hdl.ErrFailedToParseUUID.Error(),
+			zap.String("path", r.URL.Path),
+			zap.Error(err),
+		)
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrFailedToParseUUID)
+		return
+	}
+
+	page, size := utils.ParsePaginationValues(r)
+	res, err := h.ctrl.ListRiskEvents(r.Context(), uid, page, size)
+	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
+		utils.ErrResponse(w, http.StatusNotFound, err)
+		return
+	} else if err != nil {
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, http.StatusOK, res)
+}
@@ -0,0 +1,240 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/JMURv/sso/internal/auth/providers"
+	"github.com/JMURv/sso/internal/auth/saml"
+	"github.com/JMURv/sso/internal/ctrl"
+	"github.com/JMURv/sso/internal/dto"
+	"github.com/JMURv/sso/internal/hdl"
+	mid "github.com/JMURv/sso/internal/hdl/http/middleware"
+	"github.com/JMURv/sso/internal/hdl/http/utils"
+	_ "github.com/JMURv/sso/internal/models"
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+func (h *Handler) RegisterSAMLRoutes() {
+	h.router.With(mid.Auth(h.au), mid.Admin).Get("/auth/saml/providers", h.listSAMLProviders)
+	h.router.With(mid.Auth(h.au), mid.Admin).Post("/auth/saml/providers", h.createSAMLProvider)
+	h.router.With(mid.Auth(h.au), mid.Admin).Delete("/auth/saml/providers/{provider}", h.deleteSAMLProvider)
+
+	h.router.Get("/auth/saml/{provider}/metadata", h.samlMetadata)
+	h.router.Get("/auth/saml/{provider}/start", h.startSAML)
+	h.router.With(mid.Device).Post("/auth/saml/{provider}/acs", h.handleSAMLCallback)
+}
+
+// listSAMLProviders godoc
+//
+//	@Summary		List SAML providers
+//	@Description	Retrieve all configured upstream SAML identity providers
+//	@Tags			SAML
+//	@Produce		json
+//	@Param			Authorization	header		string	true	"Authorization token"
+//	@Success		200				{array}		models.SAMLProvider
+//	@Failure		403				{object}	utils.ErrorsResponse	"not authorized"
+//	@Failure		500				{object}	utils.ErrorsResponse	"internal error"
+//	@Router			/auth/saml/providers [get]
+func (h *Handler) listSAMLProviders(w http.ResponseWriter, r *http.Request) {
+	res, err := h.ctrl.ListSAMLProviders(r.Context())
+	if err != nil {
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, http.StatusOK, res)
+}
+
+// createSAMLProvider godoc
+//
+//	@Summary		Create SAML provider
+//	@Description	Register an upstream SAML identity provider from its metadata XML
+//	@Tags			SAML
+//	@Accept			json
+//	@Produce		json
+//	@Param			body			body		dto.CreateSAMLProviderRequest	true	"Provider details"
+//	@Param			Authorization	header		string							true	"Authorization token"
+//	@Success		201				{int}		"Provider ID"
+//	@Failure		400				{object}	utils.ErrorsResponse	"invalid request or metadata"
+//	@Failure		403				{object}	utils.ErrorsResponse	"not authorized"
+//	@Failure		409				{object}	utils.ErrorsResponse	"provider already exists"
+//	@Failure		500				{object}	utils.ErrorsResponse	"internal error"
+//	@Router			/auth/saml/providers [post]
+func (h *Handler) createSAMLProvider(w http.ResponseWriter, r *http.Request) {
+	req := &dto.CreateSAMLProviderRequest{}
+	if ok := utils.ParseAndValidate(w, r, req); !ok {
+		return
+	}
+
+	res, err := h.ctrl.CreateSAMLProvider(r.Context(), req)
+	if err != nil && errors.Is(err, saml.ErrInvalidMetadata) {
+		utils.ErrResponse(w, http.StatusBadRequest, err)
+		return
+	} else if err != nil && errors.Is(err, ctrl.ErrAlreadyExists) {
+		utils.ErrResponse(w, http.StatusConflict, err)
+		return
+	} else if err != nil {
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, http.StatusCreated, res)
+}
+
+// deleteSAMLProvider godoc
+//
+//	@Summary		Delete SAML provider
+//	@Description	Remove an upstream SAML identity provider and its user links
+//	@Tags			SAML
+//	@Param			provider		path		string	true	"SAML provider name"
+//	@Param			Authorization	header		string	true	"Authorization token"
+//	@Success		204				{object}	nil		"OK"
+//	@Failure		403				{object}	utils.ErrorsResponse	"not authorized"
+//	@Failure		404				{object}	utils.ErrorsResponse	"provider not found"
+//	@Failure		500				{object}	utils.ErrorsResponse	"internal error"
+//	@Router			/auth/saml/providers/{provider} [delete]
+func (h *Handler) deleteSAMLProvider(w http.ResponseWriter, r *http.Request) {
+	provider := chi.URLParam(r, "provider")
+	if provider == "" {
+		utils.ErrResponse(w, http.StatusBadRequest, ErrInvalidURL)
+		return
+	}
+
+	err := h.ctrl.DeleteSAMLProvider(r.Context(), provider)
+	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
+		utils.ErrResponse(w, http.StatusNotFound, err)
+		return
+	} else if err != nil {
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	utils.StatusResponse(w, http.StatusNoContent)
+}
+
+// samlMetadata godoc
+//
+//	@Summary		Service provider metadata
+//	@Description	Returns SAML service provider metadata to be imported into the upstream IdP
+//	@Tags			SAML
+//	@Produce		xml
+//	@Param			provider	path		string	true	"SAML provider name"
+//	@Success		200			{string}	string	"SP metadata XML"
+//	@Failure		404			{object}	utils.ErrorsResponse	"provider not found"
+//	@Failure		500			{object}	utils.ErrorsResponse	"internal error"
+//	@Router			/auth/saml/{provider}/metadata [get]
+func (h *Handler) samlMetadata(w http.ResponseWriter, r *http.Request) {
+	provider := chi.URLParam(r, "provider")
+	if provider == "" {
+		utils.ErrResponse(w, http.StatusBadRequest, ErrInvalidURL)
+		return
+	}
+
+	res, err := h.ctrl.GetSAMLMetadata(r.Context(), provider)
+	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
+		utils.ErrResponse(w, http.StatusNotFound, err)
+		return
+	} else if err != nil {
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/samlmetadata+xml")
+	w.WriteHeader(http.StatusOK)
+	if _, err = w.Write(res); err != nil {
+		zap.L().Error("failed to write metadata", zap.Error(err))
+	}
+}
+
+// startSAML godoc
+//
+//	@Summary		Start SAML authentication flow
+//	@Description	Redirects user to the upstream IdP with a signed AuthnRequest
+//	@Tags			SAML
+//	@Param			provider	path		string					true	"SAML provider name"
+//	@Success		307			{object}	nil						"Redirect to IdP"
+//	@Failure		404			{object}	utils.ErrorsResponse	"provider not found"
+//	@Failure		500			{object}	utils.ErrorsResponse	"internal error"
+//	@Router			/auth/saml/{provider}/start [get]
+func (h *Handler) startSAML(w http.ResponseWriter, r *http.Request) {
+	provider := chi.URLParam(r, "provider")
+	if provider == "" {
+		utils.ErrResponse(w, http.StatusBadRequest, ErrInvalidURL)
+		return
+	}
+
+	res, err := h.ctrl.GetSAMLAuthURL(r.Context(), provider)
+	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
+		utils.ErrResponse(w, http.StatusNotFound, err)
+		return
+	} else if err != nil {
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	http.Redirect(w, r, res.URL, http.StatusTemporaryRedirect)
+}
+
+// handleSAMLCallback godoc
+//
+//	@Summary		SAML assertion consumer service
+//	@Description	Validates the IdP response, links or provisions the user, sets authentication cookies and redirects to success URL
+//	@Tags			SAML
+//	@Accept			x-www-form-urlencoded
+//	@Param			provider		path		string					true	"SAML provider name"
+//	@Param			SAMLResponse	formData	string					true	"Base64 encoded SAML response"
+//	@Param			RelayState		formData	string					false	"Relay state returned by IdP"
+//	@Param			X-Real-IP		header		string					true	"Client real IP address"
+//	@Param			User-Agent		header		string					true	"Client User-Agent"
+//	@Success		303				{object}	nil						"Redirect to success URL"
+//	@Failure		400				{object}	utils.ErrorsResponse	"invalid relay state or missing device info"
+//	@Failure		401				{object}	utils.ErrorsResponse	"invalid or replayed assertion"
+//	@Failure		403				{object}	utils.ErrorsResponse	"login is too risky"
+//	@Failure		404				{object}	utils.ErrorsResponse	"provider or request not found"
+//	@Failure		409				{object}	utils.ErrorsResponse	"email belongs to account which is not linked to provider"
+//	@Failure		500				{object}	utils.ErrorsResponse	"internal error"
+//	@Router			/auth/saml/{provider}/acs [post]
+func (h *Handler) handleSAMLCallback(w http.ResponseWriter, r *http.Request) {
+	provider := chi.URLParam(r, "provider")
+	if provider == "" {
+		utils.ErrResponse(w, http.StatusBadRequest, ErrInvalidURL)
+		return
+	}
+
+	if err := r.ParseForm(); err != nil {
+		utils.ErrResponse(w, http.StatusBadRequest, hdl.ErrDecodeRequest)
+		return
+	}
+
+	d, ok := utils.ParseDeviceByRequest(r)
+	if !ok {
+		utils.ErrResponse(w, http.StatusBadRequest, hdl.ErrNoDeviceInfo)
+		return
+	}
+
+	res, err := h.ctrl.HandleSAMLCallback(r.Context(), &d, provider, r.PostForm.Get("RelayState"), r)
+	if err != nil {
+		switch {
+		case errors.Is(err, ctrl.ErrNotFound):
+			utils.ErrResponse(w, http.StatusNotFound, err)
+		case errors.Is(err, providers.ErrInvalidStateFormat),
+			errors.Is(err, providers.ErrInvalidSignature),
+			errors.Is(err, providers.ErrStateExpired):
+			utils.ErrResponse(w, http.StatusBadRequest, err)
+		case errors.Is(err, saml.ErrInvalidAssertion), errors.Is(err, ctrl.ErrAssertionReplayed):
+			utils.ErrResponse(w, http.StatusUnauthorized, err)
+		case errors.Is(err, ctrl.ErrAccountNotLinked):
+			utils.ErrResponse(w, http.StatusConflict, err)
+		case errors.Is(err, ctrl.ErrRiskBlocked):
+			utils.ErrResponse(w, http.StatusForbidden, err)
+		default:
+			utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		}
+		return
+	}
+
+	utils.SetAuthCookies(w, res.Access, res.Refresh)
+	http.Redirect(w, r, res.SuccessURL, http.StatusSeeOther)
+}
@@ -0,0 +1,229 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/JMURv/sso/internal/ctrl"
+	"github.com/JMURv/sso/internal/dto"
+	"github.com/JMURv/sso/internal/hdl"
+	mid "github.com/JMURv/sso/internal/hdl/http/middleware"
+	"github.com/JMURv/sso/internal/hdl/http/utils"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+func (h *Handler) RegisterTOTPRoutes() {
+	h.router.With(mid.Auth(h.au), mid.Reauth(h.au)).Post("/auth/mfa/totp/enroll", h.enrollTOTP)
+	h.router.With(mid.Auth(h.au), mid.Reauth(h.au)).Post("/auth/mfa/totp/confirm", h.confirmTOTP)
+	h.router.With(mid.Auth(h.au), mid.Reauth(h.au)).Post("/auth/mfa/totp/disable", h.disableTOTP)
+	h.router.With(mid.RateLimit(h.rl, "auth_mfa_verify"), mid.Device).Post("/auth/mfa/totp/verify", h.verifyTOTP)
+}
+
+// enrollTOTP godoc
+//
+//	@Summary		Start TOTP enrollment
+//	@Description	Generates new authenticator secret, returns otpauth URI and QR code PNG. Enrollment is pending until confirmed with a first code
+//	@Tags			MFA
+//	@Produce		json
+//	@Param			Authorization	header		string	true	"Authorization token"
+//	@Success		200				{object}	dto.TOTPEnrollResponse
+//	@Failure		403				{object}	utils.ErrorsResponse	"recent authentication required"
+//	@Failure		404				{object}	utils.ErrorsResponse	"user not found"
+//	@Failure		409				{object}	utils.ErrorsResponse	"totp is already enabled"
+//	@Failure		500				{object}	utils.ErrorsResponse	"internal error"
+//	@Router			/auth/mfa/totp/enroll [post]
+func (h *Handler) enrollTOTP(w http.ResponseWriter, r *http.Request) {
+	uid, ok := r.Context().Value("uid").(uuid.UUID)
+	if !ok {
+		zap.L().Error(
+			hdl.ErrFailedToParseUUID.Error(),
+			zap.Any("uid", r.Context().Value("uid")),
+			zap.Error(hdl.ErrFailedToParseUUID),
+		)
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	res, err := h.ctrl.EnrollTOTP(r.Context(), uid)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrNotFound) {
+			utils.ErrResponse(w, http.StatusNotFound, err)
+			return
+		}
+		if errors.Is(err, ctrl.ErrAlreadyExists) {
+			utils.ErrResponse(w, http.StatusConflict, err)
+			return
+		}
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, http.StatusOK, res)
+}
+
+// confirmTOTP godoc
+//
+//	@Summary		Confirm TOTP enrollment
+//	@Description	Enables TOTP after the first code from authenticator app is accepted. Returns one-time recovery codes, they are shown only once
+//	@Tags			MFA
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization	header		string					true	"Authorization token"
+//	@Param			body			body		dto.TOTPCodeRequest		true	"code from authenticator app"
+//	@Success		200				{object}	dto.RecoveryCodesResponse
+//	@Failure		400				{object}	utils.ErrorsResponse	"invalid code"
+//	@Failure		403				{object}	utils.ErrorsResponse	"recent authentication required"
+//	@Failure		404				{object}	utils.ErrorsResponse	"no pending enrollment"
+//	@Failure		409				{object}	utils.ErrorsResponse	"totp is already enabled"
+//	@Failure		500				{object}	utils.ErrorsResponse	"internal error"
+//	@Router			/auth/mfa/totp/confirm [post]
+func (h *Handler) confirmTOTP(w http.ResponseWriter, r *http.Request) {
+	uid, ok := r.Context().Value("uid").(uuid.UUID)
+	if !ok {
+		zap.L().Error(
+			hdl.ErrFailedToParseUUID.Error(),
+			zap.Any("uid", r.Context().Value("uid")),
+			zap.Error(hdl.ErrFailedToParseUUID),
+		)
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	req := &dto.TOTPCodeRequest{}
+	if ok = utils.ParseAndValidate(w, r, req); !ok {
+		return
+	}
+
+	res, err := h.ctrl.ConfirmTOTP(r.Context(), uid, req.Code)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrCodeIsNotValid) {
+			utils.ErrResponse(w, http.StatusBadRequest, err)
+			return
+		}
+		if errors.Is(err, ctrl.ErrNotFound) {
+			utils.ErrResponse(w, http.StatusNotFound, err)
+			return
+		}
+		if errors.Is(err, ctrl.ErrAlreadyExists) {
+			utils.ErrResponse(w, http.StatusConflict, err)
+			return
+		}
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, http.StatusOK, res)
+}
+
+// disableTOTP godoc
+//
+//	@Summary		Disable TOTP
+//	@Description	Removes authenticator app after checking current code
+//	@Tags			MFA
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization	header		string					true	"Authorization token"
+//	@Param			body			body		dto.TOTPCodeRequest		true	"code from authenticator app"
+//	@Success		200				{object}	nil						"OK"
+//	@Failure		400				{object}	utils.ErrorsResponse	"invalid or reused code"
+//	@Failure		403				{object}	utils.ErrorsResponse	"recent authentication required"
+//	@Failure		404				{object}	utils.ErrorsResponse	"totp is not enabled"
+//	@Failure		423				{object}	utils.ErrorsResponse	"too many wrong codes"
+//	@Failure		429				{object}	utils.ErrorsResponse	"next attempt is delayed"
+//	@Failure		500				{object}	utils.ErrorsResponse	"internal error"
+//	@Router			/auth/mfa/totp/disable [post]
+func (h *Handler) disableTOTP(w http.ResponseWriter, r *http.Request) {
+	uid, ok := r.Context().Value("uid").(uuid.UUID)
+	if !ok {
+		zap.L().Error(
+			hdl.ErrFailedToParseUUID.Error(),
+			zap.Any("uid", r.Context().Value("uid")),
+			zap.Error(hdl.ErrFailedToParseUUID),
+		)
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	req := &dto.TOTPCodeRequest{}
+	if ok = utils.ParseAndValidate(w, r, req); !ok {
+		return
+	}
+
+	err := h.ctrl.DisableTOTP(r.Context(), uid, req.Code)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrCodeIsNotValid) || errors.Is(err, ctrl.ErrCodeReused) {
+			utils.ErrResponse(w, http.StatusBadRequest, err)
+			return
+		}
+		if errors.Is(err, ctrl.ErrNotFound) {
+			utils.ErrResponse(w, http.StatusNotFound, err)
+			return
+		}
+		if errors.Is(err, ctrl.ErrAccountLocked) {
+			utils.ErrResponse(w, http.StatusLocked, err)
+			return
+		}
+		if errors.Is(err, ctrl.ErrTooManyRequests) {
+			utils.ErrResponse(w, http.StatusTooManyRequests, err)
+			return
+		}
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	utils.StatusResponse(w, http.StatusOK)
+}
+
+// verifyTOTP godoc
+//
+//	@Summary		Pass TOTP challenge
+//	@Description	Completes login which returned MFA challenge, sets JWT cookies
+//	@Tags			MFA
+//	@Accept			json
+//	@Produce		json
+//	@Param			X-Real-IP	header		string					true	"Client real IP address"
+//	@Param			User-Agent	header		string					true	"Client User-Agent"
+//	@Param			body		body		dto.VerifyTOTPRequest	true	"challenge token and code"
+//	@Success		200			{object}	nil						"OK"
+//	@Failure		400			{object}	utils.ErrorsResponse	"missing device info or bad payload"
+//	@Failure		401			{object}	utils.ErrorsResponse	"invalid or reused code, unknown challenge"
+//	@Failure		423			{object}	utils.ErrorsResponse	"too many wrong codes"
+//	@Failure		429			{object}	utils.ErrorsResponse	"next attempt is delayed or rate limit exceeded"
+//	@Failure		500			{object}	utils.ErrorsResponse	"internal error"
+//	@Router			/auth/mfa/totp/verify [post]
+func (h *Handler) verifyTOTP(w http.ResponseWriter, r *http.Request) {
+	d, ok := utils.ParseDeviceByRequest(r)
+	if !ok {
+		utils.ErrResponse(w, http.StatusBadRequest, hdl.ErrNoDeviceInfo)
+		return
+	}
+
+	req := &dto.VerifyTOTPRequest{}
+	if ok = utils.ParseAndValidate(w, r, req); !ok {
+		return
+	}
+
+	res, err := h.ctrl.VerifyTOTP(r.Context(), &d, req)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrCodeIsNotValid) ||
+			errors.Is(err, ctrl.ErrCodeReused) ||
+			errors.Is(err, ctrl.ErrChallengeNotFound) ||
+			errors.Is(err, ctrl.ErrNotFound) {
+			utils.ErrResponse(w, http.StatusUnauthorized, err)
+			return
+		}
+		if errors.Is(err, ctrl.ErrAccountLocked) {
+			utils.ErrResponse(w, http.StatusLocked, err)
+			return
+		}
+		if errors.Is(err, ctrl.ErrTooManyRequests) {
+			utils.ErrResponse(w, http.StatusTooManyRequests, err)
+			return
+		}
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	loginResponse(w, res)
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"net/http"
 
+	"github.com/JMURv/sso/internal/auth/password"
 	"github.com/JMURv/sso/internal/ctrl"
 	"github.com/JMURv/sso/internal/dto"
 	"github.com/JMURv/sso/internal/hdl"
@@ -19,9 +20,9 @@ import (
 )
 
 func (h *Handler) RegisterUserRoutes() {
-	h.router.Post("/users/exists", h.existsUser)
+	h.router.With(mid.RateLimit(h.rl, "users_exists"), mid.Auth(h.au), mid.Admin).Post("/users/exists", h.existsUser)
 	h.router.With(mid.Auth(h.au)).Get("/users/me", h.getMe)
-	h.router.With(mid.Auth(h.au)).Put("/users/me", h.updateMe)
+	h.router.With(mid.Auth(h.au), mid.Reauth(h.au)).Put("/users/me", h.updateMe)
 	h.router.Get("/users", h.listUsers)
 	h.router.Post("/users", h.createUser)
 	h.router.Get("/users/{id}", h.getUser)
@@ -32,14 +33,18 @@ func (h *Handler) RegisterUserRoutes() {
 // existsUser godoc
 //
 //	@Summary		Check if a user exists by email
-//	@Description	Returns 200 if user exists, 404 otherwise
+//	@Description	Returns 200 if user exists, 404 otherwise. Available to admins only
 //	@Tags			User
 //	@Accept			json
 //	@Produce		json
-//	@Param			body	body		dto.CheckEmailRequest	true	"Email payload"
-//	@Success		200		{object}	dto.ExistsUserResponse
-//	@Failure		404		{object}	utils.ErrorsResponse	"user not found"
-//	@Failure		500		{object}	utils.ErrorsResponse	"internal error"
+//	@Param			Authorization	header		string					true	"Authorization token"
+//	@Param			body			body		dto.CheckEmailRequest	true	"Email payload"
+//	@Success		200				{object}	dto.ExistsUserResponse
+//	@Failure		401				{object}	utils.ErrorsResponse	"unauthorized"
+//	@Failure		403				{object}	utils.ErrorsResponse	"not authorized"
+//	@Failure		404				{object}	utils.ErrorsResponse	"user not found"
+//	@Failure		429				{object}	utils.ErrorsResponse	"rate limit exceeded"
+//	@Failure		500				{object}	utils.ErrorsResponse	"internal error"
 //	@Router			/users/exists [post]
 func (h *Handler) existsUser(w http.ResponseWriter, r *http.Request) {
 	req := &dto.CheckEmailRequest{}
@@ -131,8 +136,9 @@ func (h *Handler) getMe(w http.ResponseWriter, r *http.Request) {
 //	@Param			avatar			formData	file					false	"Avatar image file"
 //	@Param			Authorization	header		string					true	"Bearer token, e.g. 'Bearer {jwt}'"
 //	@Success		200				{object}	nil						"OK"
-//	@Failure		400				{object}	utils.ErrorsResponse	"bad request"
+//	@Failure		400				{object}	utils.ErrorsResponse	"bad request or password policy violation"
 //	@Failure		401				{object}	utils.ErrorsResponse	"unauthorized"
+//	@Failure		403				{object}	utils.ErrorsResponse	"recent authentication required"
 //	@Failure		404				{object}	utils.ErrorsResponse	"user not found"
 //	@Failure		500				{object}	utils.ErrorsResponse	"internal error"
 //	@Router			/users/me [put]
@@ -179,6 +185,9 @@ func (h *Handler) updateMe(w http.ResponseWriter, r *http.Request) {
 	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
 		utils.ErrResponse(w, http.StatusNotFound, err)
 		return
+	} else if err != nil && errors.Is(err, password.ErrPolicy) {
+		utils.ErrResponse(w, http.StatusBadRequest, err)
+		return
 	} else if err != nil {
 		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
 		return
@@ -234,7 +243,7 @@ func (h *Handler) getUser(w http.ResponseWriter, r *http.Request) {
 //	@Param			data	formData	string	true	"JSON payload in 'data' field"
 //	@Param			avatar	formData	file	false	"Avatar image file"
 //	@Success		201		{object}	dto.CreateUserResponse
-//	@Failure		400		{object}	utils.ErrorsResponse	"bad request or file too large"
+//	@Failure		400		{object}	utils.ErrorsResponse	"bad request, file too large or password policy violation"
 //	@Failure		409		{object}	utils.ErrorsResponse	"user already exists"
 //	@Failure		500		{object}	utils.ErrorsResponse	"internal error"
 //	@Router			/users [post]
@@ -272,6 +281,10 @@ func (h *Handler) createUser(w http.ResponseWriter, r *http.Request) {
 			utils.ErrResponse(w, http.StatusConflict, err)
 			return
 		}
+		if errors.Is(err, password.ErrPolicy) {
+			utils.ErrResponse(w, http.StatusBadRequest, err)
+			return
+		}
 		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
 		return
 	}
@@ -291,7 +304,7 @@ func (h *Handler) createUser(w http.ResponseWriter, r *http.Request) {
 //	@Param			avatar			formData	file					false	"Avatar image file"
 //	@Param			Authorization	header		string					true	"Bearer token, e.g. 'Bearer {jwt}'"
 //	@Success		200				{object}	nil						"OK"
-//	@Failure		400				{object}	utils.ErrorsResponse	"bad request"
+//	@Failure		400				{object}	utils.ErrorsResponse	"bad request or password policy violation"
 //	@Failure		401				{object}	utils.ErrorsResponse	"unauthorized"
 //	@Failure		404				{object}	utils.ErrorsResponse	"user not found"
 //	@Failure		500				{object}	utils.ErrorsResponse	"internal error"
@@ -339,6 +352,9 @@ func (h *Handler) updateUser(w http.ResponseWriter, r *http.Request) {
 	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
 		utils.ErrResponse(w, http.StatusNotFound, err)
 		return
+	} else if err != nil && errors.Is(err, password.ErrPolicy) {
+		utils.ErrResponse(w, http.StatusBadRequest, err)
+		return
 	} else if err != nil {
 		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
 		return
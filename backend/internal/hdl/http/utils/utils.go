@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/JMURv/sso/internal/auth/password"
 	"github.com/JMURv/sso/internal/config"
 	"github.com/JMURv/sso/internal/dto"
 	"github.com/JMURv/sso/internal/hdl"
@@ -40,11 +41,17 @@ func ErrResponse(w http.ResponseWriter, statusCode int, err error) {
 	w.WriteHeader(statusCode)
 
 	msgs := make([]string, 0, 1)
+	var pErr *password.PolicyError
 	if errs, ok := err.(validator.ValidationErrors); ok {
 		msgs = make([]string, 0, len(errs))
 		for _, fe := range errs {
 			msgs = append(msgs, fmt.Sprintf("%s failed on the %s rule", fe.Field(), fe.Tag()))
 		}
+	} else if errors.As(err, &pErr) {
+		msgs = make([]string, 0, len(pErr.Rules))
+		for _, rule := range pErr.Rules {
+			msgs = append(msgs, fmt.Sprintf("Password failed on the %s rule", rule))
+		}
 	} else {
 		msgs = append(msgs, err.Error())
 	}
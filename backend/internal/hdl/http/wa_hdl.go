@@ -1,25 +1,31 @@
 package http
 
 import (
+	"bytes"
 	"errors"
+	"io"
 	"net/http"
 
 	"github.com/JMURv/sso/internal/auth/captcha"
+	wa "github.com/JMURv/sso/internal/auth/webauthn"
 	"github.com/JMURv/sso/internal/ctrl"
 	"github.com/JMURv/sso/internal/dto"
 	"github.com/JMURv/sso/internal/hdl"
 	mid "github.com/JMURv/sso/internal/hdl/http/middleware"
 	"github.com/JMURv/sso/internal/hdl/http/utils"
-	_ "github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/protocol"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 )
 
 func (h *Handler) RegisterWebAuthnRoutes() {
-	h.router.With(mid.Auth(h.au)).Post("/auth/webauthn/register/start", h.registrationStart)
-	h.router.With(mid.Auth(h.au)).Post("/auth/webauthn/register/finish", h.registrationFinish)
+	h.router.With(mid.Auth(h.au), mid.Reauth(h.au)).Post("/auth/webauthn/register/start", h.registrationStart)
+	h.router.With(mid.Auth(h.au), mid.Reauth(h.au)).Post("/auth/webauthn/register/finish", h.registrationFinish)
+	h.router.With(mid.Auth(h.au), mid.Admin).Put("/auth/webauthn/metadata", h.updateMetadata)
 	h.router.Post("/auth/webauthn/login/start", h.loginStart)
 	h.router.With(mid.Device).Post("/auth/webauthn/login/finish", h.loginFinish)
+	h.router.Post("/auth/webauthn/login/discoverable/start", h.discoverableLoginStart)
+	h.router.With(mid.Device).Post("/auth/webauthn/login/discoverable/finish", h.discoverableLoginFinish)
 }
 
 // registrationStart godoc
@@ -30,6 +36,7 @@ func (h *Handler) RegisterWebAuthnRoutes() {
 //	@Produce		json
 //	@Param			Authorization	header		string	true	"Authorization token"
 //	@Success		200				{object}	protocol.CredentialCreation
+//	@Failure		403				{object}	utils.ErrorsResponse	"recent authentication required"
 //	@Failure		500				{object}	utils.ErrorsResponse	"internal error"
 //	@Router			/auth/webauthn/register/start [post]
 func (h *Handler) registrationStart(w http.ResponseWriter, r *http.Request) {
@@ -64,6 +71,7 @@ func (h *Handler) registrationStart(w http.ResponseWriter, r *http.Request) {
 //	@Param			Authorization	header		string					true	"Authorization token"
 //	@Success		200				{object}	nil						"OK"
 //	@Failure		400				{object}	utils.ErrorsResponse	"invalid request"
+//	@Failure		403				{object}	utils.ErrorsResponse	"recent authentication required or authenticator is not allowed by policy"
 //	@Failure		500				{object}	utils.ErrorsResponse	"internal error"
 //	@Router			/auth/webauthn/register/finish [post]
 func (h *Handler) registrationFinish(w http.ResponseWriter, r *http.Request) {
@@ -80,6 +88,10 @@ func (h *Handler) registrationFinish(w http.ResponseWriter, r *http.Request) {
 
 	err := h.ctrl.FinishRegistration(r.Context(), uid, r)
 	if err != nil {
+		if errors.Is(err, wa.ErrPolicyViolation) {
+			utils.ErrResponse(w, http.StatusForbidden, err)
+			return
+		}
 		utils.ErrResponse(w, http.StatusInternalServerError, err)
 		return
 	}
@@ -87,6 +99,46 @@ func (h *Handler) registrationFinish(w http.ResponseWriter, r *http.Request) {
 	utils.StatusResponse(w, http.StatusOK)
 }
 
+// updateMetadata godoc
+//
+//	@Summary		Update authenticator metadata
+//	@Description	Replaces locally stored FIDO Metadata Service blob, signature is verified against FIDO root certificate
+//	@Tags			WebAuthn
+//	@Accept			plain
+//	@Produce		json
+//	@Param			body			body		string	true	"MDS blob (JWT)"
+//	@Param			Authorization	header		string	true	"Authorization token"
+//	@Success		200				{object}	dto.WAMetadataResponse
+//	@Failure		400				{object}	utils.ErrorsResponse	"invalid metadata"
+//	@Failure		403				{object}	utils.ErrorsResponse	"not authorized"
+//	@Failure		413				{object}	utils.ErrorsResponse	"file too large"
+//	@Failure		500				{object}	utils.ErrorsResponse	"internal error"
+//	@Router			/auth/webauthn/metadata [put]
+func (h *Handler) updateMetadata(w http.ResponseWriter, r *http.Request) {
+	blob, err := io.ReadAll(http.MaxBytesReader(w, r.Body, 32<<20)) // 32MB
+	if err != nil {
+		var maxErr *http.MaxBytesError
+		if errors.As(err, &maxErr) {
+			utils.ErrResponse(w, http.StatusRequestEntityTooLarge, hdl.ErrFileTooLarge)
+			return
+		}
+		utils.ErrResponse(w, http.StatusBadRequest, hdl.ErrDecodeRequest)
+		return
+	}
+
+	res, err := h.ctrl.UpdateWAMetadata(r.Context(), bytes.TrimSpace(blob))
+	if err != nil {
+		if errors.Is(err, wa.ErrInvalidMetadata) {
+			utils.ErrResponse(w, http.StatusBadRequest, err)
+			return
+		}
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, http.StatusOK, res)
+}
+
 // loginStart godoc
 //
 //	@Summary		Start WebAuthn login
@@ -142,6 +194,7 @@ func (h *Handler) loginStart(w http.ResponseWriter, r *http.Request) {
 //	@Produce		json
 //	@Success		200	{object}	dto.TokenPair
 //	@Failure		400	{object}	utils.ErrorsResponse	"missing email or device info"
+//	@Failure		403	{object}	utils.ErrorsResponse	"login is too risky"
 //	@Failure		500	{object}	utils.ErrorsResponse	"internal error"
 //	@Router			/auth/webauthn/login/finish [post]
 func (h *Handler) loginFinish(w http.ResponseWriter, r *http.Request) {
@@ -159,6 +212,77 @@ func (h *Handler) loginFinish(w http.ResponseWriter, r *http.Request) {
 
 	res, err := h.ctrl.FinishLogin(r.Context(), email, d, r)
 	if err != nil {
+		if errors.Is(err, ctrl.ErrRiskBlocked) {
+			utils.ErrResponse(w, http.StatusForbidden, err)
+			return
+		}
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	utils.SetAuthCookies(w, res.Access, res.Refresh)
+	utils.SuccessResponse(w, http.StatusOK, res)
+}
+
+// discoverableLoginStart godoc
+//
+//	@Summary		Start usernameless WebAuthn login
+//	@Description	Generates an authentication challenge without allowed credentials, so the authenticator offers its resident passkeys. Pass mediation=conditional for browser autofill
+//	@Tags			WebAuthn
+//	@Produce		json
+//	@Param			mediation	query		string	false	"credential mediation"	Enums(conditional)
+//	@Success		200			{object}	protocol.CredentialAssertion
+//	@Failure		400			{object}	utils.ErrorsResponse	"unsupported mediation"
+//	@Failure		500			{object}	utils.ErrorsResponse	"internal error"
+//	@Router			/auth/webauthn/login/discoverable/start [post]
+func (h *Handler) discoverableLoginStart(w http.ResponseWriter, r *http.Request) {
+	mediation := r.URL.Query().Get("mediation")
+	if mediation != "" && mediation != string(protocol.MediationConditional) {
+		utils.ErrResponse(w, http.StatusBadRequest, ErrInvalidMediation)
+		return
+	}
+
+	res, err := h.ctrl.BeginDiscoverableLogin(r.Context(), mediation)
+	if err != nil {
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, http.StatusOK, res)
+}
+
+// discoverableLoginFinish godoc
+//
+//	@Summary		Complete usernameless WebAuthn login
+//	@Description	Resolves user from the user handle of the assertion, verifies it and sets auth cookies
+//	@Tags			WebAuthn
+//	@Param			X-Real-IP	header	string	true	"Client real IP address"
+//	@Param			User-Agent	header	string	true	"Client User-Agent"
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	dto.TokenPair
+//	@Failure		400	{object}	utils.ErrorsResponse	"missing device info"
+//	@Failure		401	{object}	utils.ErrorsResponse	"unknown passkey or expired challenge"
+//	@Failure		403	{object}	utils.ErrorsResponse	"login is too risky"
+//	@Failure		500	{object}	utils.ErrorsResponse	"internal error"
+//	@Router			/auth/webauthn/login/discoverable/finish [post]
+func (h *Handler) discoverableLoginFinish(w http.ResponseWriter, r *http.Request) {
+	d, ok := utils.ParseDeviceByRequest(r)
+	if !ok {
+		utils.ErrResponse(w, http.StatusBadRequest, hdl.ErrNoDeviceInfo)
+		return
+	}
+
+	res, err := h.ctrl.FinishDiscoverableLogin(r.Context(), d, r)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrPasskeyNotValid) || errors.Is(err, ctrl.ErrNotFound) {
+			utils.ErrResponse(w, http.StatusUnauthorized, err)
+			return
+		}
+		if errors.Is(err, ctrl.ErrRiskBlocked) {
+			utils.ErrResponse(w, http.StatusForbidden, err)
+			return
+		}
 		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
 		return
 	}
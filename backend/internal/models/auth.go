@@ -41,3 +41,27 @@ type Oauth2Connection struct {
 	ExpiresAt    time.Time `json:"expires_at" db:"expires_at"`
 	CreatedAt    time.Time `json:"created_at" db:"created_at"`
 }
+
+// Authentication method references put into amr claim, RFC 8176.
+const (
+	AMRPassword     = "pwd"
+	AMROTP          = "otp"
+	AMRHardwareKey  = "hwk"
+	AMRFederated    = "fed"
+	AMRMultiFactor  = "mfa"
+	AMRMultiChannel = "mca"
+)
+
+// Authentication context classes put into acr claim.
+const (
+	ACRSingleFactor = "aal1"
+	ACRMultiFactor  = "aal2"
+)
+
+// Channels one-time codes can be delivered through.
+const (
+	ChannelEmail  = "email"
+	ChannelSMS    = "sms"
+	ChannelVoice  = "voice"
+	ChannelDevice = "device"
+)
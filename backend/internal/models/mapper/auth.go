@@ -0,0 +1,73 @@
+package mapper
+
+import (
+	"github.com/JMURv/sso/api/grpc/v1/gen"
+	"github.com/JMURv/sso/internal/dto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TokenPairToProto(req *dto.TokenPair) *gen.SSO_TokenPair {
+	res := &gen.SSO_TokenPair{
+		Access:                 req.Access,
+		Refresh:                req.Refresh,
+		PasswordChangeRequired: req.PasswordChange,
+	}
+
+	if req.Challenge != nil {
+		res.Challenge = &gen.SSO_MFAChallenge{
+			Token:   req.Challenge.Token,
+			Methods: req.Challenge.Methods,
+		}
+	}
+
+	if req.Approval != nil {
+		res.Approval = &gen.SSO_LoginApproval{
+			Id:     req.Approval.ID,
+			Number: int32(req.Approval.Number),
+		}
+	}
+	return res
+}
+
+func QRLoginToProto(req *dto.QRLoginResponse) *gen.SSO_QRLoginRes {
+	return &gen.SSO_QRLoginRes{
+		Id:        req.ID,
+		Url:       req.URL,
+		Qr:        req.QR,
+		ExpiresAt: timestamppb.New(req.ExpiresAt),
+	}
+}
+
+func QRLoginSessionToProto(req *dto.QRLoginSession) *gen.SSO_QRLoginSession {
+	return &gen.SSO_QRLoginSession{
+		Code:       req.Code,
+		DeviceType: req.DeviceType,
+		Os:         req.OS,
+		Browser:    req.Browser,
+		Ip:         req.IP,
+		CreatedAt:  timestamppb.New(req.CreatedAt),
+	}
+}
+
+func PendingLoginsToProto(req []dto.PendingLogin) []*gen.SSO_PendingLogin {
+	res := make([]*gen.SSO_PendingLogin, 0, len(req))
+	for i := 0; i < len(req); i++ {
+		choices := make([]int32, 0, len(req[i].Choices))
+		for _, v := range req[i].Choices {
+			choices = append(choices, int32(v))
+		}
+
+		res = append(
+			res, &gen.SSO_PendingLogin{
+				Id:         req[i].ID,
+				DeviceType: req[i].DeviceType,
+				Os:         req[i].OS,
+				Browser:    req[i].Browser,
+				Ip:         req[i].IP,
+				Choices:    choices,
+				CreatedAt:  timestamppb.New(req[i].CreatedAt),
+			},
+		)
+	}
+	return res
+}
@@ -0,0 +1,30 @@
+package mapper
+
+import (
+	pb "github.com/JMURv/sso/api/grpc/v1/gen"
+	md "github.com/JMURv/sso/internal/models"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ListPasskeysToProto(req []md.Passkey) []*pb.SSO_Passkey {
+	res := make([]*pb.SSO_Passkey, len(req))
+	for i := 0; i < len(req); i++ {
+		res[i] = PasskeyToProto(&req[i])
+	}
+	return res
+}
+
+func PasskeyToProto(p *md.Passkey) *pb.SSO_Passkey {
+	res := &pb.SSO_Passkey{
+		Id:             p.ID,
+		Name:           p.Name,
+		Transports:     p.Transports,
+		BackupEligible: p.BackupEligible,
+		BackupState:    p.BackupState,
+		CreatedAt:      timestamppb.New(p.CreatedAt),
+	}
+	if p.LastUsedAt != nil {
+		res.LastUsedAt = timestamppb.New(*p.LastUsedAt)
+	}
+	return res
+}
@@ -27,6 +27,7 @@ func ModelToProto(u *md.User) *gen.SSO_User {
 		Password:  u.Password,
 		Email:     u.Email,
 		Avatar:    u.Avatar,
+		Phone:     u.Phone,
 		Roles:     roles,
 		CreatedAt: timestamppb.New(u.CreatedAt),
 		UpdatedAt: timestamppb.New(u.UpdatedAt),
@@ -44,6 +45,7 @@ func ProtoToModel(u *gen.SSO_User) *md.User {
 		Password:  u.Password,
 		Email:     u.Email,
 		Avatar:    u.Avatar,
+		Phone:     u.Phone,
 		Roles:     perms,
 		CreatedAt: u.CreatedAt.AsTime(),
 		UpdatedAt: u.UpdatedAt.AsTime(),
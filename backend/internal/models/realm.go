@@ -0,0 +1,23 @@
+package models
+
+import "time"
+
+// Login methods returned by home-realm discovery.
+const (
+	LoginMethodPassword  = "password"
+	LoginMethodPasskey   = "passkey"
+	LoginMethodOAuth2    = "oauth2"
+	LoginMethodOIDC      = "oidc"
+	LoginMethodSAML      = "saml"
+	LoginMethodLDAP      = "ldap"
+	LoginMethodEmailCode = "email_code"
+)
+
+type RealmDomain struct {
+	ID         uint64    `json:"id" db:"id"`
+	Domain     string    `json:"domain" db:"domain"`
+	Method     string    `json:"method" db:"method"`
+	Connection string    `json:"connection" db:"connection"`
+	ForceSSO   bool      `json:"force_sso" db:"force_sso"`
+	CreatedAt  time.Time `json:"created_at" db:"created_at"`
+}
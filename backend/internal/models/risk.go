@@ -0,0 +1,37 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Decisions of risk engine, ordered from the least to the most strict.
+const (
+	RiskActionAllow   = "allow"
+	RiskActionCaptcha = "captcha"
+	RiskActionMFA     = "mfa"
+	RiskActionBlock   = "block"
+)
+
+// Signals which raised risk score of login attempt.
+const (
+	RiskReasonNewDevice        = "new_device"
+	RiskReasonBadIP            = "bad_ip"
+	RiskReasonNewCountry       = "new_country"
+	RiskReasonImpossibleTravel = "impossible_travel"
+	RiskReasonFailures         = "recent_failures"
+)
+
+// RiskEvent is audit record of single login assessment.
+type RiskEvent struct {
+	ID        int64     `json:"id" db:"id"`
+	UserID    uuid.UUID `json:"user_id" db:"user_id"`
+	DeviceID  string    `json:"device_id" db:"device_id"`
+	IP        string    `json:"ip" db:"ip"`
+	Country   string    `json:"country" db:"country"`
+	Score     int       `json:"score" db:"score"`
+	Action    string    `json:"action" db:"action"`
+	Reasons   []string  `json:"reasons" db:"reasons"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
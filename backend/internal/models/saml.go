@@ -0,0 +1,35 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SAMLProvider struct {
+	ID                uint64            `json:"id" db:"id"`
+	Name              string            `json:"name" db:"name"`
+	EntityID          string            `json:"entity_id" db:"entity_id"`
+	Metadata          string            `json:"metadata" db:"metadata"`
+	AttributeMap      SAMLAttributeMap  `json:"attribute_map" db:"attribute_map"`
+	RoleMap           map[string]string `json:"role_map" db:"role_map"`
+	AllowIDPInitiated bool              `json:"allow_idp_initiated" db:"allow_idp_initiated"`
+	LinkDomains       []string          `json:"link_domains" db:"link_domains"`
+	CreatedAt         time.Time         `json:"created_at" db:"created_at"`
+}
+
+type SAMLAttributeMap struct {
+	Email  string `json:"email"`
+	Name   string `json:"name"`
+	Avatar string `json:"avatar"`
+	Groups string `json:"groups"`
+}
+
+type SAMLConnection struct {
+	ID           uint64    `json:"id" db:"id"`
+	UserID       uuid.UUID `json:"user_id" db:"user_id"`
+	Provider     string    `json:"provider" db:"provider"`
+	NameID       string    `json:"name_id" db:"name_id"`
+	SessionIndex string    `json:"session_index" db:"session_index"`
+	CreatedAt    time.Time `json:"created_at" db:"created_at"`
+}
@@ -0,0 +1,22 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Second factor methods offered in login challenge.
+const (
+	MFAMethodTOTP         = "totp"
+	MFAMethodRecoveryCode = "recovery_code"
+	MFAMethodEmail        = "email"
+)
+
+type TOTP struct {
+	UserID      uuid.UUID  `json:"user_id" db:"user_id"`
+	Secret      string     `json:"-" db:"secret"`
+	LastStep    int64      `json:"-" db:"last_step"`
+	ConfirmedAt *time.Time `json:"confirmed_at" db:"confirmed_at"`
+	CreatedAt   time.Time  `json:"created_at" db:"created_at"`
+}
@@ -7,17 +7,21 @@ import (
 )
 
 type User struct {
-	ID                uuid.UUID          `json:"id" db:"id"`
-	Name              string             `json:"name" db:"name"`
-	Password          string             `json:"password" db:"password"`
-	Email             string             `json:"email" db:"email"`
-	Avatar            string             `json:"avatar" db:"avatar"`
-	IsWA              bool               `json:"is_wa" db:"is_wa"`
-	IsActive          bool               `json:"is_active" db:"is_active"`
-	IsEmailVerified   bool               `json:"is_email_verified" db:"is_email_verified"`
-	Roles             []Role             `json:"roles"`
-	Oauth2Connections []Oauth2Connection `json:"oauth2_connections"`
-	Devices           []Device           `json:"devices"`
-	CreatedAt         time.Time          `json:"created_at" db:"created_at"`
-	UpdatedAt         time.Time          `json:"updated_at" db:"updated_at"`
+	ID              uuid.UUID `json:"id" db:"id"`
+	Name            string    `json:"name" db:"name"`
+	Password        string    `json:"password" db:"password"`
+	Email           string    `json:"email" db:"email"`
+	Avatar          string    `json:"avatar" db:"avatar"`
+	IsWA            bool      `json:"is_wa" db:"is_wa"`
+	IsActive        bool      `json:"is_active" db:"is_active"`
+	IsEmailVerified bool      `json:"is_email_verified" db:"is_email_verified"`
+	Phone           string    `json:"phone,omitempty" db:"phone"`
+	// PasswordChangedAt together with per-role max age decides whether password has expired.
+	PasswordChangedAt  time.Time          `json:"password_changed_at" db:"password_changed_at"`
+	MustChangePassword bool               `json:"must_change_password" db:"must_change_password"`
+	Roles              []Role             `json:"roles"`
+	Oauth2Connections  []Oauth2Connection `json:"oauth2_connections"`
+	Devices            []Device           `json:"devices"`
+	CreatedAt          time.Time          `json:"created_at" db:"created_at"`
+	UpdatedAt          time.Time          `json:"updated_at" db:"updated_at"`
 }
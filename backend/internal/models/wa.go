@@ -1,11 +1,24 @@
 package models
 
 import (
+	"time"
+
 	"github.com/go-webauthn/webauthn/protocol"
 	"github.com/go-webauthn/webauthn/webauthn"
 	"github.com/google/uuid"
 )
 
+// Passkey is user facing view of WebAuthn credential. ID is base64url encoded credential ID.
+type Passkey struct {
+	ID             string     `json:"id"`
+	Name           string     `json:"name"`
+	Transports     []string   `json:"transports"`
+	BackupEligible bool       `json:"backup_eligible"`
+	BackupState    bool       `json:"backup_state"`
+	CreatedAt      time.Time  `json:"created_at"`
+	LastUsedAt     *time.Time `json:"last_used_at"`
+}
+
 type WebauthnUser struct {
 	ID          uuid.UUID
 	Email       string
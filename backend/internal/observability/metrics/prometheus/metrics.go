@@ -43,6 +43,7 @@ func (m *Metric) Start(ctx context.Context) {
 	m.reg.MustRegister(
 		SrvMetrics,
 		RequestMetrics,
+		RateLimitRejections,
 		collectors.NewGoCollector(),
 		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
 	)
@@ -83,3 +84,15 @@ var RequestMetrics = promauto.NewSummaryVec(
 func ObserveRequest(d time.Duration, status int, endpoint string) {
 	RequestMetrics.WithLabelValues(strconv.Itoa(status), endpoint).Observe(d.Seconds())
 }
+
+var RateLimitRejections = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: "svc",
+		Name:      "rate_limit_rejections_total",
+		Help:      "Requests rejected by rate limit policy.",
+	}, []string{"policy", "key"},
+)
+
+func ObserveRateLimitRejection(policy, key string) {
+	RateLimitRejections.WithLabelValues(policy, key).Inc()
+}
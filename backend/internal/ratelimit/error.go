@@ -0,0 +1,11 @@
+package ratelimit
+
+import "errors"
+
+var (
+	// ErrLimitExceeded is error that indicates request rejected by rate limit policy.
+	ErrLimitExceeded = errors.New("rate limit exceeded")
+
+	// ErrInvalidPolicy is error that indicates policy not in limit/window/key format.
+	ErrInvalidPolicy = errors.New("invalid rate limit policy")
+)
@@ -0,0 +1,139 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/JMURv/sso/internal/config"
+	metrics "github.com/JMURv/sso/internal/observability/metrics/prometheus"
+	"go.uber.org/zap"
+)
+
+// Key is request attribute requests are counted by.
+type Key string
+
+const (
+	KeyIP     Key = "ip"
+	KeyUser   Key = "user"
+	KeyEmail  Key = "email"
+	KeyClient Key = "client"
+)
+
+const keyFmt = "ratelimit:%s:%s"
+
+// Store counts hits within sliding window shared by every instance of the service.
+type Store interface {
+	SlidingWindow(ctx context.Context, key string, limit int, window time.Duration) (bool, int, time.Duration, error)
+}
+
+type Policy struct {
+	Limit  int
+	Window time.Duration
+	Key    Key
+}
+
+type Result struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	Reset     time.Duration
+}
+
+// Limiter applies named policies from config. Store errors do not reject requests,
+// so outage of Redis does not take authentication down with it.
+type Limiter struct {
+	store    Store
+	policies map[string]Policy
+}
+
+func New(conf config.Config, store Store) *Limiter {
+	policies, err := ParsePolicies(conf.RateLimit.Policies)
+	if err != nil {
+		zap.L().Fatal("Failed to parse rate limit policies", zap.Error(err))
+	}
+
+	return &Limiter{
+		store:    store,
+		policies: policies,
+	}
+}
+
+// ParsePolicies parses policies in limit/window/key format, e.g. 5/10m/email.
+func ParsePolicies(raw map[string]string) (map[string]Policy, error) {
+	policies := make(map[string]Policy, len(raw))
+	for name, val := range raw {
+		parts := strings.Split(val, "/")
+		if len(parts) != 3 {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidPolicy, name)
+		}
+
+		limit, err := strconv.Atoi(parts[0])
+		if err != nil || limit <= 0 {
+			return nil, fmt.Errorf("%w: %s: limit must be positive number", ErrInvalidPolicy, name)
+		}
+
+		window, err := time.ParseDuration(parts[1])
+		if err != nil || window <= 0 {
+			return nil, fmt.Errorf("%w: %s: window must be positive duration", ErrInvalidPolicy, name)
+		}
+
+		key := Key(parts[2])
+		switch key {
+		case KeyIP, KeyUser, KeyEmail, KeyClient:
+		default:
+			return nil, fmt.Errorf("%w: %s: unknown key %q", ErrInvalidPolicy, name, parts[2])
+		}
+
+		policies[name] = Policy{
+			Limit:  limit,
+			Window: window,
+			Key:    key,
+		}
+	}
+	return policies, nil
+}
+
+func (l *Limiter) Policy(name string) (Policy, bool) {
+	if l == nil {
+		return Policy{}, false
+	}
+
+	p, ok := l.policies[name]
+	return p, ok
+}
+
+// Allow registers hit of subject against named policy. Subject is value of policy key, e.g. IP address.
+func (l *Limiter) Allow(ctx context.Context, name, subject string) Result {
+	p, ok := l.Policy(name)
+	if !ok {
+		return Result{Allowed: true}
+	}
+
+	allowed, count, reset, err := l.store.SlidingWindow(ctx, fmt.Sprintf(keyFmt, name, subject), p.Limit, p.Window)
+	if err != nil {
+		zap.L().Error(
+			"failed to check rate limit",
+			zap.String("policy", name), zap.String("subject", subject),
+			zap.Error(err),
+		)
+		return Result{Allowed: true}
+	}
+
+	if !allowed {
+		metrics.ObserveRateLimitRejection(name, string(p.Key))
+		zap.L().Warn(
+			"rate limit exceeded",
+			zap.String("policy", name), zap.String("subject", subject),
+		)
+	}
+
+	return Result{
+		Allowed:   allowed,
+		Limit:     p.Limit,
+		Remaining: max(p.Limit-count, 0),
+		Reset:     reset,
+	}
+}
@@ -0,0 +1,73 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeStore struct {
+	hits map[string]int
+	err  error
+}
+
+func (s *fakeStore) SlidingWindow(_ context.Context, key string, limit int, window time.Duration) (bool, int, time.Duration, error) {
+	if s.err != nil {
+		return false, 0, 0, s.err
+	}
+
+	if s.hits[key] >= limit {
+		return false, s.hits[key], window, nil
+	}
+	s.hits[key]++
+	return true, s.hits[key], window, nil
+}
+
+func TestParsePolicies(t *testing.T) {
+	policies, err := ParsePolicies(map[string]string{"users_exists": "20/1m/ip", "auth_email_send": "5/10m/email"})
+	require.NoError(t, err)
+	assert.Equal(t, Policy{Limit: 20, Window: time.Minute, Key: KeyIP}, policies["users_exists"])
+	assert.Equal(t, Policy{Limit: 5, Window: 10 * time.Minute, Key: KeyEmail}, policies["auth_email_send"])
+
+	for _, val := range []string{"20/1m", "0/1m/ip", "x/1m/ip", "20/0s/ip", "20/minute/ip", "20/1m/phone"} {
+		_, err = ParsePolicies(map[string]string{"p": val})
+		assert.ErrorIs(t, err, ErrInvalidPolicy, val)
+	}
+}
+
+func TestLimiter_Allow(t *testing.T) {
+	ctx := context.Background()
+	store := &fakeStore{hits: make(map[string]int)}
+	l := &Limiter{
+		store:    store,
+		policies: map[string]Policy{"send": {Limit: 2, Window: time.Minute, Key: KeyEmail}},
+	}
+
+	res := l.Allow(ctx, "send", "test@example.com")
+	assert.Equal(t, Result{Allowed: true, Limit: 2, Remaining: 1, Reset: time.Minute}, res)
+
+	res = l.Allow(ctx, "send", "test@example.com")
+	assert.True(t, res.Allowed)
+	assert.Equal(t, 0, res.Remaining)
+
+	res = l.Allow(ctx, "send", "test@example.com")
+	assert.False(t, res.Allowed)
+	assert.Equal(t, 0, res.Remaining)
+
+	res = l.Allow(ctx, "send", "other@example.com")
+	assert.True(t, res.Allowed)
+
+	res = l.Allow(ctx, "unknown", "test@example.com")
+	assert.Equal(t, Result{Allowed: true}, res)
+
+	store.err = errors.New("connection refused")
+	res = l.Allow(ctx, "send", "test@example.com")
+	assert.True(t, res.Allowed)
+
+	var nilLimiter *Limiter
+	assert.True(t, nilLimiter.Allow(ctx, "send", "test@example.com").Allowed)
+}
@@ -0,0 +1,229 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/JMURv/sso/internal/crypto"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+)
+
+// sensitiveColumn is column stored sealed with key ring. Row is SQL expression identifying
+// the row, its value is bound to ciphertext as associated data.
+type sensitiveColumn struct {
+	table  string
+	column string
+	row    string
+}
+
+var (
+	oauth2AccessToken  = sensitiveColumn{table: "oauth2_connections", column: "access_token", row: "provider || ':' || provider_id"}
+	oauth2RefreshToken = sensitiveColumn{table: "oauth2_connections", column: "refresh_token", row: "provider || ':' || provider_id"}
+	oauth2IDToken      = sensitiveColumn{table: "oauth2_connections", column: "id_token", row: "provider || ':' || provider_id"}
+	totpSecret         = sensitiveColumn{table: "user_totp", column: "secret", row: "user_id::text"}
+)
+
+// sensitiveColumns are re-encrypted with active data key by StartKeyRotation.
+var sensitiveColumns = []sensitiveColumn{oauth2AccessToken, oauth2RefreshToken, oauth2IDToken, totpSecret}
+
+func (c sensitiveColumn) ad(row string) string {
+	return c.table + "." + c.column + ":" + row
+}
+
+// reencryptCursor holds last row listed in every sensitive column. Rows which cannot be decrypted stay
+// stale, cursor steps over them, so they are not listed again by every batch and do not stop the rest.
+type reencryptCursor map[sensitiveColumn]string
+
+type staleSecret struct {
+	Row   string `db:"row_id"`
+	Value string `db:"secret"`
+}
+
+func (r *Repository) ListDataKeys(ctx context.Context) ([]crypto.DataKey, error) {
+	const op = "crypto.ListDataKeys.repo"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res := make([]crypto.DataKey, 0, 4)
+	if err := r.conn.SelectContext(ctx, &res, listDataKeys); err != nil {
+		zap.L().Error(
+			"failed to list data keys",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	return res, nil
+}
+
+func (r *Repository) CreateDataKey(ctx context.Context, wrapped string) (*crypto.DataKey, error) {
+	const op = "crypto.CreateDataKey.repo"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res := &crypto.DataKey{}
+	if err := r.conn.GetContext(ctx, res, createDataKey, wrapped); err != nil {
+		zap.L().Error(
+			"failed to create data key",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	return res, nil
+}
+
+// seal encrypts value of sensitive column, empty value stays empty.
+func (r *Repository) seal(col sensitiveColumn, row, val string) (string, error) {
+	if val == "" {
+		return "", nil
+	}
+
+	res, err := r.kr.Encrypt([]byte(val), col.ad(row))
+	if err != nil {
+		zap.L().Error(
+			"failed to encrypt value",
+			zap.String("column", col.table+"."+col.column),
+			zap.Error(err),
+		)
+		return "", err
+	}
+	return res, nil
+}
+
+// open decrypts value of sensitive column. Values written before encryption was
+// enabled are returned as is, until re-encryption seals them.
+func (r *Repository) open(ctx context.Context, col sensitiveColumn, row, val string) (string, error) {
+	if !crypto.IsSealed(val) {
+		return val, nil
+	}
+
+	res, err := r.kr.Decrypt(val, col.ad(row))
+	if errors.Is(err, crypto.ErrUnknownKey) {
+		// data key may have been created by another instance
+		if err = r.kr.Load(ctx, r); err == nil {
+			res, err = r.kr.Decrypt(val, col.ad(row))
+		}
+	}
+
+	if err != nil {
+		zap.L().Error(
+			"failed to decrypt value",
+			zap.String("column", col.table+"."+col.column),
+			zap.Error(err),
+		)
+		return "", err
+	}
+	return string(res), nil
+}
+
+// reencrypt seals up to batch values of every sensitive column which are plain or sealed with older
+// data key, starting after cursor. It returns number of updated and listed values, nothing listed
+// means every column has been walked through.
+func (r *Repository) reencrypt(ctx context.Context, batch int, cursor reencryptCursor) (int, int, error) {
+	const op = "crypto.reencrypt.repo"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	active, _ := r.kr.Active()
+	count, listed := 0, 0
+	for _, col := range sensitiveColumns {
+		rows := make([]staleSecret, 0, batch)
+		q := fmt.Sprintf(listStaleSecretsQ, col.table, col.column, col.row)
+		if err := r.conn.SelectContext(ctx, &rows, q, crypto.SealedPrefix(active)+"%", cursor[col], batch); err != nil {
+			zap.L().Error(
+				"failed to list stale secrets",
+				zap.String("op", op),
+				zap.String("table", col.table),
+				zap.String("column", col.column),
+				zap.Error(err),
+			)
+			return count, listed, err
+		}
+
+		listed += len(rows)
+		q = fmt.Sprintf(updateSecretQ, col.table, col.column, col.row)
+		for _, row := range rows {
+			cursor[col] = row.Row
+			plain, err := r.open(ctx, col, row.Row, row.Value)
+			if err != nil {
+				continue
+			}
+
+			sealed, err := r.seal(col, row.Row, plain)
+			if err != nil {
+				return count, listed, err
+			}
+
+			res, err := r.conn.ExecContext(ctx, q, sealed, row.Row, row.Value)
+			if err != nil {
+				zap.L().Error(
+					"failed to update secret",
+					zap.String("op", op),
+					zap.String("table", col.table),
+					zap.String("column", col.column),
+					zap.Error(err),
+				)
+				return count, listed, err
+			}
+
+			if aff, err := res.RowsAffected(); err == nil {
+				count += int(aff)
+			}
+		}
+	}
+	return count, listed, nil
+}
+
+// StartKeyRotation periodically creates new data key once active one is older than configured
+// rotation period and re-encrypts values sealed with older keys. Instances may rotate at the same
+// time, it only leaves an extra key, as every instance switches to the newest one on next run.
+func (r *Repository) StartKeyRotation(ctx context.Context) {
+	if r.crypto.ReencryptInterval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(r.crypto.ReencryptInterval)
+	defer ticker.Stop()
+
+	for {
+		r.rotateKeys(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *Repository) rotateKeys(ctx context.Context) {
+	const op = "crypto.rotateKeys.repo"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	if err := r.kr.Load(ctx, r); err != nil {
+		zap.L().Error("failed to load data keys", zap.String("op", op), zap.Error(err))
+		return
+	}
+
+	if _, created := r.kr.Active(); r.crypto.Rotation > 0 && time.Since(created) > r.crypto.Rotation {
+		if _, err := r.kr.Rotate(ctx, r); err != nil {
+			zap.L().Error("failed to rotate data key", zap.String("op", op), zap.Error(err))
+			return
+		}
+	}
+
+	cursor := reencryptCursor{}
+	for {
+		n, listed, err := r.reencrypt(ctx, r.crypto.Batch, cursor)
+		if err != nil || listed == 0 {
+			return
+		}
+		if n > 0 {
+			zap.L().Info("secrets have been re-encrypted", zap.String("op", op), zap.Int("count", n))
+		}
+	}
+}
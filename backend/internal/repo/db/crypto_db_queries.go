@@ -0,0 +1,29 @@
+package db
+
+const listDataKeys = `
+SELECT version, wrapped, created_at
+FROM data_keys
+ORDER BY version
+`
+
+const createDataKey = `
+INSERT INTO data_keys (wrapped)
+VALUES ($1)
+RETURNING version, wrapped, created_at
+`
+
+// listStaleSecretsQ is formatted with table, column and row expression of sensitiveColumn.
+const listStaleSecretsQ = `
+SELECT %[3]s AS row_id, %[2]s AS secret
+FROM %[1]s
+WHERE %[2]s IS NOT NULL AND %[2]s <> '' AND %[2]s NOT LIKE $1 AND %[3]s > $2
+ORDER BY %[3]s
+LIMIT $3
+`
+
+// updateSecretQ compares old value, so concurrent write of fresh secret is not overwritten.
+const updateSecretQ = `
+UPDATE %[1]s
+SET %[2]s = $1
+WHERE %[3]s = $2 AND %[2]s = $3
+`
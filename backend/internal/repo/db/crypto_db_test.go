@@ -0,0 +1,200 @@
+package db
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/base64"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/JMURv/sso/internal/config"
+	"github.com/JMURv/sso/internal/crypto"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+// sealedWith matches argument sealed with given data key version.
+type sealedWith int
+
+func (v sealedWith) Match(arg driver.Value) bool {
+	s, ok := arg.(string)
+	if !ok {
+		return false
+	}
+	version, ok := crypto.Version(s)
+	return ok && version == int(v)
+}
+
+// memKeyStore keeps data keys in memory, so tests only mock queries of sealed columns.
+type memKeyStore struct {
+	keys []crypto.DataKey
+}
+
+func (s *memKeyStore) ListDataKeys(_ context.Context) ([]crypto.DataKey, error) {
+	return s.keys, nil
+}
+
+func (s *memKeyStore) CreateDataKey(_ context.Context, wrapped string) (*crypto.DataKey, error) {
+	dk := crypto.DataKey{Version: len(s.keys) + 1, Wrapped: wrapped, CreatedAt: time.Now()}
+	s.keys = append(s.keys, dk)
+	return &dk, nil
+}
+
+func newCryptoRepo(t *testing.T) (*Repository, sqlmock.Sqlmock) {
+	t.Helper()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	conf := config.Config{}
+	conf.Crypto.MasterKey = base64.StdEncoding.EncodeToString([]byte(strings.Repeat("k", 32)))
+	repo := &Repository{conn: sqlx.NewDb(db, "sqlmock"), kr: crypto.New(conf), crypto: conf.Crypto}
+	require.NoError(t, repo.kr.Load(context.Background(), &memKeyStore{}))
+	return repo, mock
+}
+
+func TestRepository_DataKeys(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: sqlx.NewDb(db, "sqlmock")}
+	now := time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(createDataKey)).
+		WithArgs("wrapped").
+		WillReturnRows(sqlmock.NewRows([]string{"version", "wrapped", "created_at"}).AddRow(2, "wrapped", now))
+
+	dk, err := repo.CreateDataKey(context.Background(), "wrapped")
+	require.NoError(t, err)
+	assert.Equal(t, 2, dk.Version)
+
+	mock.ExpectQuery(regexp.QuoteMeta(listDataKeys)).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"version", "wrapped", "created_at"}).
+				AddRow(1, "old", now).
+				AddRow(2, "wrapped", now),
+		)
+
+	list, err := repo.ListDataKeys(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, list, 2)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_UpsertTOTP_Sealed(t *testing.T) {
+	repo, mock := newCryptoRepo(t)
+	uid := uuid.New()
+
+	mock.ExpectExec(regexp.QuoteMeta(upsertTOTP)).
+		WithArgs(uid, sealedWith(1)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(t, repo.UpsertTOTP(context.Background(), uid, "sealed-by-totp"))
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	stored, err := repo.seal(totpSecret, uid.String(), "sealed-by-totp")
+	require.NoError(t, err)
+
+	mock.ExpectQuery(regexp.QuoteMeta(getTOTP)).
+		WithArgs(uid).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"user_id", "secret", "last_step", "confirmed_at", "created_at"}).
+				AddRow(uid.String(), stored, 0, nil, time.Now()),
+		)
+
+	res, err := repo.GetTOTP(context.Background(), uid)
+	require.NoError(t, err)
+	assert.Equal(t, "sealed-by-totp", res.Secret)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	t.Run("Bound to row", func(t *testing.T) {
+		_, err := repo.open(context.Background(), totpSecret, uuid.NewString(), stored)
+		assert.ErrorIs(t, err, crypto.ErrDecrypt)
+	})
+}
+
+func TestRepository_Reencrypt(t *testing.T) {
+	repo, mock := newCryptoRepo(t)
+	ctx := context.Background()
+
+	for _, col := range sensitiveColumns {
+		rows := sqlmock.NewRows([]string{"row_id", "secret"})
+		if col == oauth2AccessToken {
+			rows.AddRow("google:42", "legacy-plain-token")
+		}
+
+		mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(listStaleSecretsQ, col.table, col.column, col.row))).
+			WithArgs("enc:v1:%", "", 10).
+			WillReturnRows(rows)
+
+		if col == oauth2AccessToken {
+			mock.ExpectExec(regexp.QuoteMeta(fmt.Sprintf(updateSecretQ, col.table, col.column, col.row))).
+				WithArgs(sealedWith(1), "google:42", "legacy-plain-token").
+				WillReturnResult(sqlmock.NewResult(0, 1))
+		}
+	}
+
+	n, listed, err := repo.reencrypt(ctx, 10, reencryptCursor{})
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, 1, listed)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_Reencrypt_Undecryptable(t *testing.T) {
+	repo, mock := newCryptoRepo(t)
+	ctx := context.Background()
+
+	// sealed for another row, so it cannot be decrypted and stays stale
+	broken, err := repo.seal(oauth2AccessToken, "google:0", "token")
+	require.NoError(t, err)
+
+	col := oauth2AccessToken
+	expect := func(after string, rows *sqlmock.Rows, update func()) {
+		for _, c := range sensitiveColumns {
+			res, arg := sqlmock.NewRows([]string{"row_id", "secret"}), ""
+			if c == col {
+				res, arg = rows, after
+			}
+			mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(listStaleSecretsQ, c.table, c.column, c.row))).
+				WithArgs("enc:v1:%", arg, 1).
+				WillReturnRows(res)
+			if c == col && update != nil {
+				update()
+			}
+		}
+	}
+
+	cursor := reencryptCursor{}
+	expect("", sqlmock.NewRows([]string{"row_id", "secret"}).AddRow("google:1", broken), nil)
+	n, listed, err := repo.reencrypt(ctx, 1, cursor)
+	require.NoError(t, err)
+	assert.Equal(t, 0, n)
+	assert.Equal(t, 1, listed)
+
+	expect(
+		"google:1", sqlmock.NewRows([]string{"row_id", "secret"}).AddRow("google:2", "legacy-plain-token"), func() {
+			mock.ExpectExec(regexp.QuoteMeta(fmt.Sprintf(updateSecretQ, col.table, col.column, col.row))).
+				WithArgs(sealedWith(1), "google:2", "legacy-plain-token").
+				WillReturnResult(sqlmock.NewResult(0, 1))
+		},
+	)
+	n, listed, err = repo.reencrypt(ctx, 1, cursor)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, 1, listed)
+
+	expect("google:2", sqlmock.NewRows([]string{"row_id", "secret"}), nil)
+	_, listed, err = repo.reencrypt(ctx, 1, cursor)
+	require.NoError(t, err)
+	assert.Equal(t, 0, listed)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -1,16 +1,20 @@
 package db
 
 import (
+	"context"
 	"fmt"
 
 	conf "github.com/JMURv/sso/internal/config"
+	"github.com/JMURv/sso/internal/crypto"
 	_ "github.com/jackc/pgx/v5/stdlib"
 	"github.com/jmoiron/sqlx"
 	"go.uber.org/zap"
 )
 
 type Repository struct {
-	conn *sqlx.DB
+	conn   *sqlx.DB
+	kr     *crypto.KeyRing
+	crypto conf.CryptoConfig
 }
 
 func New(conf conf.Config) *Repository {
@@ -37,7 +41,12 @@ func New(conf conf.Config) *Repository {
 	}
 
 	mustPrecreate(conf, conn.DB)
-	return &Repository{conn: conn}
+
+	r := &Repository{conn: conn, kr: crypto.New(conf), crypto: conf.Crypto}
+	if err = r.kr.Load(context.Background(), r); err != nil {
+		zap.L().Fatal("failed to load data keys", zap.Error(err))
+	}
+	return r
 }
 
 func (r *Repository) Close() error {
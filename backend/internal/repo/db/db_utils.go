@@ -9,13 +9,13 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/JMURv/sso/internal/auth/password"
 	"github.com/JMURv/sso/internal/config"
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/golang-migrate/migrate/v4/database/pgx/v5"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
-	"golang.org/x/crypto/bcrypt"
 )
 
 func applyMigrations(db *sql.DB, conf config.Config) error {
@@ -84,6 +84,7 @@ func mustPrecreate(conf config.Config, db *sql.DB) {
 		return
 	}
 
+	hasher := password.New(conf)
 	for i := 0; i < len(conf.Auth.Admins); i++ {
 		email := conf.Auth.Admins[i]
 		name := strings.Split(email, "@")[0]
@@ -97,16 +98,16 @@ func mustPrecreate(conf config.Config, db *sql.DB) {
 					return
 				}
 
-				password, err := bcrypt.GenerateFromPassword([]byte(randomPassword), bcrypt.DefaultCost)
+				password, err := hasher.Hash(randomPassword)
 				if err != nil {
 					zap.L().Error("failed to generate password", zap.Error(err))
 					return
 				}
 
 				if err = tx.QueryRow(
-					`INSERT INTO users (name, password, email, avatar, is_active, is_email_verified) 
-			 		 VALUES ($1, $2, $3, $4, $5, $6)
-			 		 RETURNING id`, name, password, email, "", true, true,
+					`INSERT INTO users (name, password, email, avatar, is_active, is_email_verified, must_change_password) 
+			 		 VALUES ($1, $2, $3, $4, $5, $6, $7)
+			 		 RETURNING id`, name, password, email, "", true, true, true,
 				).Scan(&userID); err != nil {
 					zap.L().Error("failed to insert user", zap.Error(err))
 					return
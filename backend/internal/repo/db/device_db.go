@@ -89,6 +89,29 @@ func (r *Repository) GetDeviceByID(ctx context.Context, dID string) (*md.Device,
 	return &res, nil
 }
 
+// UpsertDevice records device without issuing refresh token for it.
+func (r *Repository) UpsertDevice(ctx context.Context, uid uuid.UUID, device *md.Device) error {
+	const op = "auth.UpsertDevice.repo"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	_, err := r.conn.ExecContext(
+		ctx, createUserDevice,
+		device.ID, uid, device.Name, device.DeviceType, device.OS, device.Browser, device.UA, device.IP,
+	)
+	if err != nil {
+		zap.L().Error(
+			"failed to upsert device",
+			zap.String("op", op),
+			zap.String("userID", uid.String()),
+			zap.String("deviceID", device.ID),
+			zap.Error(err),
+		)
+		return err
+	}
+	return nil
+}
+
 func (r *Repository) UpdateDevice(ctx context.Context, uid uuid.UUID, dID string, req *dto.UpdateDeviceRequest) error {
 	const op = "auth.UpdateDevice.repo"
 	span, ctx := opentracing.StartSpanFromContext(ctx, op)
@@ -0,0 +1,113 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/JMURv/sso/internal/dto"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+)
+
+func (r *Repository) IsLDAPUser(ctx context.Context, userID uuid.UUID) (bool, error) {
+	const op = "ldap.IsLDAPUser.repo"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	var res bool
+	if err := r.conn.QueryRowContext(ctx, isLDAPUser, userID).Scan(&res); err != nil {
+		zap.L().Error(
+			"failed to check ldap connection",
+			zap.String("op", op),
+			zap.String("userID", userID.String()),
+			zap.Error(err),
+		)
+		return false, err
+	}
+	return res, nil
+}
+
+func (r *Repository) SyncLDAPUser(ctx context.Context, userID uuid.UUID, req *dto.LDAPUser, managed []string) error {
+	const op = "ldap.SyncLDAPUser.repo"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	tx, err := r.conn.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		zap.L().Error(
+			"failed to begin transaction",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+		return err
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
+			zap.L().Error(
+				"error while transaction rollback",
+				zap.String("op", op),
+				zap.Error(err),
+			)
+		}
+	}()
+
+	if _, err = tx.ExecContext(ctx, upsertLDAPConnection, userID, req.DN); err != nil {
+		zap.L().Error(
+			"failed to upsert ldap connection",
+			zap.String("op", op),
+			zap.String("userID", userID.String()),
+			zap.String("dn", req.DN),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	if _, err = tx.ExecContext(ctx, syncLDAPUserName, req.Name, userID); err != nil {
+		zap.L().Error(
+			"failed to sync user attributes",
+			zap.String("op", op),
+			zap.String("userID", userID.String()),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	if len(managed) > 0 {
+		_, err = tx.ExecContext(ctx, removeLDAPRoles, userID, pq.Array(managed), pq.Array(req.Roles))
+		if err != nil {
+			zap.L().Error(
+				"failed to revoke directory roles",
+				zap.String("op", op),
+				zap.String("userID", userID.String()),
+				zap.Error(err),
+			)
+			return err
+		}
+	}
+
+	if len(req.Roles) > 0 {
+		if _, err = tx.ExecContext(ctx, userAddRolesByNameQ, userID, pq.Array(req.Roles)); err != nil {
+			zap.L().Error(
+				"failed to grant directory roles",
+				zap.String("op", op),
+				zap.String("userID", userID.String()),
+				zap.Strings("roles", req.Roles),
+				zap.Error(err),
+			)
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		zap.L().Error(
+			"failed to commit transaction",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+		return err
+	}
+	return nil
+}
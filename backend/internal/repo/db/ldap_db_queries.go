@@ -0,0 +1,27 @@
+package db
+
+const isLDAPUser = `
+SELECT EXISTS (SELECT 1 FROM ldap_connections WHERE user_id = $1)
+`
+
+const upsertLDAPConnection = `
+INSERT INTO ldap_connections (user_id, dn, synced_at)
+VALUES ($1, $2, NOW())
+ON CONFLICT (user_id) DO UPDATE
+SET dn = EXCLUDED.dn, synced_at = NOW()
+`
+
+const syncLDAPUserName = `
+UPDATE users
+SET name = $1, updated_at = NOW()
+WHERE id = $2 AND $1 <> ''
+`
+
+const removeLDAPRoles = `
+DELETE FROM user_roles ur
+USING roles r
+WHERE ur.role_id = r.id
+  AND ur.user_id = $1
+  AND r.name = ANY($2)
+  AND NOT r.name = ANY($3)
+`
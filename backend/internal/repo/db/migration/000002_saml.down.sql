@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS saml_connections CASCADE;
+DROP TABLE IF EXISTS saml_providers CASCADE;
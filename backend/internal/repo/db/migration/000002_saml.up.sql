@@ -0,0 +1,28 @@
+-- SAML IDENTITY PROVIDERS
+CREATE TABLE IF NOT EXISTS saml_providers (
+    id                  SERIAL PRIMARY KEY,
+    name                VARCHAR(50)  NOT NULL UNIQUE,
+    entity_id           VARCHAR(255) NOT NULL,
+    metadata            TEXT         NOT NULL,
+    attribute_map       JSONB        NOT NULL DEFAULT '{}',
+    role_map            JSONB        NOT NULL DEFAULT '{}',
+    allow_idp_initiated BOOLEAN      NOT NULL DEFAULT FALSE,
+    link_domains        TEXT[]       NOT NULL DEFAULT '{}', -- existing accounts of these domains may be linked by email
+    created_at          TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+-- SAML
+CREATE TABLE IF NOT EXISTS saml_connections (
+    id            SERIAL PRIMARY KEY,
+    user_id       UUID         NOT NULL,
+    provider      VARCHAR(50)  NOT NULL, -- saml_providers.name
+    name_id       VARCHAR(255) NOT NULL, -- NameID from assertion
+    session_index VARCHAR(255),
+    created_at    TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
+    CONSTRAINT fk_provider FOREIGN KEY (provider) REFERENCES saml_providers (name) ON DELETE CASCADE,
+    UNIQUE (provider, name_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_saml_user ON saml_connections (user_id);
+CREATE INDEX IF NOT EXISTS idx_saml_provider ON saml_connections (provider);
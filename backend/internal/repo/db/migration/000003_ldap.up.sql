@@ -0,0 +1,7 @@
+-- LDAP
+CREATE TABLE IF NOT EXISTS ldap_connections (
+    user_id   UUID        PRIMARY KEY,
+    dn        TEXT        NOT NULL UNIQUE, -- distinguished name of directory entry
+    synced_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS realm_domains CASCADE;
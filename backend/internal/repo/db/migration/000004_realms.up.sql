@@ -0,0 +1,9 @@
+-- HOME REALM DISCOVERY
+CREATE TABLE IF NOT EXISTS realm_domains (
+    id         SERIAL PRIMARY KEY,
+    domain     VARCHAR(255) NOT NULL UNIQUE,
+    method     VARCHAR(20)  NOT NULL, -- password, passkey, oauth2, oidc, saml, ldap, email_code
+    connection VARCHAR(50)  NOT NULL DEFAULT '', -- provider name for federated methods
+    force_sso  BOOLEAN      NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
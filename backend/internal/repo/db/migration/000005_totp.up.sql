@@ -0,0 +1,9 @@
+-- TOTP SECOND FACTOR
+CREATE TABLE IF NOT EXISTS user_totp (
+    user_id      UUID PRIMARY KEY,
+    secret       TEXT        NOT NULL, -- AES-GCM sealed, base64
+    last_step    BIGINT      NOT NULL DEFAULT 0,
+    confirmed_at TIMESTAMPTZ,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
@@ -0,0 +1,12 @@
+-- MFA RECOVERY CODES
+CREATE TABLE IF NOT EXISTS recovery_codes (
+    id         SERIAL PRIMARY KEY,
+    user_id    UUID        NOT NULL,
+    code_hash  VARCHAR(64) NOT NULL, -- sha256 of normalized code
+    used_at    TIMESTAMPTZ,
+    used_ip    VARCHAR(45) NOT NULL DEFAULT '',
+    used_ua    TEXT        NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
+    UNIQUE (user_id, code_hash)
+);
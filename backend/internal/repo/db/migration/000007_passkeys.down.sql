@@ -0,0 +1,7 @@
+ALTER TABLE wa_credentials
+    DROP COLUMN IF EXISTS name,
+    DROP COLUMN IF EXISTS transports,
+    DROP COLUMN IF EXISTS backup_eligible,
+    DROP COLUMN IF EXISTS backup_state,
+    DROP COLUMN IF EXISTS created_at,
+    DROP COLUMN IF EXISTS last_used_at;
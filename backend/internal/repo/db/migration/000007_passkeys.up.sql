@@ -0,0 +1,8 @@
+-- PASSKEY METADATA
+ALTER TABLE wa_credentials
+    ADD COLUMN IF NOT EXISTS name            VARCHAR(64) NOT NULL DEFAULT 'Passkey',
+    ADD COLUMN IF NOT EXISTS transports      TEXT[]      NOT NULL DEFAULT '{}',
+    ADD COLUMN IF NOT EXISTS backup_eligible BOOLEAN     NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS backup_state    BOOLEAN     NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    ADD COLUMN IF NOT EXISTS last_used_at    TIMESTAMPTZ;
@@ -0,0 +1,4 @@
+-- VERIFIED PHONE NUMBERS (E.164)
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS phone             VARCHAR(16) UNIQUE,
+    ADD COLUMN IF NOT EXISTS phone_verified_at TIMESTAMPTZ;
@@ -0,0 +1,9 @@
+-- PREVIOUSLY USED PASSWORD HASHES
+CREATE TABLE IF NOT EXISTS password_history (
+    id         SERIAL PRIMARY KEY,
+    user_id    UUID         NOT NULL,
+    hash       VARCHAR(255) NOT NULL,
+    created_at TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_password_history_user ON password_history (user_id, created_at DESC);
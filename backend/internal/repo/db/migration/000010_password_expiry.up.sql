@@ -0,0 +1,4 @@
+-- PASSWORD ROTATION
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS password_changed_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    ADD COLUMN IF NOT EXISTS must_change_password BOOLEAN     NOT NULL DEFAULT FALSE;
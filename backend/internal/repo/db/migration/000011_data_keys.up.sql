@@ -0,0 +1,6 @@
+-- DATA KEYS FOR SECRETS STORED IN COLUMNS, SEALED WITH MASTER KEY
+CREATE TABLE IF NOT EXISTS data_keys (
+    version    SERIAL PRIMARY KEY,
+    wrapped    TEXT        NOT NULL, -- AES-GCM sealed with master key, base64
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS login_risk_events CASCADE;
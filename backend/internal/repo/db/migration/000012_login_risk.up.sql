@@ -0,0 +1,14 @@
+-- RISK ASSESSMENT OF EVERY LOGIN, KEPT FOR AUDIT
+CREATE TABLE IF NOT EXISTS login_risk_events (
+    id         BIGSERIAL PRIMARY KEY,
+    user_id    UUID        NOT NULL,
+    device_id  VARCHAR(36) NOT NULL,
+    ip         VARCHAR(45) NOT NULL DEFAULT '',
+    country    VARCHAR(2)  NOT NULL DEFAULT '',
+    score      INT         NOT NULL,
+    action     VARCHAR(16) NOT NULL,
+    reasons    TEXT[]      NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_login_risk_events_user ON login_risk_events (user_id, created_at DESC);
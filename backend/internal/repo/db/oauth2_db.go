@@ -62,9 +62,20 @@ func (r *Repository) CreateOAuth2Connection(ctx context.Context, userID uuid.UUI
 	span, ctx := opentracing.StartSpanFromContext(ctx, op)
 	defer span.Finish()
 
-	_, err := r.conn.ExecContext(
+	row := provider + ":" + req.ProviderID
+	access, err := r.seal(oauth2AccessToken, row, req.AccessToken)
+	if err != nil {
+		return err
+	}
+
+	refresh, err := r.seal(oauth2RefreshToken, row, req.RefreshToken)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.conn.ExecContext(
 		ctx, createOAuth2Connection,
-		userID, provider, req.ProviderID, req.AccessToken, req.RefreshToken, req.Expiry,
+		userID, provider, req.ProviderID, access, refresh, req.Expiry,
 	)
 	if err != nil {
 		zap.L().Error(
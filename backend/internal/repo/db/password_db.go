@@ -0,0 +1,86 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+)
+
+// ListPasswordHistory returns current password hash followed by up to limit previous ones.
+func (r *Repository) ListPasswordHistory(ctx context.Context, userID uuid.UUID, limit int) ([]string, error) {
+	const op = "password.ListPasswordHistory.repo"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res := make([]string, 0, limit+1)
+	if err := r.conn.SelectContext(ctx, &res, listPasswordHistory, userID, limit); err != nil {
+		zap.L().Error(
+			"failed to list password history",
+			zap.String("op", op),
+			zap.String("userID", userID.String()),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	return res, nil
+}
+
+// AddPasswordHistory stores hash of newly set password and keeps only last keep entries.
+func (r *Repository) AddPasswordHistory(ctx context.Context, userID uuid.UUID, hash string, keep int) error {
+	const op = "password.AddPasswordHistory.repo"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	tx, err := r.conn.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		zap.L().Error(
+			"failed to begin transaction",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+		return err
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
+			zap.L().Error(
+				"error while transaction rollback",
+				zap.String("op", op),
+				zap.Error(err),
+			)
+		}
+	}()
+
+	if _, err = tx.ExecContext(ctx, createPasswordHistory, userID, hash); err != nil {
+		zap.L().Error(
+			"failed to add password history",
+			zap.String("op", op),
+			zap.String("userID", userID.String()),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	if _, err = tx.ExecContext(ctx, trimPasswordHistory, userID, keep); err != nil {
+		zap.L().Error(
+			"failed to trim password history",
+			zap.String("op", op),
+			zap.String("userID", userID.String()),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		zap.L().Error(
+			"failed to commit transaction",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+package db
+
+// listPasswordHistory includes current password, so it is rejected even for users without history yet.
+const listPasswordHistory = `
+SELECT password FROM users WHERE id = $1 AND password IS NOT NULL AND password <> ''
+UNION ALL
+(SELECT hash FROM password_history WHERE user_id = $1 ORDER BY created_at DESC, id DESC LIMIT $2)
+`
+
+const createPasswordHistory = `
+INSERT INTO password_history (user_id, hash) VALUES ($1, $2)
+`
+
+const trimPasswordHistory = `
+DELETE FROM password_history
+WHERE user_id = $1 AND id NOT IN (
+    SELECT id FROM password_history WHERE user_id = $1 ORDER BY created_at DESC, id DESC LIMIT $2
+)
+`
@@ -0,0 +1,78 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+func TestListPasswordHistory(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: &sqlx.DB{DB: db}}
+	testUserID := uuid.New()
+	t.Run(
+		"Success", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta(listPasswordHistory)).
+				WithArgs(testUserID.String(), 5).
+				WillReturnRows(sqlmock.NewRows([]string{"password"}).AddRow("current").AddRow("previous"))
+
+			res, err := repo.ListPasswordHistory(context.Background(), testUserID, 5)
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"current", "previous"}, res)
+
+			err = mock.ExpectationsWereMet()
+			assert.NoError(t, err)
+		},
+	)
+
+	t.Run(
+		"ErrInternal", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta(listPasswordHistory)).
+				WithArgs(testUserID.String(), 5).
+				WillReturnError(errors.New("db error"))
+
+			_, err := repo.ListPasswordHistory(context.Background(), testUserID, 5)
+			assert.Error(t, err)
+
+			err = mock.ExpectationsWereMet()
+			assert.NoError(t, err)
+		},
+	)
+}
+
+func TestAddPasswordHistory(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: &sqlx.DB{DB: db}}
+	testUserID := uuid.New()
+	t.Run(
+		"Success", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(createPasswordHistory)).
+				WithArgs(testUserID.String(), "hash").
+				WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectExec(regexp.QuoteMeta(trimPasswordHistory)).
+				WithArgs(testUserID.String(), 5).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectCommit()
+
+			err := repo.AddPasswordHistory(context.Background(), testUserID, "hash", 5)
+			assert.NoError(t, err)
+
+			err = mock.ExpectationsWereMet()
+			assert.NoError(t, err)
+		},
+	)
+}
@@ -0,0 +1,84 @@
+package db
+
+import (
+	"context"
+
+	"github.com/JMURv/sso/internal/repo"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+)
+
+// SetPhone stores phone number which ownership has already been confirmed.
+func (r *Repository) SetPhone(ctx context.Context, userID uuid.UUID, phone string) error {
+	const op = "phone.SetPhone.repo"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := r.conn.ExecContext(ctx, setPhone, userID, phone)
+	if err != nil {
+		if err, ok := err.(*pgconn.PgError); ok && err.Code == "23505" {
+			zap.L().Debug(
+				"phone already belongs to another user",
+				zap.String("op", op),
+				zap.String("userID", userID.String()),
+			)
+			return repo.ErrAlreadyExists
+		}
+		zap.L().Error(
+			"failed to set phone",
+			zap.String("op", op),
+			zap.String("userID", userID.String()),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	aff, err := res.RowsAffected()
+	if err != nil {
+		zap.L().Error(
+			"failed to get affected rows",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	if aff == 0 {
+		return repo.ErrNotFound
+	}
+	return nil
+}
+
+func (r *Repository) DeletePhone(ctx context.Context, userID uuid.UUID) error {
+	const op = "phone.DeletePhone.repo"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := r.conn.ExecContext(ctx, deletePhone, userID)
+	if err != nil {
+		zap.L().Error(
+			"failed to delete phone",
+			zap.String("op", op),
+			zap.String("userID", userID.String()),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	aff, err := res.RowsAffected()
+	if err != nil {
+		zap.L().Error(
+			"failed to get affected rows",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	if aff == 0 {
+		return repo.ErrNotFound
+	}
+	return nil
+}
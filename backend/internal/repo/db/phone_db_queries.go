@@ -0,0 +1,13 @@
+package db
+
+const setPhone = `
+UPDATE users
+SET phone = $2, phone_verified_at = NOW()
+WHERE id = $1
+`
+
+const deletePhone = `
+UPDATE users
+SET phone = NULL, phone_verified_at = NULL
+WHERE id = $1 AND phone IS NOT NULL
+`
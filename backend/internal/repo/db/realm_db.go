@@ -0,0 +1,116 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"github.com/JMURv/sso/internal/dto"
+	md "github.com/JMURv/sso/internal/models"
+	"github.com/JMURv/sso/internal/repo"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+)
+
+func (r *Repository) ListRealmDomains(ctx context.Context) ([]md.RealmDomain, error) {
+	const op = "realms.ListRealmDomains.repo"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res := make([]md.RealmDomain, 0)
+	if err := r.conn.SelectContext(ctx, &res, listRealmDomains); err != nil {
+		zap.L().Error(
+			"failed to list realm domains",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	return res, nil
+}
+
+func (r *Repository) CreateRealmDomain(ctx context.Context, req *dto.CreateRealmDomainRequest) (uint64, error) {
+	const op = "realms.CreateRealmDomain.repo"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	var id uint64
+	err := r.conn.QueryRowContext(
+		ctx, createRealmDomain,
+		strings.ToLower(req.Domain), req.Method, req.Connection, req.ForceSSO,
+	).Scan(&id)
+	if err != nil {
+		if err, ok := err.(*pgconn.PgError); ok && err.Code == "23505" {
+			zap.L().Debug(
+				"realm domain already exists",
+				zap.String("op", op),
+				zap.String("domain", req.Domain),
+			)
+			return 0, repo.ErrAlreadyExists
+		}
+		zap.L().Error(
+			"failed to create realm domain",
+			zap.String("op", op),
+			zap.String("domain", req.Domain),
+			zap.Error(err),
+		)
+		return 0, err
+	}
+	return id, nil
+}
+
+func (r *Repository) DeleteRealmDomain(ctx context.Context, domain string) error {
+	const op = "realms.DeleteRealmDomain.repo"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := r.conn.ExecContext(ctx, deleteRealmDomain, strings.ToLower(domain))
+	if err != nil {
+		zap.L().Error(
+			"failed to delete realm domain",
+			zap.String("op", op),
+			zap.String("domain", domain),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	aff, err := res.RowsAffected()
+	if err != nil {
+		zap.L().Error(
+			"failed to get affected rows",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	if aff == 0 {
+		return repo.ErrNotFound
+	}
+	return nil
+}
+
+func (r *Repository) GetUserConnection(ctx context.Context, userID uuid.UUID) (string, string, error) {
+	const op = "realms.GetUserConnection.repo"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	var method, provider string
+	err := r.conn.QueryRowContext(ctx, getUserConnection, userID).Scan(&method, &provider)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", "", repo.ErrNotFound
+	} else if err != nil {
+		zap.L().Error(
+			"failed to get user connection",
+			zap.String("op", op),
+			zap.String("userID", userID.String()),
+			zap.Error(err),
+		)
+		return "", "", err
+	}
+	return method, provider, nil
+}
@@ -0,0 +1,27 @@
+package db
+
+const listRealmDomains = `
+SELECT id, domain, method, connection, force_sso, created_at
+FROM realm_domains
+ORDER BY domain
+`
+
+const createRealmDomain = `
+INSERT INTO realm_domains (domain, method, connection, force_sso)
+VALUES ($1, $2, $3, $4)
+RETURNING id
+`
+
+const deleteRealmDomain = `
+DELETE FROM realm_domains WHERE domain = $1
+`
+
+const getUserConnection = `
+SELECT method, provider FROM (
+    SELECT 'saml' AS method, provider, created_at FROM saml_connections WHERE user_id = $1
+    UNION ALL
+    SELECT 'oauth2' AS method, provider, created_at FROM oauth2_connections WHERE user_id = $1
+) c
+ORDER BY created_at DESC
+LIMIT 1
+`
@@ -0,0 +1,122 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	md "github.com/JMURv/sso/internal/models"
+	"github.com/JMURv/sso/internal/repo"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+)
+
+// ReplaceRecoveryCodes drops all codes of the user, used ones included, and stores new set.
+func (r *Repository) ReplaceRecoveryCodes(ctx context.Context, userID uuid.UUID, hashes []string) error {
+	const op = "recovery.ReplaceRecoveryCodes.repo"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	tx, err := r.conn.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		zap.L().Error(
+			"failed to begin transaction",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+		return err
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
+			zap.L().Error(
+				"error while transaction rollback",
+				zap.String("op", op),
+				zap.Error(err),
+			)
+		}
+	}()
+
+	if _, err = tx.ExecContext(ctx, deleteRecoveryCodes, userID); err != nil {
+		zap.L().Error(
+			"failed to delete recovery codes",
+			zap.String("op", op),
+			zap.String("userID", userID.String()),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	if _, err = tx.ExecContext(ctx, createRecoveryCodes, userID, pq.Array(hashes)); err != nil {
+		zap.L().Error(
+			"failed to create recovery codes",
+			zap.String("op", op),
+			zap.String("userID", userID.String()),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		zap.L().Error(
+			"failed to commit transaction",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+		return err
+	}
+	return nil
+}
+
+// UseRecoveryCode marks code as used by the device. It returns ErrNotFound
+// when there is no such unused code.
+func (r *Repository) UseRecoveryCode(ctx context.Context, userID uuid.UUID, hash string, d *md.Device) error {
+	const op = "recovery.UseRecoveryCode.repo"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := r.conn.ExecContext(ctx, useRecoveryCode, userID, hash, d.IP, d.UA)
+	if err != nil {
+		zap.L().Error(
+			"failed to use recovery code",
+			zap.String("op", op),
+			zap.String("userID", userID.String()),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	aff, err := res.RowsAffected()
+	if err != nil {
+		zap.L().Error(
+			"failed to get affected rows",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	if aff == 0 {
+		return repo.ErrNotFound
+	}
+	return nil
+}
+
+func (r *Repository) CountRecoveryCodes(ctx context.Context, userID uuid.UUID) (int, error) {
+	const op = "recovery.CountRecoveryCodes.repo"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	var res int
+	if err := r.conn.QueryRowContext(ctx, countRecoveryCodes, userID).Scan(&res); err != nil {
+		zap.L().Error(
+			"failed to count recovery codes",
+			zap.String("op", op),
+			zap.String("userID", userID.String()),
+			zap.Error(err),
+		)
+		return 0, err
+	}
+	return res, nil
+}
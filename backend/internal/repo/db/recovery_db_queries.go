@@ -0,0 +1,20 @@
+package db
+
+const deleteRecoveryCodes = `
+DELETE FROM recovery_codes WHERE user_id = $1
+`
+
+const createRecoveryCodes = `
+INSERT INTO recovery_codes (user_id, code_hash)
+SELECT $1, UNNEST($2::VARCHAR[])
+`
+
+const useRecoveryCode = `
+UPDATE recovery_codes
+SET used_at = NOW(), used_ip = $3, used_ua = $4
+WHERE user_id = $1 AND code_hash = $2 AND used_at IS NULL
+`
+
+const countRecoveryCodes = `
+SELECT COUNT(*) FROM recovery_codes WHERE user_id = $1 AND used_at IS NULL
+`
@@ -0,0 +1,115 @@
+package db
+
+import (
+	"context"
+
+	"github.com/JMURv/sso/internal/dto"
+	md "github.com/JMURv/sso/internal/models"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+)
+
+func (r *Repository) CreateRiskEvent(ctx context.Context, e *md.RiskEvent) error {
+	const op = "risk.CreateRiskEvent.repo"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	_, err := r.conn.ExecContext(
+		ctx, createRiskEvent,
+		e.UserID, e.DeviceID, e.IP, e.Country, e.Score, e.Action, pq.Array(e.Reasons),
+	)
+	if err != nil {
+		zap.L().Error(
+			"failed to create risk event",
+			zap.String("op", op),
+			zap.String("userID", e.UserID.String()),
+			zap.Error(err),
+		)
+		return err
+	}
+	return nil
+}
+
+func (r *Repository) ListRiskEvents(ctx context.Context, uid uuid.UUID, page, size int) (*dto.PaginatedRiskEventResponse, error) {
+	const op = "risk.ListRiskEvents.repo"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	var count int64
+	if err := r.conn.QueryRowContext(ctx, countRiskEvents, uid).Scan(&count); err != nil {
+		zap.L().Error(
+			"failed to count risk events",
+			zap.String("op", op),
+			zap.String("userID", uid.String()),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	rows, err := r.conn.QueryxContext(ctx, listRiskEvents, uid, size, (page-1)*size)
+	if err != nil {
+		zap.L().Error(
+			"failed to list risk events",
+			zap.String("op", op),
+			zap.String("userID", uid.String()),
+			zap.Int("page", page),
+			zap.Int("size", size),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	defer func(rows *sqlx.Rows) {
+		if err := rows.Close(); err != nil {
+			zap.L().Error(
+				"failed to close rows",
+				zap.String("op", op),
+				zap.Error(err),
+			)
+		}
+	}(rows)
+
+	res := make([]md.RiskEvent, 0, size)
+	for rows.Next() {
+		e := md.RiskEvent{}
+		if err = rows.Scan(
+			&e.ID,
+			&e.UserID,
+			&e.DeviceID,
+			&e.IP,
+			&e.Country,
+			&e.Score,
+			&e.Action,
+			pq.Array(&e.Reasons),
+			&e.CreatedAt,
+		); err != nil {
+			zap.L().Error(
+				"failed to scan risk event",
+				zap.String("op", op),
+				zap.Error(err),
+			)
+			return nil, err
+		}
+		res = append(res, e)
+	}
+
+	if err = rows.Err(); err != nil {
+		zap.L().Error(
+			"failed to scan rows",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	totalPages := int((count + int64(size) - 1) / int64(size))
+	return &dto.PaginatedRiskEventResponse{
+		Data:        res,
+		Count:       count,
+		TotalPages:  totalPages,
+		CurrentPage: page,
+		HasNextPage: page < totalPages,
+	}, nil
+}
@@ -0,0 +1,18 @@
+package db
+
+const createRiskEvent = `
+INSERT INTO login_risk_events (user_id, device_id, ip, country, score, action, reasons)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+`
+
+const countRiskEvents = `
+SELECT COUNT(*) FROM login_risk_events WHERE user_id = $1
+`
+
+const listRiskEvents = `
+SELECT id, user_id, device_id, ip, country, score, action, reasons, created_at
+FROM login_risk_events
+WHERE user_id = $1
+ORDER BY created_at DESC, id DESC
+LIMIT $2 OFFSET $3
+`
@@ -0,0 +1,104 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	md "github.com/JMURv/sso/internal/models"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+func TestCreateRiskEvent(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: &sqlx.DB{DB: db}}
+	e := &md.RiskEvent{
+		UserID:   uuid.New(),
+		DeviceID: "device",
+		IP:       "81.2.69.10",
+		Country:  "GB",
+		Score:    45,
+		Action:   md.RiskActionCaptcha,
+		Reasons:  []string{md.RiskReasonNewDevice, md.RiskReasonNewCountry},
+	}
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mock.ExpectExec(regexp.QuoteMeta(createRiskEvent)).
+				WithArgs(e.UserID, e.DeviceID, e.IP, e.Country, e.Score, e.Action, pq.Array(e.Reasons)).
+				WillReturnResult(sqlmock.NewResult(1, 1))
+
+			assert.NoError(t, repo.CreateRiskEvent(context.Background(), e))
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"ErrInternal", func(t *testing.T) {
+			mock.ExpectExec(regexp.QuoteMeta(createRiskEvent)).
+				WillReturnError(errors.New("db error"))
+
+			assert.Error(t, repo.CreateRiskEvent(context.Background(), e))
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+}
+
+func TestListRiskEvents(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: sqlx.NewDb(db, "sqlmock")}
+	uid := uuid.New()
+	now := time.Now()
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta(countRiskEvents)).
+				WithArgs(uid).
+				WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+			mock.ExpectQuery(regexp.QuoteMeta(listRiskEvents)).
+				WithArgs(uid, 2, 0).
+				WillReturnRows(
+					sqlmock.NewRows(
+						[]string{"id", "user_id", "device_id", "ip", "country", "score", "action", "reasons", "created_at"},
+					).
+						AddRow(2, uid.String(), "device", "1.128.0.10", "AU", 70, md.RiskActionMFA, "{new_country,impossible_travel}", now).
+						AddRow(1, uid.String(), "device", "81.2.69.10", "GB", 0, md.RiskActionAllow, "{}", now),
+				)
+
+			res, err := repo.ListRiskEvents(context.Background(), uid, 1, 2)
+			require.NoError(t, err)
+			assert.Equal(t, int64(3), res.Count)
+			assert.Equal(t, 2, res.TotalPages)
+			assert.True(t, res.HasNextPage)
+			require.Len(t, res.Data, 2)
+			assert.Equal(t, []string{md.RiskReasonNewCountry, md.RiskReasonImpossibleTravel}, res.Data[0].Reasons)
+			assert.Empty(t, res.Data[1].Reasons)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"ErrInternal", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta(countRiskEvents)).
+				WithArgs(uid).
+				WillReturnError(errors.New("db error"))
+
+			_, err := repo.ListRiskEvents(context.Background(), uid, 1, 2)
+			assert.Error(t, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+}
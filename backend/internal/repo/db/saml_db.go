@@ -0,0 +1,272 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/JMURv/sso/internal/dto"
+	md "github.com/JMURv/sso/internal/models"
+	"github.com/JMURv/sso/internal/repo"
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/lib/pq"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+)
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanSAMLProvider(row rowScanner) (*md.SAMLProvider, error) {
+	var attrs, roles []byte
+	res := &md.SAMLProvider{}
+	if err := row.Scan(
+		&res.ID,
+		&res.Name,
+		&res.EntityID,
+		&res.Metadata,
+		&attrs,
+		&roles,
+		&res.AllowIDPInitiated,
+		pq.Array(&res.LinkDomains),
+		&res.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(attrs, &res.AttributeMap); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(roles, &res.RoleMap); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (r *Repository) ListSAMLProviders(ctx context.Context) ([]md.SAMLProvider, error) {
+	const op = "saml.ListSAMLProviders.repo"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	rows, err := r.conn.QueryContext(ctx, listSAMLProviders)
+	if err != nil {
+		zap.L().Error(
+			"failed to list saml providers",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		if err := rows.Close(); err != nil {
+			zap.L().Error(
+				"failed to close rows",
+				zap.String("op", op),
+				zap.Error(err),
+			)
+		}
+	}(rows)
+
+	res := make([]md.SAMLProvider, 0)
+	for rows.Next() {
+		p, err := scanSAMLProvider(rows)
+		if err != nil {
+			zap.L().Error(
+				"failed to scan saml provider",
+				zap.String("op", op),
+				zap.Error(err),
+			)
+			return nil, err
+		}
+		res = append(res, *p)
+	}
+
+	if err = rows.Err(); err != nil {
+		zap.L().Error(
+			"failed to scan rows",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	return res, nil
+}
+
+func (r *Repository) GetSAMLProvider(ctx context.Context, name string) (*md.SAMLProvider, error) {
+	const op = "saml.GetSAMLProvider.repo"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := scanSAMLProvider(r.conn.QueryRowContext(ctx, getSAMLProvider, name))
+	if errors.Is(err, sql.ErrNoRows) {
+		zap.L().Debug(
+			"no saml provider found",
+			zap.String("op", op),
+			zap.String("name", name),
+		)
+		return nil, repo.ErrNotFound
+	} else if err != nil {
+		zap.L().Error(
+			"failed to get saml provider",
+			zap.String("op", op),
+			zap.String("name", name),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	return res, nil
+}
+
+func (r *Repository) CreateSAMLProvider(ctx context.Context, entityID string, req *dto.CreateSAMLProviderRequest) (uint64, error) {
+	const op = "saml.CreateSAMLProvider.repo"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	attrs, err := json.Marshal(req.AttributeMap)
+	if err != nil {
+		zap.L().Error("failed to marshal attribute map", zap.String("op", op), zap.Error(err))
+		return 0, err
+	}
+
+	if req.RoleMap == nil {
+		req.RoleMap = map[string]string{}
+	}
+
+	if req.LinkDomains == nil {
+		req.LinkDomains = []string{}
+	}
+
+	roles, err := json.Marshal(req.RoleMap)
+	if err != nil {
+		zap.L().Error("failed to marshal role map", zap.String("op", op), zap.Error(err))
+		return 0, err
+	}
+
+	var id uint64
+	err = r.conn.QueryRowContext(
+		ctx, createSAMLProvider,
+		req.Name, entityID, req.Metadata, attrs, roles, req.AllowIDPInitiated, pq.Array(req.LinkDomains),
+	).Scan(&id)
+	if err != nil {
+		if err, ok := err.(*pgconn.PgError); ok && err.Code == "23505" {
+			zap.L().Debug(
+				"saml provider already exists",
+				zap.String("op", op),
+				zap.String("name", req.Name),
+			)
+			return 0, repo.ErrAlreadyExists
+		}
+		zap.L().Error(
+			"failed to create saml provider",
+			zap.String("op", op),
+			zap.String("name", req.Name),
+			zap.Error(err),
+		)
+		return 0, err
+	}
+	return id, nil
+}
+
+func (r *Repository) DeleteSAMLProvider(ctx context.Context, name string) error {
+	const op = "saml.DeleteSAMLProvider.repo"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := r.conn.ExecContext(ctx, deleteSAMLProvider, name)
+	if err != nil {
+		zap.L().Error(
+			"failed to delete saml provider",
+			zap.String("op", op),
+			zap.String("name", name),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	aff, err := res.RowsAffected()
+	if err != nil {
+		zap.L().Error(
+			"failed to get affected rows",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	if aff == 0 {
+		zap.L().Debug(
+			"failed to find saml provider",
+			zap.String("op", op),
+			zap.String("name", name),
+		)
+		return repo.ErrNotFound
+	}
+	return nil
+}
+
+func (r *Repository) GetUserBySAML(ctx context.Context, provider, nameID string) (*md.User, error) {
+	const op = "saml.GetUserBySAML.repo"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res := &md.User{}
+	roles := make([]string, 0, 5)
+	err := r.conn.QueryRowContext(ctx, getUserSAML, provider, nameID).Scan(
+		&res.ID, &res.Email, &res.Name, &res.Avatar, pq.Array(&roles),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			zap.L().Debug(
+				"no user found",
+				zap.String("op", op),
+				zap.String("provider", provider),
+				zap.String("nameID", nameID),
+			)
+			return nil, repo.ErrNotFound
+		}
+		zap.L().Error(
+			"failed to get user",
+			zap.String("op", op),
+			zap.String("provider", provider),
+			zap.String("nameID", nameID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	res.Roles, err = ScanRoles(roles)
+	if err != nil {
+		zap.L().Error(
+			"failed to scan roles",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	return res, nil
+}
+
+func (r *Repository) CreateSAMLConnection(ctx context.Context, userID uuid.UUID, provider string, req *dto.SAMLAssertion) error {
+	const op = "saml.CreateSAMLConnection.repo"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	_, err := r.conn.ExecContext(
+		ctx, createSAMLConnection,
+		userID, provider, req.NameID, req.SessionIndex,
+	)
+	if err != nil {
+		zap.L().Error(
+			"failed to create saml connection",
+			zap.String("op", op),
+			zap.String("userID", userID.String()),
+			zap.String("provider", provider),
+			zap.Error(err),
+		)
+	}
+	return err
+}
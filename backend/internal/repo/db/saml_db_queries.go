@@ -0,0 +1,46 @@
+package db
+
+const listSAMLProviders = `
+SELECT id, name, entity_id, metadata, attribute_map, role_map, allow_idp_initiated, link_domains, created_at
+FROM saml_providers
+ORDER BY name
+`
+
+const getSAMLProvider = `
+SELECT id, name, entity_id, metadata, attribute_map, role_map, allow_idp_initiated, link_domains, created_at
+FROM saml_providers
+WHERE name = $1
+`
+
+const createSAMLProvider = `
+INSERT INTO saml_providers (name, entity_id, metadata, attribute_map, role_map, allow_idp_initiated, link_domains)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id
+`
+
+const deleteSAMLProvider = `
+DELETE FROM saml_providers
+WHERE name = $1
+`
+
+const getUserSAML = `
+SELECT 
+    u.id,
+    u.email,
+    u.name,
+    u.avatar,
+    ARRAY_AGG(r.id || '|' || r.name || '|' || r.description) FILTER (WHERE r.id IS NOT NULL) AS roles
+FROM users u
+JOIN saml_connections sc ON u.id = sc.user_id
+LEFT JOIN user_roles ur ON ur.user_id = u.id
+LEFT JOIN roles r ON r.id = ur.role_id
+WHERE sc.provider = $1 AND sc.name_id = $2
+GROUP BY u.id
+`
+
+const createSAMLConnection = `
+INSERT INTO saml_connections (user_id, provider, name_id, session_index)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (provider, name_id) DO UPDATE
+SET session_index = EXCLUDED.session_index
+`
@@ -0,0 +1,175 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/JMURv/sso/internal/dto"
+	md "github.com/JMURv/sso/internal/models"
+	rrepo "github.com/JMURv/sso/internal/repo"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+var samlProviderColumns = []string{
+	"id", "name", "entity_id", "metadata", "attribute_map", "role_map", "allow_idp_initiated", "link_domains", "created_at",
+}
+
+func TestGetSAMLProvider(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: &sqlx.DB{DB: db}}
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta(getSAMLProvider)).
+				WithArgs("corp").
+				WillReturnRows(
+					sqlmock.NewRows(samlProviderColumns).AddRow(
+						1, "corp", "https://idp.corp.com", "<xml/>",
+						`{"email":"mail","groups":"memberOf"}`, `{"admins":"admin"}`,
+						true, "{corp.com,corp.io}", time.Now(),
+					),
+				)
+
+			res, err := repo.GetSAMLProvider(context.Background(), "corp")
+			require.NoError(t, err)
+			assert.Equal(t, "mail", res.AttributeMap.Email)
+			assert.Equal(t, map[string]string{"admins": "admin"}, res.RoleMap)
+			assert.Equal(t, []string{"corp.com", "corp.io"}, res.LinkDomains)
+			assert.True(t, res.AllowIDPInitiated)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"ErrNotFound", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta(getSAMLProvider)).
+				WithArgs("missing").
+				WillReturnRows(sqlmock.NewRows(samlProviderColumns))
+
+			_, err := repo.GetSAMLProvider(context.Background(), "missing")
+			assert.ErrorIs(t, err, rrepo.ErrNotFound)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+}
+
+func TestCreateSAMLProvider(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: &sqlx.DB{DB: db}}
+	req := &dto.CreateSAMLProviderRequest{
+		Name:     "corp",
+		Metadata: "<xml/>",
+	}
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta(createSAMLProvider)).
+				WithArgs(
+					req.Name, "https://idp.corp.com", req.Metadata,
+					[]byte(`{"email":"","name":"","avatar":"","groups":""}`), []byte(`{}`),
+					false, pq.Array([]string{}),
+				).
+				WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+
+			id, err := repo.CreateSAMLProvider(context.Background(), "https://idp.corp.com", req)
+			require.NoError(t, err)
+			assert.Equal(t, uint64(1), id)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"ErrAlreadyExists", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta(createSAMLProvider)).
+				WillReturnError(&pgconn.PgError{Code: "23505"})
+
+			_, err := repo.CreateSAMLProvider(context.Background(), "https://idp.corp.com", req)
+			assert.ErrorIs(t, err, rrepo.ErrAlreadyExists)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+}
+
+func TestGetUserBySAML(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: &sqlx.DB{DB: db}}
+	uid := uuid.New()
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta(getUserSAML)).
+				WithArgs("corp", "name-id").
+				WillReturnRows(
+					sqlmock.NewRows([]string{"id", "email", "name", "avatar", "roles"}).
+						AddRow(uid.String(), "john@corp.com", "John", "", `{"1|admin|Administrator"}`),
+				)
+
+			res, err := repo.GetUserBySAML(context.Background(), "corp", "name-id")
+			require.NoError(t, err)
+			assert.Equal(t, uid, res.ID)
+			assert.Equal(t, []md.Role{{ID: 1, Name: "admin", Description: "Administrator"}}, res.Roles)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"ErrNotFound", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta(getUserSAML)).
+				WithArgs("corp", "unknown").
+				WillReturnRows(sqlmock.NewRows([]string{"id", "email", "name", "avatar", "roles"}))
+
+			_, err := repo.GetUserBySAML(context.Background(), "corp", "unknown")
+			assert.ErrorIs(t, err, rrepo.ErrNotFound)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+}
+
+func TestCreateSAMLConnection(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: &sqlx.DB{DB: db}}
+	uid := uuid.New()
+	assertion := &dto.SAMLAssertion{NameID: "name-id", SessionIndex: "session"}
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mock.ExpectExec(regexp.QuoteMeta(createSAMLConnection)).
+				WithArgs(uid, "corp", assertion.NameID, assertion.SessionIndex).
+				WillReturnResult(sqlmock.NewResult(1, 1))
+
+			assert.NoError(t, repo.CreateSAMLConnection(context.Background(), uid, "corp", assertion))
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"ErrInternal", func(t *testing.T) {
+			mock.ExpectExec(regexp.QuoteMeta(createSAMLConnection)).
+				WillReturnError(errors.New("db error"))
+
+			assert.Error(t, repo.CreateSAMLConnection(context.Background(), uid, "corp", assertion))
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+}
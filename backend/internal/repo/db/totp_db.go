@@ -0,0 +1,169 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	md "github.com/JMURv/sso/internal/models"
+	"github.com/JMURv/sso/internal/repo"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+)
+
+func (r *Repository) GetTOTP(ctx context.Context, userID uuid.UUID) (*md.TOTP, error) {
+	const op = "totp.GetTOTP.repo"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res := &md.TOTP{}
+	err := r.conn.GetContext(ctx, res, getTOTP, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repo.ErrNotFound
+	} else if err != nil {
+		zap.L().Error(
+			"failed to get totp",
+			zap.String("op", op),
+			zap.String("userID", userID.String()),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	if res.Secret, err = r.open(ctx, totpSecret, userID.String(), res.Secret); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (r *Repository) UpsertTOTP(ctx context.Context, userID uuid.UUID, secret string) error {
+	const op = "totp.UpsertTOTP.repo"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	sealed, err := r.seal(totpSecret, userID.String(), secret)
+	if err != nil {
+		return err
+	}
+
+	res, err := r.conn.ExecContext(ctx, upsertTOTP, userID, sealed)
+	if err != nil {
+		zap.L().Error(
+			"failed to upsert totp",
+			zap.String("op", op),
+			zap.String("userID", userID.String()),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	aff, err := res.RowsAffected()
+	if err != nil {
+		zap.L().Error(
+			"failed to get affected rows",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	if aff == 0 {
+		return repo.ErrAlreadyExists
+	}
+	return nil
+}
+
+func (r *Repository) ConfirmTOTP(ctx context.Context, userID uuid.UUID, step int64) error {
+	const op = "totp.ConfirmTOTP.repo"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := r.conn.ExecContext(ctx, confirmTOTP, userID, step)
+	if err != nil {
+		zap.L().Error(
+			"failed to confirm totp",
+			zap.String("op", op),
+			zap.String("userID", userID.String()),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	aff, err := res.RowsAffected()
+	if err != nil {
+		zap.L().Error(
+			"failed to get affected rows",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	if aff == 0 {
+		return repo.ErrNotFound
+	}
+	return nil
+}
+
+// UseTOTPStep moves last accepted step forward. It returns false when step is not
+// newer than the stored one, which means code has already been used.
+func (r *Repository) UseTOTPStep(ctx context.Context, userID uuid.UUID, step int64) (bool, error) {
+	const op = "totp.UseTOTPStep.repo"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := r.conn.ExecContext(ctx, useTOTPStep, userID, step)
+	if err != nil {
+		zap.L().Error(
+			"failed to update totp step",
+			zap.String("op", op),
+			zap.String("userID", userID.String()),
+			zap.Error(err),
+		)
+		return false, err
+	}
+
+	aff, err := res.RowsAffected()
+	if err != nil {
+		zap.L().Error(
+			"failed to get affected rows",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+		return false, err
+	}
+	return aff > 0, nil
+}
+
+func (r *Repository) DeleteTOTP(ctx context.Context, userID uuid.UUID) error {
+	const op = "totp.DeleteTOTP.repo"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := r.conn.ExecContext(ctx, deleteTOTP, userID)
+	if err != nil {
+		zap.L().Error(
+			"failed to delete totp",
+			zap.String("op", op),
+			zap.String("userID", userID.String()),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	aff, err := res.RowsAffected()
+	if err != nil {
+		zap.L().Error(
+			"failed to get affected rows",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	if aff == 0 {
+		return repo.ErrNotFound
+	}
+	return nil
+}
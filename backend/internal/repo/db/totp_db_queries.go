@@ -0,0 +1,32 @@
+package db
+
+const getTOTP = `
+SELECT user_id, secret, last_step, confirmed_at, created_at
+FROM user_totp
+WHERE user_id = $1
+`
+
+// upsertTOTP replaces pending enrollment but never overwrites confirmed one.
+const upsertTOTP = `
+INSERT INTO user_totp (user_id, secret)
+VALUES ($1, $2)
+ON CONFLICT (user_id) DO UPDATE
+SET secret = EXCLUDED.secret, last_step = 0, created_at = NOW()
+WHERE user_totp.confirmed_at IS NULL
+`
+
+const confirmTOTP = `
+UPDATE user_totp
+SET confirmed_at = NOW(), last_step = $2
+WHERE user_id = $1 AND confirmed_at IS NULL
+`
+
+const useTOTPStep = `
+UPDATE user_totp
+SET last_step = $2
+WHERE user_id = $1 AND last_step < $2
+`
+
+const deleteTOTP = `
+DELETE FROM user_totp WHERE user_id = $1
+`
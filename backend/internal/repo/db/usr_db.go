@@ -70,6 +70,7 @@ func (r *Repository) ListUsers(ctx context.Context, page, size int, filters map[
 			&user.IsWA,
 			&user.IsActive,
 			&user.IsEmailVerified,
+			&user.Phone,
 			&user.CreatedAt,
 			&user.UpdatedAt,
 			pq.Array(&roles),
@@ -151,6 +152,9 @@ func (r *Repository) GetUserByID(ctx context.Context, userID uuid.UUID) (*md.Use
 		&res.IsWA,
 		&res.IsActive,
 		&res.IsEmailVerified,
+		&res.Phone,
+		&res.PasswordChangedAt,
+		&res.MustChangePassword,
 		&res.CreatedAt,
 		&res.UpdatedAt,
 		pq.Array(&roles),
@@ -214,6 +218,9 @@ func (r *Repository) GetUserByEmail(ctx context.Context, email string) (*md.User
 			&res.IsWA,
 			&res.IsActive,
 			&res.IsEmailVerified,
+			&res.Phone,
+			&res.PasswordChangedAt,
+			&res.MustChangePassword,
 			&res.CreatedAt,
 			&res.UpdatedAt,
 			pq.Array(&roles),
@@ -282,6 +289,7 @@ func (r *Repository) CreateUser(ctx context.Context, req *dto.CreateUserRequest)
 		req.Avatar,
 		req.IsActive,
 		req.IsEmail,
+		req.MustChangePassword,
 	).Scan(&id)
 	if err != nil {
 		if err, ok := err.(*pgconn.PgError); ok && err.Code == "23505" {
@@ -359,6 +367,7 @@ func (r *Repository) UpdateUser(ctx context.Context, id uuid.UUID, req *dto.Upda
 			req.Avatar,
 			req.IsActive,
 			req.IsEmail,
+			req.MustChangePassword,
 			id,
 		)
 	} else {
@@ -493,6 +502,7 @@ func (r *Repository) UpdateMe(ctx context.Context, id uuid.UUID, req *dto.Update
 			req.Avatar,
 			req.IsActive,
 			req.IsEmail,
+			false,
 			id,
 		)
 	} else {
@@ -535,3 +545,54 @@ func (r *Repository) UpdateMe(ctx context.Context, id uuid.UUID, req *dto.Update
 	}
 	return nil
 }
+
+func (r *Repository) AddUserRolesByName(ctx context.Context, id uuid.UUID, roles []string) error {
+	const op = "users.AddUserRolesByName.repo"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	if _, err := r.conn.ExecContext(ctx, userAddRolesByNameQ, id, pq.Array(roles)); err != nil {
+		zap.L().Error(
+			"failed to add roles to user",
+			zap.String("op", op),
+			zap.String("userID", id.String()),
+			zap.Strings("roles", roles),
+			zap.Error(err),
+		)
+		return err
+	}
+	return nil
+}
+
+// UpdatePasswordHash replaces stored hash of the same password, e.g. when hashing parameters are upgraded.
+func (r *Repository) UpdatePasswordHash(ctx context.Context, id uuid.UUID, hash string) error {
+	const op = "users.UpdatePasswordHash.repo"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := r.conn.ExecContext(ctx, userUpdatePasswordHashQ, id, hash)
+	if err != nil {
+		zap.L().Error(
+			"failed to update password hash",
+			zap.String("op", op),
+			zap.String("userID", id.String()),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	aff, err := res.RowsAffected()
+	if err != nil {
+		zap.L().Error(
+			"failed to get affected rows",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	if aff == 0 {
+		return repo.ErrNotFound
+	}
+	return nil
+}
@@ -19,6 +19,7 @@ SELECT
 	u.is_wa,
 	u.is_active,
 	u.is_email_verified,
+	COALESCE(u.phone, '') AS phone,
 	u.created_at, 
 	u.updated_at,
 	ARRAY_AGG(r.id || '|' || r.name || '|' || r.description) FILTER (WHERE r.id IS NOT NULL) AS roles,
@@ -53,6 +54,9 @@ SELECT
 	u.is_wa,
 	u.is_active,
 	u.is_email_verified,
+	COALESCE(u.phone, '') AS phone,
+	u.password_changed_at,
+	u.must_change_password,
 	u.created_at, 
 	u.updated_at,
 	ARRAY_AGG(r.id || '|' || r.name || '|' || r.description) FILTER (WHERE r.id IS NOT NULL) AS roles,
@@ -75,6 +79,9 @@ SELECT
 	u.is_wa,
 	u.is_active,
 	u.is_email_verified,
+	COALESCE(u.phone, '') AS phone,
+	u.password_changed_at,
+	u.must_change_password,
     u.created_at, 
     u.updated_at,
     ARRAY_AGG(r.id || '|' || r.name || '|' || r.description) FILTER (WHERE r.id IS NOT NULL) AS roles
@@ -86,8 +93,8 @@ GROUP BY u.id
 `
 
 const userCreateQ = `
-INSERT INTO users (name, password, email, avatar, is_active, is_email_verified) 
-VALUES ($1, $2, $3, $4, $5, $6)
+INSERT INTO users (name, password, email, avatar, is_active, is_email_verified, must_change_password) 
+VALUES ($1, $2, $3, $4, $5, $6, $7)
 RETURNING id
 `
 
@@ -107,8 +114,10 @@ SET name = $1,
 	password = $3,
     avatar = $4,
 	is_active = $5,
-	is_email_verified = $6
-WHERE id = $7`
+	is_email_verified = $6,
+	password_changed_at = NOW(),
+	must_change_password = $7
+WHERE id = $8`
 
 const userDeleteQ = `
 DELETE FROM users 
@@ -125,3 +134,15 @@ const userRemoveRoleQ = `
 DELETE FROM user_roles 
 WHERE user_id = $1
 `
+
+const userAddRolesByNameQ = `
+INSERT INTO user_roles (user_id, role_id)
+SELECT $1, id FROM roles WHERE name = ANY($2)
+ON CONFLICT (user_id, role_id) DO NOTHING
+`
+
+const userUpdatePasswordHashQ = `
+UPDATE users
+SET password = $2
+WHERE id = $1
+`
@@ -514,3 +514,40 @@ func TestDeleteUser(t *testing.T) {
 		},
 	)
 }
+
+func TestUpdatePasswordHash(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: &sqlx.DB{DB: db}}
+	testUserID := uuid.New()
+	hash := "$argon2id$v=19$m=65536,t=3,p=2$c2FsdA$a2V5"
+	t.Run(
+		"Success", func(t *testing.T) {
+			mock.ExpectExec(regexp.QuoteMeta(userUpdatePasswordHashQ)).
+				WithArgs(testUserID.String(), hash).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+
+			err := repo.UpdatePasswordHash(context.Background(), testUserID, hash)
+			assert.NoError(t, err)
+
+			err = mock.ExpectationsWereMet()
+			assert.NoError(t, err)
+		},
+	)
+
+	t.Run(
+		"ErrNotFound", func(t *testing.T) {
+			mock.ExpectExec(regexp.QuoteMeta(userUpdatePasswordHashQ)).
+				WithArgs(testUserID.String(), hash).
+				WillReturnResult(sqlmock.NewResult(0, 0))
+
+			err := repo.UpdatePasswordHash(context.Background(), testUserID, hash)
+			assert.ErrorIs(t, err, rrepo.ErrNotFound)
+
+			err = mock.ExpectationsWereMet()
+			assert.NoError(t, err)
+		},
+	)
+}
@@ -3,12 +3,17 @@ package db
 import (
 	"context"
 	"database/sql"
+	"encoding/base64"
+	"errors"
 
 	"github.com/JMURv/sso/internal/config"
+	md "github.com/JMURv/sso/internal/models"
 	"github.com/JMURv/sso/internal/repo"
+	"github.com/go-webauthn/webauthn/protocol"
 	"github.com/go-webauthn/webauthn/webauthn"
 	"github.com/goccy/go-json"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/opentracing/opentracing-go"
 	"go.uber.org/zap"
 )
@@ -45,6 +50,8 @@ func (r *Repository) GetWACredentials(ctx context.Context, userID uuid.UUID) ([]
 			publicKey       []byte
 			attestationType string
 			authenticator   []byte
+			transports      []string
+			flags           webauthn.CredentialFlags
 		)
 
 		if err = rows.Scan(
@@ -52,6 +59,9 @@ func (r *Repository) GetWACredentials(ctx context.Context, userID uuid.UUID) ([]
 			&publicKey,
 			&attestationType,
 			&authenticator,
+			pq.Array(&transports),
+			&flags.BackupEligible,
+			&flags.BackupState,
 		); err != nil {
 			zap.L().Error(
 				"failed to scan WebAuthn credential",
@@ -71,11 +81,18 @@ func (r *Repository) GetWACredentials(ctx context.Context, userID uuid.UUID) ([]
 			return nil, err
 		}
 
+		tr := make([]protocol.AuthenticatorTransport, len(transports))
+		for i := range transports {
+			tr[i] = protocol.AuthenticatorTransport(transports[i])
+		}
+
 		creds = append(
 			creds, webauthn.Credential{
 				ID:              id,
 				PublicKey:       publicKey,
 				AttestationType: attestationType,
+				Transport:       tr,
+				Flags:           flags,
 				Authenticator:   authJSON,
 			},
 		)
@@ -92,7 +109,7 @@ func (r *Repository) GetWACredentials(ctx context.Context, userID uuid.UUID) ([]
 	return creds, nil
 }
 
-func (r *Repository) CreateWACredential(ctx context.Context, userID uuid.UUID, cred *webauthn.Credential) error {
+func (r *Repository) CreateWACredential(ctx context.Context, userID uuid.UUID, name string, cred *webauthn.Credential) error {
 	const op = "auth.CreateWACredential.repo"
 	span, ctx := opentracing.StartSpanFromContext(ctx, op)
 	defer span.Finish()
@@ -103,6 +120,11 @@ func (r *Repository) CreateWACredential(ctx context.Context, userID uuid.UUID, c
 		return err
 	}
 
+	transports := make([]string, len(cred.Transport))
+	for i := range cred.Transport {
+		transports[i] = string(cred.Transport[i])
+	}
+
 	_, err = r.conn.ExecContext(
 		ctx, createWACredential,
 		cred.ID,
@@ -110,6 +132,10 @@ func (r *Repository) CreateWACredential(ctx context.Context, userID uuid.UUID, c
 		cred.AttestationType,
 		authenticatorJSON,
 		userID,
+		name,
+		pq.Array(transports),
+		cred.Flags.BackupEligible,
+		cred.Flags.BackupState,
 	)
 	if err != nil {
 		zap.L().Error(
@@ -148,6 +174,7 @@ func (r *Repository) UpdateWACredential(ctx context.Context, cred *webauthn.Cred
 		cred.PublicKey,
 		cred.AttestationType,
 		authenticatorJSON,
+		cred.Flags.BackupState,
 		cred.ID,
 	)
 	if err != nil {
@@ -179,18 +206,134 @@ func (r *Repository) UpdateWACredential(ctx context.Context, cred *webauthn.Cred
 	return nil
 }
 
-func (r *Repository) DeleteWACredential(ctx context.Context, id int, uid uuid.UUID) error {
-	const op = "auth.UpdateWACredential.repo"
+func (r *Repository) ListPasskeys(ctx context.Context, userID uuid.UUID) ([]md.Passkey, error) {
+	const op = "auth.ListPasskeys.repo"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	rows, err := r.conn.QueryContext(ctx, listPasskeys, userID)
+	if err != nil {
+		zap.L().Error(
+			"failed to list passkeys",
+			zap.String("op", op),
+			zap.String("userID", userID.String()),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		if err := rows.Close(); err != nil {
+			zap.L().Error(
+				"failed to close rows",
+				zap.String("op", op),
+				zap.Error(err),
+			)
+		}
+	}(rows)
+
+	res := make([]md.Passkey, 0, config.DefaultSize)
+	for rows.Next() {
+		var (
+			id []byte
+			pk md.Passkey
+		)
+
+		if err = rows.Scan(
+			&id,
+			&pk.Name,
+			pq.Array(&pk.Transports),
+			&pk.BackupEligible,
+			&pk.BackupState,
+			&pk.CreatedAt,
+			&pk.LastUsedAt,
+		); err != nil {
+			zap.L().Error(
+				"failed to scan passkey",
+				zap.String("op", op),
+				zap.Error(err),
+			)
+			return nil, err
+		}
+
+		pk.ID = base64.RawURLEncoding.EncodeToString(id)
+		res = append(res, pk)
+	}
+
+	if err = rows.Err(); err != nil {
+		zap.L().Error(
+			"failed to list passkeys",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	return res, nil
+}
+
+func (r *Repository) RenamePasskey(ctx context.Context, userID uuid.UUID, id []byte, name string) error {
+	const op = "auth.RenamePasskey.repo"
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := r.conn.ExecContext(ctx, renamePasskey, id, userID, name)
+	if err != nil {
+		zap.L().Error(
+			"failed to rename passkey",
+			zap.String("op", op),
+			zap.String("userID", userID.String()),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	aff, err := res.RowsAffected()
+	if err != nil {
+		zap.L().Error(
+			"failed to get affected rows",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	if aff == 0 {
+		return repo.ErrNotFound
+	}
+	return nil
+}
+
+// DeleteWACredential removes credential and clears is_wa flag of the user
+// when it was the last one.
+func (r *Repository) DeleteWACredential(ctx context.Context, userID uuid.UUID, id []byte) error {
+	const op = "auth.DeleteWACredential.repo"
 	span, ctx := opentracing.StartSpanFromContext(ctx, op)
 	defer span.Finish()
 
-	res, err := r.conn.ExecContext(ctx, deleteWACredential, id, uid)
+	tx, err := r.conn.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		zap.L().Error(
+			"failed to begin transaction",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+		return err
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
+			zap.L().Error(
+				"error while transaction rollback",
+				zap.String("op", op),
+				zap.Error(err),
+			)
+		}
+	}()
+
+	res, err := tx.ExecContext(ctx, deleteWACredential, id, userID)
 	if err != nil {
 		zap.L().Error(
 			"failed to delete WebAuthn credential",
 			zap.String("op", op),
-			zap.String("uid", uid.String()),
-			zap.Int("id", id),
+			zap.String("uid", userID.String()),
 			zap.Error(err),
 		)
 		return err
@@ -201,8 +344,7 @@ func (r *Repository) DeleteWACredential(ctx context.Context, id int, uid uuid.UU
 		zap.L().Error(
 			"failed to get affected rows",
 			zap.String("op", op),
-			zap.String("uid", uid.String()),
-			zap.Int("id", id),
+			zap.String("uid", userID.String()),
 			zap.Error(err),
 		)
 		return err
@@ -212,11 +354,28 @@ func (r *Repository) DeleteWACredential(ctx context.Context, id int, uid uuid.UU
 		zap.L().Debug(
 			"failed to find WebAuthn credential",
 			zap.String("op", op),
-			zap.String("uid", uid.String()),
-			zap.Int("id", id),
-			zap.Error(err),
+			zap.String("uid", userID.String()),
 		)
 		return repo.ErrNotFound
 	}
+
+	if _, err = tx.ExecContext(ctx, syncIsWA, userID); err != nil {
+		zap.L().Error(
+			"failed to update user is_wa",
+			zap.String("op", op),
+			zap.String("uid", userID.String()),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		zap.L().Error(
+			"failed to commit transaction",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+		return err
+	}
 	return nil
 }
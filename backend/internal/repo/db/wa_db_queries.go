@@ -5,15 +5,18 @@ SELECT
 	id, 
 	public_key,
 	attestation_type,
-	authenticator
+	authenticator,
+	transports,
+	backup_eligible,
+	backup_state
 FROM wa_credentials
 WHERE user_id = $1
 `
 
 const createWACredential = `
 INSERT INTO wa_credentials 
-(id, public_key, attestation_type, authenticator, user_id)
-VALUES ($1, $2, $3, $4, $5)
+(id, public_key, attestation_type, authenticator, user_id, name, transports, backup_eligible, backup_state)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 `
 
 const setIsWA = `
@@ -27,11 +30,39 @@ UPDATE wa_credentials
 SET
 	public_key = $1,
 	attestation_type = $2,
-	authenticator = $3
-WHERE id = $4
+	authenticator = $3,
+	backup_state = $4,
+	last_used_at = NOW()
+WHERE id = $5
+`
+
+const listPasskeys = `
+SELECT 
+	id,
+	name,
+	transports,
+	backup_eligible,
+	backup_state,
+	created_at,
+	last_used_at
+FROM wa_credentials
+WHERE user_id = $1
+ORDER BY created_at DESC
+`
+
+const renamePasskey = `
+UPDATE wa_credentials
+SET name = $3
+WHERE id = $1 AND user_id = $2
 `
 
 const deleteWACredential = `
 DELETE FROM wa_credentials
 WHERE id = $1 AND user_id = $2
 `
+
+const syncIsWA = `
+UPDATE users
+SET is_wa = EXISTS (SELECT 1 FROM wa_credentials WHERE user_id = $1)
+WHERE id = $1
+`
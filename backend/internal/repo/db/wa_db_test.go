@@ -0,0 +1,56 @@
+package db
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	rrepo "github.com/JMURv/sso/internal/repo"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+func TestRepository_DeleteWACredential(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: &sqlx.DB{DB: db}}
+	ctx := context.Background()
+	uid := uuid.New()
+	credID := []byte("credential")
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(deleteWACredential)).
+				WithArgs(credID, uid).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectExec(regexp.QuoteMeta(syncIsWA)).
+				WithArgs(uid).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectCommit()
+
+			err := repo.DeleteWACredential(ctx, uid, credID)
+			assert.NoError(t, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"ErrNotFound", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(deleteWACredential)).
+				WithArgs(credID, uid).
+				WillReturnResult(sqlmock.NewResult(0, 0))
+			mock.ExpectRollback()
+
+			err := repo.DeleteWACredential(ctx, uid, credID)
+			assert.ErrorIs(t, err, rrepo.ErrNotFound)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+}
@@ -0,0 +1,14 @@
+package sms
+
+import "errors"
+
+var (
+	// ErrGateway is error that indicates message rejected by gateway.
+	ErrGateway = errors.New("sms gateway error")
+
+	// ErrVoiceNotSupported is error that indicates gateway without text-to-speech calls.
+	ErrVoiceNotSupported = errors.New("voice calls are not supported by gateway")
+
+	// ErrNoGateway is error that indicates message sent while SMS is disabled.
+	ErrNoGateway = errors.New("sms gateway is not configured")
+)
@@ -0,0 +1,206 @@
+package sms
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	md "github.com/JMURv/sso/internal/models"
+	"github.com/goccy/go-json"
+	"go.uber.org/zap"
+)
+
+type Twilio struct {
+	baseURL string
+	sid     string
+	token   string
+	from    string
+	client  *http.Client
+}
+
+func NewTwilio(baseURL, sid, token, from string) *Twilio {
+	return &Twilio{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		sid:     sid,
+		token:   token,
+		from:    from,
+		client:  &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (t *Twilio) SendSMS(ctx context.Context, to, body string) error {
+	return t.post(ctx, "Messages.json", url.Values{"To": {to}, "From": {t.from}, "Body": {body}})
+}
+
+func (t *Twilio) Call(ctx context.Context, to, text string) error {
+	var say strings.Builder
+	if err := xml.EscapeText(&say, []byte(text)); err != nil {
+		return err
+	}
+
+	twiml := fmt.Sprintf("<Response><Say>%s</Say><Pause length=\"1\"/><Say>%s</Say></Response>", say.String(), say.String())
+	return t.post(ctx, "Calls.json", url.Values{"To": {to}, "From": {t.from}, "Twiml": {twiml}})
+}
+
+func (t *Twilio) post(ctx context.Context, resource string, form url.Values) error {
+	endpoint := fmt.Sprintf("%s/2010-04-01/Accounts/%s/%s", t.baseURL, t.sid, resource)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(t.sid, t.token)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	res, err := t.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= http.StatusBadRequest {
+		body, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return fmt.Errorf("%w: twilio status %d: %s", ErrGateway, res.StatusCode, body)
+	}
+	return nil
+}
+
+type Vonage struct {
+	baseURL string
+	key     string
+	secret  string
+	from    string
+	client  *http.Client
+}
+
+func NewVonage(baseURL, key, secret, from string) *Vonage {
+	return &Vonage{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		key:     key,
+		secret:  secret,
+		from:    from,
+		client:  &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (v *Vonage) SendSMS(ctx context.Context, to, body string) error {
+	form := url.Values{
+		"api_key":    {v.key},
+		"api_secret": {v.secret},
+		"from":       {v.from},
+		"to":         {strings.TrimPrefix(to, "+")},
+		"text":       {body},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, v.baseURL+"/sms/json", strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	res, err := v.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("%w: vonage status %d", ErrGateway, res.StatusCode)
+	}
+
+	// Vonage answers 200 even for rejected messages, status is reported per message part.
+	var payload struct {
+		Messages []struct {
+			Status    string `json:"status"`
+			ErrorText string `json:"error-text"`
+		} `json:"messages"`
+	}
+	if err = json.NewDecoder(res.Body).Decode(&payload); err != nil {
+		return err
+	}
+
+	for _, m := range payload.Messages {
+		if m.Status != "0" {
+			return fmt.Errorf("%w: vonage status %s: %s", ErrGateway, m.Status, m.ErrorText)
+		}
+	}
+	return nil
+}
+
+// Call is not supported, Vonage voice API requires application keys instead of account credentials.
+func (v *Vonage) Call(context.Context, string, string) error {
+	return ErrVoiceNotSupported
+}
+
+type Message struct {
+	Channel string    `json:"channel"`
+	To      string    `json:"to"`
+	Text    string    `json:"text"`
+	SentAt  time.Time `json:"sent_at"`
+}
+
+// File appends messages as JSON lines, so tests can read delivered codes back.
+type File struct {
+	mu   sync.Mutex
+	path string
+}
+
+func NewFile(path string) *File {
+	return &File{path: path}
+}
+
+func (f *File) SendSMS(_ context.Context, to, body string) error {
+	return f.write(Message{Channel: md.ChannelSMS, To: to, Text: body, SentAt: time.Now()})
+}
+
+func (f *File) Call(_ context.Context, to, text string) error {
+	return f.write(Message{Channel: md.ChannelVoice, To: to, Text: text, SentAt: time.Now()})
+}
+
+func (f *File) write(m Message) error {
+	line, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	file, err := os.OpenFile(f.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = file.Write(append(line, '\n'))
+	return err
+}
+
+// Log only writes messages to application log, it is meant for tests and local development.
+// Messages carry login codes and reset links, so text is logged at debug level and only with Body.
+type Log struct {
+	Body bool
+}
+
+func (l Log) SendSMS(_ context.Context, to, body string) error {
+	l.log("SMS", to, body)
+	return nil
+}
+
+func (l Log) Call(_ context.Context, to, text string) error {
+	l.log("Voice call", to, text)
+	return nil
+}
+
+func (l Log) log(msg, to, text string) {
+	zap.L().Info(msg, zap.String("to", to), zap.Int("length", len(text)))
+	if l.Body {
+		zap.L().Debug(msg, zap.String("to", to), zap.String("body", text))
+	}
+}
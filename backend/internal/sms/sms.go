@@ -0,0 +1,124 @@
+package sms
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/JMURv/sso/internal/config"
+	md "github.com/JMURv/sso/internal/models"
+	"go.uber.org/zap"
+)
+
+// Gateway delivers text to phone number, either as message or as text-to-speech call.
+type Gateway interface {
+	SendSMS(ctx context.Context, to, body string) error
+	Call(ctx context.Context, to, text string) error
+}
+
+type SMSServer struct {
+	gw           Gateway
+	allowed      []string
+	serverConfig config.ServerConfig
+}
+
+// New fails when SMS is enabled without gateway, so codes and reset links are not silently dropped.
+func New(conf config.Config) *SMSServer {
+	var gw Gateway
+	switch conf.SMS.Gateway {
+	case "twilio":
+		gw = NewTwilio(conf.SMS.Twilio.BaseURL, conf.SMS.Twilio.AccountSID, conf.SMS.Twilio.AuthToken, conf.SMS.From)
+	case "vonage":
+		gw = NewVonage(conf.SMS.Vonage.BaseURL, conf.SMS.Vonage.APIKey, conf.SMS.Vonage.APISecret, conf.SMS.From)
+	case "file":
+		gw = NewFile(conf.SMS.File)
+	case "log":
+		gw = Log{Body: conf.SMS.LogBody}
+	case "":
+	default:
+		zap.L().Fatal("Unknown SMS gateway", zap.String("gateway", conf.SMS.Gateway))
+	}
+
+	if !conf.SMS.Enabled {
+		gw = nil
+	} else if gw == nil {
+		zap.L().Fatal("SMS is enabled without gateway, set SMS_GATEWAY")
+	}
+
+	allowed := make([]string, 0, len(conf.SMS.AllowedCountries))
+	for _, v := range conf.SMS.AllowedCountries {
+		if v = strings.TrimPrefix(strings.TrimSpace(v), "+"); v != "" {
+			allowed = append(allowed, "+"+v)
+		}
+	}
+
+	return &SMSServer{
+		gw:           gw,
+		allowed:      allowed,
+		serverConfig: conf.Server,
+	}
+}
+
+// Enabled reports whether messages can be sent, without gateway SMS and voice channels are turned off.
+func (s *SMSServer) Enabled() bool {
+	return s.gw != nil
+}
+
+// Allowed reports whether number belongs to one of allowed countries.
+func (s *SMSServer) Allowed(phone string) bool {
+	if len(s.allowed) == 0 {
+		return true
+	}
+
+	for i := 0; i < len(s.allowed); i++ {
+		if strings.HasPrefix(phone, s.allowed[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *SMSServer) Send(ctx context.Context, channel, to, text string) error {
+	if s.gw == nil {
+		return ErrNoGateway
+	}
+
+	var err error
+	if channel == md.ChannelVoice {
+		err = s.gw.Call(ctx, to, text)
+	} else {
+		err = s.gw.SendSMS(ctx, to, text)
+	}
+
+	if err != nil {
+		zap.L().Error(
+			"Failed to deliver message",
+			zap.String("channel", channel),
+			zap.Error(err),
+		)
+		return err
+	}
+	return nil
+}
+
+func (s *SMSServer) SendLoginCode(ctx context.Context, channel, phone, code string) {
+	_ = s.Send(ctx, channel, phone, fmt.Sprintf("Login code: %v", spell(channel, code)))
+}
+
+func (s *SMSServer) SendPhoneVerificationCode(ctx context.Context, channel, phone, code string) {
+	_ = s.Send(ctx, channel, phone, fmt.Sprintf("Phone verification code: %v", spell(channel, code)))
+}
+
+// SendForgotPasswordSMS always goes as text message, reset link cannot be dictated.
+func (s *SMSServer) SendForgotPasswordSMS(ctx context.Context, phone, token string) {
+	resetURL := fmt.Sprintf("%v://%v/email/password-reset/?token=%v", s.serverConfig.Scheme, s.serverConfig.Domain, token)
+	_ = s.Send(ctx, md.ChannelSMS, phone, fmt.Sprintf("Forgot password URL: %v", resetURL))
+}
+
+// spell separates digits so text-to-speech reads them one by one.
+func spell(channel, code string) string {
+	if channel != md.ChannelVoice {
+		return code
+	}
+	return strings.Join(strings.Split(code, ""), " ")
+}
@@ -0,0 +1,140 @@
+package sms
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/JMURv/sso/internal/config"
+	md "github.com/JMURv/sso/internal/models"
+	"github.com/goccy/go-json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+const phone = "+15550100"
+
+func TestSMSServer_Allowed(t *testing.T) {
+	conf := config.Config{}
+	conf.SMS.AllowedCountries = []string{"1", " +44 "}
+
+	s := New(conf)
+	assert.True(t, s.Allowed(phone))
+	assert.True(t, s.Allowed("+447700900123"))
+	assert.False(t, s.Allowed("+79001234567"))
+
+	assert.True(t, New(config.Config{}).Allowed("+79001234567"))
+}
+
+func TestSMSServer_Enabled(t *testing.T) {
+	s := New(config.Config{})
+	assert.False(t, s.Enabled())
+	assert.ErrorIs(t, s.Send(context.Background(), md.ChannelSMS, phone, "Login code: 1234"), ErrNoGateway)
+
+	conf := config.Config{}
+	conf.SMS.Gateway = "log"
+	assert.False(t, New(conf).Enabled())
+
+	conf.SMS.Enabled = true
+	assert.True(t, New(conf).Enabled())
+}
+
+func TestLog(t *testing.T) {
+	core, logs := observer.New(zap.DebugLevel)
+	defer zap.ReplaceGlobals(zap.New(core))()
+
+	require.NoError(t, Log{}.SendSMS(context.Background(), phone, "Login code: 1234"))
+	require.Equal(t, 1, logs.Len())
+	assert.NotContains(t, logs.All()[0].ContextMap(), "body")
+
+	require.NoError(t, Log{Body: true}.Call(context.Background(), phone, "Login code: 1 2 3 4"))
+	debug := logs.FilterLevelExact(zap.DebugLevel).All()
+	require.Len(t, debug, 1)
+	assert.Equal(t, "Login code: 1 2 3 4", debug[0].ContextMap()["body"])
+}
+
+func TestFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sms.jsonl")
+	s := &SMSServer{gw: NewFile(path)}
+
+	s.SendLoginCode(context.Background(), md.ChannelSMS, phone, "1234")
+	s.SendPhoneVerificationCode(context.Background(), md.ChannelVoice, phone, "5678")
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var msgs []Message
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		m := Message{}
+		require.NoError(t, json.Unmarshal(sc.Bytes(), &m))
+		msgs = append(msgs, m)
+	}
+
+	require.Len(t, msgs, 2)
+	assert.Equal(t, md.ChannelSMS, msgs[0].Channel)
+	assert.Equal(t, phone, msgs[0].To)
+	assert.Equal(t, "Login code: 1234", msgs[0].Text)
+	assert.Equal(t, md.ChannelVoice, msgs[1].Channel)
+	assert.Equal(t, "Phone verification code: 5 6 7 8", msgs[1].Text)
+}
+
+func TestTwilio(t *testing.T) {
+	var path, to, twiml string
+	srv := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				sid, token, _ := r.BasicAuth()
+				if sid != "AC1" || token != "secret" {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				require.NoError(t, r.ParseForm())
+				path, to, twiml = r.URL.Path, r.PostForm.Get("To"), r.PostForm.Get("Twiml")
+				w.WriteHeader(http.StatusCreated)
+			},
+		),
+	)
+	defer srv.Close()
+
+	tw := NewTwilio(srv.URL, "AC1", "secret", "+15550000")
+	require.NoError(t, tw.SendSMS(context.Background(), phone, "hi"))
+	assert.Equal(t, "/2010-04-01/Accounts/AC1/Messages.json", path)
+	assert.Equal(t, phone, to)
+
+	require.NoError(t, tw.Call(context.Background(), phone, "1 <2>"))
+	assert.Equal(t, "/2010-04-01/Accounts/AC1/Calls.json", path)
+	assert.Contains(t, twiml, "<Say>1 &lt;2&gt;</Say>")
+
+	err := NewTwilio(srv.URL, "AC1", "wrong", "+15550000").SendSMS(context.Background(), phone, "hi")
+	assert.ErrorIs(t, err, ErrGateway)
+}
+
+func TestVonage(t *testing.T) {
+	status := "0"
+	srv := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				require.NoError(t, r.ParseForm())
+				assert.Equal(t, "/sms/json", r.URL.Path)
+				assert.Equal(t, "15550100", r.PostForm.Get("to"))
+				_, _ = w.Write([]byte(`{"messages":[{"status":"` + status + `","error-text":"Throttled"}]}`))
+			},
+		),
+	)
+	defer srv.Close()
+
+	v := NewVonage(srv.URL, "key", "secret", "SSO")
+	assert.NoError(t, v.SendSMS(context.Background(), phone, "hi"))
+
+	status = "1"
+	assert.ErrorIs(t, v.SendSMS(context.Background(), phone, "hi"), ErrGateway)
+	assert.ErrorIs(t, v.Call(context.Background(), phone, "hi"), ErrVoiceNotSupported)
+}
@@ -50,25 +50,59 @@ func (s *EmailServer) Send(m *gomail.Message) error {
 	return nil
 }
 
-func (s *EmailServer) SendLoginEmail(_ context.Context, code int, toEmail string) {
+func (s *EmailServer) SendLoginEmail(_ context.Context, code, toEmail string) {
 	m := s.GetMessageBase("Login Code", toEmail)
 	m.SetBody("text/plain", fmt.Sprintf("Login code: %v", code))
 	_ = s.Send(m)
 }
 
+func (s *EmailServer) SendMagicLinkEmail(_ context.Context, token, toEmail string) {
+	m := s.GetMessageBase("Login Link", toEmail)
+
+	loginURL := fmt.Sprintf("%v://%v/email/magic-link/?token=%v", s.serverConfig.Scheme, s.serverConfig.Domain, token)
+
+	m.SetBody("text/plain", fmt.Sprintf("Login URL: %v\nIt works once, in the browser login was requested from.", loginURL))
+	_ = s.Send(m)
+}
+
 func (s *EmailServer) SendActivationCodeEmail(_ context.Context, code uint64, toEmail string) {
 	m := s.GetMessageBase("Activation Code", toEmail)
 	m.SetBody("text/plain", fmt.Sprintf("Activation code: %v", code))
 	_ = s.Send(m)
 }
 
-func (s *EmailServer) SendForgotPasswordEmail(_ context.Context, token, uid64, toEmail string) {
-	m := s.GetMessageBase("Forgot Password Code", toEmail)
+func (s *EmailServer) SendForgotPasswordEmail(_ context.Context, token, toEmail string) {
+	m := s.GetMessageBase("Password Reset", toEmail)
+
+	resetURL := fmt.Sprintf("%v://%v/email/password-reset/?token=%v", s.serverConfig.Scheme, s.serverConfig.Domain, token)
 
-	params := fmt.Sprintf("?uidb64=%v&token=%v", uid64, token)
-	resetURL := fmt.Sprintf("%v://%v/email/password-reset/%v", s.serverConfig.Scheme, s.serverConfig.Domain, params)
+	m.SetBody("text/plain", fmt.Sprintf("Password reset URL: %v\nIt works once and expires in 30 minutes.", resetURL))
+	_ = s.Send(m)
+}
+
+func (s *EmailServer) SendPasswordChangedEmail(_ context.Context, toEmail, ip string) {
+	m := s.GetMessageBase("Password Changed", toEmail)
+	m.SetBody(
+		"text/plain",
+		fmt.Sprintf(
+			"Your password was changed from %v and all sessions were signed out.\n"+
+				"If it was not you, reset your password again and contact support.",
+			ip,
+		),
+	)
+	_ = s.Send(m)
+}
 
-	m.SetBody("text/plain", fmt.Sprintf("Forgot password URL: %v", resetURL))
+func (s *EmailServer) SendRecoveryCodeUsedEmail(_ context.Context, toEmail, ip string, remaining int) {
+	m := s.GetMessageBase("Recovery Code Used", toEmail)
+	m.SetBody(
+		"text/plain",
+		fmt.Sprintf(
+			"A recovery code was used to sign in to your account from %v.\n"+
+				"Recovery codes left: %v. If it was not you, change your password and regenerate recovery codes.",
+			ip, remaining,
+		),
+	)
 	_ = s.Send(m)
 }
 
@@ -10,11 +10,14 @@ import (
 	hdl "github.com/JMURv/sso/internal/hdl/http"
 	"github.com/JMURv/sso/internal/repo/db"
 	"github.com/JMURv/sso/internal/repo/s3"
+	"github.com/JMURv/sso/internal/sms"
 	"github.com/JMURv/sso/internal/smtp"
 	"go.uber.org/zap"
-	"net/http"
+	"net"
 	"net/http/httptest"
 	"strings"
+	"testing"
+	"time"
 )
 
 const getTables = `
@@ -23,22 +26,43 @@ FROM pg_tables
 WHERE schemaname = 'public';
 `
 
-func setupTestServer() (*httptest.Server, func()) {
+func setupTestServer(t *testing.T) (*httptest.Server, func()) {
+	t.Helper()
 	zap.ReplaceGlobals(zap.Must(zap.NewDevelopment()))
 
-	conf := config.MustLoad("config/.env")
+	// Built-in IdP is mounted on the same server, so federated logins run offline
+	srv := httptest.NewUnstartedServer(nil)
+	base := "http://" + srv.Listener.Addr().String()
+	t.Setenv("MOCK_IDP_ENABLED", "true")
+	t.Setenv("MOCK_IDP_ISSUER", base+"/mock-idp")
+	t.Setenv("MOCK_IDP_OAUTH2_REDIRECT_URL", base+"/auth/oauth2/mock/callback")
+	t.Setenv("MOCK_IDP_OIDC_REDIRECT_URL", base+"/auth/oidc/mock/callback")
+
+	conf := config.MustLoad("../../../../configs/envs/.env.test")
+	requireServices(t, srv, conf)
 	au := auth.New(conf)
 
 	repo := db.New(conf)
 	cache := redis.New(conf)
-	svc := ctrl.New(repo, au, cache, s3.New(conf), smtp.New(conf))
-	handler := hdl.New(svc, au)
+	svc := ctrl.New(repo, au, cache, s3.New(conf), smtp.New(conf), sms.New(conf))
+	// requests are not rate limited, tests hit the same routes many times
+	handler := hdl.New(svc, au, nil, nil)
 
-	mux := http.NewServeMux()
 	handler.RegisterAuthRoutes()
 	handler.RegisterOAuth2Routes()
 	handler.RegisterOIDCRoutes()
+	handler.RegisterMockIDPRoutes()
+	handler.RegisterSAMLRoutes()
+	handler.RegisterRealmRoutes()
+	handler.RegisterTOTPRoutes()
+	handler.RegisterRecoveryRoutes()
 	handler.RegisterWebAuthnRoutes()
+	handler.RegisterPasskeyRoutes()
+	handler.RegisterPhoneRoutes()
+	handler.RegisterApprovalRoutes()
+	handler.RegisterQRLoginRoutes()
+	handler.RegisterLockoutRoutes()
+	handler.RegisterRiskRoutes()
 	handler.RegisterUserRoutes()
 	handler.RegisterPermRoutes()
 	handler.RegisterRoleRoutes()
@@ -95,5 +119,21 @@ func setupTestServer() (*httptest.Server, func()) {
 		}
 	}
 
-	return httptest.NewServer(mux), cleanupFunc
+	srv.Config.Handler = handler
+	srv.Start()
+	return srv, cleanupFunc
+}
+
+// requireServices skips test when database or cache the server depends on is not running.
+func requireServices(t *testing.T, srv *httptest.Server, conf config.Config) {
+	t.Helper()
+
+	for _, addr := range []string{fmt.Sprintf("%s:%d", conf.DB.Host, conf.DB.Port), conf.Redis.Addr} {
+		conn, err := net.DialTimeout("tcp", addr, time.Second)
+		if err != nil {
+			srv.Close()
+			t.Skipf("%s is not reachable: %v", addr, err)
+		}
+		_ = conn.Close()
+	}
 }
@@ -0,0 +1,110 @@
+package http
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/JMURv/sso/internal/config"
+	md "github.com/JMURv/sso/internal/models"
+	"github.com/goccy/go-json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const mockUser = "alice@example.com"
+
+func TestOIDC_MockProviderCallback(t *testing.T) {
+	srv, cleanup := setupTestServer(t)
+	defer srv.Close()
+	defer cleanup()
+
+	cli := &http.Client{
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	// login goes through start, IdP authorize and callback like a browser and returns session cookie
+	login := func(t *testing.T) *http.Cookie {
+		t.Helper()
+
+		resp, err := cli.Get(srv.URL + "/auth/oidc/mock/start")
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+		require.Equal(t, http.StatusTemporaryRedirect, resp.StatusCode)
+
+		resp, err = cli.Get(resp.Header.Get("Location") + "&user=" + url.QueryEscape(mockUser))
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+		require.Equal(t, http.StatusFound, resp.StatusCode)
+
+		callback, err := url.Parse(resp.Header.Get("Location"))
+		require.NoError(t, err)
+		assert.Equal(t, "/auth/oidc/mock/callback", callback.Path)
+
+		req, err := http.NewRequest(http.MethodGet, callback.String(), nil)
+		require.NoError(t, err)
+		req.Header.Set("User-Agent", "e2e")
+
+		resp, err = cli.Do(req)
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+		require.Equal(t, http.StatusTemporaryRedirect, resp.StatusCode)
+
+		for _, c := range resp.Cookies() {
+			if c.Name == config.AccessCookieName {
+				return c
+			}
+		}
+		require.FailNow(t, "access cookie is not set")
+		return nil
+	}
+
+	me := func(t *testing.T, access *http.Cookie) *md.User {
+		t.Helper()
+
+		req, err := http.NewRequest(http.MethodGet, srv.URL+"/users/me", nil)
+		require.NoError(t, err)
+		req.AddCookie(access)
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		res := &md.User{}
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(res))
+		return res
+	}
+
+	first := me(t, login(t))
+	assert.Equal(t, mockUser, first.Email)
+
+	second := me(t, login(t))
+	assert.Equal(t, first.ID, second.ID, "second login must reuse provider connection")
+
+	t.Run(
+		"Replayed callback", func(t *testing.T) {
+			resp, err := cli.Get(srv.URL + "/auth/oidc/mock/start")
+			require.NoError(t, err)
+			_ = resp.Body.Close()
+
+			resp, err = cli.Get(resp.Header.Get("Location") + "&user=" + url.QueryEscape(mockUser))
+			require.NoError(t, err)
+			_ = resp.Body.Close()
+			callback := resp.Header.Get("Location")
+
+			for i, status := range []int{http.StatusTemporaryRedirect, http.StatusInternalServerError} {
+				req, err := http.NewRequest(http.MethodGet, callback, nil)
+				require.NoError(t, err)
+				req.Header.Set("User-Agent", "e2e")
+
+				resp, err = cli.Do(req)
+				require.NoError(t, err)
+				_ = resp.Body.Close()
+				assert.Equal(t, status, resp.StatusCode, "attempt %d", i+1)
+			}
+		},
+	)
+}
@@ -18,6 +18,9 @@ import (
 	captcha "github.com/JMURv/sso/internal/auth/captcha"
 	jwt "github.com/JMURv/sso/internal/auth/jwt"
 	providers "github.com/JMURv/sso/internal/auth/providers"
+	risk "github.com/JMURv/sso/internal/auth/risk"
+	config "github.com/JMURv/sso/internal/config"
+	dto "github.com/JMURv/sso/internal/dto"
 	models "github.com/JMURv/sso/internal/models"
 	protocol "github.com/go-webauthn/webauthn/protocol"
 	webauthn "github.com/go-webauthn/webauthn/webauthn"
@@ -49,6 +52,55 @@ func (m *MockCore) EXPECT() *MockCoreMockRecorder {
 	return m.recorder
 }
 
+// AntiEnumeration mocks base method.
+func (m *MockCore) AntiEnumeration() config.AntiEnumerationConfig {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AntiEnumeration")
+	ret0, _ := ret[0].(config.AntiEnumerationConfig)
+	return ret0
+}
+
+// AntiEnumeration indicates an expected call of AntiEnumeration.
+func (mr *MockCoreMockRecorder) AntiEnumeration() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AntiEnumeration", reflect.TypeOf((*MockCore)(nil).AntiEnumeration))
+}
+
+// AssessRisk mocks base method.
+func (m *MockCore) AssessRisk(s risk.Signals) risk.Assessment {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssessRisk", s)
+	ret0, _ := ret[0].(risk.Assessment)
+	return ret0
+}
+
+// AssessRisk indicates an expected call of AssessRisk.
+func (mr *MockCoreMockRecorder) AssessRisk(s any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssessRisk", reflect.TypeOf((*MockCore)(nil).AssessRisk), s)
+}
+
+// BeginDiscoverableMediatedLogin mocks base method.
+func (m *MockCore) BeginDiscoverableMediatedLogin(mediation protocol.CredentialMediationRequirement, opts ...webauthn.LoginOption) (*protocol.CredentialAssertion, *webauthn.SessionData, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{mediation}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BeginDiscoverableMediatedLogin", varargs...)
+	ret0, _ := ret[0].(*protocol.CredentialAssertion)
+	ret1, _ := ret[1].(*webauthn.SessionData)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// BeginDiscoverableMediatedLogin indicates an expected call of BeginDiscoverableMediatedLogin.
+func (mr *MockCoreMockRecorder) BeginDiscoverableMediatedLogin(mediation any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{mediation}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeginDiscoverableMediatedLogin", reflect.TypeOf((*MockCore)(nil).BeginDiscoverableMediatedLogin), varargs...)
+}
+
 // BeginLogin mocks base method.
 func (m *MockCore) BeginLogin(user webauthn.User, opts ...webauthn.LoginOption) (*protocol.CredentialAssertion, *webauthn.SessionData, error) {
 	m.ctrl.T.Helper()
@@ -91,6 +143,20 @@ func (mr *MockCoreMockRecorder) BeginRegistration(user any, opts ...any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeginRegistration", reflect.TypeOf((*MockCore)(nil).BeginRegistration), varargs...)
 }
 
+// CompareOTP mocks base method.
+func (m *MockCore) CompareOTP(hashed, purpose, subject, code string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompareOTP", hashed, purpose, subject, code)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// CompareOTP indicates an expected call of CompareOTP.
+func (mr *MockCoreMockRecorder) CompareOTP(hashed, purpose, subject, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompareOTP", reflect.TypeOf((*MockCore)(nil).CompareOTP), hashed, purpose, subject, code)
+}
+
 // ComparePasswords mocks base method.
 func (m *MockCore) ComparePasswords(hashed, pswd []byte) error {
 	m.ctrl.T.Helper()
@@ -105,6 +171,18 @@ func (mr *MockCoreMockRecorder) ComparePasswords(hashed, pswd any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ComparePasswords", reflect.TypeOf((*MockCore)(nil).ComparePasswords), hashed, pswd)
 }
 
+// DummyCompare mocks base method.
+func (m *MockCore) DummyCompare(pswd []byte) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DummyCompare", pswd)
+}
+
+// DummyCompare indicates an expected call of DummyCompare.
+func (mr *MockCoreMockRecorder) DummyCompare(pswd any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DummyCompare", reflect.TypeOf((*MockCore)(nil).DummyCompare), pswd)
+}
+
 // FinishLogin mocks base method.
 func (m *MockCore) FinishLogin(user webauthn.User, session webauthn.SessionData, response *http.Request) (*webauthn.Credential, error) {
 	m.ctrl.T.Helper()
@@ -136,9 +214,9 @@ func (mr *MockCoreMockRecorder) FinishRegistration(user, session, response any)
 }
 
 // GenPair mocks base method.
-func (m *MockCore) GenPair(ctx context.Context, uid uuid.UUID, roles []models.Role) (string, string, error) {
+func (m *MockCore) GenPair(ctx context.Context, uid uuid.UUID, roles []models.Role, ai jwt.AuthInfo) (string, string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GenPair", ctx, uid, roles)
+	ret := m.ctrl.Call(m, "GenPair", ctx, uid, roles, ai)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(string)
 	ret2, _ := ret[2].(error)
@@ -146,9 +224,24 @@ func (m *MockCore) GenPair(ctx context.Context, uid uuid.UUID, roles []models.Ro
 }
 
 // GenPair indicates an expected call of GenPair.
-func (mr *MockCoreMockRecorder) GenPair(ctx, uid, roles any) *gomock.Call {
+func (mr *MockCoreMockRecorder) GenPair(ctx, uid, roles, ai any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenPair", reflect.TypeOf((*MockCore)(nil).GenPair), ctx, uid, roles, ai)
+}
+
+// GenerateOTP mocks base method.
+func (m *MockCore) GenerateOTP() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateOTP")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateOTP indicates an expected call of GenerateOTP.
+func (mr *MockCoreMockRecorder) GenerateOTP() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenPair", reflect.TypeOf((*MockCore)(nil).GenPair), ctx, uid, roles)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateOTP", reflect.TypeOf((*MockCore)(nil).GenerateOTP))
 }
 
 // GenerateSignedState mocks base method.
@@ -165,6 +258,22 @@ func (mr *MockCoreMockRecorder) GenerateSignedState() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateSignedState", reflect.TypeOf((*MockCore)(nil).GenerateSignedState))
 }
 
+// GenerateTOTP mocks base method.
+func (m *MockCore) GenerateTOTP(account string) (*dto.TOTPEnrollResponse, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateTOTP", account)
+	ret0, _ := ret[0].(*dto.TOTPEnrollResponse)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GenerateTOTP indicates an expected call of GenerateTOTP.
+func (mr *MockCoreMockRecorder) GenerateTOTP(account any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateTOTP", reflect.TypeOf((*MockCore)(nil).GenerateTOTP), account)
+}
+
 // Get mocks base method.
 func (m *MockCore) Get(provider providers.Providers, flow providers.Flow) (providers.OAuthProvider, error) {
 	m.ctrl.T.Helper()
@@ -223,19 +332,160 @@ func (mr *MockCoreMockRecorder) Hash(val any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Hash", reflect.TypeOf((*MockCore)(nil).Hash), val)
 }
 
+// HashOTP mocks base method.
+func (m *MockCore) HashOTP(purpose, subject, code string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HashOTP", purpose, subject, code)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// HashOTP indicates an expected call of HashOTP.
+func (mr *MockCoreMockRecorder) HashOTP(purpose, subject, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HashOTP", reflect.TypeOf((*MockCore)(nil).HashOTP), purpose, subject, code)
+}
+
+// IsLDAPUser mocks base method.
+func (m *MockCore) IsLDAPUser(email string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsLDAPUser", email)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsLDAPUser indicates an expected call of IsLDAPUser.
+func (mr *MockCoreMockRecorder) IsLDAPUser(email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsLDAPUser", reflect.TypeOf((*MockCore)(nil).IsLDAPUser), email)
+}
+
+// LDAPAuthenticate mocks base method.
+func (m *MockCore) LDAPAuthenticate(ctx context.Context, login, password string) (*dto.LDAPUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LDAPAuthenticate", ctx, login, password)
+	ret0, _ := ret[0].(*dto.LDAPUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LDAPAuthenticate indicates an expected call of LDAPAuthenticate.
+func (mr *MockCoreMockRecorder) LDAPAuthenticate(ctx, login, password any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LDAPAuthenticate", reflect.TypeOf((*MockCore)(nil).LDAPAuthenticate), ctx, login, password)
+}
+
+// LDAPFailureKey mocks base method.
+func (m *MockCore) LDAPFailureKey(login, password string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LDAPFailureKey", login, password)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// LDAPFailureKey indicates an expected call of LDAPFailureKey.
+func (mr *MockCoreMockRecorder) LDAPFailureKey(login, password any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LDAPFailureKey", reflect.TypeOf((*MockCore)(nil).LDAPFailureKey), login, password)
+}
+
+// LDAPManagedRoles mocks base method.
+func (m *MockCore) LDAPManagedRoles() []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LDAPManagedRoles")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// LDAPManagedRoles indicates an expected call of LDAPManagedRoles.
+func (mr *MockCoreMockRecorder) LDAPManagedRoles() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LDAPManagedRoles", reflect.TypeOf((*MockCore)(nil).LDAPManagedRoles))
+}
+
+// Lockout mocks base method.
+func (m *MockCore) Lockout() config.LockoutConfig {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lockout")
+	ret0, _ := ret[0].(config.LockoutConfig)
+	return ret0
+}
+
+// Lockout indicates an expected call of Lockout.
+func (mr *MockCoreMockRecorder) Lockout() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lockout", reflect.TypeOf((*MockCore)(nil).Lockout))
+}
+
+// MockIDP mocks base method.
+func (m *MockCore) MockIDP() http.Handler {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MockIDP")
+	ret0, _ := ret[0].(http.Handler)
+	return ret0
+}
+
+// MockIDP indicates an expected call of MockIDP.
+func (mr *MockCoreMockRecorder) MockIDP() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MockIDP", reflect.TypeOf((*MockCore)(nil).MockIDP))
+}
+
+// NeedsRehash mocks base method.
+func (m *MockCore) NeedsRehash(hashed string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NeedsRehash", hashed)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// NeedsRehash indicates an expected call of NeedsRehash.
+func (mr *MockCoreMockRecorder) NeedsRehash(hashed any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NeedsRehash", reflect.TypeOf((*MockCore)(nil).NeedsRehash), hashed)
+}
+
 // NewToken mocks base method.
-func (m *MockCore) NewToken(ctx context.Context, uid uuid.UUID, roles []models.Role, d time.Duration) (string, error) {
+func (m *MockCore) NewToken(ctx context.Context, uid uuid.UUID, roles []models.Role, ai jwt.AuthInfo, d time.Duration) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "NewToken", ctx, uid, roles, d)
+	ret := m.ctrl.Call(m, "NewToken", ctx, uid, roles, ai, d)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // NewToken indicates an expected call of NewToken.
-func (mr *MockCoreMockRecorder) NewToken(ctx, uid, roles, d any) *gomock.Call {
+func (mr *MockCoreMockRecorder) NewToken(ctx, uid, roles, ai, d any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewToken", reflect.TypeOf((*MockCore)(nil).NewToken), ctx, uid, roles, ai, d)
+}
+
+// OTP mocks base method.
+func (m *MockCore) OTP() config.OTPConfig {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OTP")
+	ret0, _ := ret[0].(config.OTPConfig)
+	return ret0
+}
+
+// OTP indicates an expected call of OTP.
+func (mr *MockCoreMockRecorder) OTP() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewToken", reflect.TypeOf((*MockCore)(nil).NewToken), ctx, uid, roles, d)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OTP", reflect.TypeOf((*MockCore)(nil).OTP))
+}
+
+// ReauthTime mocks base method.
+func (m *MockCore) ReauthTime() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReauthTime")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// ReauthTime indicates an expected call of ReauthTime.
+func (mr *MockCoreMockRecorder) ReauthTime() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReauthTime", reflect.TypeOf((*MockCore)(nil).ReauthTime))
 }
 
 // ParseClaims mocks base method.
@@ -253,6 +503,150 @@ func (mr *MockCoreMockRecorder) ParseClaims(ctx, tokenStr any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseClaims", reflect.TypeOf((*MockCore)(nil).ParseClaims), ctx, tokenStr)
 }
 
+// ParseIDPMetadata mocks base method.
+func (m *MockCore) ParseIDPMetadata(data []byte) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParseIDPMetadata", data)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ParseIDPMetadata indicates an expected call of ParseIDPMetadata.
+func (mr *MockCoreMockRecorder) ParseIDPMetadata(data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseIDPMetadata", reflect.TypeOf((*MockCore)(nil).ParseIDPMetadata), data)
+}
+
+// ParseSAMLResponse mocks base method.
+func (m *MockCore) ParseSAMLResponse(p *models.SAMLProvider, r *http.Request, requestIDs []string) (*dto.SAMLAssertion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParseSAMLResponse", p, r, requestIDs)
+	ret0, _ := ret[0].(*dto.SAMLAssertion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ParseSAMLResponse indicates an expected call of ParseSAMLResponse.
+func (mr *MockCoreMockRecorder) ParseSAMLResponse(p, r, requestIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseSAMLResponse", reflect.TypeOf((*MockCore)(nil).ParseSAMLResponse), p, r, requestIDs)
+}
+
+// PasswordHistory mocks base method.
+func (m *MockCore) PasswordHistory() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PasswordHistory")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// PasswordHistory indicates an expected call of PasswordHistory.
+func (mr *MockCoreMockRecorder) PasswordHistory() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PasswordHistory", reflect.TypeOf((*MockCore)(nil).PasswordHistory))
+}
+
+// PasswordMaxAge mocks base method.
+func (m *MockCore) PasswordMaxAge(roles []models.Role) time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PasswordMaxAge", roles)
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// PasswordMaxAge indicates an expected call of PasswordMaxAge.
+func (mr *MockCoreMockRecorder) PasswordMaxAge(roles any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PasswordMaxAge", reflect.TypeOf((*MockCore)(nil).PasswordMaxAge), roles)
+}
+
+// QRLoginURL mocks base method.
+func (m *MockCore) QRLoginURL(code string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QRLoginURL", code)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// QRLoginURL indicates an expected call of QRLoginURL.
+func (mr *MockCoreMockRecorder) QRLoginURL(code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QRLoginURL", reflect.TypeOf((*MockCore)(nil).QRLoginURL), code)
+}
+
+// RegistrationOptions mocks base method.
+func (m *MockCore) RegistrationOptions(roles []models.Role) []webauthn.RegistrationOption {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegistrationOptions", roles)
+	ret0, _ := ret[0].([]webauthn.RegistrationOption)
+	return ret0
+}
+
+// RegistrationOptions indicates an expected call of RegistrationOptions.
+func (mr *MockCoreMockRecorder) RegistrationOptions(roles any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegistrationOptions", reflect.TypeOf((*MockCore)(nil).RegistrationOptions), roles)
+}
+
+// RiskEnabled mocks base method.
+func (m *MockCore) RiskEnabled() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RiskEnabled")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// RiskEnabled indicates an expected call of RiskEnabled.
+func (mr *MockCoreMockRecorder) RiskEnabled() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RiskEnabled", reflect.TypeOf((*MockCore)(nil).RiskEnabled))
+}
+
+// SAMLAuthURL mocks base method.
+func (m *MockCore) SAMLAuthURL(p *models.SAMLProvider, relayState string) (*dto.SAMLAuthRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SAMLAuthURL", p, relayState)
+	ret0, _ := ret[0].(*dto.SAMLAuthRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SAMLAuthURL indicates an expected call of SAMLAuthURL.
+func (mr *MockCoreMockRecorder) SAMLAuthURL(p, relayState any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SAMLAuthURL", reflect.TypeOf((*MockCore)(nil).SAMLAuthURL), p, relayState)
+}
+
+// SAMLMetadata mocks base method.
+func (m *MockCore) SAMLMetadata(p *models.SAMLProvider) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SAMLMetadata", p)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SAMLMetadata indicates an expected call of SAMLMetadata.
+func (mr *MockCoreMockRecorder) SAMLMetadata(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SAMLMetadata", reflect.TypeOf((*MockCore)(nil).SAMLMetadata), p)
+}
+
+// SelfRegistration mocks base method.
+func (m *MockCore) SelfRegistration() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelfRegistration")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// SelfRegistration indicates an expected call of SelfRegistration.
+func (mr *MockCoreMockRecorder) SelfRegistration() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelfRegistration", reflect.TypeOf((*MockCore)(nil).SelfRegistration))
+}
+
 // SuccessURL mocks base method.
 func (m *MockCore) SuccessURL() string {
 	m.ctrl.T.Helper()
@@ -267,6 +661,56 @@ func (mr *MockCoreMockRecorder) SuccessURL() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuccessURL", reflect.TypeOf((*MockCore)(nil).SuccessURL))
 }
 
+// UpdateMetadata mocks base method.
+func (m *MockCore) UpdateMetadata(blob []byte) (*dto.WAMetadataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMetadata", blob)
+	ret0, _ := ret[0].(*dto.WAMetadataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMetadata indicates an expected call of UpdateMetadata.
+func (mr *MockCoreMockRecorder) UpdateMetadata(blob any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMetadata", reflect.TypeOf((*MockCore)(nil).UpdateMetadata), blob)
+}
+
+// ValidatePasskeyLogin mocks base method.
+func (m *MockCore) ValidatePasskeyLogin(handler webauthn.DiscoverableUserHandler, session webauthn.SessionData, parsedResponse *protocol.ParsedCredentialAssertionData) (webauthn.User, *webauthn.Credential, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidatePasskeyLogin", handler, session, parsedResponse)
+	ret0, _ := ret[0].(webauthn.User)
+	ret1, _ := ret[1].(*webauthn.Credential)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ValidatePasskeyLogin indicates an expected call of ValidatePasskeyLogin.
+func (mr *MockCoreMockRecorder) ValidatePasskeyLogin(handler, session, parsedResponse any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatePasskeyLogin", reflect.TypeOf((*MockCore)(nil).ValidatePasskeyLogin), handler, session, parsedResponse)
+}
+
+// ValidatePassword mocks base method.
+func (m *MockCore) ValidatePassword(pswd string, history []string, personal ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []any{pswd, history}
+	for _, a := range personal {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ValidatePassword", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidatePassword indicates an expected call of ValidatePassword.
+func (mr *MockCoreMockRecorder) ValidatePassword(pswd, history any, personal ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{pswd, history}, personal...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatePassword", reflect.TypeOf((*MockCore)(nil).ValidatePassword), varargs...)
+}
+
 // ValidateSignedState mocks base method.
 func (m *MockCore) ValidateSignedState(signedState string, maxAge time.Duration) error {
 	m.ctrl.T.Helper()
@@ -281,6 +725,35 @@ func (mr *MockCoreMockRecorder) ValidateSignedState(signedState, maxAge any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateSignedState", reflect.TypeOf((*MockCore)(nil).ValidateSignedState), signedState, maxAge)
 }
 
+// ValidateTOTP mocks base method.
+func (m *MockCore) ValidateTOTP(secret, code string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateTOTP", secret, code)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateTOTP indicates an expected call of ValidateTOTP.
+func (mr *MockCoreMockRecorder) ValidateTOTP(secret, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateTOTP", reflect.TypeOf((*MockCore)(nil).ValidateTOTP), secret, code)
+}
+
+// VerifyPolicy mocks base method.
+func (m *MockCore) VerifyPolicy(ctx context.Context, roles []models.Role, cred *webauthn.Credential) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyPolicy", ctx, roles, cred)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyPolicy indicates an expected call of VerifyPolicy.
+func (mr *MockCoreMockRecorder) VerifyPolicy(ctx, roles, cred any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyPolicy", reflect.TypeOf((*MockCore)(nil).VerifyPolicy), ctx, roles, cred)
+}
+
 // VerifyRecaptcha mocks base method.
 func (m *MockCore) VerifyRecaptcha(token string, action captcha.Actions) (bool, error) {
 	m.ctrl.T.Helper()
@@ -50,6 +50,63 @@ func (m *MockAppRepo) EXPECT() *MockAppRepoMockRecorder {
 	return m.recorder
 }
 
+// AddPasswordHistory mocks base method.
+func (m *MockAppRepo) AddPasswordHistory(ctx context.Context, userID uuid.UUID, hash string, keep int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPasswordHistory", ctx, userID, hash, keep)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddPasswordHistory indicates an expected call of AddPasswordHistory.
+func (mr *MockAppRepoMockRecorder) AddPasswordHistory(ctx, userID, hash, keep any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPasswordHistory", reflect.TypeOf((*MockAppRepo)(nil).AddPasswordHistory), ctx, userID, hash, keep)
+}
+
+// AddUserRolesByName mocks base method.
+func (m *MockAppRepo) AddUserRolesByName(ctx context.Context, id uuid.UUID, roles []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddUserRolesByName", ctx, id, roles)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddUserRolesByName indicates an expected call of AddUserRolesByName.
+func (mr *MockAppRepoMockRecorder) AddUserRolesByName(ctx, id, roles any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUserRolesByName", reflect.TypeOf((*MockAppRepo)(nil).AddUserRolesByName), ctx, id, roles)
+}
+
+// ConfirmTOTP mocks base method.
+func (m *MockAppRepo) ConfirmTOTP(ctx context.Context, userID uuid.UUID, step int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmTOTP", ctx, userID, step)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConfirmTOTP indicates an expected call of ConfirmTOTP.
+func (mr *MockAppRepoMockRecorder) ConfirmTOTP(ctx, userID, step any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmTOTP", reflect.TypeOf((*MockAppRepo)(nil).ConfirmTOTP), ctx, userID, step)
+}
+
+// CountRecoveryCodes mocks base method.
+func (m *MockAppRepo) CountRecoveryCodes(ctx context.Context, userID uuid.UUID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountRecoveryCodes", ctx, userID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountRecoveryCodes indicates an expected call of CountRecoveryCodes.
+func (mr *MockAppRepoMockRecorder) CountRecoveryCodes(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountRecoveryCodes", reflect.TypeOf((*MockAppRepo)(nil).CountRecoveryCodes), ctx, userID)
+}
+
 // CreateOAuth2Connection mocks base method.
 func (m *MockAppRepo) CreateOAuth2Connection(ctx context.Context, userID uuid.UUID, provider string, data *dto.ProviderResponse) error {
 	m.ctrl.T.Helper()
@@ -79,6 +136,35 @@ func (mr *MockAppRepoMockRecorder) CreatePerm(ctx, req any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePerm", reflect.TypeOf((*MockAppRepo)(nil).CreatePerm), ctx, req)
 }
 
+// CreateRealmDomain mocks base method.
+func (m *MockAppRepo) CreateRealmDomain(ctx context.Context, req *dto.CreateRealmDomainRequest) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRealmDomain", ctx, req)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRealmDomain indicates an expected call of CreateRealmDomain.
+func (mr *MockAppRepoMockRecorder) CreateRealmDomain(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRealmDomain", reflect.TypeOf((*MockAppRepo)(nil).CreateRealmDomain), ctx, req)
+}
+
+// CreateRiskEvent mocks base method.
+func (m *MockAppRepo) CreateRiskEvent(ctx context.Context, e *models.RiskEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRiskEvent", ctx, e)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRiskEvent indicates an expected call of CreateRiskEvent.
+func (mr *MockAppRepoMockRecorder) CreateRiskEvent(ctx, e any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRiskEvent", reflect.TypeOf((*MockAppRepo)(nil).CreateRiskEvent), ctx, e)
+}
+
 // CreateRole mocks base method.
 func (m *MockAppRepo) CreateRole(ctx context.Context, req *dto.CreateRoleRequest) (uint64, error) {
 	m.ctrl.T.Helper()
@@ -94,6 +180,35 @@ func (mr *MockAppRepoMockRecorder) CreateRole(ctx, req any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRole", reflect.TypeOf((*MockAppRepo)(nil).CreateRole), ctx, req)
 }
 
+// CreateSAMLConnection mocks base method.
+func (m *MockAppRepo) CreateSAMLConnection(ctx context.Context, userID uuid.UUID, provider string, req *dto.SAMLAssertion) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSAMLConnection", ctx, userID, provider, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSAMLConnection indicates an expected call of CreateSAMLConnection.
+func (mr *MockAppRepoMockRecorder) CreateSAMLConnection(ctx, userID, provider, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSAMLConnection", reflect.TypeOf((*MockAppRepo)(nil).CreateSAMLConnection), ctx, userID, provider, req)
+}
+
+// CreateSAMLProvider mocks base method.
+func (m *MockAppRepo) CreateSAMLProvider(ctx context.Context, entityID string, req *dto.CreateSAMLProviderRequest) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSAMLProvider", ctx, entityID, req)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSAMLProvider indicates an expected call of CreateSAMLProvider.
+func (mr *MockAppRepoMockRecorder) CreateSAMLProvider(ctx, entityID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSAMLProvider", reflect.TypeOf((*MockAppRepo)(nil).CreateSAMLProvider), ctx, entityID, req)
+}
+
 // CreateToken mocks base method.
 func (m *MockAppRepo) CreateToken(ctx context.Context, userID uuid.UUID, hashedT string, expiresAt time.Time, device *models.Device) error {
 	m.ctrl.T.Helper()
@@ -124,17 +239,17 @@ func (mr *MockAppRepoMockRecorder) CreateUser(ctx, req any) *gomock.Call {
 }
 
 // CreateWACredential mocks base method.
-func (m *MockAppRepo) CreateWACredential(ctx context.Context, userID uuid.UUID, cred *webauthn.Credential) error {
+func (m *MockAppRepo) CreateWACredential(ctx context.Context, userID uuid.UUID, name string, cred *webauthn.Credential) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateWACredential", ctx, userID, cred)
+	ret := m.ctrl.Call(m, "CreateWACredential", ctx, userID, name, cred)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateWACredential indicates an expected call of CreateWACredential.
-func (mr *MockAppRepoMockRecorder) CreateWACredential(ctx, userID, cred any) *gomock.Call {
+func (mr *MockAppRepoMockRecorder) CreateWACredential(ctx, userID, name, cred any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWACredential", reflect.TypeOf((*MockAppRepo)(nil).CreateWACredential), ctx, userID, cred)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWACredential", reflect.TypeOf((*MockAppRepo)(nil).CreateWACredential), ctx, userID, name, cred)
 }
 
 // DeleteDevice mocks base method.
@@ -165,6 +280,34 @@ func (mr *MockAppRepoMockRecorder) DeletePerm(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePerm", reflect.TypeOf((*MockAppRepo)(nil).DeletePerm), ctx, id)
 }
 
+// DeletePhone mocks base method.
+func (m *MockAppRepo) DeletePhone(ctx context.Context, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePhone", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePhone indicates an expected call of DeletePhone.
+func (mr *MockAppRepoMockRecorder) DeletePhone(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePhone", reflect.TypeOf((*MockAppRepo)(nil).DeletePhone), ctx, userID)
+}
+
+// DeleteRealmDomain mocks base method.
+func (m *MockAppRepo) DeleteRealmDomain(ctx context.Context, domain string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRealmDomain", ctx, domain)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRealmDomain indicates an expected call of DeleteRealmDomain.
+func (mr *MockAppRepoMockRecorder) DeleteRealmDomain(ctx, domain any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRealmDomain", reflect.TypeOf((*MockAppRepo)(nil).DeleteRealmDomain), ctx, domain)
+}
+
 // DeleteRole mocks base method.
 func (m *MockAppRepo) DeleteRole(ctx context.Context, id uint64) error {
 	m.ctrl.T.Helper()
@@ -179,6 +322,34 @@ func (mr *MockAppRepoMockRecorder) DeleteRole(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRole", reflect.TypeOf((*MockAppRepo)(nil).DeleteRole), ctx, id)
 }
 
+// DeleteSAMLProvider mocks base method.
+func (m *MockAppRepo) DeleteSAMLProvider(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSAMLProvider", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSAMLProvider indicates an expected call of DeleteSAMLProvider.
+func (mr *MockAppRepoMockRecorder) DeleteSAMLProvider(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSAMLProvider", reflect.TypeOf((*MockAppRepo)(nil).DeleteSAMLProvider), ctx, name)
+}
+
+// DeleteTOTP mocks base method.
+func (m *MockAppRepo) DeleteTOTP(ctx context.Context, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTOTP", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTOTP indicates an expected call of DeleteTOTP.
+func (mr *MockAppRepoMockRecorder) DeleteTOTP(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTOTP", reflect.TypeOf((*MockAppRepo)(nil).DeleteTOTP), ctx, userID)
+}
+
 // DeleteUser mocks base method.
 func (m *MockAppRepo) DeleteUser(ctx context.Context, userID uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -193,6 +364,20 @@ func (mr *MockAppRepoMockRecorder) DeleteUser(ctx, userID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockAppRepo)(nil).DeleteUser), ctx, userID)
 }
 
+// DeleteWACredential mocks base method.
+func (m *MockAppRepo) DeleteWACredential(ctx context.Context, userID uuid.UUID, id []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWACredential", ctx, userID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWACredential indicates an expected call of DeleteWACredential.
+func (mr *MockAppRepoMockRecorder) DeleteWACredential(ctx, userID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWACredential", reflect.TypeOf((*MockAppRepo)(nil).DeleteWACredential), ctx, userID, id)
+}
+
 // GetByDevice mocks base method.
 func (m *MockAppRepo) GetByDevice(ctx context.Context, userID uuid.UUID, deviceID string) (*models.RefreshToken, error) {
 	m.ctrl.T.Helper()
@@ -268,6 +453,36 @@ func (mr *MockAppRepoMockRecorder) GetRole(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRole", reflect.TypeOf((*MockAppRepo)(nil).GetRole), ctx, id)
 }
 
+// GetSAMLProvider mocks base method.
+func (m *MockAppRepo) GetSAMLProvider(ctx context.Context, name string) (*models.SAMLProvider, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSAMLProvider", ctx, name)
+	ret0, _ := ret[0].(*models.SAMLProvider)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSAMLProvider indicates an expected call of GetSAMLProvider.
+func (mr *MockAppRepoMockRecorder) GetSAMLProvider(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSAMLProvider", reflect.TypeOf((*MockAppRepo)(nil).GetSAMLProvider), ctx, name)
+}
+
+// GetTOTP mocks base method.
+func (m *MockAppRepo) GetTOTP(ctx context.Context, userID uuid.UUID) (*models.TOTP, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTOTP", ctx, userID)
+	ret0, _ := ret[0].(*models.TOTP)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTOTP indicates an expected call of GetTOTP.
+func (mr *MockAppRepoMockRecorder) GetTOTP(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTOTP", reflect.TypeOf((*MockAppRepo)(nil).GetTOTP), ctx, userID)
+}
+
 // GetUserByEmail mocks base method.
 func (m *MockAppRepo) GetUserByEmail(ctx context.Context, email string) (*models.User, error) {
 	m.ctrl.T.Helper()
@@ -313,6 +528,37 @@ func (mr *MockAppRepoMockRecorder) GetUserByOAuth2(ctx, provider, providerID any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByOAuth2", reflect.TypeOf((*MockAppRepo)(nil).GetUserByOAuth2), ctx, provider, providerID)
 }
 
+// GetUserBySAML mocks base method.
+func (m *MockAppRepo) GetUserBySAML(ctx context.Context, provider, nameID string) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserBySAML", ctx, provider, nameID)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserBySAML indicates an expected call of GetUserBySAML.
+func (mr *MockAppRepoMockRecorder) GetUserBySAML(ctx, provider, nameID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserBySAML", reflect.TypeOf((*MockAppRepo)(nil).GetUserBySAML), ctx, provider, nameID)
+}
+
+// GetUserConnection mocks base method.
+func (m *MockAppRepo) GetUserConnection(ctx context.Context, userID uuid.UUID) (string, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserConnection", ctx, userID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUserConnection indicates an expected call of GetUserConnection.
+func (mr *MockAppRepoMockRecorder) GetUserConnection(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserConnection", reflect.TypeOf((*MockAppRepo)(nil).GetUserConnection), ctx, userID)
+}
+
 // GetWACredentials mocks base method.
 func (m *MockAppRepo) GetWACredentials(ctx context.Context, userID uuid.UUID) ([]webauthn.Credential, error) {
 	m.ctrl.T.Helper()
@@ -328,6 +574,21 @@ func (mr *MockAppRepoMockRecorder) GetWACredentials(ctx, userID any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWACredentials", reflect.TypeOf((*MockAppRepo)(nil).GetWACredentials), ctx, userID)
 }
 
+// IsLDAPUser mocks base method.
+func (m *MockAppRepo) IsLDAPUser(ctx context.Context, userID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsLDAPUser", ctx, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsLDAPUser indicates an expected call of IsLDAPUser.
+func (mr *MockAppRepoMockRecorder) IsLDAPUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsLDAPUser", reflect.TypeOf((*MockAppRepo)(nil).IsLDAPUser), ctx, userID)
+}
+
 // IsTokenValid mocks base method.
 func (m *MockAppRepo) IsTokenValid(ctx context.Context, userID uuid.UUID, d *models.Device, token string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -358,6 +619,36 @@ func (mr *MockAppRepoMockRecorder) ListDevices(ctx, uid any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDevices", reflect.TypeOf((*MockAppRepo)(nil).ListDevices), ctx, uid)
 }
 
+// ListPasskeys mocks base method.
+func (m *MockAppRepo) ListPasskeys(ctx context.Context, userID uuid.UUID) ([]models.Passkey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPasskeys", ctx, userID)
+	ret0, _ := ret[0].([]models.Passkey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPasskeys indicates an expected call of ListPasskeys.
+func (mr *MockAppRepoMockRecorder) ListPasskeys(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPasskeys", reflect.TypeOf((*MockAppRepo)(nil).ListPasskeys), ctx, userID)
+}
+
+// ListPasswordHistory mocks base method.
+func (m *MockAppRepo) ListPasswordHistory(ctx context.Context, userID uuid.UUID, limit int) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPasswordHistory", ctx, userID, limit)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPasswordHistory indicates an expected call of ListPasswordHistory.
+func (mr *MockAppRepoMockRecorder) ListPasswordHistory(ctx, userID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPasswordHistory", reflect.TypeOf((*MockAppRepo)(nil).ListPasswordHistory), ctx, userID, limit)
+}
+
 // ListPermissions mocks base method.
 func (m *MockAppRepo) ListPermissions(ctx context.Context, page, size int, filters map[string]any) (*dto.PaginatedPermissionResponse, error) {
 	m.ctrl.T.Helper()
@@ -373,6 +664,36 @@ func (mr *MockAppRepoMockRecorder) ListPermissions(ctx, page, size, filters any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPermissions", reflect.TypeOf((*MockAppRepo)(nil).ListPermissions), ctx, page, size, filters)
 }
 
+// ListRealmDomains mocks base method.
+func (m *MockAppRepo) ListRealmDomains(ctx context.Context) ([]models.RealmDomain, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRealmDomains", ctx)
+	ret0, _ := ret[0].([]models.RealmDomain)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRealmDomains indicates an expected call of ListRealmDomains.
+func (mr *MockAppRepoMockRecorder) ListRealmDomains(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRealmDomains", reflect.TypeOf((*MockAppRepo)(nil).ListRealmDomains), ctx)
+}
+
+// ListRiskEvents mocks base method.
+func (m *MockAppRepo) ListRiskEvents(ctx context.Context, uid uuid.UUID, page, size int) (*dto.PaginatedRiskEventResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRiskEvents", ctx, uid, page, size)
+	ret0, _ := ret[0].(*dto.PaginatedRiskEventResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRiskEvents indicates an expected call of ListRiskEvents.
+func (mr *MockAppRepoMockRecorder) ListRiskEvents(ctx, uid, page, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRiskEvents", reflect.TypeOf((*MockAppRepo)(nil).ListRiskEvents), ctx, uid, page, size)
+}
+
 // ListRoles mocks base method.
 func (m *MockAppRepo) ListRoles(ctx context.Context, page, size int, filters map[string]any) (*dto.PaginatedRoleResponse, error) {
 	m.ctrl.T.Helper()
@@ -388,6 +709,21 @@ func (mr *MockAppRepoMockRecorder) ListRoles(ctx, page, size, filters any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoles", reflect.TypeOf((*MockAppRepo)(nil).ListRoles), ctx, page, size, filters)
 }
 
+// ListSAMLProviders mocks base method.
+func (m *MockAppRepo) ListSAMLProviders(ctx context.Context) ([]models.SAMLProvider, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSAMLProviders", ctx)
+	ret0, _ := ret[0].([]models.SAMLProvider)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSAMLProviders indicates an expected call of ListSAMLProviders.
+func (mr *MockAppRepoMockRecorder) ListSAMLProviders(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSAMLProviders", reflect.TypeOf((*MockAppRepo)(nil).ListSAMLProviders), ctx)
+}
+
 // ListUsers mocks base method.
 func (m *MockAppRepo) ListUsers(ctx context.Context, page, size int, filters map[string]any) (*dto.PaginatedUserResponse, error) {
 	m.ctrl.T.Helper()
@@ -403,6 +739,34 @@ func (mr *MockAppRepoMockRecorder) ListUsers(ctx, page, size, filters any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockAppRepo)(nil).ListUsers), ctx, page, size, filters)
 }
 
+// RenamePasskey mocks base method.
+func (m *MockAppRepo) RenamePasskey(ctx context.Context, userID uuid.UUID, id []byte, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenamePasskey", ctx, userID, id, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenamePasskey indicates an expected call of RenamePasskey.
+func (mr *MockAppRepoMockRecorder) RenamePasskey(ctx, userID, id, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenamePasskey", reflect.TypeOf((*MockAppRepo)(nil).RenamePasskey), ctx, userID, id, name)
+}
+
+// ReplaceRecoveryCodes mocks base method.
+func (m *MockAppRepo) ReplaceRecoveryCodes(ctx context.Context, userID uuid.UUID, hashes []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceRecoveryCodes", ctx, userID, hashes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceRecoveryCodes indicates an expected call of ReplaceRecoveryCodes.
+func (mr *MockAppRepoMockRecorder) ReplaceRecoveryCodes(ctx, userID, hashes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceRecoveryCodes", reflect.TypeOf((*MockAppRepo)(nil).ReplaceRecoveryCodes), ctx, userID, hashes)
+}
+
 // RevokeAllTokens mocks base method.
 func (m *MockAppRepo) RevokeAllTokens(ctx context.Context, userID uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -431,6 +795,34 @@ func (mr *MockAppRepoMockRecorder) RevokeByDevice(ctx, userID, deviceID any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeByDevice", reflect.TypeOf((*MockAppRepo)(nil).RevokeByDevice), ctx, userID, deviceID)
 }
 
+// SetPhone mocks base method.
+func (m *MockAppRepo) SetPhone(ctx context.Context, userID uuid.UUID, phone string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPhone", ctx, userID, phone)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPhone indicates an expected call of SetPhone.
+func (mr *MockAppRepoMockRecorder) SetPhone(ctx, userID, phone any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPhone", reflect.TypeOf((*MockAppRepo)(nil).SetPhone), ctx, userID, phone)
+}
+
+// SyncLDAPUser mocks base method.
+func (m *MockAppRepo) SyncLDAPUser(ctx context.Context, userID uuid.UUID, req *dto.LDAPUser, managed []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncLDAPUser", ctx, userID, req, managed)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SyncLDAPUser indicates an expected call of SyncLDAPUser.
+func (mr *MockAppRepoMockRecorder) SyncLDAPUser(ctx, userID, req, managed any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncLDAPUser", reflect.TypeOf((*MockAppRepo)(nil).SyncLDAPUser), ctx, userID, req, managed)
+}
+
 // UpdateDevice mocks base method.
 func (m *MockAppRepo) UpdateDevice(ctx context.Context, uid uuid.UUID, dID string, req *dto.UpdateDeviceRequest) error {
 	m.ctrl.T.Helper()
@@ -459,6 +851,20 @@ func (mr *MockAppRepoMockRecorder) UpdateMe(ctx, id, req any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMe", reflect.TypeOf((*MockAppRepo)(nil).UpdateMe), ctx, id, req)
 }
 
+// UpdatePasswordHash mocks base method.
+func (m *MockAppRepo) UpdatePasswordHash(ctx context.Context, id uuid.UUID, hash string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePasswordHash", ctx, id, hash)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePasswordHash indicates an expected call of UpdatePasswordHash.
+func (mr *MockAppRepoMockRecorder) UpdatePasswordHash(ctx, id, hash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePasswordHash", reflect.TypeOf((*MockAppRepo)(nil).UpdatePasswordHash), ctx, id, hash)
+}
+
 // UpdatePerm mocks base method.
 func (m *MockAppRepo) UpdatePerm(ctx context.Context, id uint64, req *dto.UpdatePermissionRequest) error {
 	m.ctrl.T.Helper()
@@ -515,6 +921,63 @@ func (mr *MockAppRepoMockRecorder) UpdateWACredential(ctx, cred any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWACredential", reflect.TypeOf((*MockAppRepo)(nil).UpdateWACredential), ctx, cred)
 }
 
+// UpsertDevice mocks base method.
+func (m *MockAppRepo) UpsertDevice(ctx context.Context, uid uuid.UUID, device *models.Device) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertDevice", ctx, uid, device)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertDevice indicates an expected call of UpsertDevice.
+func (mr *MockAppRepoMockRecorder) UpsertDevice(ctx, uid, device any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertDevice", reflect.TypeOf((*MockAppRepo)(nil).UpsertDevice), ctx, uid, device)
+}
+
+// UpsertTOTP mocks base method.
+func (m *MockAppRepo) UpsertTOTP(ctx context.Context, userID uuid.UUID, secret string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertTOTP", ctx, userID, secret)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertTOTP indicates an expected call of UpsertTOTP.
+func (mr *MockAppRepoMockRecorder) UpsertTOTP(ctx, userID, secret any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertTOTP", reflect.TypeOf((*MockAppRepo)(nil).UpsertTOTP), ctx, userID, secret)
+}
+
+// UseRecoveryCode mocks base method.
+func (m *MockAppRepo) UseRecoveryCode(ctx context.Context, userID uuid.UUID, hash string, d *models.Device) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseRecoveryCode", ctx, userID, hash, d)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UseRecoveryCode indicates an expected call of UseRecoveryCode.
+func (mr *MockAppRepoMockRecorder) UseRecoveryCode(ctx, userID, hash, d any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseRecoveryCode", reflect.TypeOf((*MockAppRepo)(nil).UseRecoveryCode), ctx, userID, hash, d)
+}
+
+// UseTOTPStep mocks base method.
+func (m *MockAppRepo) UseTOTPStep(ctx context.Context, userID uuid.UUID, step int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseTOTPStep", ctx, userID, step)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseTOTPStep indicates an expected call of UseTOTPStep.
+func (mr *MockAppRepoMockRecorder) UseTOTPStep(ctx, userID, step any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseTOTPStep", reflect.TypeOf((*MockAppRepo)(nil).UseTOTPStep), ctx, userID, step)
+}
+
 // MockAppCtrl is a mock of AppCtrl interface.
 type MockAppCtrl struct {
 	ctrl     *gomock.Controller
@@ -539,6 +1002,20 @@ func (m *MockAppCtrl) EXPECT() *MockAppCtrlMockRecorder {
 	return m.recorder
 }
 
+// ApproveLogin mocks base method.
+func (m *MockAppCtrl) ApproveLogin(ctx context.Context, uid uuid.UUID, id string, req *dto.ApproveLoginRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveLogin", ctx, uid, id, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApproveLogin indicates an expected call of ApproveLogin.
+func (mr *MockAppCtrlMockRecorder) ApproveLogin(ctx, uid, id, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveLogin", reflect.TypeOf((*MockAppCtrl)(nil).ApproveLogin), ctx, uid, id, req)
+}
+
 // Authenticate mocks base method.
 func (m *MockAppCtrl) Authenticate(ctx context.Context, d *dto.DeviceRequest, req *dto.EmailAndPasswordRequest) (*dto.TokenPair, error) {
 	m.ctrl.T.Helper()
@@ -554,6 +1031,21 @@ func (mr *MockAppCtrlMockRecorder) Authenticate(ctx, d, req any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockAppCtrl)(nil).Authenticate), ctx, d, req)
 }
 
+// BeginDiscoverableLogin mocks base method.
+func (m *MockAppCtrl) BeginDiscoverableLogin(ctx context.Context, mediation string) (*protocol.CredentialAssertion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BeginDiscoverableLogin", ctx, mediation)
+	ret0, _ := ret[0].(*protocol.CredentialAssertion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BeginDiscoverableLogin indicates an expected call of BeginDiscoverableLogin.
+func (mr *MockAppCtrlMockRecorder) BeginDiscoverableLogin(ctx, mediation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeginDiscoverableLogin", reflect.TypeOf((*MockAppCtrl)(nil).BeginDiscoverableLogin), ctx, mediation)
+}
+
 // BeginLogin mocks base method.
 func (m *MockAppCtrl) BeginLogin(ctx context.Context, email string) (*protocol.CredentialAssertion, error) {
 	m.ctrl.T.Helper()
@@ -569,18 +1061,48 @@ func (mr *MockAppCtrlMockRecorder) BeginLogin(ctx, email any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeginLogin", reflect.TypeOf((*MockAppCtrl)(nil).BeginLogin), ctx, email)
 }
 
+// ChangePassword mocks base method.
+func (m *MockAppCtrl) ChangePassword(ctx context.Context, d *dto.DeviceRequest, uid uuid.UUID, req *dto.ChangePasswordRequest) (*dto.TokenPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePassword", ctx, d, uid, req)
+	ret0, _ := ret[0].(*dto.TokenPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangePassword indicates an expected call of ChangePassword.
+func (mr *MockAppCtrlMockRecorder) ChangePassword(ctx, d, uid, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockAppCtrl)(nil).ChangePassword), ctx, d, uid, req)
+}
+
 // CheckForgotPasswordEmail mocks base method.
-func (m *MockAppCtrl) CheckForgotPasswordEmail(ctx context.Context, req *dto.CheckForgotPasswordEmailRequest) error {
+func (m *MockAppCtrl) CheckForgotPasswordEmail(ctx context.Context, d *dto.DeviceRequest, req *dto.CheckForgotPasswordEmailRequest) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CheckForgotPasswordEmail", ctx, req)
+	ret := m.ctrl.Call(m, "CheckForgotPasswordEmail", ctx, d, req)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CheckForgotPasswordEmail indicates an expected call of CheckForgotPasswordEmail.
-func (mr *MockAppCtrlMockRecorder) CheckForgotPasswordEmail(ctx, req any) *gomock.Call {
+func (mr *MockAppCtrlMockRecorder) CheckForgotPasswordEmail(ctx, d, req any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckForgotPasswordEmail", reflect.TypeOf((*MockAppCtrl)(nil).CheckForgotPasswordEmail), ctx, req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckForgotPasswordEmail", reflect.TypeOf((*MockAppCtrl)(nil).CheckForgotPasswordEmail), ctx, d, req)
+}
+
+// CheckLoginApproval mocks base method.
+func (m *MockAppCtrl) CheckLoginApproval(ctx context.Context, d *dto.DeviceRequest, id string) (*dto.TokenPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckLoginApproval", ctx, d, id)
+	ret0, _ := ret[0].(*dto.TokenPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckLoginApproval indicates an expected call of CheckLoginApproval.
+func (mr *MockAppCtrlMockRecorder) CheckLoginApproval(ctx, d, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckLoginApproval", reflect.TypeOf((*MockAppCtrl)(nil).CheckLoginApproval), ctx, d, id)
 }
 
 // CheckLoginCode mocks base method.
@@ -598,6 +1120,64 @@ func (mr *MockAppCtrlMockRecorder) CheckLoginCode(ctx, d, req any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckLoginCode", reflect.TypeOf((*MockAppCtrl)(nil).CheckLoginCode), ctx, d, req)
 }
 
+// CheckQRLogin mocks base method.
+func (m *MockAppCtrl) CheckQRLogin(ctx context.Context, d *dto.DeviceRequest, id string) (*dto.TokenPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckQRLogin", ctx, d, id)
+	ret0, _ := ret[0].(*dto.TokenPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckQRLogin indicates an expected call of CheckQRLogin.
+func (mr *MockAppCtrlMockRecorder) CheckQRLogin(ctx, d, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckQRLogin", reflect.TypeOf((*MockAppCtrl)(nil).CheckQRLogin), ctx, d, id)
+}
+
+// ConfirmPhone mocks base method.
+func (m *MockAppCtrl) ConfirmPhone(ctx context.Context, uid uuid.UUID, req *dto.ConfirmPhoneRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmPhone", ctx, uid, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConfirmPhone indicates an expected call of ConfirmPhone.
+func (mr *MockAppCtrlMockRecorder) ConfirmPhone(ctx, uid, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmPhone", reflect.TypeOf((*MockAppCtrl)(nil).ConfirmPhone), ctx, uid, req)
+}
+
+// ConfirmQRLogin mocks base method.
+func (m *MockAppCtrl) ConfirmQRLogin(ctx context.Context, uid uuid.UUID, d *dto.DeviceRequest, code string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmQRLogin", ctx, uid, d, code)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConfirmQRLogin indicates an expected call of ConfirmQRLogin.
+func (mr *MockAppCtrlMockRecorder) ConfirmQRLogin(ctx, uid, d, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmQRLogin", reflect.TypeOf((*MockAppCtrl)(nil).ConfirmQRLogin), ctx, uid, d, code)
+}
+
+// ConfirmTOTP mocks base method.
+func (m *MockAppCtrl) ConfirmTOTP(ctx context.Context, uid uuid.UUID, code string) (*dto.RecoveryCodesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmTOTP", ctx, uid, code)
+	ret0, _ := ret[0].(*dto.RecoveryCodesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmTOTP indicates an expected call of ConfirmTOTP.
+func (mr *MockAppCtrlMockRecorder) ConfirmTOTP(ctx, uid, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmTOTP", reflect.TypeOf((*MockAppCtrl)(nil).ConfirmTOTP), ctx, uid, code)
+}
+
 // CreatePerm mocks base method.
 func (m *MockAppCtrl) CreatePerm(ctx context.Context, req *dto.CreatePermissionRequest) (uint64, error) {
 	m.ctrl.T.Helper()
@@ -613,6 +1193,21 @@ func (mr *MockAppCtrlMockRecorder) CreatePerm(ctx, req any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePerm", reflect.TypeOf((*MockAppCtrl)(nil).CreatePerm), ctx, req)
 }
 
+// CreateRealmDomain mocks base method.
+func (m *MockAppCtrl) CreateRealmDomain(ctx context.Context, req *dto.CreateRealmDomainRequest) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRealmDomain", ctx, req)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRealmDomain indicates an expected call of CreateRealmDomain.
+func (mr *MockAppCtrlMockRecorder) CreateRealmDomain(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRealmDomain", reflect.TypeOf((*MockAppCtrl)(nil).CreateRealmDomain), ctx, req)
+}
+
 // CreateRole mocks base method.
 func (m *MockAppCtrl) CreateRole(ctx context.Context, req *dto.CreateRoleRequest) (uint64, error) {
 	m.ctrl.T.Helper()
@@ -628,6 +1223,21 @@ func (mr *MockAppCtrlMockRecorder) CreateRole(ctx, req any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRole", reflect.TypeOf((*MockAppCtrl)(nil).CreateRole), ctx, req)
 }
 
+// CreateSAMLProvider mocks base method.
+func (m *MockAppCtrl) CreateSAMLProvider(ctx context.Context, req *dto.CreateSAMLProviderRequest) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSAMLProvider", ctx, req)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSAMLProvider indicates an expected call of CreateSAMLProvider.
+func (mr *MockAppCtrlMockRecorder) CreateSAMLProvider(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSAMLProvider", reflect.TypeOf((*MockAppCtrl)(nil).CreateSAMLProvider), ctx, req)
+}
+
 // CreateUser mocks base method.
 func (m *MockAppCtrl) CreateUser(ctx context.Context, u *dto.CreateUserRequest, file *s3.UploadFileRequest) (*dto.CreateUserResponse, error) {
 	m.ctrl.T.Helper()
@@ -657,6 +1267,20 @@ func (mr *MockAppCtrlMockRecorder) DeleteDevice(ctx, uid, dID any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDevice", reflect.TypeOf((*MockAppCtrl)(nil).DeleteDevice), ctx, uid, dID)
 }
 
+// DeletePasskey mocks base method.
+func (m *MockAppCtrl) DeletePasskey(ctx context.Context, uid uuid.UUID, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePasskey", ctx, uid, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePasskey indicates an expected call of DeletePasskey.
+func (mr *MockAppCtrlMockRecorder) DeletePasskey(ctx, uid, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePasskey", reflect.TypeOf((*MockAppCtrl)(nil).DeletePasskey), ctx, uid, id)
+}
+
 // DeletePerm mocks base method.
 func (m *MockAppCtrl) DeletePerm(ctx context.Context, id uint64) error {
 	m.ctrl.T.Helper()
@@ -671,6 +1295,34 @@ func (mr *MockAppCtrlMockRecorder) DeletePerm(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePerm", reflect.TypeOf((*MockAppCtrl)(nil).DeletePerm), ctx, id)
 }
 
+// DeletePhone mocks base method.
+func (m *MockAppCtrl) DeletePhone(ctx context.Context, uid uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePhone", ctx, uid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePhone indicates an expected call of DeletePhone.
+func (mr *MockAppCtrlMockRecorder) DeletePhone(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePhone", reflect.TypeOf((*MockAppCtrl)(nil).DeletePhone), ctx, uid)
+}
+
+// DeleteRealmDomain mocks base method.
+func (m *MockAppCtrl) DeleteRealmDomain(ctx context.Context, domain string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRealmDomain", ctx, domain)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRealmDomain indicates an expected call of DeleteRealmDomain.
+func (mr *MockAppCtrlMockRecorder) DeleteRealmDomain(ctx, domain any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRealmDomain", reflect.TypeOf((*MockAppCtrl)(nil).DeleteRealmDomain), ctx, domain)
+}
+
 // DeleteRole mocks base method.
 func (m *MockAppCtrl) DeleteRole(ctx context.Context, uid uint64) error {
 	m.ctrl.T.Helper()
@@ -685,6 +1337,20 @@ func (mr *MockAppCtrlMockRecorder) DeleteRole(ctx, uid any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRole", reflect.TypeOf((*MockAppCtrl)(nil).DeleteRole), ctx, uid)
 }
 
+// DeleteSAMLProvider mocks base method.
+func (m *MockAppCtrl) DeleteSAMLProvider(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSAMLProvider", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSAMLProvider indicates an expected call of DeleteSAMLProvider.
+func (mr *MockAppCtrlMockRecorder) DeleteSAMLProvider(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSAMLProvider", reflect.TypeOf((*MockAppCtrl)(nil).DeleteSAMLProvider), ctx, name)
+}
+
 // DeleteUser mocks base method.
 func (m *MockAppCtrl) DeleteUser(ctx context.Context, userID uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -699,6 +1365,65 @@ func (mr *MockAppCtrlMockRecorder) DeleteUser(ctx, userID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockAppCtrl)(nil).DeleteUser), ctx, userID)
 }
 
+// DisableTOTP mocks base method.
+func (m *MockAppCtrl) DisableTOTP(ctx context.Context, uid uuid.UUID, code string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableTOTP", ctx, uid, code)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableTOTP indicates an expected call of DisableTOTP.
+func (mr *MockAppCtrlMockRecorder) DisableTOTP(ctx, uid, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableTOTP", reflect.TypeOf((*MockAppCtrl)(nil).DisableTOTP), ctx, uid, code)
+}
+
+// DiscoverRealm mocks base method.
+func (m *MockAppCtrl) DiscoverRealm(ctx context.Context, email string) (*dto.DiscoverRealmResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiscoverRealm", ctx, email)
+	ret0, _ := ret[0].(*dto.DiscoverRealmResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiscoverRealm indicates an expected call of DiscoverRealm.
+func (mr *MockAppCtrlMockRecorder) DiscoverRealm(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiscoverRealm", reflect.TypeOf((*MockAppCtrl)(nil).DiscoverRealm), ctx, email)
+}
+
+// EnrollTOTP mocks base method.
+func (m *MockAppCtrl) EnrollTOTP(ctx context.Context, uid uuid.UUID) (*dto.TOTPEnrollResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnrollTOTP", ctx, uid)
+	ret0, _ := ret[0].(*dto.TOTPEnrollResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnrollTOTP indicates an expected call of EnrollTOTP.
+func (mr *MockAppCtrlMockRecorder) EnrollTOTP(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnrollTOTP", reflect.TypeOf((*MockAppCtrl)(nil).EnrollTOTP), ctx, uid)
+}
+
+// FinishDiscoverableLogin mocks base method.
+func (m *MockAppCtrl) FinishDiscoverableLogin(ctx context.Context, d dto.DeviceRequest, r *http.Request) (dto.TokenPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishDiscoverableLogin", ctx, d, r)
+	ret0, _ := ret[0].(dto.TokenPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FinishDiscoverableLogin indicates an expected call of FinishDiscoverableLogin.
+func (mr *MockAppCtrlMockRecorder) FinishDiscoverableLogin(ctx, d, r any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishDiscoverableLogin", reflect.TypeOf((*MockAppCtrl)(nil).FinishDiscoverableLogin), ctx, d, r)
+}
+
 // FinishLogin mocks base method.
 func (m *MockAppCtrl) FinishLogin(ctx context.Context, email string, d dto.DeviceRequest, r *http.Request) (dto.TokenPair, error) {
 	m.ctrl.T.Helper()
@@ -729,18 +1454,23 @@ func (mr *MockAppCtrlMockRecorder) FinishRegistration(ctx, uid, r any) *gomock.C
 }
 
 // GenPair mocks base method.
-func (m *MockAppCtrl) GenPair(ctx context.Context, d *dto.DeviceRequest, uid uuid.UUID, p []models.Role) (dto.TokenPair, error) {
+func (m *MockAppCtrl) GenPair(ctx context.Context, d *dto.DeviceRequest, uid uuid.UUID, p []models.Role, amr ...string) (dto.TokenPair, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GenPair", ctx, d, uid, p)
+	varargs := []any{ctx, d, uid, p}
+	for _, a := range amr {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GenPair", varargs...)
 	ret0, _ := ret[0].(dto.TokenPair)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GenPair indicates an expected call of GenPair.
-func (mr *MockAppCtrlMockRecorder) GenPair(ctx, d, uid, p any) *gomock.Call {
+func (mr *MockAppCtrlMockRecorder) GenPair(ctx, d, uid, p any, amr ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenPair", reflect.TypeOf((*MockAppCtrl)(nil).GenPair), ctx, d, uid, p)
+	varargs := append([]any{ctx, d, uid, p}, amr...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenPair", reflect.TypeOf((*MockAppCtrl)(nil).GenPair), varargs...)
 }
 
 // GetDevice mocks base method.
@@ -773,6 +1503,21 @@ func (mr *MockAppCtrlMockRecorder) GetDeviceByID(ctx, dID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeviceByID", reflect.TypeOf((*MockAppCtrl)(nil).GetDeviceByID), ctx, dID)
 }
 
+// GetLockout mocks base method.
+func (m *MockAppCtrl) GetLockout(ctx context.Context, uid uuid.UUID) (*dto.LockoutStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLockout", ctx, uid)
+	ret0, _ := ret[0].(*dto.LockoutStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLockout indicates an expected call of GetLockout.
+func (mr *MockAppCtrlMockRecorder) GetLockout(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLockout", reflect.TypeOf((*MockAppCtrl)(nil).GetLockout), ctx, uid)
+}
+
 // GetOAuth2AuthURL mocks base method.
 func (m *MockAppCtrl) GetOAuth2AuthURL(ctx context.Context, provider string) (*dto.StartProviderResponse, error) {
 	m.ctrl.T.Helper()
@@ -806,31 +1551,76 @@ func (mr *MockAppCtrlMockRecorder) GetOIDCAuthURL(ctx, provider any) *gomock.Cal
 // GetPermission mocks base method.
 func (m *MockAppCtrl) GetPermission(ctx context.Context, id uint64) (*models.Permission, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPermission", ctx, id)
-	ret0, _ := ret[0].(*models.Permission)
+	ret := m.ctrl.Call(m, "GetPermission", ctx, id)
+	ret0, _ := ret[0].(*models.Permission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPermission indicates an expected call of GetPermission.
+func (mr *MockAppCtrlMockRecorder) GetPermission(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPermission", reflect.TypeOf((*MockAppCtrl)(nil).GetPermission), ctx, id)
+}
+
+// GetQRLogin mocks base method.
+func (m *MockAppCtrl) GetQRLogin(ctx context.Context, code string) (*dto.QRLoginSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQRLogin", ctx, code)
+	ret0, _ := ret[0].(*dto.QRLoginSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQRLogin indicates an expected call of GetQRLogin.
+func (mr *MockAppCtrlMockRecorder) GetQRLogin(ctx, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQRLogin", reflect.TypeOf((*MockAppCtrl)(nil).GetQRLogin), ctx, code)
+}
+
+// GetRole mocks base method.
+func (m *MockAppCtrl) GetRole(ctx context.Context, uid uint64) (*models.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRole", ctx, uid)
+	ret0, _ := ret[0].(*models.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRole indicates an expected call of GetRole.
+func (mr *MockAppCtrlMockRecorder) GetRole(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRole", reflect.TypeOf((*MockAppCtrl)(nil).GetRole), ctx, uid)
+}
+
+// GetSAMLAuthURL mocks base method.
+func (m *MockAppCtrl) GetSAMLAuthURL(ctx context.Context, provider string) (*dto.StartProviderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSAMLAuthURL", ctx, provider)
+	ret0, _ := ret[0].(*dto.StartProviderResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetPermission indicates an expected call of GetPermission.
-func (mr *MockAppCtrlMockRecorder) GetPermission(ctx, id any) *gomock.Call {
+// GetSAMLAuthURL indicates an expected call of GetSAMLAuthURL.
+func (mr *MockAppCtrlMockRecorder) GetSAMLAuthURL(ctx, provider any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPermission", reflect.TypeOf((*MockAppCtrl)(nil).GetPermission), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSAMLAuthURL", reflect.TypeOf((*MockAppCtrl)(nil).GetSAMLAuthURL), ctx, provider)
 }
 
-// GetRole mocks base method.
-func (m *MockAppCtrl) GetRole(ctx context.Context, uid uint64) (*models.Role, error) {
+// GetSAMLMetadata mocks base method.
+func (m *MockAppCtrl) GetSAMLMetadata(ctx context.Context, provider string) ([]byte, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetRole", ctx, uid)
-	ret0, _ := ret[0].(*models.Role)
+	ret := m.ctrl.Call(m, "GetSAMLMetadata", ctx, provider)
+	ret0, _ := ret[0].([]byte)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetRole indicates an expected call of GetRole.
-func (mr *MockAppCtrlMockRecorder) GetRole(ctx, uid any) *gomock.Call {
+// GetSAMLMetadata indicates an expected call of GetSAMLMetadata.
+func (mr *MockAppCtrlMockRecorder) GetSAMLMetadata(ctx, provider any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRole", reflect.TypeOf((*MockAppCtrl)(nil).GetRole), ctx, uid)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSAMLMetadata", reflect.TypeOf((*MockAppCtrl)(nil).GetSAMLMetadata), ctx, provider)
 }
 
 // GetUserByEmail mocks base method.
@@ -909,18 +1699,33 @@ func (mr *MockAppCtrlMockRecorder) HandleOAuth2Callback(ctx, d, provider, code,
 }
 
 // HandleOIDCCallback mocks base method.
-func (m *MockAppCtrl) HandleOIDCCallback(ctx context.Context, d *dto.DeviceRequest, provider, code, state string) (*dto.HandleCallbackResponse, error) {
+func (m *MockAppCtrl) HandleOIDCCallback(ctx context.Context, d *dto.DeviceRequest, provider, code, state, profile string) (*dto.HandleCallbackResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "HandleOIDCCallback", ctx, d, provider, code, state)
+	ret := m.ctrl.Call(m, "HandleOIDCCallback", ctx, d, provider, code, state, profile)
 	ret0, _ := ret[0].(*dto.HandleCallbackResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // HandleOIDCCallback indicates an expected call of HandleOIDCCallback.
-func (mr *MockAppCtrlMockRecorder) HandleOIDCCallback(ctx, d, provider, code, state any) *gomock.Call {
+func (mr *MockAppCtrlMockRecorder) HandleOIDCCallback(ctx, d, provider, code, state, profile any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleOIDCCallback", reflect.TypeOf((*MockAppCtrl)(nil).HandleOIDCCallback), ctx, d, provider, code, state, profile)
+}
+
+// HandleSAMLCallback mocks base method.
+func (m *MockAppCtrl) HandleSAMLCallback(ctx context.Context, d *dto.DeviceRequest, provider, relayState string, r *http.Request) (*dto.HandleCallbackResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HandleSAMLCallback", ctx, d, provider, relayState, r)
+	ret0, _ := ret[0].(*dto.HandleCallbackResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HandleSAMLCallback indicates an expected call of HandleSAMLCallback.
+func (mr *MockAppCtrlMockRecorder) HandleSAMLCallback(ctx, d, provider, relayState, r any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleOIDCCallback", reflect.TypeOf((*MockAppCtrl)(nil).HandleOIDCCallback), ctx, d, provider, code, state)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleSAMLCallback", reflect.TypeOf((*MockAppCtrl)(nil).HandleSAMLCallback), ctx, d, provider, relayState, r)
 }
 
 // IsUserExist mocks base method.
@@ -953,6 +1758,36 @@ func (mr *MockAppCtrlMockRecorder) ListDevices(ctx, uid any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDevices", reflect.TypeOf((*MockAppCtrl)(nil).ListDevices), ctx, uid)
 }
 
+// ListLoginApprovals mocks base method.
+func (m *MockAppCtrl) ListLoginApprovals(ctx context.Context, uid uuid.UUID) ([]dto.PendingLogin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLoginApprovals", ctx, uid)
+	ret0, _ := ret[0].([]dto.PendingLogin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLoginApprovals indicates an expected call of ListLoginApprovals.
+func (mr *MockAppCtrlMockRecorder) ListLoginApprovals(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLoginApprovals", reflect.TypeOf((*MockAppCtrl)(nil).ListLoginApprovals), ctx, uid)
+}
+
+// ListPasskeys mocks base method.
+func (m *MockAppCtrl) ListPasskeys(ctx context.Context, uid uuid.UUID) ([]models.Passkey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPasskeys", ctx, uid)
+	ret0, _ := ret[0].([]models.Passkey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPasskeys indicates an expected call of ListPasskeys.
+func (mr *MockAppCtrlMockRecorder) ListPasskeys(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPasskeys", reflect.TypeOf((*MockAppCtrl)(nil).ListPasskeys), ctx, uid)
+}
+
 // ListPermissions mocks base method.
 func (m *MockAppCtrl) ListPermissions(ctx context.Context, page, size int, filters map[string]any) (*dto.PaginatedPermissionResponse, error) {
 	m.ctrl.T.Helper()
@@ -968,6 +1803,36 @@ func (mr *MockAppCtrlMockRecorder) ListPermissions(ctx, page, size, filters any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPermissions", reflect.TypeOf((*MockAppCtrl)(nil).ListPermissions), ctx, page, size, filters)
 }
 
+// ListRealmDomains mocks base method.
+func (m *MockAppCtrl) ListRealmDomains(ctx context.Context) ([]models.RealmDomain, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRealmDomains", ctx)
+	ret0, _ := ret[0].([]models.RealmDomain)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRealmDomains indicates an expected call of ListRealmDomains.
+func (mr *MockAppCtrlMockRecorder) ListRealmDomains(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRealmDomains", reflect.TypeOf((*MockAppCtrl)(nil).ListRealmDomains), ctx)
+}
+
+// ListRiskEvents mocks base method.
+func (m *MockAppCtrl) ListRiskEvents(ctx context.Context, uid uuid.UUID, page, size int) (*dto.PaginatedRiskEventResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRiskEvents", ctx, uid, page, size)
+	ret0, _ := ret[0].(*dto.PaginatedRiskEventResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRiskEvents indicates an expected call of ListRiskEvents.
+func (mr *MockAppCtrlMockRecorder) ListRiskEvents(ctx, uid, page, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRiskEvents", reflect.TypeOf((*MockAppCtrl)(nil).ListRiskEvents), ctx, uid, page, size)
+}
+
 // ListRoles mocks base method.
 func (m *MockAppCtrl) ListRoles(ctx context.Context, page, size int, filters map[string]any) (*dto.PaginatedRoleResponse, error) {
 	m.ctrl.T.Helper()
@@ -983,6 +1848,21 @@ func (mr *MockAppCtrlMockRecorder) ListRoles(ctx, page, size, filters any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoles", reflect.TypeOf((*MockAppCtrl)(nil).ListRoles), ctx, page, size, filters)
 }
 
+// ListSAMLProviders mocks base method.
+func (m *MockAppCtrl) ListSAMLProviders(ctx context.Context) ([]models.SAMLProvider, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSAMLProviders", ctx)
+	ret0, _ := ret[0].([]models.SAMLProvider)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSAMLProviders indicates an expected call of ListSAMLProviders.
+func (mr *MockAppCtrlMockRecorder) ListSAMLProviders(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSAMLProviders", reflect.TypeOf((*MockAppCtrl)(nil).ListSAMLProviders), ctx)
+}
+
 // ListUsers mocks base method.
 func (m *MockAppCtrl) ListUsers(ctx context.Context, page, size int, filters map[string]any) (*dto.PaginatedUserResponse, error) {
 	m.ctrl.T.Helper()
@@ -1027,6 +1907,21 @@ func (mr *MockAppCtrlMockRecorder) ParseClaims(ctx, token any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseClaims", reflect.TypeOf((*MockAppCtrl)(nil).ParseClaims), ctx, token)
 }
 
+// Reauthenticate mocks base method.
+func (m *MockAppCtrl) Reauthenticate(ctx context.Context, d *dto.DeviceRequest, uid uuid.UUID, req *dto.ReauthRequest) (*dto.TokenPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reauthenticate", ctx, d, uid, req)
+	ret0, _ := ret[0].(*dto.TokenPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reauthenticate indicates an expected call of Reauthenticate.
+func (mr *MockAppCtrlMockRecorder) Reauthenticate(ctx, d, uid, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reauthenticate", reflect.TypeOf((*MockAppCtrl)(nil).Reauthenticate), ctx, d, uid, req)
+}
+
 // Refresh mocks base method.
 func (m *MockAppCtrl) Refresh(ctx context.Context, d *dto.DeviceRequest, req *dto.RefreshRequest) (*dto.TokenPair, error) {
 	m.ctrl.T.Helper()
@@ -1042,33 +1937,106 @@ func (mr *MockAppCtrlMockRecorder) Refresh(ctx, d, req any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MockAppCtrl)(nil).Refresh), ctx, d, req)
 }
 
+// RegenerateRecoveryCodes mocks base method.
+func (m *MockAppCtrl) RegenerateRecoveryCodes(ctx context.Context, uid uuid.UUID) (*dto.RecoveryCodesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegenerateRecoveryCodes", ctx, uid)
+	ret0, _ := ret[0].(*dto.RecoveryCodesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegenerateRecoveryCodes indicates an expected call of RegenerateRecoveryCodes.
+func (mr *MockAppCtrlMockRecorder) RegenerateRecoveryCodes(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegenerateRecoveryCodes", reflect.TypeOf((*MockAppCtrl)(nil).RegenerateRecoveryCodes), ctx, uid)
+}
+
+// RenamePasskey mocks base method.
+func (m *MockAppCtrl) RenamePasskey(ctx context.Context, uid uuid.UUID, id string, req *dto.RenamePasskeyRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenamePasskey", ctx, uid, id, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenamePasskey indicates an expected call of RenamePasskey.
+func (mr *MockAppCtrlMockRecorder) RenamePasskey(ctx, uid, id, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenamePasskey", reflect.TypeOf((*MockAppCtrl)(nil).RenamePasskey), ctx, uid, id, req)
+}
+
 // SendForgotPasswordEmail mocks base method.
-func (m *MockAppCtrl) SendForgotPasswordEmail(ctx context.Context, email string) error {
+func (m *MockAppCtrl) SendForgotPasswordEmail(ctx context.Context, email, channel string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SendForgotPasswordEmail", ctx, email)
+	ret := m.ctrl.Call(m, "SendForgotPasswordEmail", ctx, email, channel)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SendForgotPasswordEmail indicates an expected call of SendForgotPasswordEmail.
-func (mr *MockAppCtrlMockRecorder) SendForgotPasswordEmail(ctx, email any) *gomock.Call {
+func (mr *MockAppCtrlMockRecorder) SendForgotPasswordEmail(ctx, email, channel any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendForgotPasswordEmail", reflect.TypeOf((*MockAppCtrl)(nil).SendForgotPasswordEmail), ctx, email)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendForgotPasswordEmail", reflect.TypeOf((*MockAppCtrl)(nil).SendForgotPasswordEmail), ctx, email, channel)
 }
 
 // SendLoginCode mocks base method.
-func (m *MockAppCtrl) SendLoginCode(ctx context.Context, d *dto.DeviceRequest, email, password string) (dto.TokenPair, error) {
+func (m *MockAppCtrl) SendLoginCode(ctx context.Context, d *dto.DeviceRequest, email, password, channel string) (dto.TokenPair, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SendLoginCode", ctx, d, email, password)
+	ret := m.ctrl.Call(m, "SendLoginCode", ctx, d, email, password, channel)
 	ret0, _ := ret[0].(dto.TokenPair)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SendLoginCode indicates an expected call of SendLoginCode.
-func (mr *MockAppCtrlMockRecorder) SendLoginCode(ctx, d, email, password any) *gomock.Call {
+func (mr *MockAppCtrlMockRecorder) SendLoginCode(ctx, d, email, password, channel any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendLoginCode", reflect.TypeOf((*MockAppCtrl)(nil).SendLoginCode), ctx, d, email, password, channel)
+}
+
+// SendMagicLink mocks base method.
+func (m *MockAppCtrl) SendMagicLink(ctx context.Context, email string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMagicLink", ctx, email)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendMagicLink indicates an expected call of SendMagicLink.
+func (mr *MockAppCtrlMockRecorder) SendMagicLink(ctx, email any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendLoginCode", reflect.TypeOf((*MockAppCtrl)(nil).SendLoginCode), ctx, d, email, password)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMagicLink", reflect.TypeOf((*MockAppCtrl)(nil).SendMagicLink), ctx, email)
+}
+
+// StartPhoneVerification mocks base method.
+func (m *MockAppCtrl) StartPhoneVerification(ctx context.Context, uid uuid.UUID, req *dto.PhoneRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartPhoneVerification", ctx, uid, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartPhoneVerification indicates an expected call of StartPhoneVerification.
+func (mr *MockAppCtrlMockRecorder) StartPhoneVerification(ctx, uid, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartPhoneVerification", reflect.TypeOf((*MockAppCtrl)(nil).StartPhoneVerification), ctx, uid, req)
+}
+
+// StartQRLogin mocks base method.
+func (m *MockAppCtrl) StartQRLogin(ctx context.Context, d *dto.DeviceRequest) (*dto.QRLoginResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartQRLogin", ctx, d)
+	ret0, _ := ret[0].(*dto.QRLoginResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartQRLogin indicates an expected call of StartQRLogin.
+func (mr *MockAppCtrlMockRecorder) StartQRLogin(ctx, d any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartQRLogin", reflect.TypeOf((*MockAppCtrl)(nil).StartQRLogin), ctx, d)
 }
 
 // StartRegistration mocks base method.
@@ -1100,6 +2068,34 @@ func (mr *MockAppCtrlMockRecorder) StoreWASession(ctx, sessionType, userID, req
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreWASession", reflect.TypeOf((*MockAppCtrl)(nil).StoreWASession), ctx, sessionType, userID, req)
 }
 
+// UnlockIP mocks base method.
+func (m *MockAppCtrl) UnlockIP(ctx context.Context, ip string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlockIP", ctx, ip)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnlockIP indicates an expected call of UnlockIP.
+func (mr *MockAppCtrlMockRecorder) UnlockIP(ctx, ip any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlockIP", reflect.TypeOf((*MockAppCtrl)(nil).UnlockIP), ctx, ip)
+}
+
+// UnlockUser mocks base method.
+func (m *MockAppCtrl) UnlockUser(ctx context.Context, uid uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlockUser", ctx, uid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnlockUser indicates an expected call of UnlockUser.
+func (mr *MockAppCtrlMockRecorder) UnlockUser(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlockUser", reflect.TypeOf((*MockAppCtrl)(nil).UnlockUser), ctx, uid)
+}
+
 // UpdateDevice mocks base method.
 func (m *MockAppCtrl) UpdateDevice(ctx context.Context, uid uuid.UUID, dID string, req *dto.UpdateDeviceRequest) error {
 	m.ctrl.T.Helper()
@@ -1170,6 +2166,81 @@ func (mr *MockAppCtrlMockRecorder) UpdateUser(ctx, id, req, file any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockAppCtrl)(nil).UpdateUser), ctx, id, req, file)
 }
 
+// UpdateWAMetadata mocks base method.
+func (m *MockAppCtrl) UpdateWAMetadata(ctx context.Context, blob []byte) (*dto.WAMetadataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWAMetadata", ctx, blob)
+	ret0, _ := ret[0].(*dto.WAMetadataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWAMetadata indicates an expected call of UpdateWAMetadata.
+func (mr *MockAppCtrlMockRecorder) UpdateWAMetadata(ctx, blob any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWAMetadata", reflect.TypeOf((*MockAppCtrl)(nil).UpdateWAMetadata), ctx, blob)
+}
+
+// VerifyEmailCode mocks base method.
+func (m *MockAppCtrl) VerifyEmailCode(ctx context.Context, d *dto.DeviceRequest, req *dto.VerifyEmailCodeRequest) (*dto.TokenPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyEmailCode", ctx, d, req)
+	ret0, _ := ret[0].(*dto.TokenPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyEmailCode indicates an expected call of VerifyEmailCode.
+func (mr *MockAppCtrlMockRecorder) VerifyEmailCode(ctx, d, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEmailCode", reflect.TypeOf((*MockAppCtrl)(nil).VerifyEmailCode), ctx, d, req)
+}
+
+// VerifyMagicLink mocks base method.
+func (m *MockAppCtrl) VerifyMagicLink(ctx context.Context, d *dto.DeviceRequest, token, nonce string) (*dto.TokenPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyMagicLink", ctx, d, token, nonce)
+	ret0, _ := ret[0].(*dto.TokenPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyMagicLink indicates an expected call of VerifyMagicLink.
+func (mr *MockAppCtrlMockRecorder) VerifyMagicLink(ctx, d, token, nonce any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyMagicLink", reflect.TypeOf((*MockAppCtrl)(nil).VerifyMagicLink), ctx, d, token, nonce)
+}
+
+// VerifyRecoveryCode mocks base method.
+func (m *MockAppCtrl) VerifyRecoveryCode(ctx context.Context, d *dto.DeviceRequest, req *dto.VerifyRecoveryCodeRequest) (*dto.TokenPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyRecoveryCode", ctx, d, req)
+	ret0, _ := ret[0].(*dto.TokenPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyRecoveryCode indicates an expected call of VerifyRecoveryCode.
+func (mr *MockAppCtrlMockRecorder) VerifyRecoveryCode(ctx, d, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyRecoveryCode", reflect.TypeOf((*MockAppCtrl)(nil).VerifyRecoveryCode), ctx, d, req)
+}
+
+// VerifyTOTP mocks base method.
+func (m *MockAppCtrl) VerifyTOTP(ctx context.Context, d *dto.DeviceRequest, req *dto.VerifyTOTPRequest) (*dto.TokenPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyTOTP", ctx, d, req)
+	ret0, _ := ret[0].(*dto.TokenPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyTOTP indicates an expected call of VerifyTOTP.
+func (mr *MockAppCtrlMockRecorder) VerifyTOTP(ctx, d, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyTOTP", reflect.TypeOf((*MockAppCtrl)(nil).VerifyTOTP), ctx, d, req)
+}
+
 // MockS3Service is a mock of S3Service interface.
 type MockS3Service struct {
 	ctrl     *gomock.Controller
@@ -1302,6 +2373,21 @@ func (mr *MockCacheServiceMockRecorder) GetToStruct(ctx, key, dest any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetToStruct", reflect.TypeOf((*MockCacheService)(nil).GetToStruct), ctx, key, dest)
 }
 
+// Incr mocks base method.
+func (m *MockCacheService) Incr(ctx context.Context, t time.Duration, key string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Incr", ctx, t, key)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Incr indicates an expected call of Incr.
+func (mr *MockCacheServiceMockRecorder) Incr(ctx, t, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Incr", reflect.TypeOf((*MockCacheService)(nil).Incr), ctx, t, key)
+}
+
 // InvalidateKeysByPattern mocks base method.
 func (m *MockCacheService) InvalidateKeysByPattern(ctx context.Context, pattern string) {
 	m.ctrl.T.Helper()
@@ -1326,6 +2412,21 @@ func (mr *MockCacheServiceMockRecorder) Set(ctx, t, key, val any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockCacheService)(nil).Set), ctx, t, key, val)
 }
 
+// SetNX mocks base method.
+func (m *MockCacheService) SetNX(ctx context.Context, t time.Duration, key string, val any) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNX", ctx, t, key, val)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetNX indicates an expected call of SetNX.
+func (mr *MockCacheServiceMockRecorder) SetNX(ctx, t, key, val any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNX", reflect.TypeOf((*MockCacheService)(nil).SetNX), ctx, t, key, val)
+}
+
 // MockEmailService is a mock of EmailService interface.
 type MockEmailService struct {
 	ctrl     *gomock.Controller
@@ -1351,19 +2452,19 @@ func (m *MockEmailService) EXPECT() *MockEmailServiceMockRecorder {
 }
 
 // SendForgotPasswordEmail mocks base method.
-func (m *MockEmailService) SendForgotPasswordEmail(ctx context.Context, token, uid64, toEmail string) {
+func (m *MockEmailService) SendForgotPasswordEmail(ctx context.Context, token, toEmail string) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "SendForgotPasswordEmail", ctx, token, uid64, toEmail)
+	m.ctrl.Call(m, "SendForgotPasswordEmail", ctx, token, toEmail)
 }
 
 // SendForgotPasswordEmail indicates an expected call of SendForgotPasswordEmail.
-func (mr *MockEmailServiceMockRecorder) SendForgotPasswordEmail(ctx, token, uid64, toEmail any) *gomock.Call {
+func (mr *MockEmailServiceMockRecorder) SendForgotPasswordEmail(ctx, token, toEmail any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendForgotPasswordEmail", reflect.TypeOf((*MockEmailService)(nil).SendForgotPasswordEmail), ctx, token, uid64, toEmail)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendForgotPasswordEmail", reflect.TypeOf((*MockEmailService)(nil).SendForgotPasswordEmail), ctx, token, toEmail)
 }
 
 // SendLoginEmail mocks base method.
-func (m *MockEmailService) SendLoginEmail(arg0 context.Context, code int, toEmail string) {
+func (m *MockEmailService) SendLoginEmail(arg0 context.Context, code, toEmail string) {
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "SendLoginEmail", arg0, code, toEmail)
 }
@@ -1374,6 +2475,42 @@ func (mr *MockEmailServiceMockRecorder) SendLoginEmail(arg0, code, toEmail any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendLoginEmail", reflect.TypeOf((*MockEmailService)(nil).SendLoginEmail), arg0, code, toEmail)
 }
 
+// SendMagicLinkEmail mocks base method.
+func (m *MockEmailService) SendMagicLinkEmail(arg0 context.Context, token, toEmail string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SendMagicLinkEmail", arg0, token, toEmail)
+}
+
+// SendMagicLinkEmail indicates an expected call of SendMagicLinkEmail.
+func (mr *MockEmailServiceMockRecorder) SendMagicLinkEmail(arg0, token, toEmail any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMagicLinkEmail", reflect.TypeOf((*MockEmailService)(nil).SendMagicLinkEmail), arg0, token, toEmail)
+}
+
+// SendPasswordChangedEmail mocks base method.
+func (m *MockEmailService) SendPasswordChangedEmail(arg0 context.Context, toEmail, ip string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SendPasswordChangedEmail", arg0, toEmail, ip)
+}
+
+// SendPasswordChangedEmail indicates an expected call of SendPasswordChangedEmail.
+func (mr *MockEmailServiceMockRecorder) SendPasswordChangedEmail(arg0, toEmail, ip any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendPasswordChangedEmail", reflect.TypeOf((*MockEmailService)(nil).SendPasswordChangedEmail), arg0, toEmail, ip)
+}
+
+// SendRecoveryCodeUsedEmail mocks base method.
+func (m *MockEmailService) SendRecoveryCodeUsedEmail(arg0 context.Context, toEmail, ip string, remaining int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SendRecoveryCodeUsedEmail", arg0, toEmail, ip, remaining)
+}
+
+// SendRecoveryCodeUsedEmail indicates an expected call of SendRecoveryCodeUsedEmail.
+func (mr *MockEmailServiceMockRecorder) SendRecoveryCodeUsedEmail(arg0, toEmail, ip, remaining any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendRecoveryCodeUsedEmail", reflect.TypeOf((*MockEmailService)(nil).SendRecoveryCodeUsedEmail), arg0, toEmail, ip, remaining)
+}
+
 // SendUserCredentials mocks base method.
 func (m *MockEmailService) SendUserCredentials(arg0 context.Context, email, pass string) {
 	m.ctrl.T.Helper()
@@ -1385,3 +2522,91 @@ func (mr *MockEmailServiceMockRecorder) SendUserCredentials(arg0, email, pass an
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendUserCredentials", reflect.TypeOf((*MockEmailService)(nil).SendUserCredentials), arg0, email, pass)
 }
+
+// MockSMSService is a mock of SMSService interface.
+type MockSMSService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSMSServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockSMSServiceMockRecorder is the mock recorder for MockSMSService.
+type MockSMSServiceMockRecorder struct {
+	mock *MockSMSService
+}
+
+// NewMockSMSService creates a new mock instance.
+func NewMockSMSService(ctrl *gomock.Controller) *MockSMSService {
+	mock := &MockSMSService{ctrl: ctrl}
+	mock.recorder = &MockSMSServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSMSService) EXPECT() *MockSMSServiceMockRecorder {
+	return m.recorder
+}
+
+// Allowed mocks base method.
+func (m *MockSMSService) Allowed(phone string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Allowed", phone)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Allowed indicates an expected call of Allowed.
+func (mr *MockSMSServiceMockRecorder) Allowed(phone any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Allowed", reflect.TypeOf((*MockSMSService)(nil).Allowed), phone)
+}
+
+// Enabled mocks base method.
+func (m *MockSMSService) Enabled() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enabled")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Enabled indicates an expected call of Enabled.
+func (mr *MockSMSServiceMockRecorder) Enabled() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enabled", reflect.TypeOf((*MockSMSService)(nil).Enabled))
+}
+
+// SendForgotPasswordSMS mocks base method.
+func (m *MockSMSService) SendForgotPasswordSMS(ctx context.Context, phone, token string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SendForgotPasswordSMS", ctx, phone, token)
+}
+
+// SendForgotPasswordSMS indicates an expected call of SendForgotPasswordSMS.
+func (mr *MockSMSServiceMockRecorder) SendForgotPasswordSMS(ctx, phone, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendForgotPasswordSMS", reflect.TypeOf((*MockSMSService)(nil).SendForgotPasswordSMS), ctx, phone, token)
+}
+
+// SendLoginCode mocks base method.
+func (m *MockSMSService) SendLoginCode(ctx context.Context, channel, phone, code string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SendLoginCode", ctx, channel, phone, code)
+}
+
+// SendLoginCode indicates an expected call of SendLoginCode.
+func (mr *MockSMSServiceMockRecorder) SendLoginCode(ctx, channel, phone, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendLoginCode", reflect.TypeOf((*MockSMSService)(nil).SendLoginCode), ctx, channel, phone, code)
+}
+
+// SendPhoneVerificationCode mocks base method.
+func (m *MockSMSService) SendPhoneVerificationCode(ctx context.Context, channel, phone, code string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SendPhoneVerificationCode", ctx, channel, phone, code)
+}
+
+// SendPhoneVerificationCode indicates an expected call of SendPhoneVerificationCode.
+func (mr *MockSMSServiceMockRecorder) SendPhoneVerificationCode(ctx, channel, phone, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendPhoneVerificationCode", reflect.TypeOf((*MockSMSService)(nil).SendPhoneVerificationCode), ctx, channel, phone, code)
+}
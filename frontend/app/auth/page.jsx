@@ -25,7 +25,8 @@ export default function Page() {
     const [email, setEmail] = useState("")
     const [password, setPassword] = useState("")
     const [isCode, setIsCode] = useState(false)
-    const [digits, setDigits] = useState(['', '', '', ''])
+    const [digits, setDigits] = useState(Array(6).fill(''))
+    const [challenge, setChallenge] = useState("")
     const { executeRecaptcha } = useReCaptcha()
 
     const successAuth = async () => {
@@ -38,6 +39,19 @@ export default function Page() {
         window.location.href = "/"
     }
 
+    // Risky login of user without second factor is confirmed with code sent by email
+    const handleChallenge = async (r) => {
+        const data = await r.json().catch(() => ({}))
+        if (!data.methods?.includes("email")) {
+            return false
+        }
+
+        setChallenge(data.token)
+        setIsCode(true)
+        toast.info("Unusual sign in, enter code sent to your email")
+        return true
+    }
+
     const handleEmailChange = async (event) => {
         setEmail(event.target.value)
     }
@@ -67,6 +81,9 @@ export default function Page() {
             const data = await r.json()
             return toast.error(data.errors)
         }
+        if (r.status === 202 && await handleChallenge(r)) {
+            return
+        }
         const cLength = r.headers.get("content-length");
         if (cLength > 300) {
             return await successAuth()
@@ -95,6 +112,14 @@ export default function Page() {
             const data = await r.json()
             return toast.error(data.errors)
         }
+        if (r.status === 202 && await handleChallenge(r)) {
+            return
+        }
+
+        const data = await r.json().catch(() => ({}))
+        if (data.password_change_required) {
+            return router.push("/auth/password-change")
+        }
         return await successAuth()
     }
 
@@ -185,23 +210,27 @@ export default function Page() {
 
     useEffect(() => {
         let code = digits.join("")
-        if (code.length === 4) {
+        if (code.length === digits.length) {
             const CheckLoginCode = async () => {
                 try {
-                    const r = await fetch("/api/auth/email/check", {
+                    const r = await fetch(challenge ? "/api/auth/mfa/email/verify" : "/api/auth/email/check", {
                         method: 'POST',
                         headers: {
                             'Content-Type': 'application/json',
                             "User-Agent": navigator.userAgent,
                         },
-                        body: JSON.stringify({
+                        body: JSON.stringify(challenge ? {
+                            challenge: challenge,
+                            code: code
+                        } : {
                             email: email,
-                            code: parseInt(code)
+                            code: code
                         }),
                     })
 
                     if (r.ok) {
                         setIsCode(false)
+                        setChallenge("")
                         return await successAuth()
                     } else {
                         toast.error("Invalid code")
@@ -233,7 +262,7 @@ export default function Page() {
                                 setDigits={setDigits}
                             />
                         </div>
-                        <button onClick={() => setIsCode(false)} className={`primary-b`}>
+                        <button onClick={() => { setIsCode(false); setChallenge("") }} className={`primary-b`}>
                             <KeyboardArrowLeft />
                         </button>
                     </>
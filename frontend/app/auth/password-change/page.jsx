@@ -0,0 +1,78 @@
+"use client"
+import {useState} from "react";
+import {toast} from "sonner";
+import {KeyboardArrowRight, LockOutlined} from "@mui/icons-material"
+import {simpleChange} from "../../../lib/fd/fd"
+
+const SuccessMessage = "Пароль изменен"
+
+export default function Page(){
+    const [formData, setFormData] = useState({
+        current_password: "",
+        password: "",
+    })
+
+    const onSubmit = async (e) => {
+        e.preventDefault()
+
+        const r = await fetch("/api/auth/password/change", {
+            method: "POST",
+            headers: {
+                'Content-Type': 'application/json',
+                "User-Agent": navigator.userAgent,
+            },
+            body: JSON.stringify(formData),
+        })
+
+        if (!r.ok) {
+            const data = await r.json()
+            return toast.error(data.errors)
+        }
+
+        toast.success(SuccessMessage)
+        window.location.href = "/"
+    }
+
+    return (
+        <div>
+            <div className={`w-full h-screen flex flex-col justify-center items-center pt-20`}>
+                <form onSubmit={onSubmit}>
+                    <h1 className={`text-4xl font-bold tracking-widest uppercase mb-3`}>
+                        Password change
+                    </h1>
+                    <div className={`w-full flex flex-col gap-3`}>
+                        <div className={`icon-input-wrapper`}>
+                            <div className={`icon-container flex justify-center items-center`}>
+                                <LockOutlined fontSize={"medium"} />
+                            </div>
+                            <input
+                                type="password"
+                                name={`current_password`}
+                                placeholder={"Current password"}
+                                onChange={(e) => simpleChange(e, setFormData)}
+                                className={`icon-input`}
+                            />
+                        </div>
+                        <div className={`w-full flex gap-3`}>
+                            <div className={`icon-input-wrapper`}>
+                                <div className={`icon-container flex justify-center items-center`}>
+                                    <LockOutlined fontSize={"medium"} />
+                                </div>
+                                <input
+                                    type="password"
+                                    name={`password`}
+                                    placeholder={"New password"}
+                                    onChange={(e) => simpleChange(e, setFormData)}
+                                    className={`icon-input`}
+                                />
+                            </div>
+                            <button type={"submit"} className={`primary-b`}>
+                                <KeyboardArrowRight />
+                            </button>
+                        </div>
+                    </div>
+                </form>
+            </div>
+        </div>
+    )
+}
@@ -9,18 +9,15 @@ const SuccessMessage = "Пароль изменен"
 
 export default function Page(){
     const searchParams = useSearchParams()
-    const uidb64 = searchParams.get("uidb64")
     const token = searchParams.get("token")
     const [formData, setFormData] = useState({
         password: "",
-        uidb64: uidb64,
         token: token,
     })
 
     const onSubmit = async (e) => {
         e.preventDefault()
 
-        formData.token = parseInt(formData.token)
         const r = await fetch("/api/auth/recovery/check", {
             method: "POST",
             headers: {
@@ -1,13 +1,13 @@
 import {useRef} from "react"
 
 export default function CodeInput({digits, setDigits}) {
-    const inputRefs = [useRef(null), useRef(null), useRef(null), useRef(null)]
+    const inputRefs = useRef([])
 
     const handleCodeInputChange = (index, value) => {
         const newDigits = [...digits]
         newDigits[index] = value
         if (value.length === 1 && index < digits.length - 1) {
-            inputRefs[index + 1].current.focus()
+            inputRefs.current[index + 1].focus()
         }
         setDigits(newDigits)
     }
@@ -15,31 +15,25 @@ export default function CodeInput({digits, setDigits}) {
     const handleCodeKeyDown = (index, event) => {
         if (event.key === "Backspace" && index > 0) {
             if (digits[index] === "") {
-                inputRefs[index - 1].current.focus()
+                inputRefs.current[index - 1].focus()
             } else {
                 const newDigits = [...digits]
                 newDigits[index] = ""
                 setDigits(newDigits)
             }
         } else if (event.key === "ArrowLeft" && index > 0) {
-            inputRefs[index - 1].current.focus()
+            inputRefs.current[index - 1].focus()
         } else if (event.key === "ArrowRight" && index < digits.length - 1) {
-            inputRefs[index + 1].current.focus()
+            inputRefs.current[index + 1].focus()
         }
     }
 
     const handlePaste = (event) => {
         event.preventDefault()
         const clipboardData = event.clipboardData || window.clipboardData
-        const pastedText = clipboardData.getData("text")
-        if (/^\d{4}$/.test(pastedText)) {
-            const newDigits = pastedText.split("")
-            newDigits.forEach((digit, i) => {
-                if (i < digits.length) {
-                    inputRefs[i].current.value = digit
-                }
-            })
-            setDigits(newDigits)
+        const pastedText = clipboardData.getData("text").replace(/[\s-]/g, "")
+        if (pastedText.length === digits.length) {
+            setDigits(pastedText.split(""))
         }
     }
 
@@ -47,8 +41,8 @@ export default function CodeInput({digits, setDigits}) {
         digits.map((digit, index) => (
             <input
                 key={index}
-                ref={inputRefs[index]}
-                className="aspect-square w-1/4 appearance-none bg-zinc-800/80 ring-1 ring-zinc-700 py-2 px-3 text-center text-colors-rev text-6xl font-medium placeholder:text-zinc-400 placeholder:font-medium leading-tight focus:outline-none focus:shadow-outline"
+                ref={(el) => inputRefs.current[index] = el}
+                className="aspect-square min-w-0 flex-1 appearance-none bg-zinc-800/80 ring-1 ring-zinc-700 py-2 px-3 text-center text-colors-rev text-4xl font-medium placeholder:text-zinc-400 placeholder:font-medium leading-tight focus:outline-none focus:shadow-outline"
                 type="text"
                 value={digit}
                 maxLength={1}